@@ -1,16 +1,27 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
 	"os"
 
+	"github.com/lukcba/club-pulse-system-api/backend/internal/bootstrap"
 	accessDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/access/domain"
 	attendanceRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/infrastructure/repository"
 	bookingDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/booking/domain"
 	disciplineDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	disciplineSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/infrastructure/service"
 	membershipDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/membership/domain"
 	paymentDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/payment/domain"
+	userApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	userPostgres "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/infrastructure/postgres"
+	userRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/infrastructure/repository"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/database"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/encryption"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/middleware"
+	"gorm.io/gorm"
 )
 
 func main() {
@@ -28,6 +39,18 @@ func main() {
 	database.InitDB()
 	db := database.GetDB()
 
+	// Subcommand: go run ./cmd/migrate convert-tournaments [-dry-run]
+	if len(os.Args) > 1 && os.Args[1] == "convert-tournaments" {
+		convertTournaments(db, os.Args[2:])
+		return
+	}
+
+	// Subcommand: go run ./cmd/migrate rotate-keys [-data-keys] [-reencrypt]
+	if len(os.Args) > 1 && os.Args[1] == "rotate-keys" {
+		rotateKeys(db, os.Args[2:])
+		return
+	}
+
 	log.Println("Migrating All Models...")
 
 	err := db.AutoMigrate(
@@ -48,3 +71,88 @@ func main() {
 
 	log.Println("Migration successful!")
 }
+
+// convertTournaments copies disciplines tournaments, teams and matches into the championship model.
+func convertTournaments(db *gorm.DB, args []string) {
+	fs := flag.NewFlagSet("convert-tournaments", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "Run the conversion and roll it back, only reporting what would change")
+	_ = fs.Parse(args)
+
+	log.Printf("Converting disciplines tournaments into championships (dry-run=%v)...", *dryRun)
+	report, err := disciplineSvc.NewChampionshipMigrator(db).Migrate(context.Background(), *dryRun)
+	if err != nil {
+		log.Fatalf("Tournament conversion failed: %v", err)
+	}
+
+	log.Printf("Tournaments: %d, Teams: %d, Matches: %d, Skipped (already converted): %d",
+		report.Tournaments, report.Teams, report.Matches, report.Skipped)
+	for _, e := range report.Errors {
+		log.Printf("  error: %s", e)
+	}
+	if len(report.Errors) > 0 {
+		os.Exit(1)
+	}
+	log.Println("Tournament conversion finished!")
+}
+
+// rotateKeys rewraps the club data keys with the primary master key (ENCRYPTION_MASTER_KEY) so the
+// keys listed in ENCRYPTION_PREVIOUS_MASTER_KEYS can be retired. Optionally rotates every club data
+// key and re-encrypts the sensitive user fields with the new versions. Health documents uploaded
+// before encryption was configured are always encrypted in place.
+func rotateKeys(db *gorm.DB, args []string) {
+	fs := flag.NewFlagSet("rotate-keys", flag.ExitOnError)
+	dataKeys := fs.Bool("data-keys", false, "Also create a new data key version for every club")
+	reencrypt := fs.Bool("reencrypt", false, "Re-encrypt sensitive user fields with the active data keys")
+	_ = fs.Parse(args)
+
+	master, err := encryption.MasterKeysFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid master key configuration: %v", err)
+	}
+	if master == nil {
+		log.Fatal("ENCRYPTION_MASTER_KEY is not set")
+	}
+
+	ctx := middleware.SystemContext(context.Background())
+	envelope := encryption.NewEnvelope(master, encryption.NewGormDataKeyStore(db))
+
+	rewrapped, err := envelope.RotateMasterKey(ctx)
+	if err != nil {
+		log.Fatalf("Master key rotation failed after %d keys: %v", rewrapped, err)
+	}
+	log.Printf("Data keys rewrapped with master key %s: %d", master.PrimaryID(), rewrapped)
+
+	if *dataKeys {
+		rotated, err := envelope.RotateAllDataKeys(ctx)
+		if err != nil {
+			log.Fatalf("Data key rotation failed after %d clubs: %v", rotated, err)
+		}
+		log.Printf("Club data keys rotated: %d", rotated)
+	}
+
+	docRepo := userPostgres.NewUserDocumentRepository(db)
+	plainDocs, err := docRepo.ListUnencryptedFiles(ctx, userDomain.EncryptedDocumentTypes)
+	if err != nil {
+		log.Fatalf("Listing unencrypted documents failed: %v", err)
+	}
+	if len(plainDocs) > 0 {
+		documents := userApp.NewDocumentStorageService(docRepo, bootstrap.NewDocumentStorage(), nil, nil, nil)
+		documents.SetEncryptor(envelope)
+		count, err := documents.EncryptStoredFiles(ctx, plainDocs)
+		if err != nil {
+			log.Fatalf("Document encryption failed after %d of %d documents: %v", count, len(plainDocs), err)
+		}
+		log.Printf("Unencrypted health documents encrypted: %d", count)
+	}
+
+	if *reencrypt {
+		users := userRepo.NewPostgresUserRepository(db)
+		users.SetFieldCipher(envelope)
+		count, err := users.ReencryptSensitiveData(ctx)
+		if err != nil {
+			log.Fatalf("Re-encryption failed after %d users: %v", count, err)
+		}
+		log.Printf("Users re-encrypted: %d", count)
+	}
+	log.Println("Key rotation finished!")
+}
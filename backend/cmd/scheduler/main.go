@@ -8,16 +8,39 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/lukcba/club-pulse-system-api/backend/internal/bootstrap"
+	attendanceApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/application"
+	attendanceDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+	attendanceRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/infrastructure/repository"
+	attendanceJobs "github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/jobs"
 	championshipRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/infrastructure/repository"
 	championshipJobs "github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/jobs"
+	disciplineRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/infrastructure/repository"
+	disciplineSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/infrastructure/service"
+	disciplineJobs "github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/jobs"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/membership/application"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/membership/infrastructure/repository"
 	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	teamRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/infrastructure/repository"
+	teamJobs "github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/jobs"
+	userApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	userPostgres "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/infrastructure/postgres"
+	userRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/infrastructure/repository"
+	userJobs "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/jobs"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/database"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/encryption"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/middleware"
 	"github.com/robfig/cron/v3"
 	"gorm.io/gorm"
 )
 
+// jobContext marks scheduled work as done by the platform (see middleware.SystemContext), so
+// data-layer policies such as decrypting sensitive user fields treat it as system access.
+func jobContext() context.Context {
+	return middleware.SystemContext(context.Background())
+}
+
 func main() {
 	log.Println("🚀 Starting Billing Scheduler Service...")
 
@@ -75,7 +98,7 @@ func main() {
 		var clubIDs []string
 		db.Table("clubs").Select("id").Find(&clubIDs)
 		for _, clubID := range clubIDs {
-			if err := matchReminderJob.Run(context.Background(), clubID); err != nil {
+			if err := matchReminderJob.Run(jobContext(), clubID); err != nil {
 				log.Printf("⚠️ Match reminder failed for club %s: %v", clubID, err)
 			}
 		}
@@ -87,10 +110,271 @@ func main() {
 		log.Printf("📅 Scheduled match reminder job with pattern: %s", matchReminderSchedule)
 	}
 
-	// 5. Start scheduler
+	// 5. Schedule Volunteer Shift Reminder Job (hourly)
+	volunteerReminderSchedule := os.Getenv("VOLUNTEER_REMINDER_CRON_SCHEDULE")
+	if volunteerReminderSchedule == "" {
+		volunteerReminderSchedule = "0 30 * * * *" // Default: Every hour at :30
+	}
+
+	volunteerReminderJob := championshipJobs.NewVolunteerShiftReminderJob(
+		championshipRepo.NewPostgresVolunteerShiftRepository(db),
+		championshipRepo.NewPostgresVolunteerRepository(db),
+		notifService,
+		24,
+	)
+
+	_, err = c.AddFunc(volunteerReminderSchedule, func() {
+		log.Printf("🙌 [%s] Starting volunteer shift reminder job...", time.Now().Format(time.RFC3339))
+		var clubIDs []string
+		db.Table("clubs").Select("id").Find(&clubIDs)
+		for _, clubID := range clubIDs {
+			if err := volunteerReminderJob.Run(jobContext(), clubID); err != nil {
+				log.Printf("⚠️ Volunteer shift reminder failed for club %s: %v", clubID, err)
+			}
+		}
+		log.Printf("✅ [%s] Volunteer shift reminder job completed", time.Now().Format(time.RFC3339))
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to schedule volunteer shift reminder job: %v", err)
+	} else {
+		log.Printf("📅 Scheduled volunteer shift reminder job with pattern: %s", volunteerReminderSchedule)
+	}
+
+	// 6. Schedule Training Session Attendance Job (daily)
+	trainingAttendanceSchedule := os.Getenv("TRAINING_ATTENDANCE_CRON_SCHEDULE")
+	if trainingAttendanceSchedule == "" {
+		trainingAttendanceSchedule = "0 0 5 * * *" // Default: 5:00 AM daily
+	}
+
+	enrollmentRepo := disciplineRepo.NewPostgresEnrollmentRepository(db)
+	attendanceUseCases := attendanceApp.NewAttendanceUseCases(
+		attendanceRepo.NewPostgresAttendanceRepository(db),
+		userRepo.NewPostgresUserRepository(db),
+		repository.NewPostgresMembershipRepository(db),
+		enrollmentRepo,
+	)
+	sessionAttendanceJob := disciplineJobs.NewSessionAttendanceJob(
+		disciplineRepo.NewPostgresDisciplineRepository(db),
+		disciplineRepo.NewPostgresScheduleRepository(db),
+		attendanceUseCases,
+		1, // Today and tomorrow
+	)
+
+	_, err = c.AddFunc(trainingAttendanceSchedule, func() {
+		log.Printf("📋 [%s] Starting training session attendance job...", time.Now().Format(time.RFC3339))
+		var clubIDs []string
+		db.Table("clubs").Select("id").Find(&clubIDs)
+		for _, clubID := range clubIDs {
+			prepared, err := sessionAttendanceJob.Run(jobContext(), clubID)
+			if err != nil {
+				log.Printf("⚠️ Training session attendance failed for club %s: %v", clubID, err)
+			}
+			if prepared > 0 {
+				log.Printf("📋 Prepared %d attendance lists for club %s", prepared, clubID)
+			}
+		}
+		log.Printf("✅ [%s] Training session attendance job completed", time.Now().Format(time.RFC3339))
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to schedule training session attendance job: %v", err)
+	} else {
+		log.Printf("📅 Scheduled training session attendance job with pattern: %s", trainingAttendanceSchedule)
+	}
+
+	// 7. Schedule At-Risk Attendance Alert Job (daily)
+	attendanceAlertSchedule := os.Getenv("ATTENDANCE_ALERT_CRON_SCHEDULE")
+	if attendanceAlertSchedule == "" {
+		attendanceAlertSchedule = "0 0 22 * * *" // Default: 10:00 PM daily, after the evening sessions
+	}
+
+	attendanceAlertJob := attendanceJobs.NewAtRiskAlertJob(
+		attendanceRepo.NewPostgresAttendanceRepository(db),
+		disciplineSvc.NewAttendanceGroupDirectory(disciplineRepo.NewPostgresDisciplineRepository(db)),
+		enrollmentRepo,
+		userRepo.NewPostgresUserRepository(db),
+		notifService,
+		attendanceDomain.DefaultAlertPolicy,
+		28, // Four weeks
+	)
+
+	_, err = c.AddFunc(attendanceAlertSchedule, func() {
+		log.Printf("⚠️ [%s] Starting at-risk attendance alert job...", time.Now().Format(time.RFC3339))
+		var clubIDs []string
+		db.Table("clubs").Select("id").Find(&clubIDs)
+		for _, clubID := range clubIDs {
+			raised, err := attendanceAlertJob.Run(jobContext(), clubID)
+			if err != nil {
+				log.Printf("⚠️ At-risk attendance alerts failed for club %s: %v", clubID, err)
+			}
+			if raised > 0 {
+				log.Printf("⚠️ Raised %d attendance alerts for club %s", raised, clubID)
+			}
+		}
+		log.Printf("✅ [%s] At-risk attendance alert job completed", time.Now().Format(time.RFC3339))
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to schedule at-risk attendance alert job: %v", err)
+	} else {
+		log.Printf("📅 Scheduled at-risk attendance alert job with pattern: %s", attendanceAlertSchedule)
+	}
+
+	// 8. Schedule Match Availability Reminder Job (hourly)
+	availabilityReminderSchedule := os.Getenv("AVAILABILITY_REMINDER_CRON_SCHEDULE")
+	if availabilityReminderSchedule == "" {
+		availabilityReminderSchedule = "0 15 * * * *" // Default: Every hour at :15
+	}
+
+	availabilityReminderJob := teamJobs.NewAvailabilityReminderJob(
+		teamRepo.NewPostgresTeamRepository(db),
+		teamRepo.NewPostgresLineupRepository(db),
+		enrollmentRepo,
+		notifService,
+		48, // Two days before the meetup
+	)
+
+	_, err = c.AddFunc(availabilityReminderSchedule, func() {
+		log.Printf("⚽ [%s] Starting match availability reminder job...", time.Now().Format(time.RFC3339))
+		var clubIDs []string
+		db.Table("clubs").Select("id").Find(&clubIDs)
+		for _, clubID := range clubIDs {
+			sent, err := availabilityReminderJob.Run(jobContext(), clubID)
+			if err != nil {
+				log.Printf("⚠️ Match availability reminder failed for club %s: %v", clubID, err)
+			}
+			if sent > 0 {
+				log.Printf("⚽ Sent %d availability reminders for club %s", sent, clubID)
+			}
+		}
+		log.Printf("✅ [%s] Match availability reminder job completed", time.Now().Format(time.RFC3339))
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to schedule match availability reminder job: %v", err)
+	} else {
+		log.Printf("📅 Scheduled match availability reminder job with pattern: %s", availabilityReminderSchedule)
+	}
+
+	// 9. Schedule Document Review Escalation Job (hourly)
+	reviewEscalationSchedule := os.Getenv("DOCUMENT_REVIEW_ESCALATION_CRON_SCHEDULE")
+	if reviewEscalationSchedule == "" {
+		reviewEscalationSchedule = "0 45 * * * *" // Default: Every hour at :45
+	}
+
+	reviewEscalationJob := userJobs.NewDocumentReviewEscalationJob(userApp.NewDocumentReviewService(
+		userPostgres.NewUserDocumentRepository(db),
+		userRepo.NewPostgresUserRepository(db),
+		notifService,
+		userPostgres.NewHealthDataAccessLogRepository(db),
+	))
+
+	_, err = c.AddFunc(reviewEscalationSchedule, func() {
+		log.Printf("🩺 [%s] Starting document review escalation job...", time.Now().Format(time.RFC3339))
+		var clubIDs []string
+		db.Table("clubs").Select("id").Find(&clubIDs)
+		for _, clubID := range clubIDs {
+			escalated, err := reviewEscalationJob.Run(jobContext(), clubID)
+			if err != nil {
+				log.Printf("⚠️ Document review escalation failed for club %s: %v", clubID, err)
+			}
+			if escalated > 0 {
+				log.Printf("🩺 Escalated %d overdue document reviews for club %s", escalated, clubID)
+			}
+		}
+		log.Printf("✅ [%s] Document review escalation job completed", time.Now().Format(time.RFC3339))
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to schedule document review escalation job: %v", err)
+	} else {
+		log.Printf("📅 Scheduled document review escalation job with pattern: %s", reviewEscalationSchedule)
+	}
+
+	// 10. Schedule GDPR Data Subject Request Job (every 15 minutes)
+	dataRequestSchedule := os.Getenv("DATA_SUBJECT_REQUEST_CRON_SCHEDULE")
+	if dataRequestSchedule == "" {
+		dataRequestSchedule = "0 */15 * * * *" // Default: Every 15 minutes
+	}
+
+	// Needs the same document storage and encryption keys as the API to read files and store the exports
+	userDocumentRepository := userPostgres.NewUserDocumentRepository(db)
+	userRepository := userRepo.NewPostgresUserRepository(db)
+	documentFiles := bootstrap.NewDocumentStorage()
+	documentStorage := userApp.NewDocumentStorageService(userDocumentRepository, documentFiles, nil, nil, userPostgres.NewHealthDataAccessLogRepository(db))
+	dataRequestService := userApp.NewDataSubjectRequestService(
+		userPostgres.NewDataSubjectRequestRepository(db),
+		userPostgres.NewPersonalDataRepository(db),
+		userApp.NewUserUseCases(userRepository, userRepo.NewPostgresFamilyGroupRepository(db)),
+		userRepository,
+		userDocumentRepository,
+		notifService,
+	)
+	dataRequestService.SetArchiveStorage(documentStorage, documentFiles)
+	if envelope := bootstrap.NewEnvelope(encryption.NewGormDataKeyStore(db)); envelope != nil {
+		userRepository.SetFieldCipher(envelope)
+		documentStorage.SetEncryptor(envelope)
+		dataRequestService.SetEncryptor(envelope)
+	}
+	dataRequestJob := userJobs.NewDataSubjectRequestJob(dataRequestService)
+
+	_, err = c.AddFunc(dataRequestSchedule, func() {
+		log.Printf("🔐 [%s] Starting data subject request job...", time.Now().Format(time.RFC3339))
+		var clubIDs []string
+		db.Table("clubs").Select("id").Find(&clubIDs)
+		for _, clubID := range clubIDs {
+			completed, err := dataRequestJob.Run(jobContext(), clubID)
+			if err != nil {
+				log.Printf("⚠️ Data subject request processing failed for club %s: %v", clubID, err)
+			}
+			if completed > 0 {
+				log.Printf("🔐 Completed %d data subject requests for club %s", completed, clubID)
+			}
+		}
+		log.Printf("✅ [%s] Data subject request job completed", time.Now().Format(time.RFC3339))
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to schedule data subject request job: %v", err)
+	} else {
+		log.Printf("📅 Scheduled data subject request job with pattern: %s", dataRequestSchedule)
+	}
+
+	// 11. Schedule Data Retention Job (daily)
+	retentionSchedule := os.Getenv("DATA_RETENTION_CRON_SCHEDULE")
+	if retentionSchedule == "" {
+		retentionSchedule = "0 30 3 * * *" // Default: 3:30 AM daily
+	}
+	retentionDryRun := os.Getenv("DATA_RETENTION_DRY_RUN") == "true" // Only record what would be removed
+
+	retentionService := userApp.NewDataRetentionService(userPostgres.NewDataRetentionRepository(db), userPostgres.NewExpiredDataRepository(db), userRepository)
+	retentionService.SetUserEraser(dataRequestService)
+	retentionJob := userJobs.NewDataRetentionJob(retentionService, retentionDryRun)
+
+	_, err = c.AddFunc(retentionSchedule, func() {
+		log.Printf("🧹 [%s] Starting data retention job (dry run: %t)...", time.Now().Format(time.RFC3339), retentionDryRun)
+		var clubIDs []string
+		db.Table("clubs").Select("id").Find(&clubIDs)
+		for _, clubID := range clubIDs {
+			run, err := retentionJob.Run(jobContext(), clubID)
+			if err != nil {
+				log.Printf("⚠️ Data retention failed for club %s: %v", clubID, err)
+				continue
+			}
+			if run.Status == userDomain.DataRetentionFailed {
+				log.Printf("⚠️ Data retention run %s for club %s finished with errors", run.ID, clubID)
+			}
+			if affected := run.TotalAffected(); affected > 0 {
+				log.Printf("🧹 Data retention run %s reached %d records for club %s", run.ID, affected, clubID)
+			}
+		}
+		log.Printf("✅ [%s] Data retention job completed", time.Now().Format(time.RFC3339))
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to schedule data retention job: %v", err)
+	} else {
+		log.Printf("📅 Scheduled data retention job with pattern: %s", retentionSchedule)
+	}
+
+	// 12. Start scheduler
 	c.Start()
 
-	// 5. Wait for shutdown signal
+	// 13. Wait for shutdown signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
@@ -118,8 +402,10 @@ func processAllClubs(db *gorm.DB) error {
 	scholarshipRepo := repository.NewPostgresScholarshipRepository(db)
 	subscriptionRepo := repository.NewPostgresSubscriptionRepository(db)
 	useCases := application.NewMembershipUseCases(membershipRepo, scholarshipRepo, subscriptionRepo)
+	// Training group fees are billed together with the membership fee
+	useCases.RegisterFeeSource(disciplineRepo.NewPostgresEnrollmentRepository(db))
 
-	ctx := context.Background()
+	ctx := jobContext()
 	totalProcessed := 0
 	failedClubs := []string{}
 
@@ -9,8 +9,11 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/encryption"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/logger"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/middleware"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/storage"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/websocket"
 
 	// Module Imports
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/auth/application"
@@ -36,6 +39,7 @@ import (
 	bookingHTTP "github.com/lukcba/club-pulse-system-api/backend/internal/modules/booking/infrastructure/http"
 	bookingRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/booking/infrastructure/repository"
 	championshipApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	championshipDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
 	championshipHttp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/infrastructure/http"
 	championshipRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/infrastructure/repository"
 	championshipSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/infrastructure/service"
@@ -51,6 +55,7 @@ import (
 	disciplineApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/application"
 	disciplineHttp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/infrastructure/http"
 	disciplineRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/infrastructure/repository"
+	disciplineSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/infrastructure/service"
 
 	paymentApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/payment/application"
 	paymentGateway "github.com/lukcba/club-pulse-system-api/backend/internal/modules/payment/infrastructure/gateways"
@@ -69,8 +74,10 @@ import (
 	storeRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/store/infrastructure/repository"
 
 	teamApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/application"
+	teamDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
 	teamHttp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/infrastructure/http"
 	teamRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/infrastructure/repository"
+	teamSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/infrastructure/service"
 
 	gamificationApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/gamification/application"
 	gamificationHttp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/gamification/infrastructure/http"
@@ -98,6 +105,9 @@ func NewApp() (*App, error) {
 	// 3. Setup Health Check (Basic) - We can move this to a module if it grows
 	server.Engine.GET("/health", healthCheckHandler(infra))
 
+	// WebSocket endpoint (clients subscribe to topics such as "match:<id>")
+	server.Engine.GET("/ws/live", websocket.HandleWebSocket(infra.Hub))
+
 	// Static Files for Uploads
 	// Ensure "uploads" directory exists or create it on startup if needed,
 	// but usually handled by deployment. For now just serve it.
@@ -183,10 +193,22 @@ func registerModules(api *gin.RouterGroup, infra *Infrastructure, tenantMiddlewa
 
 	// --- Module: User ---
 	userRepository := userRepo.NewPostgresUserRepository(db)
+	// Encryption at rest (contactos de emergencia, N° de afiliado y archivos de salud) si hay master key configurada
+	envelope := NewEnvelope(encryption.NewGormDataKeyStore(db))
+	if envelope != nil {
+		userRepository.SetFieldCipher(envelope)
+	}
 	familyGroupRepository := userRepo.NewPostgresFamilyGroupRepository(db)
 	userUseCase := userApp.NewUserUseCases(userRepository, familyGroupRepository)
 	userHandler := userHttp.NewUserHandler(userUseCase)
 
+	// Consentimientos con políticas versionadas. Auth, /consents y las solicitudes de derechos quedan
+	// sin el gate de re-consentimiento; el resto de las rutas lo exige.
+	consentService := userApp.NewConsentService(userPostgres.NewConsentRepository(db), userRepository)
+	userHttp.RegisterConsentRoutes(api, userHttp.NewConsentHandler(consentService), authMiddleware, tenantMiddleware)
+	authWithoutConsentGate := authMiddleware
+	authMiddleware = authHttp.AuthMiddleware(tokenService, userHttp.ConsentGate(consentService))
+
 	userHttp.RegisterRoutes(api, userHandler, authMiddleware, tenantMiddleware)
 	userHttp.RegisterPublicRoutes(api, userHandler)
 
@@ -255,26 +277,27 @@ func registerModules(api *gin.RouterGroup, infra *Infrastructure, tenantMiddlewa
 	accessHTTP.RegisterRoutes(api, accessHandler, authMiddleware, tenantMiddleware)
 
 	// --- Module: Attendance (New) ---
+	// Training group lists are built from the disciplines enrollment roster
+	enrollmentRepo := disciplineRepo.NewPostgresEnrollmentRepository(db)
 	attendanceRepository := attendanceRepo.NewPostgresAttendanceRepository(db)
-	attendanceUseCase := attendanceApp.NewAttendanceUseCases(attendanceRepository, userRepository, membershipRepository)
+	attendanceUseCase := attendanceApp.NewAttendanceUseCases(attendanceRepository, userRepository, membershipRepository, enrollmentRepo)
+	// QR check-in codes are signed with their own secret when set, otherwise with the JWT one
+	checkInSecret := os.Getenv("ATTENDANCE_QR_SECRET")
+	if checkInSecret == "" {
+		checkInSecret = jwtSecret
+	}
+	attendanceUseCase.SetCheckInSigner(attendanceApp.NewCheckInSigner(checkInSecret, 5*time.Minute))
 	attendanceHandler := attendanceHTTP.NewAttendanceHandler(attendanceUseCase)
 
 	attendanceHTTP.RegisterRoutes(api, attendanceHandler, authMiddleware, tenantMiddleware)
 
-	// --- Module: Disciplines (New) ---
-	dRepo := disciplineRepo.NewPostgresDisciplineRepository(db)
-	tRepo := disciplineRepo.NewPostgresTournamentRepository(db)
-	dUseCase := disciplineApp.NewDisciplineUseCases(dRepo, tRepo, userRepository)
-	dHandler := disciplineHttp.NewDisciplineHandler(dUseCase)
-
-	disciplineHttp.RegisterRoutes(api, dHandler, authMiddleware, tenantMiddleware)
-
 	paymentHandler := paymentHttp.NewPaymentHandler(paymentUseCases)
 	paymentHttp.RegisterRoutes(api, paymentHandler, authMiddleware, tenantMiddleware)
 
 	// --- Module: Club (Super Admin) ---
 	// clubRepository already initialized above
 	clubUseCase := clubApp.NewClubUseCases(clubRepository, clubRepository, clubRepository, notifier)
+	clubUseCase.SetMarketingAudience(consentService) // Las noticias solo se envían a quienes aceptaron marketing
 	clubHandler := clubHttp.NewClubHandler(clubUseCase)
 
 	// Register Club Routes
@@ -288,8 +311,31 @@ func registerModules(api *gin.RouterGroup, infra *Infrastructure, tenantMiddlewa
 
 	// --- Module: Championship ---
 	champRepo := championshipRepo.NewPostgresChampionshipRepository(db)
+	matchEventRepo := championshipRepo.NewPostgresMatchEventRepository(db)
 	championshipBookingAdapter := championshipSvc.NewChampionshipBookingAdapter(bookingUseCase) // Use bookingApp instance
-	champUseCases := championshipApp.NewChampionshipUseCases(champRepo, championshipBookingAdapter, userUseCase)
+	champUseCases := championshipApp.NewChampionshipUseCases(champRepo, championshipBookingAdapter, userUseCase, matchEventRepo)
+
+	// --- Module: Disciplines (New) ---
+	// Tournaments are served by the championship module through a compatibility adapter
+	dRepo := disciplineRepo.NewPostgresDisciplineRepository(db)
+	tRepo := disciplineSvc.NewChampionshipTournamentAdapter(champUseCases, dRepo)
+	dUseCase := disciplineApp.NewDisciplineUseCases(dRepo, tRepo, userRepository, enrollmentRepo)
+	dHandler := disciplineHttp.NewDisciplineHandler(dUseCase)
+
+	disciplineHttp.RegisterRoutes(api, dHandler, authMiddleware, tenantMiddleware)
+
+	// Training group enrollments (roster, waitlist, staff); group fees are added to the monthly billing
+	enrollmentUseCase := disciplineApp.NewEnrollmentUseCases(dRepo, enrollmentRepo, userRepository)
+	disciplineHttp.RegisterEnrollmentRoutes(api, disciplineHttp.NewEnrollmentHandler(enrollmentUseCase), authMiddleware, tenantMiddleware)
+	membershipUseCase.RegisterFeeSource(enrollmentRepo)
+
+	// Structured training sessions: courts are held with CLASS recurring rules of the booking module
+	scheduleUseCase := disciplineApp.NewScheduleUseCases(dRepo, disciplineRepo.NewPostgresScheduleRepository(db), disciplineSvc.NewClassBookingAdapter(bookingUseCase))
+	disciplineHttp.RegisterScheduleRoutes(api, disciplineHttp.NewScheduleHandler(scheduleUseCase), authMiddleware, tenantMiddleware)
+
+	// Attendance analytics by training group (groups are resolved through the disciplines module)
+	attendanceAnalytics := attendanceApp.NewAnalyticsUseCases(attendanceRepository, disciplineSvc.NewAttendanceGroupDirectory(dRepo))
+	attendanceHTTP.RegisterAnalyticsRoutes(api, attendanceHTTP.NewAnalyticsHandler(attendanceAnalytics), authMiddleware, tenantMiddleware)
 
 	// Volunteer Service (Gestión de Voluntarios)
 	volunteerRepo := championshipRepo.NewPostgresVolunteerRepository(db)
@@ -297,6 +343,10 @@ func registerModules(api *gin.RouterGroup, infra *Infrastructure, tenantMiddlewa
 
 	championshipHttp.NewChampionshipHandler(champUseCases, volunteerService, clubUseCase).RegisterRoutes(api, authMiddleware, tenantMiddleware)
 
+	// Match Events (Goles, tarjetas, figura) y estadísticas por jugador
+	matchEventService := championshipApp.NewMatchEventService(champRepo, matchEventRepo)
+	championshipHttp.NewMatchEventHandler(matchEventService).RegisterRoutes(api, authMiddleware, tenantMiddleware)
+
 	// --- Module: Team (Matches, Availability, Player Status) ---
 	teamRepository := teamRepo.NewPostgresTeamRepository(db)
 	teamUseCase := teamApp.NewTeamUseCases(teamRepository)
@@ -313,10 +363,127 @@ func registerModules(api *gin.RouterGroup, infra *Infrastructure, tenantMiddlewa
 
 	// Travel Event Service (Gestión de Viajes)
 	travelEventRepo := teamRepo.NewPostgresTravelEventRepository(db)
-	travelEventService := teamApp.NewTravelEventService(travelEventRepo)
+	// Los menores solo confirman con la autorización de viaje firmada por su padre/madre
+	travelAuthorizationService := teamApp.NewTravelAuthorizationService(travelEventRepo, teamRepo.NewPostgresTravelAuthorizationRepository(db), userRepository, notifier)
+	travelEventService := teamApp.NewTravelEventService(travelEventRepo, travelAuthorizationService)
 
 	teamHandler := teamHttp.NewTeamHandler(teamUseCase, playerStatusService, travelEventService)
 	teamHttp.RegisterRoutes(api, teamHandler, authMiddleware, tenantMiddleware)
+	teamHttp.RegisterTravelAuthorizationRoutes(api, teamHttp.NewTravelAuthorizationHandler(travelAuthorizationService), authMiddleware, tenantMiddleware)
+
+	// Travel Logistics (cobro per cápita vía Payment y carpooling con autorización de menores)
+	travelLogisticsService := teamApp.NewTravelLogisticsService(travelEventRepo, teamRepo.NewPostgresTravelLogisticsRepository(db), teamSvc.NewTravelPaymentAdapter(paymentUseCases), userRepository, notifier)
+	paymentUseCases.RegisterResponder(teamDomain.TravelChargeReferenceType, travelLogisticsService)
+	teamHttp.RegisterTravelLogisticsRoutes(api, teamHttp.NewTravelLogisticsHandler(travelLogisticsService), authMiddleware, tenantMiddleware)
+
+	// Lineup Builder (Convocatoria y alineación sobre el plantel del grupo)
+	lineupService := teamApp.NewLineupService(teamRepository, teamRepo.NewPostgresLineupRepository(db), enrollmentRepo, playerStatusService, notifier)
+	teamHttp.RegisterLineupRoutes(api, teamHttp.NewLineupHandler(lineupService), authMiddleware, tenantMiddleware)
+
+	// Suspensions (Sanciones por tarjetas) y habilitación de jugadores para torneos
+	// Combina documentación (User) y semáforo del jugador (Team), por eso se arma después de ambos
+	eligibilityService := userApp.NewEligibilityService(userDocumentRepo)
+	eligibilityService.SetRules(userPostgres.NewEligibilityRuleRepository(db), userRepository)
+	playerStatusService.SetEligibilityChecker(eligibilityService)
+
+	// User Documents (DNI, apto médico): archivos en el FileStorage configurado y links firmados de descarga
+	healthDataAccessLog := userPostgres.NewHealthDataAccessLogRepository(db)
+	documentURLSecret := os.Getenv("DOCUMENT_URL_SECRET")
+	if documentURLSecret == "" {
+		documentURLSecret = jwtSecret
+	}
+	documentFiles := NewDocumentStorage()
+	documentScanner := NewDocumentScanner()
+	documentStorageService := userApp.NewDocumentStorageService(
+		userDocumentRepo,
+		documentFiles,
+		documentScanner,
+		storage.NewURLSigner(documentURLSecret, 5*time.Minute),
+		healthDataAccessLog,
+	)
+	if envelope != nil {
+		documentStorageService.SetEncryptor(envelope)
+	}
+	// Certificados médicos de los avisos de ausencia: mismo storage, cifrado y registro de accesos
+	attendanceUseCase.SetDocumentStorage(documentFiles, documentScanner)
+	attendanceUseCase.SetAccessLogger(healthDataAccessLog)
+	if envelope != nil {
+		attendanceUseCase.SetEncryptor(envelope)
+	}
+	// Cola de revisión: toma por revisor, motivos de rechazo y SLA (el escalamiento corre en el scheduler)
+	documentReviewService := userApp.NewDocumentReviewService(userDocumentRepo, userRepository, notifier, healthDataAccessLog)
+	documentHandler := userHttp.NewDocumentHandler(userDocumentRepo, eligibilityService, documentStorageService, documentReviewService)
+	userHttp.RegisterDocumentRoutes(api, documentHandler, authMiddleware, tenantMiddleware)
+
+	// Carpeta de Liga: el equipo es un grupo de entrenamiento; el ZIP incluye los archivos del storage
+	leagueExportService := userApp.NewLeagueExportService(userDocumentRepo, eligibilityService)
+	leagueExportService.SetPackageSources(disciplineSvc.NewLeagueRosterDirectory(dRepo, enrollmentRepo), userRepository, documentStorageService)
+	userHttp.RegisterLeagueExportRoutes(api, userHttp.NewLeagueExportHandler(leagueExportService), authMiddleware, tenantMiddleware)
+
+	// Solicitudes de derechos GDPR: se verifican por email y se procesan en el scheduler.
+	// /users/me/data-export y /users/me/gdpr-erasure pasan a encolar una solicitud.
+	dataSubjectRequestService := userApp.NewDataSubjectRequestService(
+		userPostgres.NewDataSubjectRequestRepository(db),
+		userPostgres.NewPersonalDataRepository(db),
+		userUseCase,
+		userRepository,
+		userDocumentRepo,
+		notifier,
+	)
+	dataSubjectRequestService.SetArchiveStorage(documentStorageService, documentFiles)
+	if envelope != nil {
+		dataSubjectRequestService.SetEncryptor(envelope)
+	}
+	userHandler.SetDataSubjectRequests(dataSubjectRequestService)
+	userHttp.RegisterDataSubjectRequestRoutes(api, userHttp.NewDataSubjectRequestHandler(dataSubjectRequestService), authWithoutConsentGate, tenantMiddleware)
+
+	// Retención de datos: plazos por categoría; el scheduler aplica la retención, acá se configura y se audita
+	dataRetentionService := userApp.NewDataRetentionService(userPostgres.NewDataRetentionRepository(db), userPostgres.NewExpiredDataRepository(db), userRepository)
+	dataRetentionService.SetUserEraser(dataSubjectRequestService)
+	userHttp.RegisterDataRetentionRoutes(api, userHttp.NewDataRetentionHandler(dataRetentionService), authMiddleware, tenantMiddleware)
+	championshipEligibilityAdapter := championshipSvc.NewChampionshipEligibilityAdapter(eligibilityService, playerStatusService, dRepo)
+	suspensionRepo := championshipRepo.NewPostgresSuspensionRepository(db)
+	suspensionService := championshipApp.NewSuspensionService(champRepo, matchEventRepo, suspensionRepo, championshipEligibilityAdapter)
+	matchEventService.RegisterListener(suspensionService)
+	champUseCases.RegisterResultListener(suspensionService)
+	championshipHttp.NewSuspensionHandler(suspensionService).RegisterRoutes(api, authMiddleware, tenantMiddleware)
+
+	// Live Scoring (marcador en vivo difundido por WebSocket en "match:<id>" y "tournament:<id>")
+	liveMatchService := championshipApp.NewLiveMatchService(champRepo, champRepo, matchEventRepo, volunteerRepo, infra.Hub)
+	matchEventService.RegisterListener(liveMatchService)
+	champUseCases.RegisterResultListener(liveMatchService)
+	championshipHttp.NewLiveMatchHandler(liveMatchService, clubUseCase).RegisterRoutes(api, authMiddleware, tenantMiddleware)
+
+	// Inter-club Registration (equipos de otros clubes, arancel vía Payment y aprobación del organizador)
+	teamRegistrationRepo := championshipRepo.NewPostgresTeamRegistrationRepository(db)
+	championshipPaymentAdapter := championshipSvc.NewChampionshipPaymentAdapter(paymentUseCases)
+	teamRegistrationService := championshipApp.NewTeamRegistrationService(champRepo, teamRegistrationRepo, championshipPaymentAdapter)
+	teamRegistrationService.SetDocumentStorage(documentFiles, documentScanner)
+	if envelope != nil {
+		teamRegistrationService.SetEncryptor(envelope)
+	}
+	paymentUseCases.RegisterResponder(championshipDomain.RegistrationReferenceType, teamRegistrationService)
+	championshipHttp.NewTeamRegistrationHandler(teamRegistrationService, clubUseCase).RegisterRoutes(api, authMiddleware, tenantMiddleware)
+
+	// Match Officials (registro de árbitros, disponibilidad, designación automática y honorarios)
+	officialRepo := championshipRepo.NewPostgresOfficialRepository(db)
+	officialService := championshipApp.NewOfficialService(champRepo, officialRepo)
+	matchEventService.SetAssignmentRepositories(volunteerRepo, officialRepo)
+	championshipHttp.NewOfficialHandler(officialService).RegisterRoutes(api, authMiddleware, tenantMiddleware)
+
+	// Volunteer Shifts (auto-inscripción a turnos, check-in/out y horas acreditables como LABOR_EXCHANGE)
+	volunteerShiftRepo := championshipRepo.NewPostgresVolunteerShiftRepository(db)
+	volunteerShiftService := championshipApp.NewVolunteerShiftService(champRepo, volunteerRepo, volunteerShiftRepo, volunteerService, championshipPaymentAdapter)
+	championshipHttp.NewVolunteerShiftHandler(volunteerShiftService).RegisterRoutes(api, authMiddleware, tenantMiddleware)
+
+	// Public Tournament Pages (llave eliminatoria, fixture CSV/PDF y widget embebible con ETag)
+	publicTournamentService := championshipApp.NewPublicTournamentService(champRepo, champRepo)
+	championshipHttp.NewPublicTournamentHandler(publicTournamentService, clubUseCase).RegisterRoutes(api)
+
+	// Match Rescheduling (propuestas entre capitanes, postergación y cambio de reserva)
+	rescheduleRepo := championshipRepo.NewPostgresRescheduleRepository(db)
+	rescheduleService := championshipApp.NewMatchRescheduleService(champRepo, rescheduleRepo, volunteerRepo, championshipBookingAdapter, notifier)
+	championshipHttp.NewRescheduleHandler(rescheduleService).RegisterRoutes(api, authMiddleware, tenantMiddleware)
 
 	// --- Module: Gamification ---
 	badgeRepository := gamificationRepo.NewPostgresBadgeRepository(db)
@@ -380,6 +547,94 @@ func registerModules(api *gin.RouterGroup, infra *Infrastructure, tenantMiddlewa
 	// I will wire what I have, and then Fix the Club module in a subsequent step.
 }
 
+// NewEnvelope builds the envelope encryption from ENCRYPTION_MASTER_KEY; nil when it is not configured,
+// which is only allowed outside release mode.
+// Shared with the scheduler, which reads and writes encrypted files too.
+func NewEnvelope(store encryption.DataKeyStore) *encryption.Envelope {
+	master, err := encryption.MasterKeysFromEnv()
+	if err != nil {
+		logger.Error("CRITICAL: Invalid encryption master key configuration: " + err.Error())
+		panic("encryption master key is invalid")
+	}
+	if master == nil {
+		if os.Getenv("GIN_MODE") == "release" {
+			logger.Error("CRITICAL: ENCRYPTION_MASTER_KEY environment variable is required in production")
+			panic("ENCRYPTION_MASTER_KEY is required in production")
+		}
+		logger.Warn("ENCRYPTION_MASTER_KEY not set: health documents and sensitive user fields are stored unencrypted. DO NOT USE IN PRODUCTION!")
+		return nil
+	}
+	logger.Info("Encryption at rest enabled (master key " + master.PrimaryID() + ")")
+	return encryption.NewEnvelope(master, store)
+}
+
+// NewDocumentStorage elige el backend de archivos según STORAGE_DRIVER ("local" por defecto o "s3").
+// Una configuración de S3 inválida o un driver desconocido impiden el arranque.
+// El directorio local no debe quedar dentro de ./uploads, que se sirve como estático.
+// También lo usa el scheduler para las exportaciones de datos personales.
+func NewDocumentStorage() storage.FileStorage {
+	switch driver := os.Getenv("STORAGE_DRIVER"); driver {
+	case "s3":
+		s3Storage, err := storage.NewS3Storage(storage.S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			Region:    os.Getenv("S3_REGION"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+		}, nil)
+		if err != nil {
+			// Caer al disco local guardaría los documentos donde nadie los busca: mejor no arrancar
+			logger.Error("CRITICAL: Invalid S3 document storage configuration: " + err.Error())
+			panic("invalid S3 document storage configuration")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := s3Storage.EnsureBucket(ctx); err != nil {
+			logger.Warn("Could not verify document storage bucket: " + err.Error())
+		}
+		logger.Info("S3 document storage enabled")
+		return s3Storage
+	case "", "local":
+	default:
+		logger.Error("CRITICAL: Unknown STORAGE_DRIVER " + driver + " (use local or s3)")
+		panic("unknown document storage driver")
+	}
+
+	root := os.Getenv("STORAGE_LOCAL_PATH")
+	if root == "" {
+		root = "./data/documents"
+	}
+	localStorage, err := storage.NewLocalStorage(root)
+	if err != nil {
+		logger.Error("CRITICAL: Failed to initialize local document storage: " + err.Error())
+		panic("document storage is not available")
+	}
+	return localStorage
+}
+
+// NewDocumentScanner configura el antivirus que se aplica a cada archivo subido (DOCUMENT_SCANNER).
+// "http" envía el archivo al servicio de DOCUMENT_SCANNER_URL; sin configurar se aceptan todos.
+func NewDocumentScanner() storage.Scanner {
+	switch driver := os.Getenv("DOCUMENT_SCANNER"); driver {
+	case "http":
+		scanner, err := storage.NewHTTPScanner(os.Getenv("DOCUMENT_SCANNER_URL"), nil)
+		if err != nil {
+			logger.Error("CRITICAL: Invalid document scanner configuration: " + err.Error())
+			panic("invalid document scanner configuration")
+		}
+		logger.Info("HTTP document scanner enabled")
+		return scanner
+	case "", "none":
+		if os.Getenv("GIN_MODE") == "release" {
+			logger.Warn("DOCUMENT_SCANNER not set, uploaded documents are not scanned")
+		}
+		return storage.NoopScanner{}
+	default:
+		logger.Error("CRITICAL: Unknown DOCUMENT_SCANNER " + driver + " (use http or none)")
+		panic("unknown document scanner")
+	}
+}
+
 func healthCheckHandler(infra *Infrastructure) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Check Redis
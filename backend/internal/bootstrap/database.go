@@ -1,6 +1,7 @@
 package bootstrap
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/database"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/logger"
 	platformRedis "github.com/lukcba/club-pulse-system-api/backend/internal/platform/redis"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/websocket"
 
 	// Domains for migration (only if strictly necessary to keep AutoMigrate for now, generally we'd move this)
 
@@ -22,6 +24,9 @@ type Infrastructure struct {
 	DB         *gorm.DB
 	Redis      *platformRedis.RedisClient
 	AuditQueue *audit.AuditQueue
+	Hub        *websocket.Hub
+
+	stopHub context.CancelFunc
 }
 
 func InitInfrastructure() (*Infrastructure, error) {
@@ -40,7 +45,13 @@ func InitInfrastructure() (*Infrastructure, error) {
 	auditQueue.StartFlushWorker()
 	logger.Info("Audit queue worker started (flush every 5 min)")
 
-	// 4. Run basic AutoMigrate (Ideally this should be separate, but keeping parity for now)
+	// 4. Start WebSocket Hub (topics fanned out through Redis Pub/Sub)
+	hubCtx, stopHub := context.WithCancel(context.Background())
+	hub := websocket.NewHub()
+	go hub.Run(hubCtx)
+	logger.Info("WebSocket hub started")
+
+	// 5. Run basic AutoMigrate (Ideally this should be separate, but keeping parity for now)
 	// We only migrate Payment here because it was explicitly in main.go
 	if err := db.AutoMigrate(&paymentDomain.Payment{}); err != nil {
 		logger.Error(fmt.Sprintf("Failed to migrate payment table: %v", err))
@@ -60,6 +71,8 @@ func InitInfrastructure() (*Infrastructure, error) {
 		DB:         db,
 		Redis:      redisClient,
 		AuditQueue: auditQueue,
+		Hub:        hub,
+		stopHub:    stopHub,
 	}, nil
 }
 
@@ -67,6 +80,9 @@ func (i *Infrastructure) Shutdown() {
 	if i.AuditQueue != nil {
 		i.AuditQueue.Stop()
 	}
+	if i.stopHub != nil {
+		i.stopHub()
+	}
 	// Close DB/Redis connections if necessary (GORM manages pool, Redis client has Close)
 	if i.Redis != nil {
 		i.Redis.Close()
@@ -0,0 +1,205 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/storage"
+)
+
+// MaxAbsenceDocumentSize is the largest medical certificate accepted with a notice (10 MB)
+const MaxAbsenceDocumentSize int64 = 10 << 20
+
+// absenceDocumentContentTypes are the accepted formats (detected from the content), with the key extension
+var absenceDocumentContentTypes = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+var (
+	ErrAbsenceDocumentNotFound    = errors.New("the absence notice has no medical certificate")
+	ErrAbsenceDocumentDenied      = errors.New("not allowed to access this medical certificate")
+	ErrAbsenceStorageUnavailable  = errors.New("document storage is not configured")
+	ErrAbsenceDocumentEmpty       = errors.New("the file is empty")
+	ErrAbsenceDocumentTooLarge    = errors.New("the file exceeds the maximum allowed size")
+	ErrAbsenceDocumentUnsupported = errors.New("unsupported file format (PDF, JPG or PNG only)")
+	ErrAbsenceDocumentInfected    = errors.New("the file was rejected by the antivirus")
+)
+
+// AbsenceDocumentEncryptor encrypts certificates with the club's data key (see encryption.Envelope)
+type AbsenceDocumentEncryptor interface {
+	Encrypt(ctx context.Context, clubID string, plaintext []byte) ([]byte, error)
+	Decrypt(ctx context.Context, clubID string, data []byte) ([]byte, error)
+}
+
+// HealthDataAccessLogger records access to health data (GDPR Art. 9).
+// Implemented by the user module's HealthDataAccessLogRepository.
+type HealthDataAccessLogger interface {
+	LogHealthDataAccess(log *userDomain.HealthDataAccessLog) error
+}
+
+// SetDocumentStorage enables medical certificate uploads on absence notices. Without a scanner
+// every file is accepted; without storage notices with a file are rejected.
+func (uc *AttendanceUseCases) SetDocumentStorage(files storage.FileStorage, scanner storage.Scanner) {
+	if scanner == nil {
+		scanner = storage.NoopScanner{}
+	}
+	uc.files = files
+	uc.scanner = scanner
+}
+
+// SetEncryptor enables encryption at rest of the medical certificates
+func (uc *AttendanceUseCases) SetEncryptor(encryptor AbsenceDocumentEncryptor) {
+	uc.encryptor = encryptor
+}
+
+// SetAccessLogger enables the health data access log for the medical certificates
+func (uc *AttendanceUseCases) SetAccessLogger(accessLog HealthDataAccessLogger) {
+	uc.accessLog = accessLog
+}
+
+// AbsenceDocumentInput is the medical certificate uploaded with a notice
+type AbsenceDocumentInput struct {
+	Size int64 // Declared by the client, checked again while reading
+	Body io.Reader
+}
+
+// AbsenceDocumentAccess identifies who opens a certificate, for access control and auditing
+type AbsenceDocumentAccess struct {
+	ClubID    string
+	UserID    string
+	Role      string
+	IPAddress string
+	UserAgent string
+}
+
+// readAbsenceDocument validates and scans the upload, returning its content and content type.
+func (uc *AttendanceUseCases) readAbsenceDocument(ctx context.Context, input *AbsenceDocumentInput) ([]byte, string, error) {
+	if uc.files == nil {
+		return nil, "", ErrAbsenceStorageUnavailable
+	}
+	if input.Size > MaxAbsenceDocumentSize {
+		return nil, "", ErrAbsenceDocumentTooLarge
+	}
+
+	data, err := io.ReadAll(io.LimitReader(input.Body, MaxAbsenceDocumentSize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("error reading file: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, "", ErrAbsenceDocumentEmpty
+	}
+	if int64(len(data)) > MaxAbsenceDocumentSize {
+		return nil, "", ErrAbsenceDocumentTooLarge
+	}
+
+	// The type is detected from the content, not the extension or the client header
+	contentType := http.DetectContentType(data)
+	if _, ok := absenceDocumentContentTypes[contentType]; !ok {
+		return nil, "", ErrAbsenceDocumentUnsupported
+	}
+	if err := uc.scanner.Scan(ctx, bytes.NewReader(data)); err != nil {
+		if errors.Is(err, storage.ErrInfected) {
+			return nil, "", ErrAbsenceDocumentInfected
+		}
+		return nil, "", fmt.Errorf("antivirus scan failed: %w", err)
+	}
+	return data, contentType, nil
+}
+
+// storeAbsenceDocument encrypts (when configured) and stores the certificate of the notice.
+func (uc *AttendanceUseCases) storeAbsenceDocument(ctx context.Context, notice *domain.AbsenceNotice, data []byte, contentType string) error {
+	notice.DocumentKey = path.Join(notice.ClubID, "absence-notices", notice.ID.String()+absenceDocumentContentTypes[contentType])
+	notice.DocumentURL = fmt.Sprintf("/attendance/absences/%s/document", notice.ID)
+	notice.DocumentContentType = contentType
+	notice.DocumentSizeBytes = int64(len(data))
+
+	stored := data
+	if uc.encryptor != nil {
+		var err error
+		if stored, err = uc.encryptor.Encrypt(ctx, notice.ClubID, data); err != nil {
+			return fmt.Errorf("error encrypting file: %w", err)
+		}
+		notice.DocumentEncrypted = true
+	}
+	if err := uc.files.Put(ctx, notice.DocumentKey, bytes.NewReader(stored), int64(len(stored)), contentType); err != nil {
+		return fmt.Errorf("error storing file: %w", err)
+	}
+	return nil
+}
+
+// OpenAbsenceDocument opens the medical certificate of a notice for the parent who reported it,
+// the player, medical staff or a super admin. Every access is recorded in the health data log.
+func (uc *AttendanceUseCases) OpenAbsenceDocument(ctx context.Context, access AbsenceDocumentAccess, noticeID uuid.UUID) (io.ReadCloser, *domain.AbsenceNotice, error) {
+	notice, err := uc.repo.GetNotice(ctx, access.ClubID, noticeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if notice == nil {
+		return nil, nil, ErrNoticeNotFound
+	}
+	if !notice.HasDocument() {
+		return nil, nil, ErrAbsenceDocumentNotFound
+	}
+	if !notice.DocumentAccessibleBy(access.UserID, access.Role) {
+		return nil, nil, ErrAbsenceDocumentDenied
+	}
+	if uc.files == nil {
+		return nil, nil, ErrAbsenceStorageUnavailable
+	}
+
+	body, _, err := uc.files.Get(ctx, notice.DocumentKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, ErrAbsenceDocumentNotFound
+		}
+		return nil, nil, fmt.Errorf("error reading file: %w", err)
+	}
+	if notice.DocumentEncrypted {
+		defer body.Close()
+		if uc.encryptor == nil {
+			return nil, nil, errors.New("the certificate is encrypted and encryption is not configured")
+		}
+		sealed, err := io.ReadAll(body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error reading file: %w", err)
+		}
+		plaintext, err := uc.encryptor.Decrypt(ctx, notice.ClubID, sealed)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error decrypting file: %w", err)
+		}
+		body = io.NopCloser(bytes.NewReader(plaintext))
+	}
+
+	uc.logDocumentAccess(access, notice, userDomain.HealthAccessDownload)
+	return body, notice, nil
+}
+
+func (uc *AttendanceUseCases) logDocumentAccess(access AbsenceDocumentAccess, notice *domain.AbsenceNotice, action string) {
+	if uc.accessLog == nil {
+		return
+	}
+	noticeID := notice.ID
+	// Auditing must not block the access: a logging error is not propagated
+	_ = uc.accessLog.LogHealthDataAccess(&userDomain.HealthDataAccessLog{
+		ClubID:            notice.ClubID,
+		AccessedUserID:    notice.UserID,
+		AccessingUserID:   access.UserID,
+		AccessingUserRole: access.Role,
+		DocumentID:        &noticeID,
+		Action:            action,
+		IPAddress:         access.IPAddress,
+		UserAgent:         access.UserAgent,
+		AccessedAt:        time.Now(),
+	})
+}
@@ -0,0 +1,191 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+)
+
+var (
+	ErrPlayerNotFound = errors.New("player not found")
+	ErrNotParent      = errors.New("only the player's parent can report absences")
+	ErrNoticeNotFound = errors.New("absence notice not found")
+)
+
+const maxAbsenceNoticeDays = 60 // Days covered by a single notice
+
+type ReportAbsenceDTO struct {
+	UserID          string
+	TrainingGroupID *uuid.UUID // Optional, every session when nil
+	FromDate        time.Time
+	UntilDate       *time.Time // Defaults to FromDate
+	Reason          string
+	Document        *AbsenceDocumentInput // Optional medical certificate
+}
+
+// ReportAbsence lets a parent pre-report an absence of their child (User.ParentID). An attached
+// medical certificate is validated and scanned before anything is stored.
+func (uc *AttendanceUseCases) ReportAbsence(ctx context.Context, clubID, parentID string, dto ReportAbsenceDTO) (*domain.AbsenceNotice, error) {
+	if dto.Reason == "" {
+		return nil, errors.New("reason is required")
+	}
+	if err := uc.checkParent(ctx, clubID, parentID, dto.UserID); err != nil {
+		return nil, err
+	}
+
+	from := truncateDay(dto.FromDate)
+	until := from
+	if dto.UntilDate != nil {
+		until = truncateDay(*dto.UntilDate)
+	}
+	if from.Before(truncateDay(time.Now())) {
+		return nil, errors.New("absences can only be reported for today or later")
+	}
+	if until.Before(from) {
+		return nil, errors.New("until_date must not be before from_date")
+	}
+	if until.Sub(from) > time.Duration(maxAbsenceNoticeDays)*24*time.Hour {
+		return nil, errors.New("an absence notice can cover at most 60 days")
+	}
+
+	var document []byte
+	var contentType string
+	if dto.Document != nil {
+		var err error
+		if document, contentType, err = uc.readAbsenceDocument(ctx, dto.Document); err != nil {
+			return nil, err
+		}
+	}
+
+	notice := &domain.AbsenceNotice{
+		ID:              uuid.New(),
+		ClubID:          clubID,
+		UserID:          dto.UserID,
+		ReportedBy:      parentID,
+		TrainingGroupID: dto.TrainingGroupID,
+		FromDate:        from,
+		UntilDate:       until,
+		Reason:          dto.Reason,
+	}
+	if document != nil {
+		if err := uc.storeAbsenceDocument(ctx, notice, document, contentType); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.repo.CreateNotice(ctx, notice); err != nil {
+		if notice.HasDocument() {
+			_ = uc.files.Delete(ctx, notice.DocumentKey) // Do not leave orphan files
+		}
+		return nil, err
+	}
+	return notice, nil
+}
+
+// CancelAbsence withdraws a notice. Records it excused and nobody marked since go back to ABSENT
+// the next time their list is opened.
+func (uc *AttendanceUseCases) CancelAbsence(ctx context.Context, clubID, parentID string, noticeID uuid.UUID) (*domain.AbsenceNotice, error) {
+	notice, err := uc.repo.GetNotice(ctx, clubID, noticeID)
+	if err != nil {
+		return nil, err
+	}
+	if notice == nil {
+		return nil, ErrNoticeNotFound
+	}
+	if notice.ReportedBy != parentID {
+		return nil, ErrNotParent
+	}
+	if notice.CancelledAt != nil {
+		return notice, nil
+	}
+
+	now := time.Now()
+	notice.CancelledAt = &now
+	if err := uc.repo.UpdateNotice(ctx, notice); err != nil {
+		return nil, err
+	}
+	return notice, nil
+}
+
+// ListChildrenAbsences returns the notices of every child of the parent.
+func (uc *AttendanceUseCases) ListChildrenAbsences(ctx context.Context, clubID, parentID string) ([]domain.AbsenceNotice, error) {
+	children, err := uc.userRepo.FindChildren(ctx, clubID, parentID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(children))
+	for i, child := range children {
+		ids[i] = child.ID
+	}
+	return uc.listNotices(ctx, clubID, ids)
+}
+
+// ListPlayerAbsences returns the notices of one player, for coaches and admins.
+func (uc *AttendanceUseCases) ListPlayerAbsences(ctx context.Context, clubID, userID string) ([]domain.AbsenceNotice, error) {
+	return uc.listNotices(ctx, clubID, []string{userID})
+}
+
+func (uc *AttendanceUseCases) listNotices(ctx context.Context, clubID string, userIDs []string) ([]domain.AbsenceNotice, error) {
+	notices, err := uc.repo.ListNotices(ctx, clubID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	if notices == nil {
+		notices = []domain.AbsenceNotice{}
+	}
+	return notices, nil
+}
+
+func (uc *AttendanceUseCases) checkParent(ctx context.Context, clubID, parentID, childID string) error {
+	child, err := uc.userRepo.GetByID(ctx, clubID, childID)
+	if err != nil {
+		return err
+	}
+	if child == nil {
+		return ErrPlayerNotFound
+	}
+	if child.ParentID == nil || *child.ParentID != parentID {
+		return ErrNotParent
+	}
+	return nil
+}
+
+// applyAbsenceNotices excuses the untouched records covered by a notice (or reverts those whose
+// notice was cancelled) and attaches the notice so coaches see the reason in the list.
+func (uc *AttendanceUseCases) applyAbsenceNotices(ctx context.Context, clubID string, list *domain.AttendanceList) error {
+	if len(list.Records) == 0 {
+		return nil
+	}
+	userIDs := make([]string, len(list.Records))
+	for i, rec := range list.Records {
+		userIDs[i] = rec.UserID
+	}
+	notices, err := uc.repo.ListActiveNotices(ctx, clubID, userIDs, truncateDay(list.Date))
+	if err != nil {
+		return err
+	}
+
+	for i := range list.Records {
+		rec := &list.Records[i]
+		var covering *domain.AbsenceNotice
+		for j := range notices {
+			if notices[j].UserID == rec.UserID && notices[j].Covers(list.TrainingGroupID, list.Date) {
+				covering = &notices[j]
+				break
+			}
+		}
+		rec.Excuse = covering
+		if rec.ApplyNotice(covering) {
+			if err := uc.repo.UpsertRecord(ctx, rec); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
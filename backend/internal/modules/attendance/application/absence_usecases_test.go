@@ -0,0 +1,263 @@
+package application_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+	membershipDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/membership/domain"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttendanceUseCases_ReportAbsence(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	parentID := "parent-1"
+	childID := "child-1"
+	today := time.Now().UTC()
+
+	t.Run("Parent reports an absence for their child", func(t *testing.T) {
+		repo := new(MockAttendanceRepo)
+		userRepo := new(MockUserRepo)
+		uc := application.NewAttendanceUseCases(repo, userRepo, new(MockMembershipRepo), nil)
+
+		userRepo.On("GetByID", ctx, clubID, childID).Return(&userDomain.User{ID: childID, ParentID: &parentID}, nil).Once()
+		repo.On("CreateNotice", ctx, mock.MatchedBy(func(n *domain.AbsenceNotice) bool {
+			return n.UserID == childID && n.ReportedBy == parentID && n.FromDate.Equal(n.UntilDate)
+		})).Return(nil).Once()
+
+		notice, err := uc.ReportAbsence(ctx, clubID, parentID, application.ReportAbsenceDTO{
+			UserID:   childID,
+			FromDate: today,
+			Reason:   "Turno médico",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "Turno médico", notice.Reason)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Rejects someone who is not the parent", func(t *testing.T) {
+		repo := new(MockAttendanceRepo)
+		userRepo := new(MockUserRepo)
+		uc := application.NewAttendanceUseCases(repo, userRepo, new(MockMembershipRepo), nil)
+
+		other := "parent-2"
+		userRepo.On("GetByID", ctx, clubID, childID).Return(&userDomain.User{ID: childID, ParentID: &other}, nil).Once()
+
+		_, err := uc.ReportAbsence(ctx, clubID, parentID, application.ReportAbsenceDTO{
+			UserID:   childID,
+			FromDate: today,
+			Reason:   "Viaje",
+		})
+		assert.ErrorIs(t, err, application.ErrNotParent)
+		repo.AssertNotCalled(t, "CreateNotice", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Rejects past dates", func(t *testing.T) {
+		repo := new(MockAttendanceRepo)
+		userRepo := new(MockUserRepo)
+		uc := application.NewAttendanceUseCases(repo, userRepo, new(MockMembershipRepo), nil)
+
+		userRepo.On("GetByID", ctx, clubID, childID).Return(&userDomain.User{ID: childID, ParentID: &parentID}, nil).Once()
+
+		_, err := uc.ReportAbsence(ctx, clubID, parentID, application.ReportAbsenceDTO{
+			UserID:   childID,
+			FromDate: today.AddDate(0, 0, -2),
+			Reason:   "Enfermedad",
+		})
+		assert.Error(t, err)
+		repo.AssertNotCalled(t, "CreateNotice", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAttendanceUseCases_AbsenceNoticesOnLists(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	groupID := uuid.New()
+	date := time.Now().UTC().Truncate(24 * time.Hour)
+	childID := "child-1"
+	notice := domain.AbsenceNotice{ID: uuid.New(), UserID: childID, FromDate: date, UntilDate: date, Reason: "Fiebre"}
+
+	t.Run("Excuses the player when the list is prepared", func(t *testing.T) {
+		repo := new(MockAttendanceRepo)
+		userRepo := new(MockUserRepo)
+		membershipRepo := new(MockMembershipRepo)
+		roster := new(MockRosterProvider)
+		uc := application.NewAttendanceUseCases(repo, userRepo, membershipRepo, roster)
+
+		userRepo.On("ListByIDs", ctx, clubID, mock.Anything).Return([]userDomain.User{}, nil)
+		membershipRepo.On("GetByUserIDs", ctx, clubID, mock.Anything).Return([]membershipDomain.Membership{}, nil)
+		roster.On("ListRosterUserIDs", ctx, clubID, groupID, date).Return([]string{childID, "child-2"}, nil).Once()
+		repo.On("GetListByTrainingGroupAndDate", ctx, clubID, groupID, date).Return(nil, nil).Once()
+		repo.On("CreateList", ctx, mock.Anything).Return(nil).Once()
+		repo.On("UpsertRecord", ctx, mock.Anything).Return(nil)
+		repo.On("ListActiveNotices", ctx, clubID, []string{childID, "child-2"}, date).Return([]domain.AbsenceNotice{notice}, nil).Once()
+
+		list, err := uc.GetOrCreateListByTrainingGroup(ctx, clubID, groupID, "Sub-15", date, "coach-1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.StatusExcused, list.Records[0].Status)
+		assert.Equal(t, domain.SourceNotice, list.Records[0].Source)
+		assert.Equal(t, "Fiebre", list.Records[0].Excuse.Reason)
+		assert.Equal(t, domain.StatusAbsent, list.Records[1].Status)
+		assert.Nil(t, list.Records[1].Excuse)
+	})
+
+	t.Run("Cancelled notice reverts the excused record", func(t *testing.T) {
+		repo := new(MockAttendanceRepo)
+		uc := application.NewAttendanceUseCases(repo, new(MockUserRepo), new(MockMembershipRepo), nil)
+
+		existing := &domain.AttendanceList{
+			ID:    uuid.New(),
+			Group: "2012",
+			Date:  date,
+			Records: []domain.AttendanceRecord{
+				{ID: uuid.New(), UserID: childID, Status: domain.StatusExcused, Source: domain.SourceNotice, AbsenceNoticeID: &notice.ID},
+			},
+		}
+		repo.On("GetListByGroupAndDate", ctx, clubID, "2012", date).Return(existing, nil).Once()
+		repo.On("ListActiveNotices", ctx, clubID, []string{childID}, date).Return([]domain.AbsenceNotice{}, nil).Once()
+		repo.On("UpsertRecord", ctx, mock.MatchedBy(func(r *domain.AttendanceRecord) bool {
+			return r.UserID == childID && r.Status == domain.StatusAbsent && r.AbsenceNoticeID == nil
+		})).Return(nil).Once()
+
+		list, err := uc.GetOrCreateList(ctx, clubID, "2012", date, "coach-1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.StatusAbsent, list.Records[0].Status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Only the reporting parent can cancel", func(t *testing.T) {
+		repo := new(MockAttendanceRepo)
+		uc := application.NewAttendanceUseCases(repo, new(MockUserRepo), new(MockMembershipRepo), nil)
+
+		stored := notice
+		stored.ReportedBy = "parent-1"
+		repo.On("GetNotice", ctx, clubID, notice.ID).Return(&stored, nil)
+
+		_, err := uc.CancelAbsence(ctx, clubID, "parent-2", notice.ID)
+		assert.ErrorIs(t, err, application.ErrNotParent)
+
+		repo.On("UpdateNotice", ctx, mock.Anything).Return(nil).Once()
+		cancelled, err := uc.CancelAbsence(ctx, clubID, "parent-1", notice.ID)
+		assert.NoError(t, err)
+		assert.NotNil(t, cancelled.CancelledAt)
+	})
+}
+
+type MockHealthDataAccessLogger struct {
+	mock.Mock
+}
+
+func (m *MockHealthDataAccessLogger) LogHealthDataAccess(log *userDomain.HealthDataAccessLog) error {
+	return m.Called(log).Error(0)
+}
+
+// xorEncryptor is a test cipher; the real one is covered in platform/encryption
+type xorEncryptor struct{}
+
+func (xorEncryptor) Encrypt(ctx context.Context, clubID string, plaintext []byte) ([]byte, error) {
+	return xorBytes(plaintext), nil
+}
+
+func (xorEncryptor) Decrypt(ctx context.Context, clubID string, data []byte) ([]byte, error) {
+	return xorBytes(data), nil
+}
+
+func xorBytes(data []byte) []byte {
+	out := make([]byte, len(data))
+	for i, b := range data {
+		out[i] = b ^ 0x5a
+	}
+	return out
+}
+
+func TestAttendanceUseCases_AbsenceDocument(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	parentID := "parent-1"
+	childID := "child-1"
+	today := time.Now().UTC()
+	pdf := []byte("%PDF-1.4\n1 0 obj << /Type /Catalog >> endobj\n")
+
+	setup := func(t *testing.T) (*application.AttendanceUseCases, *MockAttendanceRepo, *MockUserRepo, *MockHealthDataAccessLogger, storage.FileStorage) {
+		files, err := storage.NewLocalStorage(t.TempDir())
+		require.NoError(t, err)
+		repo := new(MockAttendanceRepo)
+		userRepo := new(MockUserRepo)
+		accessLog := new(MockHealthDataAccessLogger)
+		uc := application.NewAttendanceUseCases(repo, userRepo, new(MockMembershipRepo), nil)
+		uc.SetDocumentStorage(files, nil)
+		uc.SetEncryptor(xorEncryptor{})
+		uc.SetAccessLogger(accessLog)
+		return uc, repo, userRepo, accessLog, files
+	}
+
+	t.Run("Stores the certificate encrypted and serves it to the parent", func(t *testing.T) {
+		uc, repo, userRepo, accessLog, files := setup(t)
+		userRepo.On("GetByID", ctx, clubID, childID).Return(&userDomain.User{ID: childID, ParentID: &parentID}, nil).Once()
+		repo.On("CreateNotice", ctx, mock.AnythingOfType("*domain.AbsenceNotice")).Return(nil).Once()
+
+		notice, err := uc.ReportAbsence(ctx, clubID, parentID, application.ReportAbsenceDTO{
+			UserID:   childID,
+			FromDate: today,
+			Reason:   "Gripe",
+			Document: &application.AbsenceDocumentInput{Size: int64(len(pdf)), Body: bytes.NewReader(pdf)},
+		})
+		require.NoError(t, err)
+		assert.True(t, notice.DocumentEncrypted)
+		assert.Equal(t, "application/pdf", notice.DocumentContentType)
+		assert.Equal(t, "/attendance/absences/"+notice.ID.String()+"/document", notice.DocumentURL)
+
+		stored, _, err := files.Get(ctx, notice.DocumentKey)
+		require.NoError(t, err)
+		sealed, _ := io.ReadAll(stored)
+		_ = stored.Close()
+		assert.NotEqual(t, pdf, sealed)
+
+		repo.On("GetNotice", ctx, clubID, notice.ID).Return(notice, nil).Once()
+		accessLog.On("LogHealthDataAccess", mock.MatchedBy(func(l *userDomain.HealthDataAccessLog) bool {
+			return l.AccessedUserID == childID && l.AccessingUserID == parentID && l.Action == userDomain.HealthAccessDownload
+		})).Return(nil).Once()
+
+		body, _, err := uc.OpenAbsenceDocument(ctx, application.AbsenceDocumentAccess{ClubID: clubID, UserID: parentID, Role: userDomain.RoleMember}, notice.ID)
+		require.NoError(t, err)
+		content, _ := io.ReadAll(body)
+		_ = body.Close()
+		assert.Equal(t, pdf, content)
+		accessLog.AssertExpectations(t)
+	})
+
+	t.Run("Coaches cannot open the certificate", func(t *testing.T) {
+		uc, repo, _, accessLog, _ := setup(t)
+		notice := &domain.AbsenceNotice{ID: uuid.New(), ClubID: clubID, UserID: childID, ReportedBy: parentID, DocumentKey: "club-1/absence-notices/x.pdf"}
+		repo.On("GetNotice", ctx, clubID, notice.ID).Return(notice, nil).Once()
+
+		_, _, err := uc.OpenAbsenceDocument(ctx, application.AbsenceDocumentAccess{ClubID: clubID, UserID: "coach-1", Role: userDomain.RoleCoach}, notice.ID)
+		assert.ErrorIs(t, err, application.ErrAbsenceDocumentDenied)
+		accessLog.AssertNotCalled(t, "LogHealthDataAccess", mock.Anything)
+	})
+
+	t.Run("Rejects unsupported files before creating the notice", func(t *testing.T) {
+		uc, repo, userRepo, _, _ := setup(t)
+		userRepo.On("GetByID", ctx, clubID, childID).Return(&userDomain.User{ID: childID, ParentID: &parentID}, nil).Once()
+
+		_, err := uc.ReportAbsence(ctx, clubID, parentID, application.ReportAbsenceDTO{
+			UserID:   childID,
+			FromDate: today,
+			Reason:   "Gripe",
+			Document: &application.AbsenceDocumentInput{Size: 5, Body: strings.NewReader("hello")},
+		})
+		assert.ErrorIs(t, err, application.ErrAbsenceDocumentUnsupported)
+		repo.AssertNotCalled(t, "CreateNotice", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,99 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+)
+
+var ErrTrainingGroupNotFound = errors.New("training group not found")
+
+// AnalyticsUseCases computes group-level attendance analytics for coaches.
+type AnalyticsUseCases struct {
+	repo   domain.AnalyticsRepository
+	groups domain.TrainingGroupDirectory
+	policy domain.AlertPolicy
+}
+
+func NewAnalyticsUseCases(repo domain.AnalyticsRepository, groups domain.TrainingGroupDirectory) *AnalyticsUseCases {
+	return &AnalyticsUseCases{
+		repo:   repo,
+		groups: groups,
+		policy: domain.DefaultAlertPolicy,
+	}
+}
+
+// GetGroupAnalytics returns the weekly trend and per-player rates and absence streaks of a group.
+func (uc *AnalyticsUseCases) GetGroupAnalytics(ctx context.Context, clubID string, groupID uuid.UUID, from, to time.Time) (*domain.GroupAnalytics, error) {
+	group, err := uc.groups.GetTrainingGroup(ctx, clubID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, ErrTrainingGroupNotFound
+	}
+
+	records, err := uc.repo.ListSessionRecords(ctx, clubID, []uuid.UUID{groupID}, from, to)
+	if err != nil {
+		return nil, err
+	}
+	analytics := domain.BuildGroupAnalytics(groupID, records, from, to, uc.policy)
+	return &analytics, nil
+}
+
+// CompareDisciplineGroups summarizes every training group of a discipline over the same period.
+func (uc *AnalyticsUseCases) CompareDisciplineGroups(ctx context.Context, clubID string, disciplineID uuid.UUID, from, to time.Time) ([]domain.GroupComparison, error) {
+	groups, err := uc.groups.ListDisciplineGroups(ctx, clubID, disciplineID)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return []domain.GroupComparison{}, nil
+	}
+
+	groupIDs := make([]uuid.UUID, len(groups))
+	for i, g := range groups {
+		groupIDs[i] = g.ID
+	}
+	records, err := uc.repo.ListSessionRecords(ctx, clubID, groupIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	byGroup := make(map[uuid.UUID][]domain.SessionRecord)
+	for _, rec := range records {
+		byGroup[rec.TrainingGroupID] = append(byGroup[rec.TrainingGroupID], rec)
+	}
+
+	comparisons := make([]domain.GroupComparison, 0, len(groups))
+	for _, g := range groups {
+		analytics := domain.BuildGroupAnalytics(g.ID, byGroup[g.ID], from, to, uc.policy)
+		comparison := domain.GroupComparison{
+			TrainingGroupID: g.ID,
+			Name:            g.Name,
+			Sessions:        analytics.Sessions,
+			Players:         len(analytics.Players),
+			Rate:            analytics.Rate,
+		}
+		for _, p := range analytics.Players {
+			if p.AtRisk {
+				comparison.AtRiskPlayers++
+			}
+		}
+		comparisons = append(comparisons, comparison)
+	}
+	return comparisons, nil
+}
+
+func (uc *AnalyticsUseCases) ListOpenAlerts(ctx context.Context, clubID string, groupID *uuid.UUID) ([]domain.AttendanceAlert, error) {
+	alerts, err := uc.repo.ListOpenAlerts(ctx, clubID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if alerts == nil {
+		alerts = []domain.AttendanceAlert{}
+	}
+	return alerts, nil
+}
@@ -0,0 +1,88 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAnalyticsRepo struct{ mock.Mock }
+
+func (m *MockAnalyticsRepo) ListSessionRecords(ctx context.Context, clubID string, groupIDs []uuid.UUID, from, to time.Time) ([]domain.SessionRecord, error) {
+	args := m.Called(ctx, clubID, groupIDs, from, to)
+	return args.Get(0).([]domain.SessionRecord), args.Error(1)
+}
+func (m *MockAnalyticsRepo) CreateAlert(ctx context.Context, alert *domain.AttendanceAlert) (bool, error) {
+	args := m.Called(ctx, alert)
+	return args.Bool(0), args.Error(1)
+}
+func (m *MockAnalyticsRepo) ListOpenAlerts(ctx context.Context, clubID string, groupID *uuid.UUID) ([]domain.AttendanceAlert, error) {
+	args := m.Called(ctx, clubID, groupID)
+	return args.Get(0).([]domain.AttendanceAlert), args.Error(1)
+}
+func (m *MockAnalyticsRepo) ResolveAlert(ctx context.Context, clubID string, id uuid.UUID, resolvedAt time.Time) error {
+	return m.Called(ctx, clubID, id, resolvedAt).Error(0)
+}
+
+type MockGroupDirectory struct{ mock.Mock }
+
+func (m *MockGroupDirectory) GetTrainingGroup(ctx context.Context, clubID string, groupID uuid.UUID) (*domain.TrainingGroupRef, error) {
+	args := m.Called(ctx, clubID, groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TrainingGroupRef), args.Error(1)
+}
+func (m *MockGroupDirectory) ListDisciplineGroups(ctx context.Context, clubID string, disciplineID uuid.UUID) ([]domain.TrainingGroupRef, error) {
+	args := m.Called(ctx, clubID, disciplineID)
+	return args.Get(0).([]domain.TrainingGroupRef), args.Error(1)
+}
+
+func TestAnalyticsUseCases_CompareDisciplineGroups(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	disciplineID := uuid.New()
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	sub12 := domain.TrainingGroupRef{ID: uuid.New(), Name: "Sub 12"}
+	sub14 := domain.TrainingGroupRef{ID: uuid.New(), Name: "Sub 14"}
+
+	repo := new(MockAnalyticsRepo)
+	groups := new(MockGroupDirectory)
+	uc := application.NewAnalyticsUseCases(repo, groups)
+
+	listID := uuid.New()
+	groups.On("ListDisciplineGroups", ctx, clubID, disciplineID).Return([]domain.TrainingGroupRef{sub12, sub14}, nil)
+	repo.On("ListSessionRecords", ctx, clubID, []uuid.UUID{sub12.ID, sub14.ID}, from, to).Return([]domain.SessionRecord{
+		{TrainingGroupID: sub12.ID, ListID: listID, Date: from, UserID: "u1", Status: domain.StatusPresent},
+		{TrainingGroupID: sub12.ID, ListID: listID, Date: from, UserID: "u2", Status: domain.StatusAbsent},
+	}, nil)
+
+	comparisons, err := uc.CompareDisciplineGroups(ctx, clubID, disciplineID, from, to)
+	assert.NoError(t, err)
+	if assert.Len(t, comparisons, 2) {
+		assert.Equal(t, "Sub 12", comparisons[0].Name)
+		assert.Equal(t, 1, comparisons[0].Sessions)
+		assert.Equal(t, 2, comparisons[0].Players)
+		assert.Equal(t, 0.5, comparisons[0].Rate)
+		// Groups without sessions are still listed
+		assert.Equal(t, 0, comparisons[1].Sessions)
+	}
+}
+
+func TestAnalyticsUseCases_GetGroupAnalytics_NotFound(t *testing.T) {
+	ctx := context.TODO()
+	groups := new(MockGroupDirectory)
+	uc := application.NewAnalyticsUseCases(new(MockAnalyticsRepo), groups)
+	groupID := uuid.New()
+	groups.On("GetTrainingGroup", ctx, "club-1", groupID).Return(nil, nil)
+
+	_, err := uc.GetGroupAnalytics(ctx, "club-1", groupID, time.Now(), time.Now())
+	assert.ErrorIs(t, err, application.ErrTrainingGroupNotFound)
+}
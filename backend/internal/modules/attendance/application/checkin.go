@@ -0,0 +1,163 @@
+package application
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+)
+
+var (
+	ErrCheckInDisabled     = errors.New("QR check-in is not configured")
+	ErrInvalidCheckInCode  = errors.New("invalid check-in code")
+	ErrCheckInCodeExpired  = errors.New("check-in code expired")
+	ErrListNotFound        = errors.New("list not found")
+	ErrNotOnAttendanceList = errors.New("user is not on this attendance list")
+)
+
+// CheckInSigner issues and verifies the short-lived codes shown as a QR by the coach.
+// A code is "<listID>.<expiresUnix>.<hmac>", the HMAC also covers the club so a code
+// cannot be replayed in another tenant.
+type CheckInSigner struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewCheckInSigner(secret string, ttl time.Duration) *CheckInSigner {
+	if ttl <= 0 {
+		ttl = 5 * time.Minute
+	}
+	return &CheckInSigner{secret: []byte(secret), ttl: ttl}
+}
+
+func (s *CheckInSigner) Sign(clubID string, listID uuid.UUID, now time.Time) (string, time.Time) {
+	expiresAt := now.Add(s.ttl).Truncate(time.Second)
+	exp := strconv.FormatInt(expiresAt.Unix(), 10)
+	return fmt.Sprintf("%s.%s.%s", listID, exp, s.mac(clubID, listID.String(), exp)), expiresAt
+}
+
+// Verify returns the list of a valid, unexpired code issued for the club.
+func (s *CheckInSigner) Verify(clubID, code string, now time.Time) (uuid.UUID, error) {
+	parts := strings.Split(code, ".")
+	if len(parts) != 3 {
+		return uuid.Nil, ErrInvalidCheckInCode
+	}
+	listID, err := uuid.Parse(parts[0])
+	if err != nil {
+		return uuid.Nil, ErrInvalidCheckInCode
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(s.mac(clubID, parts[0], parts[1]))) {
+		return uuid.Nil, ErrInvalidCheckInCode
+	}
+	exp, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return uuid.Nil, ErrInvalidCheckInCode
+	}
+	if now.After(time.Unix(exp, 0)) {
+		return uuid.Nil, ErrCheckInCodeExpired
+	}
+	return listID, nil
+}
+
+func (s *CheckInSigner) mac(clubID, listID, exp string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(clubID + "|" + listID + "|" + exp))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SetCheckInSigner enables QR check-in. Without a signer the check-in endpoints are rejected.
+func (uc *AttendanceUseCases) SetCheckInSigner(signer *CheckInSigner) {
+	uc.checkInSigner = signer
+}
+
+type CheckInCode struct {
+	Code      string    `json:"code"` // Content of the QR
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// GetCheckInCode issues a fresh code for the list; coach apps refresh it before it expires.
+func (uc *AttendanceUseCases) GetCheckInCode(ctx context.Context, clubID string, listID uuid.UUID) (*CheckInCode, error) {
+	if uc.checkInSigner == nil {
+		return nil, ErrCheckInDisabled
+	}
+	list, err := uc.repo.GetListByID(ctx, clubID, listID)
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		return nil, ErrListNotFound
+	}
+
+	code, expiresAt := uc.checkInSigner.Sign(clubID, listID, time.Now())
+	return &CheckInCode{Code: code, ExpiresAt: expiresAt}, nil
+}
+
+// CheckIn marks the player present from a scanned code. Members enrolled in the training group
+// after the list was created are added to it.
+func (uc *AttendanceUseCases) CheckIn(ctx context.Context, clubID, userID, code string) (*domain.AttendanceRecord, error) {
+	if uc.checkInSigner == nil {
+		return nil, ErrCheckInDisabled
+	}
+	now := time.Now()
+	listID, err := uc.checkInSigner.Verify(clubID, code, now)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := uc.repo.GetListByID(ctx, clubID, listID)
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		return nil, ErrListNotFound
+	}
+
+	record, err := uc.findOrAddRosterRecord(ctx, clubID, list, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	record.ApplyScan(now)
+	if err := uc.repo.UpsertRecord(ctx, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// findOrAddRosterRecord returns the user's record in the list. A user without a record only gets
+// one if the training group roster includes them on the list date.
+func (uc *AttendanceUseCases) findOrAddRosterRecord(ctx context.Context, clubID string, list *domain.AttendanceList, userID string) (*domain.AttendanceRecord, error) {
+	for i := range list.Records {
+		if list.Records[i].UserID == userID {
+			return &list.Records[i], nil
+		}
+	}
+
+	if list.TrainingGroupID == nil || uc.rosterProvider == nil {
+		return nil, ErrNotOnAttendanceList
+	}
+	roster, err := uc.rosterProvider.ListRosterUserIDs(ctx, clubID, *list.TrainingGroupID, list.Date)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range roster {
+		if id == userID {
+			list.Records = append(list.Records, domain.AttendanceRecord{
+				ID:               uuid.New(),
+				AttendanceListID: list.ID,
+				UserID:           userID,
+				Status:           domain.StatusAbsent,
+			})
+			return &list.Records[len(list.Records)-1], nil
+		}
+	}
+	return nil, ErrNotOnAttendanceList
+}
@@ -0,0 +1,158 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheckInSigner(t *testing.T) {
+	signer := application.NewCheckInSigner("secret", time.Minute)
+	listID := uuid.New()
+	now := time.Now()
+
+	code, expiresAt := signer.Sign("club-1", listID, now)
+	assert.True(t, expiresAt.After(now))
+
+	got, err := signer.Verify("club-1", code, now)
+	assert.NoError(t, err)
+	assert.Equal(t, listID, got)
+
+	_, err = signer.Verify("club-2", code, now)
+	assert.ErrorIs(t, err, application.ErrInvalidCheckInCode)
+
+	_, err = signer.Verify("club-1", code, now.Add(2*time.Minute))
+	assert.ErrorIs(t, err, application.ErrCheckInCodeExpired)
+
+	_, err = application.NewCheckInSigner("other", time.Minute).Verify("club-1", code, now)
+	assert.ErrorIs(t, err, application.ErrInvalidCheckInCode)
+}
+
+func TestAttendanceUseCases_CheckIn(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	groupID := uuid.New()
+	listID := uuid.New()
+	signer := application.NewCheckInSigner("secret", time.Minute)
+	code, _ := signer.Sign(clubID, listID, time.Now())
+
+	setup := func() (*application.AttendanceUseCases, *MockAttendanceRepo, *MockRosterProvider) {
+		repo := new(MockAttendanceRepo)
+		roster := new(MockRosterProvider)
+		uc := application.NewAttendanceUseCases(repo, nil, nil, roster)
+		uc.SetCheckInSigner(signer)
+		return uc, repo, roster
+	}
+	newList := func() *domain.AttendanceList {
+		return &domain.AttendanceList{
+			ID:              listID,
+			TrainingGroupID: &groupID,
+			Records:         []domain.AttendanceRecord{{ID: uuid.New(), UserID: "u1", Status: domain.StatusAbsent}},
+		}
+	}
+
+	t.Run("Marks listed player present", func(t *testing.T) {
+		uc, repo, _ := setup()
+		repo.On("GetListByID", ctx, clubID, listID).Return(newList(), nil).Once()
+		repo.On("UpsertRecord", ctx, mock.MatchedBy(func(r *domain.AttendanceRecord) bool {
+			return r.UserID == "u1" && r.Status == domain.StatusPresent && r.Source == domain.SourceScan && r.ScannedAt != nil
+		})).Return(nil).Once()
+
+		_, err := uc.CheckIn(ctx, clubID, "u1", code)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Adds late enrollment from roster", func(t *testing.T) {
+		uc, repo, roster := setup()
+		list := newList()
+		repo.On("GetListByID", ctx, clubID, listID).Return(list, nil).Once()
+		roster.On("ListRosterUserIDs", ctx, clubID, groupID, list.Date).Return([]string{"u1", "u2"}, nil).Once()
+		repo.On("UpsertRecord", ctx, mock.MatchedBy(func(r *domain.AttendanceRecord) bool {
+			return r.UserID == "u2" && r.Status == domain.StatusPresent
+		})).Return(nil).Once()
+
+		_, err := uc.CheckIn(ctx, clubID, "u2", code)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Fail: not on roster", func(t *testing.T) {
+		uc, repo, roster := setup()
+		list := newList()
+		repo.On("GetListByID", ctx, clubID, listID).Return(list, nil).Once()
+		roster.On("ListRosterUserIDs", ctx, clubID, groupID, list.Date).Return([]string{"u1"}, nil).Once()
+
+		_, err := uc.CheckIn(ctx, clubID, "intruder", code)
+		assert.ErrorIs(t, err, application.ErrNotOnAttendanceList)
+		repo.AssertNotCalled(t, "UpsertRecord", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Fail: disabled without signer", func(t *testing.T) {
+		uc := application.NewAttendanceUseCases(new(MockAttendanceRepo), nil, nil, nil)
+		_, err := uc.CheckIn(ctx, clubID, "u1", code)
+		assert.ErrorIs(t, err, application.ErrCheckInDisabled)
+	})
+}
+
+func TestAttendanceUseCases_SyncBatch(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	listID := uuid.New()
+	scannedAt := time.Now().Add(-10 * time.Minute)
+
+	repo := new(MockAttendanceRepo)
+	uc := application.NewAttendanceUseCases(repo, nil, nil, nil)
+
+	list := &domain.AttendanceList{
+		ID: listID,
+		Records: []domain.AttendanceRecord{
+			{ID: uuid.New(), UserID: "u1", Status: domain.StatusAbsent},
+			{ID: uuid.New(), UserID: "u2", Status: domain.StatusPresent, Source: domain.SourceScan, ScannedAt: &scannedAt},
+		},
+	}
+	repo.On("GetListByID", ctx, clubID, listID).Return(list, nil).Once()
+	repo.On("GetSyncOperation", ctx, clubID, "k1").Return(nil, nil)
+	repo.On("GetSyncOperation", ctx, clubID, "k2").Return(nil, nil)
+	repo.On("GetSyncOperation", ctx, clubID, "k3").Return(&domain.SyncOperation{Outcome: domain.SyncApplied}, nil)
+	repo.On("GetSyncOperation", ctx, clubID, "k4").Return(nil, nil)
+	repo.On("GetSyncOperation", ctx, clubID, "k5").Return(nil, nil)
+	repo.On("CreateSyncOperation", ctx, mock.MatchedBy(func(op *domain.SyncOperation) bool { return op.IdempotencyKey != "k5" }), mock.Anything).Return(true, nil)
+	// k5 was stored by a concurrent retry between the lookup and the insert
+	repo.On("CreateSyncOperation", ctx, mock.MatchedBy(func(op *domain.SyncOperation) bool { return op.IdempotencyKey == "k5" }), mock.Anything).Return(false, nil)
+
+	results, err := uc.SyncBatch(ctx, clubID, "coach-1", application.SyncBatchDTO{Records: []application.SyncRecordDTO{
+		{IdempotencyKey: "k1", ListID: listID, UserID: "u1", Status: domain.StatusPresent, MarkedAt: scannedAt.Add(-time.Minute)},
+		// Taken offline before u2 scanned the QR: the scan keeps deciding
+		{IdempotencyKey: "k2", ListID: listID, UserID: "u2", Status: domain.StatusAbsent, MarkedAt: scannedAt.Add(-time.Minute)},
+		{IdempotencyKey: "k3", ListID: listID, UserID: "u1", Status: domain.StatusAbsent, MarkedAt: scannedAt},
+		{IdempotencyKey: "k4", ListID: listID, UserID: "u1", Status: "MAYBE", MarkedAt: scannedAt},
+		{IdempotencyKey: "k5", ListID: listID, UserID: "u1", Status: domain.StatusPresent, MarkedAt: scannedAt},
+	}})
+
+	assert.NoError(t, err)
+	if assert.Len(t, results, 5) {
+		assert.Equal(t, domain.SyncApplied, results[0].Outcome)
+		assert.Equal(t, domain.SyncSuperseded, results[1].Outcome)
+		assert.Equal(t, domain.SyncDuplicate, results[2].Outcome)
+		assert.Equal(t, domain.SyncRejected, results[3].Outcome)
+		assert.Equal(t, domain.SyncDuplicate, results[4].Outcome)
+	}
+	// The mark is stored with its key, never on its own
+	repo.AssertNotCalled(t, "UpsertRecord", mock.Anything, mock.Anything)
+	repo.AssertCalled(t, "CreateSyncOperation", ctx, mock.MatchedBy(func(op *domain.SyncOperation) bool { return op.IdempotencyKey == "k1" }),
+		mock.MatchedBy(func(r *domain.AttendanceRecord) bool {
+			return r != nil && r.UserID == "u1" && r.Status == domain.StatusPresent
+		}))
+	assert.Equal(t, domain.StatusPresent, list.Records[0].Status)
+	assert.Equal(t, "coach-1", list.Records[0].MarkedBy)
+	assert.Equal(t, domain.StatusPresent, list.Records[1].Status)
+	// Duplicates are not stored again
+	repo.AssertNumberOfCalls(t, "CreateSyncOperation", 4)
+}
@@ -0,0 +1,132 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+)
+
+// SyncRecordDTO is one mark taken by a coach while offline.
+type SyncRecordDTO struct {
+	IdempotencyKey string                  `json:"idempotency_key" binding:"required,max=100"` // Generated by the client per mark
+	ListID         uuid.UUID               `json:"list_id" binding:"required"`
+	UserID         string                  `json:"user_id" binding:"required"`
+	Status         domain.AttendanceStatus `json:"status" binding:"required"`
+	Notes          string                  `json:"notes"`
+	MarkedAt       time.Time               `json:"marked_at" binding:"required"` // Client time of the mark
+}
+
+type SyncBatchDTO struct {
+	Records []SyncRecordDTO `json:"records" binding:"required,min=1,max=500,dive"`
+}
+
+type SyncRecordResult struct {
+	IdempotencyKey string             `json:"idempotency_key"`
+	Outcome        domain.SyncOutcome `json:"outcome"`
+	Error          string             `json:"error,omitempty"`
+}
+
+// SyncBatch applies an offline batch in order. Each mark is applied once per idempotency key and
+// resolved against QR scans by its client timestamp (see AttendanceRecord.ApplyManualMark).
+// A mark and its key are stored in the same transaction, so storage errors abort the batch
+// without leaving half-applied marks and the client can simply resend it.
+func (uc *AttendanceUseCases) SyncBatch(ctx context.Context, clubID, coachID string, dto SyncBatchDTO) ([]SyncRecordResult, error) {
+	now := time.Now()
+	lists := make(map[uuid.UUID]*domain.AttendanceList)
+	results := make([]SyncRecordResult, 0, len(dto.Records))
+
+	for _, item := range dto.Records {
+		result := SyncRecordResult{IdempotencyKey: item.IdempotencyKey}
+
+		op, err := uc.repo.GetSyncOperation(ctx, clubID, item.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+		if op != nil {
+			result.Outcome = domain.SyncDuplicate
+			results = append(results, result)
+			continue
+		}
+
+		outcome, reason, record, err := uc.applySyncRecord(ctx, clubID, coachID, item, lists, now)
+		if err != nil {
+			return nil, err
+		}
+		result.Outcome = outcome
+		result.Error = reason
+
+		created, err := uc.repo.CreateSyncOperation(ctx, &domain.SyncOperation{
+			ID:               uuid.New(),
+			ClubID:           clubID,
+			IdempotencyKey:   item.IdempotencyKey,
+			AttendanceListID: item.ListID,
+			UserID:           item.UserID,
+			Outcome:          outcome,
+		}, record)
+		if err != nil {
+			return nil, err
+		}
+		if !created {
+			// A concurrent retry of the batch processed the key first
+			result.Outcome, result.Error = domain.SyncDuplicate, ""
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// applySyncRecord returns the outcome of a mark, with the reason when it is rejected, and the
+// record to store (nil when nothing changed).
+func (uc *AttendanceUseCases) applySyncRecord(ctx context.Context, clubID, coachID string, item SyncRecordDTO, lists map[uuid.UUID]*domain.AttendanceList, now time.Time) (domain.SyncOutcome, string, *domain.AttendanceRecord, error) {
+	if !item.Status.IsValid() {
+		return domain.SyncRejected, "invalid attendance status", nil, nil
+	}
+
+	list, ok := lists[item.ListID]
+	if !ok {
+		var err error
+		list, err = uc.repo.GetListByID(ctx, clubID, item.ListID)
+		if err != nil {
+			return "", "", nil, err
+		}
+		lists[item.ListID] = list // Cache misses too
+	}
+	if list == nil {
+		return domain.SyncRejected, ErrListNotFound.Error(), nil, nil
+	}
+
+	var record *domain.AttendanceRecord
+	for i := range list.Records {
+		if list.Records[i].UserID == item.UserID {
+			record = &list.Records[i]
+			break
+		}
+	}
+	if record == nil {
+		// The coach may add players that were not on the list (e.g. a trial session)
+		list.Records = append(list.Records, domain.AttendanceRecord{
+			ID:               uuid.New(),
+			AttendanceListID: list.ID,
+			UserID:           item.UserID,
+			Status:           domain.StatusAbsent,
+		})
+		record = &list.Records[len(list.Records)-1]
+	}
+
+	// Device clocks can run ahead; a mark can never be later than its arrival
+	markedAt := item.MarkedAt
+	if markedAt.After(now) {
+		markedAt = now
+	}
+
+	if record.MarkedAt != nil && markedAt.Before(*record.MarkedAt) {
+		return domain.SyncSuperseded, "", nil, nil
+	}
+	outcome := domain.SyncApplied
+	if !record.ApplyManualMark(item.Status, item.Notes, coachID, markedAt) {
+		outcome = domain.SyncSuperseded
+	}
+	return outcome, "", record, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
 	membershipDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/membership/domain"
 	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/storage"
 	"github.com/shopspring/decimal"
 )
 
@@ -16,13 +17,20 @@ type AttendanceUseCases struct {
 	repo           domain.AttendanceRepository
 	userRepo       userDomain.UserRepository
 	membershipRepo membershipDomain.MembershipRepository
+	rosterProvider domain.GroupRosterProvider
+	checkInSigner  *CheckInSigner
+	files          storage.FileStorage
+	scanner        storage.Scanner
+	encryptor      AbsenceDocumentEncryptor
+	accessLog      HealthDataAccessLogger
 }
 
-func NewAttendanceUseCases(repo domain.AttendanceRepository, userRepo userDomain.UserRepository, membershipRepo membershipDomain.MembershipRepository) *AttendanceUseCases {
+func NewAttendanceUseCases(repo domain.AttendanceRepository, userRepo userDomain.UserRepository, membershipRepo membershipDomain.MembershipRepository, rosterProvider domain.GroupRosterProvider) *AttendanceUseCases {
 	return &AttendanceUseCases{
 		repo:           repo,
 		userRepo:       userRepo,
 		membershipRepo: membershipRepo,
+		rosterProvider: rosterProvider,
 	}
 }
 
@@ -39,6 +47,9 @@ func (uc *AttendanceUseCases) GetOrCreateList(ctx context.Context, clubID, group
 		return nil, err
 	}
 	if list != nil {
+		if err := uc.applyAbsenceNotices(ctx, clubID, list); err != nil {
+			return nil, err
+		}
 		return list, nil
 	}
 
@@ -84,57 +95,70 @@ func (uc *AttendanceUseCases) GetOrCreateList(ctx context.Context, clubID, group
 		}
 	}
 
+	// Absences reported by parents are excused from the start
+	if err := uc.applyAbsenceNotices(ctx, clubID, newList); err != nil {
+		return nil, err
+	}
+
 	return newList, nil
 }
 
-func (uc *AttendanceUseCases) GetOrCreateListByTrainingGroup(ctx context.Context, clubID string, groupID uuid.UUID, groupName string, category string, date time.Time, coachID string) (*domain.AttendanceList, error) {
-	list, err := uc.repo.GetListByTrainingGroupAndDate(ctx, clubID, groupID, date)
+// GetOrCreateListByTrainingGroup returns the list of a training group session, creating it from
+// the group's enrollment roster on that date. Members enrolled after the list was created are
+// added to it as ABSENT.
+func (uc *AttendanceUseCases) GetOrCreateListByTrainingGroup(ctx context.Context, clubID string, groupID uuid.UUID, groupName string, date time.Time, coachID string) (*domain.AttendanceList, error) {
+	roster, err := uc.rosterProvider.ListRosterUserIDs(ctx, clubID, groupID, date)
 	if err != nil {
 		return nil, err
 	}
-	if list != nil {
-		uc.populateRecords(ctx, clubID, list)
-		return list, nil
-	}
 
-	newList := &domain.AttendanceList{
-		ID:              uuid.New(),
-		ClubID:          clubID,
-		Date:            date,
-		Group:           groupName,
-		TrainingGroupID: &groupID,
-		CoachID:         coachID,
-		CreatedAt:       time.Now(),
-		UpdatedAt:       time.Now(),
-	}
-
-	// UserRepo call
-	users, err := uc.userRepo.List(ctx, clubID, 100, 0, map[string]interface{}{"category": category})
-	if err == nil && len(users) > 0 {
-		records := make([]domain.AttendanceRecord, len(users))
-		for i, u := range users {
-			records[i] = domain.AttendanceRecord{
-				ID:               uuid.New(),
-				AttendanceListID: newList.ID,
-				UserID:           u.ID,
-				Status:           domain.StatusAbsent,
-			}
+	list, err := uc.repo.GetListByTrainingGroupAndDate(ctx, clubID, groupID, date)
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		list = &domain.AttendanceList{
+			ID:              uuid.New(),
+			ClubID:          clubID,
+			Date:            date,
+			Group:           groupName,
+			TrainingGroupID: &groupID,
+			CoachID:         coachID,
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
+		}
+		if err := uc.repo.CreateList(ctx, list); err != nil {
+			return nil, err
 		}
-		newList.Records = records
 	}
 
-	if err := uc.repo.CreateList(ctx, newList); err != nil {
-		return nil, err
+	existing := make(map[string]bool, len(list.Records))
+	for _, rec := range list.Records {
+		existing[rec.UserID] = true
 	}
-
-	for _, rec := range newList.Records {
+	for _, userID := range roster {
+		if existing[userID] {
+			continue
+		}
+		rec := domain.AttendanceRecord{
+			ID:               uuid.New(),
+			AttendanceListID: list.ID,
+			UserID:           userID,
+			Status:           domain.StatusAbsent,
+		}
 		if err := uc.repo.UpsertRecord(ctx, &rec); err != nil {
 			return nil, err
 		}
+		list.Records = append(list.Records, rec)
 	}
 
-	uc.populateRecords(ctx, clubID, newList)
-	return newList, nil
+	// Absences reported by parents are excused, also when reported after the list was prepared
+	if err := uc.applyAbsenceNotices(ctx, clubID, list); err != nil {
+		return nil, err
+	}
+
+	uc.populateRecords(ctx, clubID, list)
+	return list, nil
 }
 
 func (uc *AttendanceUseCases) populateRecords(ctx context.Context, clubID string, list *domain.AttendanceList) {
@@ -207,41 +231,40 @@ type MarkAttendanceDTO struct {
 	ScannedAt *time.Time              `json:"scanned_at"`
 }
 
-func (uc *AttendanceUseCases) MarkAttendance(ctx context.Context, clubID string, listID uuid.UUID, dto MarkAttendanceDTO) error {
+// MarkAttendance records a manual mark taken by markedBy (the coach or admin using the app).
+func (uc *AttendanceUseCases) MarkAttendance(ctx context.Context, clubID string, listID uuid.UUID, dto MarkAttendanceDTO, markedBy string) error {
 	list, err := uc.repo.GetListByID(ctx, clubID, listID)
 	if err != nil {
 		return err
 	}
 	if list == nil {
-		return errors.New("list not found")
+		return ErrListNotFound
+	}
+
+	if !dto.Status.IsValid() {
+		return errors.New("invalid attendance status")
 	}
 
+	// If the user is already in the list we update their record, otherwise a new one is inserted
 	record := &domain.AttendanceRecord{
-		ID: uuid.New(), // Might need to check if exists to keep ID? Repo Upsert handles it?
-		// Logic: If user already in list, update. If not, insert.
+		ID:               uuid.New(),
 		AttendanceListID: listID,
 		UserID:           dto.UserID,
-		Status:           dto.Status,
-		Notes:            dto.Notes,
-		ScannedAt:        dto.ScannedAt,
+		Status:           domain.StatusAbsent,
 	}
-
-	if record.ScannedAt == nil {
-		now := time.Now()
-		record.ScannedAt = &now
-	}
-
-	// For Upsert to work on ID, we need the ID.
-	// The repo implementation uses ID as PK.
-	// We need to find the specific record ID if it exists.
-	// The repo `GetListByID` preloads records. We can search there.
 	for _, r := range list.Records {
 		if r.UserID == dto.UserID {
-			record.ID = r.ID
+			existing := r
+			record = &existing
 			break
 		}
 	}
-	// If record.ID is new, Upsert (GORM Save) will Insert.
+
+	// A scan time sent by the coach app counts as a check-in; the mark itself is taken now
+	if dto.ScannedAt != nil {
+		record.ApplyScan(*dto.ScannedAt)
+	}
+	record.ApplyManualMark(dto.Status, dto.Notes, markedBy, time.Now())
 
 	return uc.repo.UpsertRecord(ctx, record)
 }
@@ -64,6 +64,47 @@ func (m *MockAttendanceRepo) GetAttendanceStats(ctx context.Context, clubID, use
 	return args.Int(0), args.Int(1), args.Error(2)
 }
 
+func (m *MockAttendanceRepo) GetSyncOperation(ctx context.Context, clubID, idempotencyKey string) (*domain.SyncOperation, error) {
+	args := m.Called(ctx, clubID, idempotencyKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SyncOperation), args.Error(1)
+}
+
+func (m *MockAttendanceRepo) CreateSyncOperation(ctx context.Context, op *domain.SyncOperation, record *domain.AttendanceRecord) (bool, error) {
+	args := m.Called(ctx, op, record)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAttendanceRepo) CreateNotice(ctx context.Context, notice *domain.AbsenceNotice) error {
+	args := m.Called(ctx, notice)
+	return args.Error(0)
+}
+
+func (m *MockAttendanceRepo) GetNotice(ctx context.Context, clubID string, id uuid.UUID) (*domain.AbsenceNotice, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AbsenceNotice), args.Error(1)
+}
+
+func (m *MockAttendanceRepo) UpdateNotice(ctx context.Context, notice *domain.AbsenceNotice) error {
+	args := m.Called(ctx, notice)
+	return args.Error(0)
+}
+
+func (m *MockAttendanceRepo) ListNotices(ctx context.Context, clubID string, userIDs []string) ([]domain.AbsenceNotice, error) {
+	args := m.Called(ctx, clubID, userIDs)
+	return args.Get(0).([]domain.AbsenceNotice), args.Error(1)
+}
+
+func (m *MockAttendanceRepo) ListActiveNotices(ctx context.Context, clubID string, userIDs []string, date time.Time) ([]domain.AbsenceNotice, error) {
+	args := m.Called(ctx, clubID, userIDs, date)
+	return args.Get(0).([]domain.AbsenceNotice), args.Error(1)
+}
+
 type MockUserRepo struct {
 	mock.Mock
 }
@@ -78,15 +119,22 @@ func (m *MockUserRepo) ListByIDs(ctx context.Context, clubID string, ids []strin
 	return args.Get(0).([]userDomain.User), args.Error(1)
 }
 
-// Minimal implementation of other methods if needed (usually just used as interface)
 func (m *MockUserRepo) GetByID(ctx context.Context, clubID, id string) (*userDomain.User, error) {
-	return nil, nil
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*userDomain.User), args.Error(1)
 }
-func (m *MockUserRepo) Update(ctx context.Context, user *userDomain.User) error { return nil }
-func (m *MockUserRepo) Delete(ctx context.Context, clubID, id string) error     { return nil }
+
 func (m *MockUserRepo) FindChildren(ctx context.Context, clubID, parentID string) ([]userDomain.User, error) {
-	return nil, nil
+	args := m.Called(ctx, clubID, parentID)
+	return args.Get(0).([]userDomain.User), args.Error(1)
 }
+
+// Minimal implementation of other methods if needed (usually just used as interface)
+func (m *MockUserRepo) Update(ctx context.Context, user *userDomain.User) error { return nil }
+func (m *MockUserRepo) Delete(ctx context.Context, clubID, id string) error     { return nil }
 func (m *MockUserRepo) Create(ctx context.Context, user *userDomain.User) error { return nil }
 func (m *MockUserRepo) CreateIncident(ctx context.Context, incident *userDomain.IncidentLog) error {
 	return nil
@@ -140,11 +188,18 @@ func (m *MockMembershipRepo) ListAll(ctx context.Context, clubID string) ([]memb
 	return nil, nil
 }
 
+type MockRosterProvider struct{ mock.Mock }
+
+func (m *MockRosterProvider) ListRosterUserIDs(ctx context.Context, clubID string, groupID uuid.UUID, date time.Time) ([]string, error) {
+	args := m.Called(ctx, clubID, groupID, date)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func TestAttendanceUseCases_GetOrCreateList(t *testing.T) {
 	repo := new(MockAttendanceRepo)
 	userRepo := new(MockUserRepo)
 	membershipRepo := new(MockMembershipRepo)
-	uc := application.NewAttendanceUseCases(repo, userRepo, membershipRepo)
+	uc := application.NewAttendanceUseCases(repo, userRepo, membershipRepo, nil)
 
 	ctx := context.TODO()
 	clubID := "club-1"
@@ -152,6 +207,8 @@ func TestAttendanceUseCases_GetOrCreateList(t *testing.T) {
 	date := time.Now().Truncate(24 * time.Hour)
 	coachID := "coach-1"
 
+	repo.On("ListActiveNotices", ctx, clubID, mock.Anything, mock.Anything).Return([]domain.AbsenceNotice{}, nil).Maybe()
+
 	t.Run("Returns existing list", func(t *testing.T) {
 		existing := &domain.AttendanceList{ID: uuid.New(), Group: group, Date: date}
 		repo.On("GetListByGroupAndDate", ctx, clubID, group, date).Return(existing, nil).Once()
@@ -183,9 +240,64 @@ func TestAttendanceUseCases_GetOrCreateList(t *testing.T) {
 	})
 }
 
+func TestAttendanceUseCases_GetOrCreateListByTrainingGroup(t *testing.T) {
+	repo := new(MockAttendanceRepo)
+	userRepo := new(MockUserRepo)
+	membershipRepo := new(MockMembershipRepo)
+	roster := new(MockRosterProvider)
+	uc := application.NewAttendanceUseCases(repo, userRepo, membershipRepo, roster)
+
+	ctx := context.TODO()
+	clubID := "club-1"
+	groupID := uuid.New()
+	date := time.Now().Truncate(24 * time.Hour)
+	enrolledA := uuid.New().String()
+	enrolledB := uuid.New().String()
+
+	userRepo.On("ListByIDs", ctx, clubID, mock.Anything).Return([]userDomain.User{}, nil)
+	repo.On("ListActiveNotices", ctx, clubID, mock.Anything, mock.Anything).Return([]domain.AbsenceNotice{}, nil).Maybe()
+	membershipRepo.On("GetByUserIDs", ctx, clubID, mock.Anything).Return([]membershipDomain.Membership{}, nil)
+
+	t.Run("Creates list from enrollment roster", func(t *testing.T) {
+		roster.On("ListRosterUserIDs", ctx, clubID, groupID, date).Return([]string{enrolledA, enrolledB}, nil).Once()
+		repo.On("GetListByTrainingGroupAndDate", ctx, clubID, groupID, date).Return(nil, nil).Once()
+		repo.On("CreateList", ctx, mock.MatchedBy(func(l *domain.AttendanceList) bool {
+			return l.TrainingGroupID != nil && *l.TrainingGroupID == groupID
+		})).Return(nil).Once()
+		repo.On("UpsertRecord", ctx, mock.Anything).Return(nil).Twice()
+
+		res, err := uc.GetOrCreateListByTrainingGroup(ctx, clubID, groupID, "Sub-15", date, "coach-1")
+		assert.NoError(t, err)
+		assert.Len(t, res.Records, 2)
+		assert.Equal(t, enrolledA, res.Records[0].UserID)
+		assert.Equal(t, domain.StatusAbsent, res.Records[1].Status)
+		userRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Adds late enrollments to existing list", func(t *testing.T) {
+		existing := &domain.AttendanceList{
+			ID:              uuid.New(),
+			TrainingGroupID: &groupID,
+			Records:         []domain.AttendanceRecord{{ID: uuid.New(), UserID: enrolledA, Status: domain.StatusPresent}},
+		}
+		roster.On("ListRosterUserIDs", ctx, clubID, groupID, date).Return([]string{enrolledA, enrolledB}, nil).Once()
+		repo.On("GetListByTrainingGroupAndDate", ctx, clubID, groupID, date).Return(existing, nil).Once()
+		repo.On("UpsertRecord", ctx, mock.MatchedBy(func(r *domain.AttendanceRecord) bool {
+			return r.UserID == enrolledB && r.AttendanceListID == existing.ID
+		})).Return(nil).Once()
+
+		res, err := uc.GetOrCreateListByTrainingGroup(ctx, clubID, groupID, "Sub-15", date, "coach-1")
+		assert.NoError(t, err)
+		assert.Len(t, res.Records, 2)
+		assert.Equal(t, domain.StatusPresent, res.Records[0].Status)
+		repo.AssertExpectations(t)
+	})
+}
+
 func TestAttendanceUseCases_MarkAttendance(t *testing.T) {
 	repo := new(MockAttendanceRepo)
-	uc := application.NewAttendanceUseCases(repo, nil, nil)
+	uc := application.NewAttendanceUseCases(repo, nil, nil, nil)
 
 	ctx := context.TODO()
 	clubID := "club-1"
@@ -203,13 +315,13 @@ func TestAttendanceUseCases_MarkAttendance(t *testing.T) {
 
 		repo.On("GetListByID", ctx, clubID, listID).Return(list, nil).Once()
 		repo.On("UpsertRecord", ctx, mock.MatchedBy(func(r *domain.AttendanceRecord) bool {
-			return r.ID == recordID && r.UserID == userID && r.Status == domain.StatusPresent
+			return r.ID == recordID && r.UserID == userID && r.Status == domain.StatusPresent && r.MarkedBy == "coach-1"
 		})).Return(nil).Once()
 
 		err := uc.MarkAttendance(ctx, clubID, listID, application.MarkAttendanceDTO{
 			UserID: userID,
 			Status: domain.StatusPresent,
-		})
+		}, "coach-1")
 		assert.NoError(t, err)
 		repo.AssertExpectations(t)
 	})
@@ -224,7 +336,7 @@ func TestAttendanceUseCases_MarkAttendance(t *testing.T) {
 		err := uc.MarkAttendance(ctx, clubID, listID, application.MarkAttendanceDTO{
 			UserID: userID,
 			Status: domain.StatusLate,
-		})
+		}, "coach-1")
 		assert.NoError(t, err)
 		repo.AssertExpectations(t)
 	})
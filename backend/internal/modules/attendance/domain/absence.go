@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// AbsenceNotice is an absence reported ahead of time by a parent for their child. Records of the
+// covered sessions are marked EXCUSED when the list is generated, unless the coach already
+// marked them or the player checked in anyway.
+type AbsenceNotice struct {
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID          string     `json:"club_id" gorm:"index;not null"`
+	UserID          string     `json:"user_id" gorm:"index;not null"` // The player
+	ReportedBy      string     `json:"reported_by" gorm:"not null"`
+	TrainingGroupID *uuid.UUID `json:"training_group_id,omitempty" gorm:"type:uuid"` // Nil covers every session of the player
+	FromDate        time.Time  `json:"from_date" gorm:"type:date;not null"`
+	UntilDate       time.Time  `json:"until_date" gorm:"type:date;not null"`
+	Reason          string     `json:"reason" gorm:"not null"`
+	// Optional medical certificate, stored in the file storage and encrypted at rest when configured
+	DocumentURL         string     `json:"document_url,omitempty"` // Authenticated download path
+	DocumentKey         string     `json:"-"`
+	DocumentContentType string     `json:"document_content_type,omitempty"`
+	DocumentSizeBytes   int64      `json:"document_size_bytes,omitempty"`
+	DocumentEncrypted   bool       `json:"document_encrypted,omitempty"`
+	CancelledAt         *time.Time `json:"cancelled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (AbsenceNotice) TableName() string {
+	return "attendance_absence_notices"
+}
+
+// HasDocument reports whether a medical certificate was uploaded with the notice.
+func (n *AbsenceNotice) HasDocument() bool {
+	return n.DocumentKey != ""
+}
+
+// DocumentAccessibleBy reports whether the user may open the medical certificate. It is health
+// data: only the parent who reported it, the player, medical staff and super admins can see it.
+func (n *AbsenceNotice) DocumentAccessibleBy(userID, role string) bool {
+	return n.ReportedBy == userID || n.UserID == userID || role == userDomain.RoleMedicalStaff || role == userDomain.RoleSuperAdmin
+}
+
+// Covers reports whether the notice applies to a session of the group (nil for category lists) on the date.
+func (n *AbsenceNotice) Covers(groupID *uuid.UUID, date time.Time) bool {
+	if n.CancelledAt != nil {
+		return false
+	}
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	if day.Before(n.FromDate) || day.After(n.UntilDate) {
+		return false
+	}
+	return n.TrainingGroupID == nil || (groupID != nil && *groupID == *n.TrainingGroupID)
+}
+
+// ApplyNotice marks an untouched record EXCUSED by the notice, or reverts it to ABSENT when the
+// notice that excused it no longer applies (nil). Records already marked by the coach or scanned
+// are left alone. It returns whether the record changed.
+func (r *AttendanceRecord) ApplyNotice(notice *AbsenceNotice) bool {
+	if r.MarkedAt != nil || r.ScannedAt != nil {
+		return false
+	}
+	if notice == nil {
+		if r.Source != SourceNotice {
+			return false
+		}
+		r.Status = StatusAbsent
+		r.Source = ""
+		r.AbsenceNoticeID = nil
+		return true
+	}
+	if r.Source == SourceNotice && r.AbsenceNoticeID != nil && *r.AbsenceNoticeID == notice.ID {
+		return false
+	}
+	r.Status = StatusExcused
+	r.Source = SourceNotice
+	id := notice.ID
+	r.AbsenceNoticeID = &id
+	return true
+}
@@ -0,0 +1,73 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbsenceNotice_Covers(t *testing.T) {
+	groupID := uuid.New()
+	from := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	notice := domain.AbsenceNotice{FromDate: from, UntilDate: from.AddDate(0, 0, 2), TrainingGroupID: &groupID}
+
+	assert.True(t, notice.Covers(&groupID, from.Add(18*time.Hour)))
+	assert.True(t, notice.Covers(&groupID, from.AddDate(0, 0, 2)))
+	assert.False(t, notice.Covers(&groupID, from.AddDate(0, 0, 3)))
+	assert.False(t, notice.Covers(&groupID, from.AddDate(0, 0, -1)))
+
+	other := uuid.New()
+	assert.False(t, notice.Covers(&other, from))
+	assert.False(t, notice.Covers(nil, from), "Category lists are not covered by a group notice")
+
+	anyGroup := domain.AbsenceNotice{FromDate: from, UntilDate: from}
+	assert.True(t, anyGroup.Covers(nil, from))
+	assert.True(t, anyGroup.Covers(&other, from))
+
+	now := time.Now()
+	anyGroup.CancelledAt = &now
+	assert.False(t, anyGroup.Covers(nil, from))
+}
+
+func TestAttendanceRecord_ApplyNotice(t *testing.T) {
+	notice := &domain.AbsenceNotice{ID: uuid.New()}
+
+	t.Run("Excuses an untouched record", func(t *testing.T) {
+		r := domain.AttendanceRecord{Status: domain.StatusAbsent}
+		assert.True(t, r.ApplyNotice(notice))
+		assert.Equal(t, domain.StatusExcused, r.Status)
+		assert.Equal(t, domain.SourceNotice, r.Source)
+		assert.Equal(t, notice.ID, *r.AbsenceNoticeID)
+
+		// Re-applying the same notice is a no-op
+		assert.False(t, r.ApplyNotice(notice))
+	})
+
+	t.Run("Reverts when the notice is withdrawn", func(t *testing.T) {
+		r := domain.AttendanceRecord{Status: domain.StatusAbsent}
+		r.ApplyNotice(notice)
+		assert.True(t, r.ApplyNotice(nil))
+		assert.Equal(t, domain.StatusAbsent, r.Status)
+		assert.Nil(t, r.AbsenceNoticeID)
+	})
+
+	t.Run("Coach marks and scans take precedence", func(t *testing.T) {
+		marked := domain.AttendanceRecord{Status: domain.StatusAbsent}
+		marked.ApplyManualMark(domain.StatusPresent, "", "coach-1", time.Now())
+		assert.False(t, marked.ApplyNotice(notice))
+		assert.Equal(t, domain.StatusPresent, marked.Status)
+
+		scanned := domain.AttendanceRecord{Status: domain.StatusAbsent}
+		scanned.ApplyScan(time.Now())
+		assert.False(t, scanned.ApplyNotice(notice))
+		assert.Equal(t, domain.StatusPresent, scanned.Status)
+	})
+
+	t.Run("Leaves records without a notice alone", func(t *testing.T) {
+		r := domain.AttendanceRecord{Status: domain.StatusAbsent}
+		assert.False(t, r.ApplyNotice(nil))
+	})
+}
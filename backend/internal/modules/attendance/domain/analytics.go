@@ -0,0 +1,236 @@
+package domain
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionRecord is one player's record in a training group session where attendance was taken.
+type SessionRecord struct {
+	TrainingGroupID uuid.UUID        `json:"training_group_id"`
+	ListID          uuid.UUID        `json:"list_id"`
+	Date            time.Time        `json:"date"`
+	UserID          string           `json:"user_id"`
+	Status          AttendanceStatus `json:"status"`
+}
+
+// counts reports whether the record is present (PRESENT or LATE) and whether it counts towards
+// the rate at all: EXCUSED absences are left out of the denominator.
+func (r SessionRecord) counts() (present, counted bool) {
+	switch r.Status {
+	case StatusPresent, StatusLate:
+		return true, true
+	case StatusExcused:
+		return false, false
+	}
+	return false, true
+}
+
+type WeeklyRate struct {
+	WeekStart time.Time `json:"week_start"` // Monday
+	Sessions  int       `json:"sessions"`
+	Present   int       `json:"present"`
+	Total     int       `json:"total"`
+	Rate      float64   `json:"rate"` // 0..1
+}
+
+type PlayerAttendance struct {
+	UserID              string     `json:"user_id"`
+	Present             int        `json:"present"`
+	Excused             int        `json:"excused"`
+	Total               int        `json:"total"` // Sessions counted for the rate (excused excluded)
+	Rate                float64    `json:"rate"`
+	ConsecutiveAbsences int        `json:"consecutive_absences"` // Current streak, most recent sessions
+	LongestAbsenceRun   int        `json:"longest_absence_run"`
+	LastPresent         *time.Time `json:"last_present,omitempty"`
+	AtRisk              bool       `json:"at_risk"`
+}
+
+type GroupAnalytics struct {
+	TrainingGroupID uuid.UUID          `json:"training_group_id"`
+	From            time.Time          `json:"from"`
+	To              time.Time          `json:"to"`
+	Sessions        int                `json:"sessions"`
+	Rate            float64            `json:"rate"`
+	Weekly          []WeeklyRate       `json:"weekly"`
+	Players         []PlayerAttendance `json:"players"`
+}
+
+// GroupComparison summarizes one group when comparing the groups of a discipline.
+type GroupComparison struct {
+	TrainingGroupID uuid.UUID `json:"training_group_id"`
+	Name            string    `json:"name"`
+	Sessions        int       `json:"sessions"`
+	Players         int       `json:"players"`
+	Rate            float64   `json:"rate"`
+	AtRiskPlayers   int       `json:"at_risk_players"`
+}
+
+// BuildGroupAnalytics aggregates the records of one group: the weekly trend and, per player, the
+// rate and absence streaks. Streaks skip EXCUSED sessions (they neither extend nor break them).
+func BuildGroupAnalytics(groupID uuid.UUID, records []SessionRecord, from, to time.Time, policy AlertPolicy) GroupAnalytics {
+	sorted := make([]SessionRecord, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	result := GroupAnalytics{TrainingGroupID: groupID, From: from, To: to, Weekly: []WeeklyRate{}, Players: []PlayerAttendance{}}
+
+	weeks := make(map[time.Time]*WeeklyRate)
+	weekLists := make(map[time.Time]map[uuid.UUID]bool)
+	sessions := make(map[uuid.UUID]bool)
+	players := make(map[string]*PlayerAttendance)
+	var order []string
+	present, total := 0, 0
+
+	for _, rec := range sorted {
+		sessions[rec.ListID] = true
+		isPresent, counted := rec.counts()
+
+		week := WeekStart(rec.Date)
+		w, ok := weeks[week]
+		if !ok {
+			w = &WeeklyRate{WeekStart: week}
+			weeks[week] = w
+			weekLists[week] = make(map[uuid.UUID]bool)
+		}
+		weekLists[week][rec.ListID] = true
+
+		p, ok := players[rec.UserID]
+		if !ok {
+			p = &PlayerAttendance{UserID: rec.UserID}
+			players[rec.UserID] = p
+			order = append(order, rec.UserID)
+		}
+
+		if !counted {
+			p.Excused++
+			continue
+		}
+		w.Total++
+		p.Total++
+		total++
+		if isPresent {
+			w.Present++
+			p.Present++
+			present++
+			date := rec.Date
+			p.LastPresent = &date
+			p.ConsecutiveAbsences = 0
+		} else {
+			p.ConsecutiveAbsences++
+			if p.ConsecutiveAbsences > p.LongestAbsenceRun {
+				p.LongestAbsenceRun = p.ConsecutiveAbsences
+			}
+		}
+	}
+
+	result.Sessions = len(sessions)
+	result.Rate = rate(present, total)
+
+	for week, w := range weeks {
+		w.Sessions = len(weekLists[week])
+		w.Rate = rate(w.Present, w.Total)
+		result.Weekly = append(result.Weekly, *w)
+	}
+	sort.Slice(result.Weekly, func(i, j int) bool { return result.Weekly[i].WeekStart.Before(result.Weekly[j].WeekStart) })
+
+	for _, userID := range order {
+		p := players[userID]
+		p.Rate = rate(p.Present, p.Total)
+		p.AtRisk = len(policy.Evaluate(*p)) > 0
+		result.Players = append(result.Players, *p)
+	}
+	// Players most at risk first
+	sort.SliceStable(result.Players, func(i, j int) bool {
+		if result.Players[i].ConsecutiveAbsences != result.Players[j].ConsecutiveAbsences {
+			return result.Players[i].ConsecutiveAbsences > result.Players[j].ConsecutiveAbsences
+		}
+		return result.Players[i].Rate < result.Players[j].Rate
+	})
+	return result
+}
+
+// WeekStart returns the Monday (UTC midnight) of the week that contains the day.
+func WeekStart(day time.Time) time.Time {
+	d := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	return d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+}
+
+func rate(present, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(present) / float64(total)
+}
+
+type AlertReason string
+
+const (
+	AlertConsecutiveAbsences AlertReason = "CONSECUTIVE_ABSENCES"
+	AlertLowAttendance       AlertReason = "LOW_ATTENDANCE"
+)
+
+// AlertPolicy decides when a player is at risk.
+type AlertPolicy struct {
+	ConsecutiveAbsences int     // Alert after this many absences in a row
+	MinRate             float64 // Alert below this rate...
+	MinSessions         int     // ...once the player has at least this many counted sessions
+}
+
+var DefaultAlertPolicy = AlertPolicy{ConsecutiveAbsences: 3, MinRate: 0.6, MinSessions: 4}
+
+func (p AlertPolicy) Evaluate(player PlayerAttendance) []AlertReason {
+	var reasons []AlertReason
+	if p.ConsecutiveAbsences > 0 && player.ConsecutiveAbsences >= p.ConsecutiveAbsences {
+		reasons = append(reasons, AlertConsecutiveAbsences)
+	}
+	if p.MinRate > 0 && player.Total >= p.MinSessions && player.Rate < p.MinRate {
+		reasons = append(reasons, AlertLowAttendance)
+	}
+	return reasons
+}
+
+// AttendanceAlert is an at-risk notice for a player in a group. It stays open while the
+// condition holds, so coaches and parents are notified once per episode.
+type AttendanceAlert struct {
+	ID              uuid.UUID   `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID          string      `json:"club_id" gorm:"index;not null"`
+	TrainingGroupID uuid.UUID   `json:"training_group_id" gorm:"type:uuid;not null"`
+	UserID          string      `json:"user_id" gorm:"not null"`
+	Reason          AlertReason `json:"reason" gorm:"size:30;not null"`
+	Value           float64     `json:"value"` // Streak length or attendance rate
+	ResolvedAt      *time.Time  `json:"resolved_at,omitempty"`
+	CreatedAt       time.Time   `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (AttendanceAlert) TableName() string {
+	return "attendance_alerts"
+}
+
+type AnalyticsRepository interface {
+	// ListSessionRecords returns the records of training group sessions where attendance was
+	// actually taken (at least one mark or scan) between from and to. No groups means all.
+	ListSessionRecords(ctx context.Context, clubID string, groupIDs []uuid.UUID, from, to time.Time) ([]SessionRecord, error)
+
+	// CreateAlert opens an alert; it returns false if the same player, group and reason already has
+	// an open one (e.g. two overlapping job runs)
+	CreateAlert(ctx context.Context, alert *AttendanceAlert) (bool, error)
+	ListOpenAlerts(ctx context.Context, clubID string, groupID *uuid.UUID) ([]AttendanceAlert, error)
+	ResolveAlert(ctx context.Context, clubID string, id uuid.UUID, resolvedAt time.Time) error
+}
+
+// TrainingGroupRef is the data attendance needs about a training group.
+type TrainingGroupRef struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	CoachID string    `json:"coach_id"`
+}
+
+// TrainingGroupDirectory looks up training groups. Implemented by an adapter of the disciplines module.
+type TrainingGroupDirectory interface {
+	GetTrainingGroup(ctx context.Context, clubID string, groupID uuid.UUID) (*TrainingGroupRef, error)
+	ListDisciplineGroups(ctx context.Context, clubID string, disciplineID uuid.UUID) ([]TrainingGroupRef, error)
+}
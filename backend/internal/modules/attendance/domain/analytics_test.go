@@ -0,0 +1,75 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildGroupAnalytics(t *testing.T) {
+	groupID := uuid.New()
+	// Two sessions a week (Mon/Wed) during two weeks
+	days := []time.Time{
+		time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC),
+	}
+	lists := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+	statuses := map[string][]domain.AttendanceStatus{
+		"regular": {domain.StatusPresent, domain.StatusLate, domain.StatusPresent, domain.StatusPresent},
+		"fading":  {domain.StatusPresent, domain.StatusAbsent, domain.StatusExcused, domain.StatusAbsent},
+		"gone":    {domain.StatusPresent, domain.StatusAbsent, domain.StatusAbsent, domain.StatusAbsent},
+	}
+
+	var records []domain.SessionRecord
+	for userID, sts := range statuses {
+		for i, st := range sts {
+			records = append(records, domain.SessionRecord{TrainingGroupID: groupID, ListID: lists[i], Date: days[i], UserID: userID, Status: st})
+		}
+	}
+
+	a := domain.BuildGroupAnalytics(groupID, records, days[0], days[3], domain.DefaultAlertPolicy)
+
+	assert.Equal(t, 4, a.Sessions)
+	if assert.Len(t, a.Weekly, 2) {
+		assert.Equal(t, days[0], a.Weekly[0].WeekStart)
+		assert.Equal(t, 2, a.Weekly[0].Sessions)
+		assert.Equal(t, 4, a.Weekly[0].Present) // 6 records, 2 absences
+		assert.Equal(t, 6, a.Weekly[0].Total)
+		// Week 2: the excused record is left out of the rate
+		assert.Equal(t, 5, a.Weekly[1].Total)
+	}
+
+	if assert.Len(t, a.Players, 3) {
+		// Most at risk first
+		assert.Equal(t, "gone", a.Players[0].UserID)
+		assert.Equal(t, 3, a.Players[0].ConsecutiveAbsences)
+		assert.True(t, a.Players[0].AtRisk)
+		assert.Equal(t, days[0], *a.Players[0].LastPresent)
+
+		fading := a.Players[1]
+		assert.Equal(t, "fading", fading.UserID)
+		assert.Equal(t, 2, fading.ConsecutiveAbsences) // Excused does not break the streak
+		assert.Equal(t, 1, fading.Excused)
+		assert.Equal(t, 3, fading.Total)
+		assert.False(t, fading.AtRisk) // Below MinSessions for the rate rule
+
+		assert.Equal(t, "regular", a.Players[2].UserID)
+		assert.Equal(t, 1.0, a.Players[2].Rate)
+	}
+}
+
+func TestAlertPolicy_Evaluate(t *testing.T) {
+	policy := domain.AlertPolicy{ConsecutiveAbsences: 3, MinRate: 0.6, MinSessions: 4}
+
+	assert.Empty(t, policy.Evaluate(domain.PlayerAttendance{Total: 5, Rate: 0.8, ConsecutiveAbsences: 1}))
+	assert.Equal(t, []domain.AlertReason{domain.AlertConsecutiveAbsences},
+		policy.Evaluate(domain.PlayerAttendance{Total: 10, Rate: 0.7, ConsecutiveAbsences: 3}))
+	assert.Equal(t, []domain.AlertReason{domain.AlertLowAttendance},
+		policy.Evaluate(domain.PlayerAttendance{Total: 4, Rate: 0.5}))
+	assert.Empty(t, policy.Evaluate(domain.PlayerAttendance{Total: 3, Rate: 0.3}))
+}
@@ -17,6 +17,23 @@ const (
 	StatusExcused AttendanceStatus = "EXCUSED"
 )
 
+func (s AttendanceStatus) IsValid() bool {
+	switch s {
+	case StatusPresent, StatusAbsent, StatusLate, StatusExcused:
+		return true
+	}
+	return false
+}
+
+// RecordSource tells which event decided the current status of a record.
+type RecordSource string
+
+const (
+	SourceManual RecordSource = "MANUAL" // Marked by the coach (online or offline sync)
+	SourceScan   RecordSource = "SCAN"   // Player scanned the session QR code
+	SourceNotice RecordSource = "NOTICE" // Excused by an absence notice of the parent
+)
+
 // AttendanceList represents a roll call session for a specific group/category on a specific date.
 type AttendanceList struct {
 	ID              uuid.UUID          `json:"id"`
@@ -37,10 +54,79 @@ type AttendanceRecord struct {
 	UserID           string           `json:"user_id"`
 	Status           AttendanceStatus `json:"status"`
 	Notes            string           `json:"notes,omitempty"`
-	ScannedAt        *time.Time       `json:"scanned_at,omitempty"`
+	ScannedAt        *time.Time       `json:"scanned_at,omitempty"` // Last QR check-in
+	MarkedAt         *time.Time       `json:"marked_at,omitempty"`  // Client time of the last manual mark
+	MarkedBy         string           `json:"marked_by,omitempty"`
+	Source           RecordSource     `json:"source,omitempty"`
+	AbsenceNoticeID  *uuid.UUID       `json:"absence_notice_id,omitempty"`
 	HasDebt          bool             `json:"has_debt"` // Computed field for UI
 	// Populated for response convenience
-	User *userDomain.User `json:"user,omitempty" gorm:"-"`
+	User   *userDomain.User `json:"user,omitempty" gorm:"-"`
+	Excuse *AbsenceNotice   `json:"excuse,omitempty" gorm:"-"` // Notice covering the session, with its reason
+}
+
+// ApplyScan registers a QR check-in at the given time. It returns false when a manual mark
+// taken later already decided the status (see resolve).
+func (r *AttendanceRecord) ApplyScan(at time.Time) bool {
+	if r.ScannedAt == nil || at.After(*r.ScannedAt) {
+		r.ScannedAt = &at
+	}
+	return r.resolve(at, SourceScan, StatusPresent)
+}
+
+// ApplyManualMark registers a coach mark taken at the given (client) time. Marks older than
+// the last one already applied are ignored; it returns false when the status was not changed
+// by this mark.
+func (r *AttendanceRecord) ApplyManualMark(status AttendanceStatus, notes, markedBy string, at time.Time) bool {
+	if r.MarkedAt != nil && at.Before(*r.MarkedAt) {
+		return false
+	}
+	r.MarkedAt = &at
+	r.MarkedBy = markedBy
+	r.Notes = notes
+	return r.resolve(at, SourceManual, status)
+}
+
+// resolve applies the conflict rule between scans and manual marks: the event that happened
+// last (by its own timestamp, not by arrival order) decides the status, and on a tie the coach
+// wins. This way an offline mark synced late does not undo a later check-in, while a coach can
+// still correct a scan afterwards (e.g. mark LATE or ABSENT).
+func (r *AttendanceRecord) resolve(at time.Time, source RecordSource, status AttendanceStatus) bool {
+	if source == SourceScan && r.MarkedAt != nil && !r.MarkedAt.Before(at) {
+		return false
+	}
+	if source == SourceManual && r.ScannedAt != nil && r.ScannedAt.After(at) {
+		return false
+	}
+	r.Status = status
+	r.Source = source
+	return true
+}
+
+// SyncOutcome is the result of one record of an offline batch.
+type SyncOutcome string
+
+const (
+	SyncApplied    SyncOutcome = "APPLIED"
+	SyncSuperseded SyncOutcome = "SUPERSEDED" // Stored, but a later event decides the status
+	SyncDuplicate  SyncOutcome = "DUPLICATE"  // Idempotency key already processed
+	SyncRejected   SyncOutcome = "REJECTED"
+)
+
+// SyncOperation remembers a processed offline record by its client idempotency key, so
+// retried batches are not applied twice.
+type SyncOperation struct {
+	ID               uuid.UUID   `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID           string      `json:"club_id" gorm:"index;not null"`
+	IdempotencyKey   string      `json:"idempotency_key" gorm:"size:100;not null"`
+	AttendanceListID uuid.UUID   `json:"attendance_list_id" gorm:"type:uuid"`
+	UserID           string      `json:"user_id"`
+	Outcome          SyncOutcome `json:"outcome" gorm:"size:20"`
+	CreatedAt        time.Time   `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (SyncOperation) TableName() string {
+	return "attendance_sync_operations"
 }
 
 type AttendanceRepository interface {
@@ -53,4 +139,23 @@ type AttendanceRepository interface {
 	UpsertRecord(ctx context.Context, record *AttendanceRecord) error
 	// GetAttendanceStats returns the count of present sessions and total sessions for a user in a date range
 	GetAttendanceStats(ctx context.Context, clubID, userID string, from, to time.Time) (present, total int, err error)
+
+	GetSyncOperation(ctx context.Context, clubID, idempotencyKey string) (*SyncOperation, error)
+	// CreateSyncOperation stores a processed key together with the record it changed (nil if none)
+	// in one transaction. It returns false, writing nothing, when the key was already processed.
+	CreateSyncOperation(ctx context.Context, op *SyncOperation, record *AttendanceRecord) (bool, error)
+
+	CreateNotice(ctx context.Context, notice *AbsenceNotice) error
+	GetNotice(ctx context.Context, clubID string, id uuid.UUID) (*AbsenceNotice, error)
+	UpdateNotice(ctx context.Context, notice *AbsenceNotice) error
+	// ListNotices returns the notices of the players, most recent first
+	ListNotices(ctx context.Context, clubID string, userIDs []string) ([]AbsenceNotice, error)
+	// ListActiveNotices returns the non-cancelled notices of the players whose range includes the date
+	ListActiveNotices(ctx context.Context, clubID string, userIDs []string, date time.Time) ([]AbsenceNotice, error)
+}
+
+// GroupRosterProvider resolves which members are enrolled in a training group on a given date.
+// Implemented by the disciplines enrollment repository.
+type GroupRosterProvider interface {
+	ListRosterUserIDs(ctx context.Context, clubID string, groupID uuid.UUID, date time.Time) ([]string, error)
 }
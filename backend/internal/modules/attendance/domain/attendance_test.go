@@ -0,0 +1,58 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttendanceRecord_ScanAndManualConflicts(t *testing.T) {
+	base := time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC)
+
+	t.Run("Scan after a manual mark wins", func(t *testing.T) {
+		r := domain.AttendanceRecord{Status: domain.StatusAbsent}
+		assert.True(t, r.ApplyManualMark(domain.StatusAbsent, "", "coach-1", base))
+		assert.True(t, r.ApplyScan(base.Add(5*time.Minute)))
+		assert.Equal(t, domain.StatusPresent, r.Status)
+		assert.Equal(t, domain.SourceScan, r.Source)
+	})
+
+	t.Run("Offline mark taken before the scan does not undo it", func(t *testing.T) {
+		r := domain.AttendanceRecord{Status: domain.StatusAbsent}
+		assert.True(t, r.ApplyScan(base.Add(5*time.Minute)))
+		// Synced later, but taken before the player scanned
+		assert.False(t, r.ApplyManualMark(domain.StatusAbsent, "", "coach-1", base))
+		assert.Equal(t, domain.StatusPresent, r.Status)
+		assert.NotNil(t, r.MarkedAt)
+	})
+
+	t.Run("Coach corrects a scan afterwards", func(t *testing.T) {
+		r := domain.AttendanceRecord{Status: domain.StatusAbsent}
+		r.ApplyScan(base)
+		assert.True(t, r.ApplyManualMark(domain.StatusLate, "llegó tarde", "coach-1", base.Add(10*time.Minute)))
+		assert.Equal(t, domain.StatusLate, r.Status)
+		assert.Equal(t, domain.SourceManual, r.Source)
+
+		// A scan older than the correction is kept as evidence only
+		assert.False(t, r.ApplyScan(base.Add(time.Minute)))
+		assert.Equal(t, domain.StatusLate, r.Status)
+	})
+
+	t.Run("Tie favors the coach", func(t *testing.T) {
+		r := domain.AttendanceRecord{Status: domain.StatusAbsent}
+		r.ApplyScan(base)
+		assert.True(t, r.ApplyManualMark(domain.StatusExcused, "", "coach-1", base))
+		assert.Equal(t, domain.StatusExcused, r.Status)
+	})
+
+	t.Run("Older manual marks are ignored", func(t *testing.T) {
+		r := domain.AttendanceRecord{Status: domain.StatusAbsent}
+		r.ApplyManualMark(domain.StatusPresent, "ok", "coach-1", base.Add(time.Minute))
+		assert.False(t, r.ApplyManualMark(domain.StatusAbsent, "", "coach-2", base))
+		assert.Equal(t, domain.StatusPresent, r.Status)
+		assert.Equal(t, "coach-1", r.MarkedBy)
+		assert.Equal(t, "ok", r.Notes)
+	})
+}
@@ -0,0 +1,144 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/application"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+type AnalyticsHandler struct {
+	useCases *application.AnalyticsUseCases
+}
+
+func NewAnalyticsHandler(useCases *application.AnalyticsUseCases) *AnalyticsHandler {
+	return &AnalyticsHandler{useCases: useCases}
+}
+
+// GetGroupAnalytics
+// GET /attendance/analytics/groups/:groupId?from=2024-01-01&to=2024-03-01
+func (h *AnalyticsHandler) GetGroupAnalytics(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	groupID, err := uuid.Parse(c.Param("groupId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group ID"})
+		return
+	}
+	from, to, ok := parseRange(c)
+	if !ok {
+		return
+	}
+
+	analytics, err := h.useCases.GetGroupAnalytics(c.Request.Context(), c.GetString("clubID"), groupID, from, to)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, application.ErrTrainingGroupNotFound) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, analytics)
+}
+
+// CompareDisciplineGroups
+// GET /attendance/analytics/disciplines/:disciplineId?from=2024-01-01&to=2024-03-01
+func (h *AnalyticsHandler) CompareDisciplineGroups(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	disciplineID, err := uuid.Parse(c.Param("disciplineId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid discipline ID"})
+		return
+	}
+	from, to, ok := parseRange(c)
+	if !ok {
+		return
+	}
+
+	comparisons, err := h.useCases.CompareDisciplineGroups(c.Request.Context(), c.GetString("clubID"), disciplineID, from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, comparisons)
+}
+
+// ListAlerts returns the open at-risk alerts
+// GET /attendance/analytics/alerts?group_id=
+func (h *AnalyticsHandler) ListAlerts(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	var groupID *uuid.UUID
+	if idStr := c.Query("group_id"); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group ID"})
+			return
+		}
+		groupID = &id
+	}
+
+	alerts, err := h.useCases.ListOpenAlerts(c.Request.Context(), c.GetString("clubID"), groupID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, alerts)
+}
+
+func requireCoach(c *gin.Context) bool {
+	role, exists := c.Get("userRole")
+	if !exists || (role != userDomain.RoleCoach && role != userDomain.RoleAdmin && role != userDomain.RoleSuperAdmin) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires COACH or ADMIN role"})
+		return false
+	}
+	return true
+}
+
+// parseRange reads from/to (YYYY-MM-DD); the default is the last eight weeks.
+func parseRange(c *gin.Context) (time.Time, time.Time, bool) {
+	now := time.Now().UTC()
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	from := to.AddDate(0, 0, -56)
+
+	if s := c.Query("from"); s != "" {
+		parsed, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format (YYYY-MM-DD)"})
+			return from, to, false
+		}
+		from = parsed
+	}
+	if s := c.Query("to"); s != "" {
+		parsed, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format (YYYY-MM-DD)"})
+			return from, to, false
+		}
+		to = parsed
+	}
+	if to.Before(from) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "to must be after from"})
+		return from, to, false
+	}
+	return from, to, true
+}
+
+func RegisterAnalyticsRoutes(r *gin.RouterGroup, handler *AnalyticsHandler, authMiddleware, tenantMiddleware gin.HandlerFunc) {
+	g := r.Group("/attendance/analytics")
+	g.Use(authMiddleware, tenantMiddleware)
+	{
+		g.GET("/groups/:groupId", handler.GetGroupAnalytics)
+		g.GET("/disciplines/:disciplineId", handler.CompareDisciplineGroups)
+		g.GET("/alerts", handler.ListAlerts)
+	}
+}
@@ -1,13 +1,17 @@
 package http
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"path"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/application"
+	attendanceDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
 	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
 )
 
@@ -73,7 +77,6 @@ func (h *AttendanceHandler) GetTrainingGroupAttendance(c *gin.Context) {
 
 	dateStr := c.Query("date")
 	groupName := c.DefaultQuery("group_name", "Training Group")
-	category := c.Query("category")
 
 	date := time.Now()
 	if dateStr != "" {
@@ -90,7 +93,7 @@ func (h *AttendanceHandler) GetTrainingGroupAttendance(c *gin.Context) {
 	}
 
 	clubID := c.GetString("clubID")
-	list, err := h.useCases.GetOrCreateListByTrainingGroup(c.Request.Context(), clubID, groupID, groupName, category, date, coachID.(string))
+	list, err := h.useCases.GetOrCreateListByTrainingGroup(c.Request.Context(), clubID, groupID, groupName, date, coachID.(string))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -123,7 +126,7 @@ func (h *AttendanceHandler) SubmitAttendance(c *gin.Context) {
 	}
 
 	clubID := c.GetString("clubID")
-	if err := h.useCases.MarkAttendance(c.Request.Context(), clubID, listID, dto); err != nil {
+	if err := h.useCases.MarkAttendance(c.Request.Context(), clubID, listID, dto, c.GetString("userID")); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -131,6 +134,232 @@ func (h *AttendanceHandler) SubmitAttendance(c *gin.Context) {
 	c.Status(http.StatusOK)
 }
 
+// GetCheckInCode returns the signed code the coach shows as a QR for players to check in
+// GET /attendance/:listID/check-in-code
+func (h *AttendanceHandler) GetCheckInCode(c *gin.Context) {
+	role, exists := c.Get("userRole")
+	if !exists || (role != userDomain.RoleCoach && role != userDomain.RoleAdmin && role != userDomain.RoleSuperAdmin) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires COACH or ADMIN role"})
+		return
+	}
+
+	listID, err := uuid.Parse(c.Param("listID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid list ID"})
+		return
+	}
+
+	code, err := h.useCases.GetCheckInCode(c.Request.Context(), c.GetString("clubID"), listID)
+	if err != nil {
+		c.JSON(checkInErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, code)
+}
+
+// CheckIn marks the authenticated player present from a scanned QR code
+// POST /attendance/check-in
+func (h *AttendanceHandler) CheckIn(c *gin.Context) {
+	var req struct {
+		Code string `json:"code" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	record, err := h.useCases.CheckIn(c.Request.Context(), c.GetString("clubID"), c.GetString("userID"), req.Code)
+	if err != nil {
+		c.JSON(checkInErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, record)
+}
+
+// SyncAttendance applies a batch of marks taken offline by the coach
+// POST /attendance/sync
+func (h *AttendanceHandler) SyncAttendance(c *gin.Context) {
+	role, exists := c.Get("userRole")
+	if !exists || (role != userDomain.RoleCoach && role != userDomain.RoleAdmin && role != userDomain.RoleSuperAdmin) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires COACH or ADMIN role"})
+		return
+	}
+
+	var dto application.SyncBatchDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	results, err := h.useCases.SyncBatch(c.Request.Context(), c.GetString("clubID"), c.GetString("userID"), dto)
+	if err != nil {
+		log.Printf("Error syncing attendance batch: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"results": results})
+}
+
+type reportAbsenceRequest struct {
+	UserID          string `form:"user_id" json:"user_id" binding:"required"`
+	TrainingGroupID string `form:"training_group_id" json:"training_group_id"`
+	FromDate        string `form:"from_date" json:"from_date" binding:"required"` // YYYY-MM-DD
+	UntilDate       string `form:"until_date" json:"until_date"`
+	Reason          string `form:"reason" json:"reason" binding:"required,max=500"`
+}
+
+// ReportAbsence lets a parent pre-report an absence of their child. Accepts JSON or a multipart
+// form with an optional "file" (medical certificate).
+// POST /attendance/absences
+func (h *AttendanceHandler) ReportAbsence(c *gin.Context) {
+	var req reportAbsenceRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	dto := application.ReportAbsenceDTO{UserID: req.UserID, Reason: req.Reason}
+	from, err := time.Parse("2006-01-02", req.FromDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format (YYYY-MM-DD)"})
+		return
+	}
+	dto.FromDate = from
+	if req.UntilDate != "" {
+		until, err := time.Parse("2006-01-02", req.UntilDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format (YYYY-MM-DD)"})
+			return
+		}
+		dto.UntilDate = &until
+	}
+	if req.TrainingGroupID != "" {
+		groupID, err := uuid.Parse(req.TrainingGroupID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid group ID"})
+			return
+		}
+		dto.TrainingGroupID = &groupID
+	}
+	if file, err := c.FormFile("file"); err == nil {
+		body, err := file.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Could not read the file"})
+			return
+		}
+		defer body.Close()
+		dto.Document = &application.AbsenceDocumentInput{Size: file.Size, Body: body}
+	}
+
+	notice, err := h.useCases.ReportAbsence(c.Request.Context(), c.GetString("clubID"), c.GetString("userID"), dto)
+	if err != nil {
+		c.JSON(absenceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, notice)
+}
+
+// ListAbsences returns the notices of the caller's children, or of one player for coaches
+// GET /attendance/absences?user_id=
+func (h *AttendanceHandler) ListAbsences(c *gin.Context) {
+	clubID := c.GetString("clubID")
+	role, _ := c.Get("userRole")
+	isStaff := role == userDomain.RoleCoach || role == userDomain.RoleAdmin || role == userDomain.RoleSuperAdmin
+
+	var notices []attendanceDomain.AbsenceNotice
+	var err error
+	if userID := c.Query("user_id"); userID != "" && isStaff {
+		notices, err = h.useCases.ListPlayerAbsences(c.Request.Context(), clubID, userID)
+	} else {
+		notices, err = h.useCases.ListChildrenAbsences(c.Request.Context(), clubID, c.GetString("userID"))
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, notices)
+}
+
+// CancelAbsence withdraws a notice reported by the caller
+// DELETE /attendance/absences/:id
+func (h *AttendanceHandler) CancelAbsence(c *gin.Context) {
+	noticeID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid notice ID"})
+		return
+	}
+
+	notice, err := h.useCases.CancelAbsence(c.Request.Context(), c.GetString("clubID"), c.GetString("userID"), noticeID)
+	if err != nil {
+		c.JSON(absenceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, notice)
+}
+
+// DownloadAbsenceDocument streams the medical certificate of a notice. Only the reporting parent,
+// the player, medical staff and super admins can open it; every access is audited.
+// GET /attendance/absences/:id/document
+func (h *AttendanceHandler) DownloadAbsenceDocument(c *gin.Context) {
+	noticeID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid notice ID"})
+		return
+	}
+
+	access := application.AbsenceDocumentAccess{
+		ClubID:    c.GetString("clubID"),
+		UserID:    c.GetString("userID"),
+		Role:      c.GetString("userRole"),
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+	body, notice, err := h.useCases.OpenAbsenceDocument(c.Request.Context(), access, noticeID)
+	if err != nil {
+		c.JSON(absenceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	defer body.Close()
+
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=%q", path.Base(notice.DocumentKey)))
+	c.Header("Cache-Control", "private, no-store")
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.DataFromReader(http.StatusOK, notice.DocumentSizeBytes, notice.DocumentContentType, body, nil)
+}
+
+func absenceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, application.ErrPlayerNotFound), errors.Is(err, application.ErrNoticeNotFound),
+		errors.Is(err, application.ErrAbsenceDocumentNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, application.ErrNotParent), errors.Is(err, application.ErrAbsenceDocumentDenied):
+		return http.StatusForbidden
+	case errors.Is(err, application.ErrAbsenceDocumentTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, application.ErrAbsenceDocumentUnsupported):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, application.ErrAbsenceDocumentInfected):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, application.ErrAbsenceStorageUnavailable):
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusBadRequest
+}
+
+func checkInErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, application.ErrListNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, application.ErrNotOnAttendanceList):
+		return http.StatusForbidden
+	case errors.Is(err, application.ErrInvalidCheckInCode), errors.Is(err, application.ErrCheckInCodeExpired):
+		return http.StatusBadRequest
+	case errors.Is(err, application.ErrCheckInDisabled):
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
 func RegisterRoutes(r *gin.RouterGroup, handler *AttendanceHandler, authMiddleware, tenantMiddleware gin.HandlerFunc) {
 	g := r.Group("/attendance")
 	g.Use(authMiddleware, tenantMiddleware)
@@ -138,5 +367,12 @@ func RegisterRoutes(r *gin.RouterGroup, handler *AttendanceHandler, authMiddlewa
 		g.GET("/groups/:group", handler.GetGroupAttendance)
 		g.GET("/training-groups/:id", handler.GetTrainingGroupAttendance)
 		g.POST("/:listID/records", handler.SubmitAttendance)
+		g.GET("/:listID/check-in-code", handler.GetCheckInCode)
+		g.POST("/check-in", handler.CheckIn)
+		g.POST("/sync", handler.SyncAttendance)
+		g.POST("/absences", handler.ReportAbsence)
+		g.GET("/absences", handler.ListAbsences)
+		g.DELETE("/absences/:id", handler.CancelAbsence)
+		g.GET("/absences/:id/document", handler.DownloadAbsenceDocument)
 	}
 }
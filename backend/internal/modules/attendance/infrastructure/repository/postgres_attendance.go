@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PostgresAttendanceRepository struct {
@@ -44,6 +45,10 @@ type AttendanceRecordModel struct {
 	Status           string // PRESENT, ABSENT, LATE, EXCUSED
 	Notes            string
 	ScannedAt        *time.Time
+	MarkedAt         *time.Time
+	MarkedBy         string
+	Source           string     // MANUAL, SCAN, NOTICE
+	AbsenceNoticeID  *uuid.UUID `gorm:"type:uuid"`
 }
 
 func (AttendanceRecordModel) TableName() string {
@@ -105,20 +110,28 @@ func (r *PostgresAttendanceRepository) GetListByTrainingGroupAndDate(ctx context
 }
 
 func (r *PostgresAttendanceRepository) UpsertRecord(ctx context.Context, record *domain.AttendanceRecord) error {
-	model := AttendanceRecordModel{
+	model := toRecordModel(record)
+	// On Conflict Update
+	// Postgres: ON CONFLICT (id) DO UPDATE
+	// But we might want unique (list_id, user_id).
+	// Let's assume ID is provided or we query first.
+	// Ideally Logic handles ID generation.
+	return r.db.WithContext(ctx).Save(&model).Error
+}
+
+func toRecordModel(record *domain.AttendanceRecord) AttendanceRecordModel {
+	return AttendanceRecordModel{
 		ID:               record.ID,
 		AttendanceListID: record.AttendanceListID,
 		UserID:           record.UserID,
 		Status:           string(record.Status),
 		Notes:            record.Notes,
 		ScannedAt:        record.ScannedAt,
+		MarkedAt:         record.MarkedAt,
+		MarkedBy:         record.MarkedBy,
+		Source:           string(record.Source),
+		AbsenceNoticeID:  record.AbsenceNoticeID,
 	}
-	// On Conflict Update
-	// Postgres: ON CONFLICT (id) DO UPDATE
-	// But we might want unique (list_id, user_id).
-	// Let's assume ID is provided or we query first.
-	// Ideally Logic handles ID generation.
-	return r.db.WithContext(ctx).Save(&model).Error
 }
 
 func (r *PostgresAttendanceRepository) UpdateRecord(ctx context.Context, record *domain.AttendanceRecord) error {
@@ -135,6 +148,10 @@ func (r *PostgresAttendanceRepository) mapToDomain(model *AttendanceListModel) *
 			Status:           domain.AttendanceStatus(rec.Status),
 			Notes:            rec.Notes,
 			ScannedAt:        rec.ScannedAt,
+			MarkedAt:         rec.MarkedAt,
+			MarkedBy:         rec.MarkedBy,
+			Source:           domain.RecordSource(rec.Source),
+			AbsenceNoticeID:  rec.AbsenceNoticeID,
 		}
 	}
 	return &domain.AttendanceList{
@@ -179,3 +196,116 @@ func (r *PostgresAttendanceRepository) GetAttendanceStats(ctx context.Context, c
 
 	return int(presentCount), int(totalCount), nil
 }
+
+func (r *PostgresAttendanceRepository) GetSyncOperation(ctx context.Context, clubID, idempotencyKey string) (*domain.SyncOperation, error) {
+	var op domain.SyncOperation
+	if err := r.db.WithContext(ctx).Where("club_id = ? AND idempotency_key = ?", clubID, idempotencyKey).First(&op).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &op, nil
+}
+
+func (r *PostgresAttendanceRepository) CreateSyncOperation(ctx context.Context, op *domain.SyncOperation, record *domain.AttendanceRecord) (bool, error) {
+	created := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The unique (club_id, idempotency_key) makes a concurrent retry of the same mark a no-op
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(op)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		if record != nil {
+			model := toRecordModel(record)
+			if err := tx.Save(&model).Error; err != nil {
+				return err
+			}
+		}
+		created = true
+		return nil
+	})
+	return created, err
+}
+
+func (r *PostgresAttendanceRepository) ListSessionRecords(ctx context.Context, clubID string, groupIDs []uuid.UUID, from, to time.Time) ([]domain.SessionRecord, error) {
+	var records []domain.SessionRecord
+	query := r.db.WithContext(ctx).Table("attendance_records ar").
+		Select("al.training_group_id, al.id AS list_id, al.date, ar.user_id, ar.status").
+		Joins("JOIN attendance_lists al ON ar.attendance_list_id = al.id").
+		Where("al.club_id = ? AND al.training_group_id IS NOT NULL", clubID).
+		Where("al.date >= ? AND al.date <= ?", from, to).
+		// Lists prepared ahead of time are all ABSENT until the coach takes attendance
+		Where("EXISTS (SELECT 1 FROM attendance_records taken WHERE taken.attendance_list_id = al.id AND (taken.marked_at IS NOT NULL OR taken.scanned_at IS NOT NULL))")
+	if len(groupIDs) > 0 {
+		query = query.Where("al.training_group_id IN ?", groupIDs)
+	}
+	err := query.Order("al.date ASC").Scan(&records).Error
+	return records, err
+}
+
+func (r *PostgresAttendanceRepository) CreateAlert(ctx context.Context, alert *domain.AttendanceAlert) (bool, error) {
+	// idx_attendance_alerts_unique_open allows one open alert per player, group and reason
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(alert)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *PostgresAttendanceRepository) ListOpenAlerts(ctx context.Context, clubID string, groupID *uuid.UUID) ([]domain.AttendanceAlert, error) {
+	var alerts []domain.AttendanceAlert
+	query := r.db.WithContext(ctx).Where("club_id = ? AND resolved_at IS NULL", clubID)
+	if groupID != nil {
+		query = query.Where("training_group_id = ?", *groupID)
+	}
+	err := query.Order("created_at DESC").Find(&alerts).Error
+	return alerts, err
+}
+
+func (r *PostgresAttendanceRepository) ResolveAlert(ctx context.Context, clubID string, id uuid.UUID, resolvedAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&domain.AttendanceAlert{}).
+		Where("id = ? AND club_id = ?", id, clubID).
+		Update("resolved_at", resolvedAt).Error
+}
+
+func (r *PostgresAttendanceRepository) CreateNotice(ctx context.Context, notice *domain.AbsenceNotice) error {
+	return r.db.WithContext(ctx).Create(notice).Error
+}
+
+func (r *PostgresAttendanceRepository) GetNotice(ctx context.Context, clubID string, id uuid.UUID) (*domain.AbsenceNotice, error) {
+	var notice domain.AbsenceNotice
+	if err := r.db.WithContext(ctx).First(&notice, "id = ? AND club_id = ?", id, clubID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &notice, nil
+}
+
+func (r *PostgresAttendanceRepository) UpdateNotice(ctx context.Context, notice *domain.AbsenceNotice) error {
+	return r.db.WithContext(ctx).Save(notice).Error
+}
+
+func (r *PostgresAttendanceRepository) ListNotices(ctx context.Context, clubID string, userIDs []string) ([]domain.AbsenceNotice, error) {
+	var notices []domain.AbsenceNotice
+	if len(userIDs) == 0 {
+		return notices, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("club_id = ? AND user_id IN ?", clubID, userIDs).
+		Order("from_date DESC").
+		Find(&notices).Error
+	return notices, err
+}
+
+func (r *PostgresAttendanceRepository) ListActiveNotices(ctx context.Context, clubID string, userIDs []string, date time.Time) ([]domain.AbsenceNotice, error) {
+	var notices []domain.AbsenceNotice
+	if len(userIDs) == 0 {
+		return notices, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("club_id = ? AND user_id IN ? AND cancelled_at IS NULL", clubID, userIDs).
+		Where("from_date <= ? AND until_date >= ?", date, date).
+		Order("created_at ASC").
+		Find(&notices).Error
+	return notices, err
+}
@@ -0,0 +1,231 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// AtRiskAlertJob alerts the coach and the parent of players that miss too many sessions.
+// An alert stays open while the condition holds and is resolved once the player recovers,
+// so each episode is notified once no matter how often the job runs.
+type AtRiskAlertJob struct {
+	repo         domain.AnalyticsRepository
+	groups       domain.TrainingGroupDirectory
+	roster       domain.GroupRosterProvider
+	userRepo     userDomain.UserRepository
+	notifier     notificationSvc.NotificationSender
+	policy       domain.AlertPolicy
+	lookbackDays int
+}
+
+func NewAtRiskAlertJob(
+	repo domain.AnalyticsRepository,
+	groups domain.TrainingGroupDirectory,
+	roster domain.GroupRosterProvider,
+	userRepo userDomain.UserRepository,
+	notifier notificationSvc.NotificationSender,
+	policy domain.AlertPolicy,
+	lookbackDays int,
+) *AtRiskAlertJob {
+	if lookbackDays <= 0 {
+		lookbackDays = 28 // Four weeks
+	}
+	return &AtRiskAlertJob{
+		repo:         repo,
+		groups:       groups,
+		roster:       roster,
+		userRepo:     userRepo,
+		notifier:     notifier,
+		policy:       policy,
+		lookbackDays: lookbackDays,
+	}
+}
+
+// Run evaluates every group with sessions in the lookback window and returns how many alerts
+// were raised.
+func (j *AtRiskAlertJob) Run(ctx context.Context, clubID string) (int, error) {
+	now := time.Now().UTC()
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	from := to.AddDate(0, 0, -j.lookbackDays)
+
+	records, err := j.repo.ListSessionRecords(ctx, clubID, nil, from, to)
+	if err != nil {
+		return 0, err
+	}
+	byGroup := make(map[uuid.UUID][]domain.SessionRecord)
+	var groupOrder []uuid.UUID
+	for _, rec := range records {
+		if _, ok := byGroup[rec.TrainingGroupID]; !ok {
+			groupOrder = append(groupOrder, rec.TrainingGroupID)
+		}
+		byGroup[rec.TrainingGroupID] = append(byGroup[rec.TrainingGroupID], rec)
+	}
+
+	openAlerts, err := j.repo.ListOpenAlerts(ctx, clubID, nil)
+	if err != nil {
+		return 0, err
+	}
+	open := make(map[string]domain.AttendanceAlert, len(openAlerts))
+	for _, a := range openAlerts {
+		open[alertKey(a.TrainingGroupID, a.UserID, a.Reason)] = a
+	}
+
+	raised := 0
+	evaluated := make(map[string]bool)
+	for _, groupID := range groupOrder {
+		n, err := j.evaluateGroup(ctx, clubID, groupID, byGroup[groupID], from, to, open, evaluated)
+		if err != nil {
+			return raised, err
+		}
+		raised += n
+	}
+
+	// Players without attendance taken in the window (or whose group had no sessions) are not at risk anymore
+	for _, alert := range openAlerts {
+		if !evaluated[playerKey(alert.TrainingGroupID, alert.UserID)] {
+			if err := j.repo.ResolveAlert(ctx, clubID, alert.ID, time.Now()); err != nil {
+				return raised, err
+			}
+		}
+	}
+	return raised, nil
+}
+
+func (j *AtRiskAlertJob) evaluateGroup(ctx context.Context, clubID string, groupID uuid.UUID, records []domain.SessionRecord, from, to time.Time, open map[string]domain.AttendanceAlert, evaluated map[string]bool) (int, error) {
+	group, err := j.groups.GetTrainingGroup(ctx, clubID, groupID)
+	if err != nil {
+		return 0, err
+	}
+	if group == nil {
+		return 0, nil
+	}
+
+	// Players that left the group are not alerted anymore
+	rosterIDs, err := j.roster.ListRosterUserIDs(ctx, clubID, groupID, to)
+	if err != nil {
+		return 0, err
+	}
+	onRoster := make(map[string]bool, len(rosterIDs))
+	for _, id := range rosterIDs {
+		onRoster[id] = true
+	}
+
+	analytics := domain.BuildGroupAnalytics(groupID, records, from, to, j.policy)
+
+	type pending struct {
+		player domain.PlayerAttendance
+		reason domain.AlertReason
+	}
+	var toRaise []pending
+	for _, player := range analytics.Players {
+		evaluated[playerKey(groupID, player.UserID)] = true
+		var reasons []domain.AlertReason
+		if onRoster[player.UserID] {
+			reasons = j.policy.Evaluate(player)
+		}
+		active := make(map[domain.AlertReason]bool, len(reasons))
+		for _, reason := range reasons {
+			active[reason] = true
+			if _, exists := open[alertKey(groupID, player.UserID, reason)]; !exists {
+				toRaise = append(toRaise, pending{player: player, reason: reason})
+			}
+		}
+		// Resolve the episodes that are over
+		for _, reason := range []domain.AlertReason{domain.AlertConsecutiveAbsences, domain.AlertLowAttendance} {
+			if alert, exists := open[alertKey(groupID, player.UserID, reason)]; exists && !active[reason] {
+				if err := j.repo.ResolveAlert(ctx, clubID, alert.ID, time.Now()); err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+	if len(toRaise) == 0 {
+		return 0, nil
+	}
+
+	userIDs := make([]string, 0, len(toRaise))
+	for _, p := range toRaise {
+		userIDs = append(userIDs, p.player.UserID)
+	}
+	users, err := j.userRepo.ListByIDs(ctx, clubID, userIDs)
+	if err != nil {
+		return 0, err
+	}
+	byID := make(map[string]userDomain.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+
+	raised := 0
+	for _, p := range toRaise {
+		alert := &domain.AttendanceAlert{
+			ID:              uuid.New(),
+			ClubID:          clubID,
+			TrainingGroupID: groupID,
+			UserID:          p.player.UserID,
+			Reason:          p.reason,
+		}
+		if p.reason == domain.AlertConsecutiveAbsences {
+			alert.Value = float64(p.player.ConsecutiveAbsences)
+		} else {
+			alert.Value = p.player.Rate
+		}
+		created, err := j.repo.CreateAlert(ctx, alert)
+		if err != nil {
+			return raised, err
+		}
+		if !created {
+			continue // Another run opened it first and already notified
+		}
+		j.notify(ctx, group, byID[p.player.UserID], p.player, p.reason)
+		raised++
+	}
+	return raised, nil
+}
+
+func (j *AtRiskAlertJob) notify(ctx context.Context, group *domain.TrainingGroupRef, user userDomain.User, player domain.PlayerAttendance, reason domain.AlertReason) {
+	name := user.Name
+	if name == "" {
+		name = player.UserID
+	}
+
+	var detail string
+	if reason == domain.AlertConsecutiveAbsences {
+		detail = fmt.Sprintf("faltó a %d entrenamientos seguidos", player.ConsecutiveAbsences)
+	} else {
+		detail = fmt.Sprintf("tiene %.0f%% de asistencia", player.Rate*100)
+	}
+
+	recipients := []string{group.CoachID}
+	if user.ParentID != nil && *user.ParentID != "" {
+		recipients = append(recipients, *user.ParentID)
+	}
+	for _, recipientID := range recipients {
+		if recipientID == "" {
+			continue
+		}
+		if err := j.notifier.Send(ctx, notificationSvc.Notification{
+			RecipientID: recipientID,
+			Type:        notificationSvc.NotificationTypePush,
+			Title:       "⚠️ Alerta de Asistencia",
+			Body:        fmt.Sprintf("%s %s en %s", name, detail, group.Name),
+		}); err != nil {
+			log.Printf("[AtRiskAlertJob] could not notify %s about player %s: %v", recipientID, player.UserID, err)
+		}
+	}
+}
+
+func playerKey(groupID uuid.UUID, userID string) string {
+	return groupID.String() + "|" + userID
+}
+
+func alertKey(groupID uuid.UUID, userID string, reason domain.AlertReason) string {
+	return groupID.String() + "|" + userID + "|" + string(reason)
+}
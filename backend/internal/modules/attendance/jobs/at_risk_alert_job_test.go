@@ -0,0 +1,211 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/jobs"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAnalyticsRepo struct {
+	mock.Mock
+}
+
+func (m *MockAnalyticsRepo) ListSessionRecords(ctx context.Context, clubID string, groupIDs []uuid.UUID, from, to time.Time) ([]domain.SessionRecord, error) {
+	args := m.Called(ctx, clubID, groupIDs, from, to)
+	return args.Get(0).([]domain.SessionRecord), args.Error(1)
+}
+func (m *MockAnalyticsRepo) CreateAlert(ctx context.Context, alert *domain.AttendanceAlert) (bool, error) {
+	args := m.Called(ctx, alert)
+	return args.Bool(0), args.Error(1)
+}
+func (m *MockAnalyticsRepo) ListOpenAlerts(ctx context.Context, clubID string, groupID *uuid.UUID) ([]domain.AttendanceAlert, error) {
+	args := m.Called(ctx, clubID, groupID)
+	return args.Get(0).([]domain.AttendanceAlert), args.Error(1)
+}
+func (m *MockAnalyticsRepo) ResolveAlert(ctx context.Context, clubID string, id uuid.UUID, resolvedAt time.Time) error {
+	return m.Called(ctx, clubID, id).Error(0)
+}
+
+type MockGroupDirectory struct {
+	mock.Mock
+}
+
+func (m *MockGroupDirectory) GetTrainingGroup(ctx context.Context, clubID string, groupID uuid.UUID) (*domain.TrainingGroupRef, error) {
+	args := m.Called(ctx, clubID, groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TrainingGroupRef), args.Error(1)
+}
+func (m *MockGroupDirectory) ListDisciplineGroups(ctx context.Context, clubID string, disciplineID uuid.UUID) ([]domain.TrainingGroupRef, error) {
+	return nil, nil
+}
+
+type MockRoster struct {
+	mock.Mock
+}
+
+func (m *MockRoster) ListRosterUserIDs(ctx context.Context, clubID string, groupID uuid.UUID, date time.Time) ([]string, error) {
+	args := m.Called(ctx, clubID, groupID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+type MockUserRepo struct {
+	mock.Mock
+}
+
+func (m *MockUserRepo) ListByIDs(ctx context.Context, clubID string, ids []string) ([]userDomain.User, error) {
+	args := m.Called(ctx, clubID, ids)
+	return args.Get(0).([]userDomain.User), args.Error(1)
+}
+func (m *MockUserRepo) GetByID(ctx context.Context, clubID, id string) (*userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepo) Update(ctx context.Context, user *userDomain.User) error { return nil }
+func (m *MockUserRepo) Delete(ctx context.Context, clubID, id string) error     { return nil }
+func (m *MockUserRepo) List(ctx context.Context, clubID string, limit, offset int, filters map[string]interface{}) ([]userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepo) FindChildren(ctx context.Context, clubID, parentID string) ([]userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepo) Create(ctx context.Context, user *userDomain.User) error { return nil }
+func (m *MockUserRepo) CreateIncident(ctx context.Context, incident *userDomain.IncidentLog) error {
+	return nil
+}
+func (m *MockUserRepo) GetByEmail(ctx context.Context, clubID, email string) (*userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepo) AnonymizeForGDPR(ctx context.Context, clubID, id string) error { return nil }
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) Send(ctx context.Context, n notificationSvc.Notification) error {
+	return m.Called(ctx, n).Error(0)
+}
+
+func TestAtRiskAlertJob_Run(t *testing.T) {
+	ctx := context.Background()
+	clubID := "c1"
+	groupID := uuid.New()
+	parentID := "parent-1"
+
+	// Three sessions in the last week: "absent" missed all of them, "ok" attended
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	var records []domain.SessionRecord
+	for i := 1; i <= 3; i++ {
+		listID := uuid.New()
+		day := today.AddDate(0, 0, -2*i)
+		records = append(records,
+			domain.SessionRecord{TrainingGroupID: groupID, ListID: listID, Date: day, UserID: "absent", Status: domain.StatusAbsent},
+			domain.SessionRecord{TrainingGroupID: groupID, ListID: listID, Date: day, UserID: "ok", Status: domain.StatusPresent},
+			domain.SessionRecord{TrainingGroupID: groupID, ListID: listID, Date: day, UserID: "recovered", Status: domain.StatusPresent},
+		)
+	}
+	openAlert := domain.AttendanceAlert{ID: uuid.New(), TrainingGroupID: groupID, UserID: "recovered", Reason: domain.AlertConsecutiveAbsences}
+
+	repo := new(MockAnalyticsRepo)
+	groups := new(MockGroupDirectory)
+	roster := new(MockRoster)
+	users := new(MockUserRepo)
+	notifier := new(MockNotifier)
+
+	repo.On("ListSessionRecords", ctx, clubID, []uuid.UUID(nil), today.AddDate(0, 0, -28), today).Return(records, nil)
+	repo.On("ListOpenAlerts", ctx, clubID, (*uuid.UUID)(nil)).Return([]domain.AttendanceAlert{openAlert}, nil)
+	groups.On("GetTrainingGroup", ctx, clubID, groupID).Return(&domain.TrainingGroupRef{ID: groupID, Name: "Sub 12", CoachID: "coach-1"}, nil)
+	roster.On("ListRosterUserIDs", ctx, clubID, groupID).Return([]string{"absent", "ok", "recovered"}, nil)
+	users.On("ListByIDs", ctx, clubID, []string{"absent"}).Return([]userDomain.User{{ID: "absent", Name: "Juan", ParentID: &parentID}}, nil)
+
+	repo.On("CreateAlert", ctx, mock.MatchedBy(func(a *domain.AttendanceAlert) bool {
+		return a.UserID == "absent" && a.Reason == domain.AlertConsecutiveAbsences && a.Value == 3
+	})).Return(true, nil).Once()
+	repo.On("ResolveAlert", ctx, clubID, openAlert.ID).Return(nil).Once()
+	notifier.On("Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool { return n.RecipientID == "coach-1" })).Return(nil).Once()
+	notifier.On("Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool { return n.RecipientID == parentID })).Return(nil).Once()
+
+	job := jobs.NewAtRiskAlertJob(repo, groups, roster, users, notifier, domain.DefaultAlertPolicy, 28)
+	raised, err := job.Run(ctx, clubID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, raised)
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+
+	t.Run("Open alerts are not notified again", func(t *testing.T) {
+		repo := new(MockAnalyticsRepo)
+		notifier := new(MockNotifier)
+		repo.On("ListSessionRecords", ctx, clubID, []uuid.UUID(nil), mock.Anything, mock.Anything).Return(records, nil)
+		repo.On("ListOpenAlerts", ctx, clubID, (*uuid.UUID)(nil)).Return([]domain.AttendanceAlert{
+			{ID: uuid.New(), TrainingGroupID: groupID, UserID: "absent", Reason: domain.AlertConsecutiveAbsences},
+		}, nil)
+
+		job := jobs.NewAtRiskAlertJob(repo, groups, roster, users, notifier, domain.DefaultAlertPolicy, 28)
+		raised, err := job.Run(ctx, clubID)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, raised)
+		notifier.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Alert opened by a concurrent run is not notified", func(t *testing.T) {
+		repo := new(MockAnalyticsRepo)
+		notifier := new(MockNotifier)
+		repo.On("ListSessionRecords", ctx, clubID, []uuid.UUID(nil), mock.Anything, mock.Anything).Return(records, nil)
+		repo.On("ListOpenAlerts", ctx, clubID, (*uuid.UUID)(nil)).Return([]domain.AttendanceAlert{}, nil)
+		repo.On("CreateAlert", ctx, mock.Anything).Return(false, nil).Once()
+
+		job := jobs.NewAtRiskAlertJob(repo, groups, roster, users, notifier, domain.DefaultAlertPolicy, 28)
+		raised, err := job.Run(ctx, clubID)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, raised)
+		notifier.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failed notifications do not stop the run", func(t *testing.T) {
+		repo := new(MockAnalyticsRepo)
+		notifier := new(MockNotifier)
+		repo.On("ListSessionRecords", ctx, clubID, []uuid.UUID(nil), mock.Anything, mock.Anything).Return(records, nil)
+		repo.On("ListOpenAlerts", ctx, clubID, (*uuid.UUID)(nil)).Return([]domain.AttendanceAlert{}, nil)
+		repo.On("CreateAlert", ctx, mock.Anything).Return(true, nil).Once()
+		notifier.On("Send", ctx, mock.Anything).Return(errors.New("push provider down"))
+
+		job := jobs.NewAtRiskAlertJob(repo, groups, roster, users, notifier, domain.DefaultAlertPolicy, 28)
+		raised, err := job.Run(ctx, clubID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, raised)
+		notifier.AssertNumberOfCalls(t, "Send", 2)
+	})
+
+	t.Run("Alerts without attendance in the window are resolved", func(t *testing.T) {
+		repo := new(MockAnalyticsRepo)
+		otherGroup := uuid.New()
+		stale := []domain.AttendanceAlert{
+			// Player left the lists of a group that still trains
+			{ID: uuid.New(), TrainingGroupID: groupID, UserID: "gone", Reason: domain.AlertLowAttendance},
+			// Group without sessions in the window
+			{ID: uuid.New(), TrainingGroupID: otherGroup, UserID: "absent", Reason: domain.AlertConsecutiveAbsences},
+		}
+		repo.On("ListSessionRecords", ctx, clubID, []uuid.UUID(nil), mock.Anything, mock.Anything).Return(records, nil)
+		repo.On("ListOpenAlerts", ctx, clubID, (*uuid.UUID)(nil)).Return(append(stale,
+			domain.AttendanceAlert{ID: uuid.New(), TrainingGroupID: groupID, UserID: "absent", Reason: domain.AlertConsecutiveAbsences}), nil)
+		repo.On("ResolveAlert", ctx, clubID, stale[0].ID).Return(nil).Once()
+		repo.On("ResolveAlert", ctx, clubID, stale[1].ID).Return(nil).Once()
+
+		job := jobs.NewAtRiskAlertJob(repo, groups, roster, users, new(MockNotifier), domain.DefaultAlertPolicy, 28)
+		_, err := job.Run(ctx, clubID)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		repo.AssertNumberOfCalls(t, "ResolveAlert", 2)
+	})
+}
@@ -6,10 +6,12 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/auth/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/middleware"
 )
 
-// AuthMiddleware creates a Gin middleware for authentication
-func AuthMiddleware(tokenService domain.TokenService) gin.HandlerFunc {
+// AuthMiddleware creates a Gin middleware for authentication. Gates run after the token is validated, in order,
+// and may abort the request (e.g. the re-consent gate of the user module); they must not call c.Next().
+func AuthMiddleware(tokenService domain.TokenService, gates ...gin.HandlerFunc) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 1. Try Cookie
 		tokenString, err := c.Cookie("access_token")
@@ -42,6 +44,15 @@ func AuthMiddleware(tokenService domain.TokenService) gin.HandlerFunc {
 		c.Set("userID", claims.UserID)
 		c.Set("userRole", claims.Role)
 		c.Set("userClubID", claims.ClubID)
+		// Also in the request context for policies applied below the handlers
+		c.Request = c.Request.WithContext(middleware.WithPrincipal(c.Request.Context(), middleware.Principal{UserID: claims.UserID, Role: claims.Role}))
+
+		for _, gate := range gates {
+			gate(c)
+			if c.IsAborted() {
+				return
+			}
+		}
 		c.Next()
 	}
 }
@@ -247,7 +247,37 @@ func (uc *BookingUseCases) CancelBooking(ctx context.Context, clubID, bookingID,
 
 	// Refund logic moved before update for safety
 
-	// Waitlist Logic
+	uc.notifyWaitlist(ctx, clubID, booking)
+
+	return nil
+}
+
+// CancelSystemBooking releases a booking created by another module (e.g. a championship match).
+// System bookings carry no payment, so neither the 24h window nor the refund apply.
+func (uc *BookingUseCases) CancelSystemBooking(ctx context.Context, clubID string, bookingID uuid.UUID) error {
+	booking, err := uc.repo.GetByID(ctx, clubID, bookingID)
+	if err != nil {
+		return err
+	}
+	if booking == nil {
+		return errors.New("booking not found")
+	}
+	if booking.Status == bookingDomain.BookingStatusCancelled {
+		return nil
+	}
+
+	booking.Status = bookingDomain.BookingStatusCancelled
+	booking.UpdatedAt = time.Now()
+	if err := uc.repo.Update(ctx, booking); err != nil {
+		return err
+	}
+
+	uc.notifyWaitlist(ctx, clubID, booking)
+	return nil
+}
+
+// notifyWaitlist tells the next user in line that the slot of a cancelled booking is free.
+func (uc *BookingUseCases) notifyWaitlist(ctx context.Context, clubID string, booking *bookingDomain.Booking) {
 	next, err := uc.repo.GetNextInLine(ctx, clubID, booking.FacilityID, booking.StartTime)
 	if err == nil && next != nil {
 		_ = uc.notifier.Send(ctx, service.Notification{
@@ -257,8 +287,6 @@ func (uc *BookingUseCases) CancelBooking(ctx context.Context, clubID, bookingID,
 			Body:        "Good news! A slot has opened up for your waitlisted time: " + booking.StartTime.String(),
 		})
 	}
-
-	return nil
 }
 
 // OnPaymentStatusChanged reacts to payment updates to confirm or handle failed bookings.
@@ -550,6 +578,75 @@ func (uc *BookingUseCases) CreateRecurringRule(ctx context.Context, clubID strin
 	return rule, nil
 }
 
+// ClassRuleDTO describes a weekly class (training group session) that holds a facility.
+type ClassRuleDTO struct {
+	FacilityID uuid.UUID
+	GroupID    uuid.UUID
+	OwnerID    *uuid.UUID // Coach in charge, if known
+	DayOfWeek  int
+	StartTime  time.Time // Only the clock is used
+	EndTime    time.Time
+	StartDate  time.Time
+	EndDate    time.Time
+}
+
+// CreateClassRule creates a CLASS recurring rule for a training group.
+// Unlike CreateRecurringRule it rejects overlaps with the facility's other recurring rules,
+// since two groups cannot train on the same court at the same time.
+func (uc *BookingUseCases) CreateClassRule(ctx context.Context, clubID string, dto ClassRuleDTO) (*bookingDomain.RecurringRule, error) {
+	if dto.DayOfWeek < 0 || dto.DayOfWeek > 6 {
+		return nil, errors.New("invalid day of week")
+	}
+	if dto.EndDate.Before(dto.StartDate) {
+		return nil, errors.New("end date must be after start date")
+	}
+	if !dto.EndTime.After(dto.StartTime) {
+		return nil, errors.New("end time must be after start time")
+	}
+
+	groupID := dto.GroupID
+	rule := &bookingDomain.RecurringRule{
+		ID:         uuid.New(),
+		ClubID:     clubID,
+		FacilityID: dto.FacilityID,
+		Type:       bookingDomain.RecurrenceTypeClass,
+		Frequency:  "WEEKLY",
+		DayOfWeek:  dto.DayOfWeek,
+		StartTime:  dto.StartTime,
+		EndTime:    dto.EndTime,
+		StartDate:  dto.StartDate,
+		EndDate:    dto.EndDate,
+		OwnerID:    dto.OwnerID,
+		GroupID:    &groupID,
+	}
+
+	existing, err := uc.recurringRepo.GetByFacility(ctx, clubID, dto.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range existing {
+		if rule.Overlaps(other) {
+			return nil, errors.New("facility already has a recurring reservation at that time")
+		}
+	}
+
+	if err := uc.recurringRepo.Create(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// DeleteRecurringRule removes a recurring rule. Bookings already generated are kept.
+func (uc *BookingUseCases) DeleteRecurringRule(ctx context.Context, clubID string, id uuid.UUID) error {
+	return uc.recurringRepo.Delete(ctx, clubID, id)
+}
+
+// DeleteClassRule removes a CLASS rule (a training session that no longer takes place) and
+// cancels the bookings it already generated from now on, so the court is freed right away.
+func (uc *BookingUseCases) DeleteClassRule(ctx context.Context, clubID string, id uuid.UUID) error {
+	return uc.recurringRepo.DeleteWithFutureBookings(ctx, clubID, id, time.Now())
+}
+
 // GenerateBookingsFromRules looks ahead and materializes recurring bookings.
 // Refactored to separate logic from loop complexity.
 func (uc *BookingUseCases) GenerateBookingsFromRules(ctx context.Context, clubID string, weeks int) error {
@@ -720,16 +817,18 @@ func (uc *BookingUseCases) calculateRecurringBookings(rule bookingDomain.Recurri
 			hEnd, minEnd, sEnd := rule.EndTime.Clock()
 			bookingEnd := time.Date(y, m, d, hEnd, minEnd, sEnd, 0, rule.EndTime.Location())
 
+			ruleID := rule.ID
 			bookings = append(bookings, bookingDomain.Booking{
-				ID:         uuid.New(),
-				UserID:     systemUser,
-				ClubID:     rule.ClubID,
-				FacilityID: rule.FacilityID,
-				StartTime:  bookingStart,
-				EndTime:    bookingEnd,
-				Status:     bookingDomain.BookingStatusConfirmed,
-				CreatedAt:  now,
-				UpdatedAt:  now,
+				ID:              uuid.New(),
+				UserID:          systemUser,
+				ClubID:          rule.ClubID,
+				FacilityID:      rule.FacilityID,
+				StartTime:       bookingStart,
+				EndTime:         bookingEnd,
+				Status:          bookingDomain.BookingStatusConfirmed,
+				RecurringRuleID: &ruleID,
+				CreatedAt:       now,
+				UpdatedAt:       now,
 			})
 		}
 		current = current.AddDate(0, 0, 1)
@@ -240,6 +240,11 @@ func (m *MockRecurringRepo) Delete(ctx context.Context, clubID string, id uuid.U
 	return args.Error(0)
 }
 
+func (m *MockRecurringRepo) DeleteWithFutureBookings(ctx context.Context, clubID string, id uuid.UUID, from time.Time) error {
+	args := m.Called(ctx, clubID, id, from)
+	return args.Error(0)
+}
+
 type MockUserRepo struct {
 	mock.Mock
 }
@@ -595,6 +600,42 @@ func TestCancelBooking(t *testing.T) {
 	}
 }
 
+func TestCancelSystemBooking(t *testing.T) {
+	clubID := "test-club"
+	bookingID := uuid.New()
+
+	t.Run("Success: Ignores 24h window and refund", func(t *testing.T) {
+		mbr := new(MockBookingRepo)
+		mrs := new(MockRefundService)
+		uc := application.NewBookingUseCases(mbr, nil, nil, nil, nil, nil, mrs)
+		mbr.On("GetByID", mock.Anything, clubID, bookingID).Return(&bookingDomain.Booking{
+			ID:        bookingID,
+			Status:    bookingDomain.BookingStatusConfirmed,
+			StartTime: time.Now().Add(2 * time.Hour),
+		}, nil).Once()
+		mbr.On("Update", mock.Anything, mock.MatchedBy(func(b *bookingDomain.Booking) bool {
+			return b.Status == bookingDomain.BookingStatusCancelled
+		})).Return(nil).Once()
+		mbr.On("GetNextInLine", mock.Anything, clubID, mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+		assert.NoError(t, uc.CancelSystemBooking(context.Background(), clubID, bookingID))
+		mbr.AssertExpectations(t)
+		mrs.AssertNotCalled(t, "Refund", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Already cancelled is a no-op", func(t *testing.T) {
+		mbr := new(MockBookingRepo)
+		uc := application.NewBookingUseCases(mbr, nil, nil, nil, nil, nil, nil)
+		mbr.On("GetByID", mock.Anything, clubID, bookingID).Return(&bookingDomain.Booking{
+			ID:     bookingID,
+			Status: bookingDomain.BookingStatusCancelled,
+		}, nil).Once()
+
+		assert.NoError(t, uc.CancelSystemBooking(context.Background(), clubID, bookingID))
+		mbr.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
 func TestGetAvailability(t *testing.T) {
 	clubID := "test-club"
 	facilityID := uuid.New().String()
@@ -681,6 +722,50 @@ func TestCreateRecurringRule(t *testing.T) {
 	})
 }
 
+func TestCreateClassRule(t *testing.T) {
+	clubID := "test-club"
+	facilityID := uuid.New()
+	clock := func(h int) time.Time { return time.Date(0, 1, 1, h, 0, 0, 0, time.UTC) }
+	dto := application.ClassRuleDTO{
+		FacilityID: facilityID,
+		GroupID:    uuid.New(),
+		DayOfWeek:  2,
+		StartTime:  clock(18),
+		EndTime:    clock(19),
+		StartDate:  time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mrr := new(MockRecurringRepo)
+		uc := application.NewBookingUseCases(nil, mrr, nil, nil, nil, nil, nil)
+		mrr.On("GetByFacility", mock.Anything, clubID, facilityID).Return([]bookingDomain.RecurringRule{}, nil).Once()
+		mrr.On("Create", mock.Anything, mock.MatchedBy(func(r *bookingDomain.RecurringRule) bool {
+			return r.Type == bookingDomain.RecurrenceTypeClass && r.GroupID != nil && *r.GroupID == dto.GroupID
+		})).Return(nil).Once()
+
+		rule, err := uc.CreateClassRule(context.Background(), clubID, dto)
+		assert.NoError(t, err)
+		assert.Equal(t, "WEEKLY", rule.Frequency)
+		mrr.AssertExpectations(t)
+	})
+
+	t.Run("Fail: overlapping rule", func(t *testing.T) {
+		mrr := new(MockRecurringRepo)
+		uc := application.NewBookingUseCases(nil, mrr, nil, nil, nil, nil, nil)
+		existing := bookingDomain.RecurringRule{
+			FacilityID: facilityID, DayOfWeek: 2,
+			StartTime: time.Date(0, 1, 1, 18, 30, 0, 0, time.UTC), EndTime: clock(20),
+			StartDate: dto.StartDate, EndDate: dto.EndDate,
+		}
+		mrr.On("GetByFacility", mock.Anything, clubID, facilityID).Return([]bookingDomain.RecurringRule{existing}, nil).Once()
+
+		_, err := uc.CreateClassRule(context.Background(), clubID, dto)
+		assert.Error(t, err)
+		mrr.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
 func TestJoinWaitlist(t *testing.T) {
 	clubID := "test-club"
 	mbr := new(MockBookingRepo)
@@ -791,13 +876,29 @@ func TestGenerateBookingsFromRules(t *testing.T) {
 			},
 		}, nil).Once()
 		mbr.On("HasTimeConflict", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
-		mbr.On("Create", mock.Anything, mock.Anything).Return(nil)
+		mbr.On("Create", mock.Anything, mock.MatchedBy(func(b *bookingDomain.Booking) bool {
+			return b.RecurringRuleID != nil
+		})).Return(nil)
 
 		err := uc.GenerateBookingsFromRules(context.Background(), clubID, 1)
 		assert.NoError(t, err)
 	})
 }
 
+func TestDeleteClassRule(t *testing.T) {
+	clubID := "test-club"
+	ruleID := uuid.New()
+	mrr := new(MockRecurringRepo)
+	uc := application.NewBookingUseCases(nil, mrr, nil, nil, nil, nil, nil)
+
+	mrr.On("DeleteWithFutureBookings", mock.Anything, clubID, ruleID, mock.MatchedBy(func(from time.Time) bool {
+		return time.Since(from) < time.Minute
+	})).Return(nil).Once()
+
+	assert.NoError(t, uc.DeleteClassRule(context.Background(), clubID, ruleID))
+	mrr.AssertExpectations(t)
+}
+
 func TestListBookings(t *testing.T) {
 	clubID := "test-club"
 	userID := uuid.New().String()
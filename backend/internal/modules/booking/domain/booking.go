@@ -72,18 +72,19 @@ func (g *GuestDetails) Scan(value interface{}) error {
 }
 
 type Booking struct {
-	ID            uuid.UUID       `json:"id" gorm:"type:uuid;primary_key"`
-	ClubID        string          `json:"club_id" gorm:"index;not null"`
-	UserID        uuid.UUID       `json:"user_id" gorm:"type:uuid;not null"`
-	FacilityID    uuid.UUID       `json:"facility_id" gorm:"type:uuid;not null"`
-	StartTime     time.Time       `json:"start_time" gorm:"not null"`
-	EndTime       time.Time       `json:"end_time" gorm:"not null"`
-	TotalPrice    decimal.Decimal `json:"total_price" gorm:"type:decimal(10,2);default:0"`
-	Status        BookingStatus   `json:"status" gorm:"type:varchar(20);default:'CONFIRMED'"`
-	GuestDetails  GuestDetails    `json:"guest_details" gorm:"type:jsonb"`
-	PaymentExpiry *time.Time      `json:"payment_expiry,omitempty" gorm:"index"` // SECURITY FIX (VUL-001): Expiry for pending payment bookings
-	CreatedAt     time.Time       `json:"created_at"`
-	UpdatedAt     time.Time       `json:"updated_at"`
+	ID              uuid.UUID       `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID          string          `json:"club_id" gorm:"index;not null"`
+	UserID          uuid.UUID       `json:"user_id" gorm:"type:uuid;not null"`
+	FacilityID      uuid.UUID       `json:"facility_id" gorm:"type:uuid;not null"`
+	StartTime       time.Time       `json:"start_time" gorm:"not null"`
+	EndTime         time.Time       `json:"end_time" gorm:"not null"`
+	TotalPrice      decimal.Decimal `json:"total_price" gorm:"type:decimal(10,2);default:0"`
+	Status          BookingStatus   `json:"status" gorm:"type:varchar(20);default:'CONFIRMED'"`
+	GuestDetails    GuestDetails    `json:"guest_details" gorm:"type:jsonb"`
+	PaymentExpiry   *time.Time      `json:"payment_expiry,omitempty" gorm:"index"`              // SECURITY FIX (VUL-001): Expiry for pending payment bookings
+	RecurringRuleID *uuid.UUID      `json:"recurring_rule_id,omitempty" gorm:"type:uuid;index"` // Set on bookings generated from a recurring rule
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
 }
 
 type BookingRepository interface {
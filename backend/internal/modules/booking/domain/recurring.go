@@ -40,9 +40,28 @@ type RecurringRule struct {
 	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index"`
 }
 
+// Overlaps reports whether both rules hold the facility on the same weekday at overlapping
+// times while their date ranges intersect.
+func (r *RecurringRule) Overlaps(other RecurringRule) bool {
+	if r.FacilityID != other.FacilityID || r.DayOfWeek != other.DayOfWeek {
+		return false
+	}
+	if r.StartDate.After(other.EndDate) || other.StartDate.After(r.EndDate) {
+		return false
+	}
+	return clockMinutes(r.StartTime) < clockMinutes(other.EndTime) && clockMinutes(other.StartTime) < clockMinutes(r.EndTime)
+}
+
+func clockMinutes(t time.Time) int {
+	h, m, _ := t.Clock()
+	return h*60 + m
+}
+
 type RecurringRepository interface {
 	Create(ctx context.Context, rule *RecurringRule) error
 	GetByFacility(ctx context.Context, clubID string, facilityID uuid.UUID) ([]RecurringRule, error)
 	GetAllActive(ctx context.Context, clubID string) ([]RecurringRule, error)
 	Delete(ctx context.Context, clubID string, id uuid.UUID) error
+	// DeleteWithFutureBookings removes the rule and cancels the bookings it generated from the given time on, in one transaction
+	DeleteWithFutureBookings(ctx context.Context, clubID string, id uuid.UUID, from time.Time) error
 }
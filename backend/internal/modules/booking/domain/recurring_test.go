@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/booking/domain"
 	"github.com/stretchr/testify/assert"
 )
@@ -36,3 +37,37 @@ func TestRecurringRule_ActiveStatus(t *testing.T) {
 	// Just asserting fields exist and are set
 	assert.False(t, rule.EndDate.IsZero())
 }
+
+func TestRecurringRule_Overlaps(t *testing.T) {
+	facilityID := uuid.New()
+	clock := func(h, m int) time.Time { return time.Date(0, 1, 1, h, m, 0, 0, time.UTC) }
+	base := domain.RecurringRule{
+		FacilityID: facilityID,
+		DayOfWeek:  1,
+		StartTime:  clock(18, 0),
+		EndTime:    clock(19, 30),
+		StartDate:  time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	overlapping := base
+	overlapping.StartTime, overlapping.EndTime = clock(19, 0), clock(20, 0)
+	assert.True(t, base.Overlaps(overlapping))
+
+	back2back := base
+	back2back.StartTime, back2back.EndTime = clock(19, 30), clock(21, 0)
+	assert.False(t, base.Overlaps(back2back))
+
+	otherDay := overlapping
+	otherDay.DayOfWeek = 3
+	assert.False(t, base.Overlaps(otherDay))
+
+	otherSeason := overlapping
+	otherSeason.StartDate = time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC)
+	otherSeason.EndDate = time.Date(2027, 11, 30, 0, 0, 0, 0, time.UTC)
+	assert.False(t, base.Overlaps(otherSeason))
+
+	otherFacility := overlapping
+	otherFacility.FacilityID = uuid.New()
+	assert.False(t, base.Overlaps(otherFacility))
+}
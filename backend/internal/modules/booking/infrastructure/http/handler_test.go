@@ -94,6 +94,9 @@ func (m *MockRecurringRepo) GetAllActive(ctx context.Context, clubID string) ([]
 func (m *MockRecurringRepo) Delete(ctx context.Context, clubID string, id uuid.UUID) error {
 	return m.Called(ctx, clubID, id).Error(0)
 }
+func (m *MockRecurringRepo) DeleteWithFutureBookings(ctx context.Context, clubID string, id uuid.UUID, from time.Time) error {
+	return m.Called(ctx, clubID, id, from).Error(0)
+}
 
 type MockFacilityRepo struct{ mock.Mock }
 
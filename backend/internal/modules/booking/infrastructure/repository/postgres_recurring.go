@@ -42,3 +42,18 @@ func (r *PostgresRecurringRepository) GetAllActive(ctx context.Context, clubID s
 func (r *PostgresRecurringRepository) Delete(ctx context.Context, clubID string, id uuid.UUID) error {
 	return r.db.WithContext(ctx).Delete(&domain.RecurringRule{}, "id = ? AND club_id = ?", id, clubID).Error
 }
+
+func (r *PostgresRecurringRepository) DeleteWithFutureBookings(ctx context.Context, clubID string, id uuid.UUID, from time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.Booking{}).
+			Where("club_id = ? AND recurring_rule_id = ? AND start_time >= ?", clubID, id, from).
+			Where("status IN ?", []domain.BookingStatus{domain.BookingStatusConfirmed, domain.BookingStatusPendingPayment}).
+			Updates(map[string]interface{}{
+				"status":     domain.BookingStatusCancelled,
+				"updated_at": time.Now(),
+			}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.RecurringRule{}, "id = ? AND club_id = ?", id, clubID).Error
+	})
+}
@@ -0,0 +1,176 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+)
+
+// LiveBroadcaster publica mensajes en tiempo real a los clientes suscriptos a un tópico (WebSocket Hub)
+type LiveBroadcaster interface {
+	Publish(ctx context.Context, topic, msgType string, payload interface{}) error
+}
+
+// LiveMatchService maneja el marcador en vivo y su difusión a los clientes suscriptos
+type LiveMatchService struct {
+	repo          domain.ChampionshipRepository
+	liveRepo      domain.LiveScoreRepository
+	eventRepo     domain.MatchEventRepository
+	volunteerRepo domain.VolunteerRepository
+	broadcaster   LiveBroadcaster
+}
+
+// NewLiveMatchService crea una nueva instancia del servicio
+func NewLiveMatchService(
+	repo domain.ChampionshipRepository,
+	liveRepo domain.LiveScoreRepository,
+	eventRepo domain.MatchEventRepository,
+	volunteerRepo domain.VolunteerRepository,
+	broadcaster LiveBroadcaster,
+) *LiveMatchService {
+	return &LiveMatchService{
+		repo:          repo,
+		liveRepo:      liveRepo,
+		eventRepo:     eventRepo,
+		volunteerRepo: volunteerRepo,
+		broadcaster:   broadcaster,
+	}
+}
+
+// IsMatchVolunteer indica si el usuario está asignado como voluntario (árbitro, mesa) al partido
+func (s *LiveMatchService) IsMatchVolunteer(ctx context.Context, clubID, matchID, userID string) bool {
+	mID, err := uuid.Parse(matchID)
+	if err != nil {
+		return false
+	}
+	assignments, err := s.volunteerRepo.GetByMatchID(ctx, clubID, mID)
+	if err != nil {
+		return false
+	}
+	for _, a := range assignments {
+		if a.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// LiveScoreInput contiene el marcador parcial enviado desde la cancha
+type LiveScoreInput struct {
+	ClubID    string  `json:"-"`
+	MatchID   string  `json:"-"`
+	HomeScore float64 `json:"home_score"`
+	AwayScore float64 `json:"away_score"`
+}
+
+// UpdateLiveScore actualiza el marcador parcial, lo difunde y publica la tabla proyectada del grupo
+func (s *LiveMatchService) UpdateLiveScore(ctx context.Context, input LiveScoreInput) (*domain.TournamentMatch, error) {
+	if input.HomeScore < 0 || input.AwayScore < 0 {
+		return nil, errors.New("el marcador no puede ser negativo")
+	}
+
+	match, err := s.repo.GetMatch(ctx, input.ClubID, input.MatchID)
+	if err != nil || match == nil {
+		return nil, errors.New("partido no encontrado")
+	}
+	if match.Status == domain.MatchCompleted || match.Status == domain.MatchCancelled {
+		return nil, errors.New("el partido no está en juego")
+	}
+
+	if err := s.liveRepo.UpdateLiveScore(ctx, input.ClubID, input.MatchID, input.HomeScore, input.AwayScore); err != nil {
+		return nil, err
+	}
+
+	home, away := input.HomeScore, input.AwayScore
+	match.HomeScore = &home
+	match.AwayScore = &away
+	match.Status = domain.MatchInProgress
+
+	s.publish(ctx, match.TournamentID, match.ID, domain.LiveMessageScore, match)
+
+	if match.GroupID != nil {
+		standings, err := s.GetLiveStandings(ctx, input.ClubID, match.GroupID.String())
+		if err != nil {
+			log.Printf("Live standings projection failed for group %s: %v", match.GroupID, err)
+		} else {
+			s.publishTournament(ctx, match.TournamentID, domain.LiveMessageStandings, standings)
+		}
+	}
+
+	return match, nil
+}
+
+// GetLiveMatch devuelve el estado actual del partido (marcador e incidencias)
+func (s *LiveMatchService) GetLiveMatch(ctx context.Context, clubID, matchID string) (*domain.LiveMatchSnapshot, error) {
+	match, err := s.repo.GetMatch(ctx, clubID, matchID)
+	if err != nil || match == nil {
+		return nil, errors.New("partido no encontrado")
+	}
+
+	events, err := s.eventRepo.GetByMatchID(ctx, clubID, match.ID)
+	if err != nil {
+		return nil, err
+	}
+	if events == nil {
+		events = []domain.MatchEvent{}
+	}
+	return &domain.LiveMatchSnapshot{Match: match, Events: events}, nil
+}
+
+// GetLiveStandings proyecta la tabla del grupo contando los partidos en curso con su marcador parcial
+func (s *LiveMatchService) GetLiveStandings(ctx context.Context, clubID, groupID string) (*domain.LiveStandings, error) {
+	gID, err := uuid.Parse(groupID)
+	if err != nil {
+		return nil, errors.New("ID de grupo inválido")
+	}
+
+	matches, err := s.repo.GetMatchesByGroup(ctx, clubID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	standings, err := s.repo.GetStandings(ctx, clubID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	domain.CalculateStandings(standings, matches, true)
+	domain.RankStandings(standings)
+	return &domain.LiveStandings{GroupID: gID, Standings: standings}, nil
+}
+
+// OnMatchEventRecorded difunde las incidencias cargadas durante el partido
+func (s *LiveMatchService) OnMatchEventRecorded(ctx context.Context, event *domain.MatchEvent) error {
+	s.publish(ctx, event.TournamentID, event.MatchID, domain.LiveMessageEvent, event)
+	return nil
+}
+
+// OnMatchCompleted difunde el resultado final y la tabla oficial recalculada
+func (s *LiveMatchService) OnMatchCompleted(ctx context.Context, clubID string, match *domain.TournamentMatch) error {
+	s.publish(ctx, match.TournamentID, match.ID, domain.LiveMessageCompleted, match)
+
+	if match.GroupID != nil {
+		standings, err := s.GetLiveStandings(ctx, clubID, match.GroupID.String())
+		if err != nil {
+			return err
+		}
+		s.publishTournament(ctx, match.TournamentID, domain.LiveMessageStandings, standings)
+	}
+	return nil
+}
+
+// publish envía el mensaje al tópico del partido y al del torneo (páginas públicas)
+func (s *LiveMatchService) publish(ctx context.Context, tournamentID, matchID uuid.UUID, msgType string, payload interface{}) {
+	if err := s.broadcaster.Publish(ctx, domain.MatchTopic(matchID), msgType, payload); err != nil {
+		log.Printf("Live broadcast failed for match %s: %v", matchID, err)
+	}
+	s.publishTournament(ctx, tournamentID, msgType, payload)
+}
+
+func (s *LiveMatchService) publishTournament(ctx context.Context, tournamentID uuid.UUID, msgType string, payload interface{}) {
+	if err := s.broadcaster.Publish(ctx, domain.TournamentTopic(tournamentID), msgType, payload); err != nil {
+		log.Printf("Live broadcast failed for tournament %s: %v", tournamentID, err)
+	}
+}
@@ -0,0 +1,100 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLiveScoreRepo struct {
+	mock.Mock
+}
+
+func (m *MockLiveScoreRepo) UpdateLiveScore(ctx context.Context, clubID, matchID string, homeScore, awayScore float64) error {
+	return m.Called(ctx, clubID, matchID, homeScore, awayScore).Error(0)
+}
+
+type MockBroadcaster struct {
+	mock.Mock
+}
+
+func (m *MockBroadcaster) Publish(ctx context.Context, topic, msgType string, payload interface{}) error {
+	return m.Called(ctx, topic, msgType, payload).Error(0)
+}
+
+func TestLiveMatchService_UpdateLiveScore(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	groupID := uuid.New()
+	home, away, other := uuid.New(), uuid.New(), uuid.New()
+
+	newMatch := func(status domain.MatchStatus) *domain.TournamentMatch {
+		return &domain.TournamentMatch{ID: uuid.New(), TournamentID: uuid.New(), GroupID: &groupID, HomeTeamID: home, AwayTeamID: away, Status: status}
+	}
+
+	t.Run("Broadcasts score and live standings", func(t *testing.T) {
+		repo := new(MockChampionshipRepo)
+		liveRepo := new(MockLiveScoreRepo)
+		broadcaster := new(MockBroadcaster)
+		svc := application.NewLiveMatchService(repo, liveRepo, nil, nil, broadcaster)
+
+		match := newMatch(domain.MatchScheduled)
+		mID := match.ID.String()
+		two, zero, one := 2.0, 0.0, 1.0
+		repo.On("GetMatch", ctx, cID, mID).Return(match, nil)
+		liveRepo.On("UpdateLiveScore", ctx, cID, mID, 2.0, 0.0).Return(nil).Once()
+		repo.On("GetMatchesByGroup", ctx, cID, groupID.String()).Return([]domain.TournamentMatch{
+			{HomeTeamID: home, AwayTeamID: away, HomeScore: &two, AwayScore: &zero, Status: domain.MatchInProgress},
+			{HomeTeamID: other, AwayTeamID: away, HomeScore: &one, AwayScore: &one, Status: domain.MatchCompleted},
+		}, nil)
+		repo.On("GetStandings", ctx, cID, groupID.String()).Return([]domain.Standing{
+			{TeamID: other}, {TeamID: away}, {TeamID: home},
+		}, nil)
+
+		broadcaster.On("Publish", ctx, domain.MatchTopic(match.ID), domain.LiveMessageScore, mock.Anything).Return(nil).Once()
+		broadcaster.On("Publish", ctx, domain.TournamentTopic(match.TournamentID), domain.LiveMessageScore, mock.Anything).Return(nil).Once()
+		broadcaster.On("Publish", ctx, domain.TournamentTopic(match.TournamentID), domain.LiveMessageStandings, mock.MatchedBy(func(ls *domain.LiveStandings) bool {
+			return ls.Standings[0].TeamID == home && ls.Standings[0].Points == 3 && ls.Standings[0].Position == 1 &&
+				ls.Standings[1].TeamID == other && ls.Standings[2].TeamID == away
+		})).Return(nil).Once()
+
+		updated, err := svc.UpdateLiveScore(ctx, application.LiveScoreInput{ClubID: cID, MatchID: mID, HomeScore: 2, AwayScore: 0})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchInProgress, updated.Status)
+		broadcaster.AssertExpectations(t)
+	})
+
+	t.Run("Rejects finished match", func(t *testing.T) {
+		repo := new(MockChampionshipRepo)
+		svc := application.NewLiveMatchService(repo, nil, nil, nil, nil)
+
+		match := newMatch(domain.MatchCompleted)
+		repo.On("GetMatch", ctx, cID, match.ID.String()).Return(match, nil)
+
+		_, err := svc.UpdateLiveScore(ctx, application.LiveScoreInput{ClubID: cID, MatchID: match.ID.String(), HomeScore: 1})
+		assert.ErrorContains(t, err, "no está en juego")
+	})
+
+	t.Run("Rejects negative score", func(t *testing.T) {
+		svc := application.NewLiveMatchService(nil, nil, nil, nil, nil)
+		_, err := svc.UpdateLiveScore(ctx, application.LiveScoreInput{ClubID: cID, MatchID: uuid.NewString(), HomeScore: -1})
+		assert.Error(t, err)
+	})
+}
+
+func TestLiveMatchService_IsMatchVolunteer(t *testing.T) {
+	volunteerRepo := new(MockVolunteerRepo)
+	svc := application.NewLiveMatchService(nil, nil, nil, volunteerRepo, nil)
+	ctx := context.TODO()
+	matchID := uuid.New()
+
+	volunteerRepo.On("GetByMatchID", ctx, "club-1", matchID).Return([]domain.VolunteerAssignment{{UserID: "referee"}}, nil)
+
+	assert.True(t, svc.IsMatchVolunteer(ctx, "club-1", matchID.String(), "referee"))
+	assert.False(t, svc.IsMatchVolunteer(ctx, "club-1", matchID.String(), "fan"))
+}
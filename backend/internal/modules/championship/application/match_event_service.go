@@ -0,0 +1,259 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+)
+
+// MatchEventService maneja la carga de incidencias por jugador y las estadísticas del torneo
+type MatchEventService struct {
+	repo          domain.ChampionshipRepository
+	eventRepo     domain.MatchEventRepository
+	volunteerRepo domain.VolunteerRepository
+	officialRepo  domain.OfficialRepository
+	listeners     []domain.MatchEventListener
+}
+
+// NewMatchEventService crea una nueva instancia del servicio
+func NewMatchEventService(repo domain.ChampionshipRepository, eventRepo domain.MatchEventRepository) *MatchEventService {
+	return &MatchEventService{
+		repo:      repo,
+		eventRepo: eventRepo,
+	}
+}
+
+// SetAssignmentRepositories habilita a los voluntarios y oficiales designados en un partido a cargar sus incidencias
+func (s *MatchEventService) SetAssignmentRepositories(volunteerRepo domain.VolunteerRepository, officialRepo domain.OfficialRepository) {
+	s.volunteerRepo = volunteerRepo
+	s.officialRepo = officialRepo
+}
+
+// IsAssignedToMatch indica si el usuario está designado en el partido como voluntario o como oficial
+// (árbitro, asistente, mesa de control) vinculado a su cuenta
+func (s *MatchEventService) IsAssignedToMatch(ctx context.Context, clubID, matchID, userID string) bool {
+	mID, err := uuid.Parse(matchID)
+	if err != nil || userID == "" {
+		return false
+	}
+
+	if s.volunteerRepo != nil {
+		volunteers, err := s.volunteerRepo.GetByMatchID(ctx, clubID, mID)
+		if err == nil {
+			for _, v := range volunteers {
+				if v.UserID == userID {
+					return true
+				}
+			}
+		}
+	}
+
+	if s.officialRepo != nil {
+		assignments, err := s.officialRepo.GetAssignmentsByMatch(ctx, clubID, mID)
+		if err != nil {
+			return false
+		}
+		for _, a := range assignments {
+			official, err := s.officialRepo.GetOfficial(ctx, clubID, a.OfficialID)
+			if err == nil && official != nil && official.UserID != nil && *official.UserID == userID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RegisterListener registra un módulo a notificar cuando se carga una incidencia
+func (s *MatchEventService) RegisterListener(listener domain.MatchEventListener) {
+	s.listeners = append(s.listeners, listener)
+}
+
+// RecordMatchEventInput contiene los datos de una incidencia a registrar
+type RecordMatchEventInput struct {
+	ClubID        string                `json:"-"`
+	MatchID       string                `json:"-"`
+	TeamID        string                `json:"team_id" binding:"required"`
+	UserID        string                `json:"user_id" binding:"required"`
+	Type          domain.MatchEventType `json:"type" binding:"required"`
+	Minute        int                   `json:"minute"`
+	RelatedUserID string                `json:"related_user_id"`
+	Notes         string                `json:"notes"`
+	RecordedBy    string                `json:"-"`
+}
+
+// RecordEvent registra una incidencia validando que el jugador pertenezca al plantel del equipo
+func (s *MatchEventService) RecordEvent(ctx context.Context, input RecordMatchEventInput) (*domain.MatchEvent, error) {
+	if !input.Type.IsValid() {
+		return nil, fmt.Errorf("tipo de evento inválido: %s", input.Type)
+	}
+	if input.Minute < 0 {
+		return nil, errors.New("el minuto no puede ser negativo")
+	}
+
+	match, err := s.repo.GetMatch(ctx, input.ClubID, input.MatchID)
+	if err != nil || match == nil {
+		return nil, errors.New("partido no encontrado")
+	}
+	if match.Status == domain.MatchCancelled {
+		return nil, errors.New("no se pueden registrar eventos en un partido cancelado")
+	}
+
+	teamID, err := uuid.Parse(input.TeamID)
+	if err != nil {
+		return nil, errors.New("ID de equipo inválido")
+	}
+	if teamID != match.HomeTeamID && teamID != match.AwayTeamID {
+		return nil, errors.New("el equipo no participa de este partido")
+	}
+
+	roster, err := s.repo.GetTeamMembers(ctx, teamID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !containsString(roster, input.UserID) {
+		return nil, errors.New("el jugador no pertenece al plantel del equipo")
+	}
+
+	event := &domain.MatchEvent{
+		ID:           uuid.New(),
+		ClubID:       input.ClubID,
+		TournamentID: match.TournamentID,
+		MatchID:      match.ID,
+		TeamID:       teamID,
+		UserID:       input.UserID,
+		Type:         input.Type,
+		Minute:       input.Minute,
+		Notes:        input.Notes,
+		RecordedBy:   input.RecordedBy,
+	}
+
+	switch input.Type {
+	case domain.MatchEventSubstitution:
+		if input.RelatedUserID == "" {
+			return nil, errors.New("un cambio requiere el jugador que ingresa")
+		}
+		if input.RelatedUserID == input.UserID {
+			return nil, errors.New("el jugador que ingresa debe ser distinto al que sale")
+		}
+		if !containsString(roster, input.RelatedUserID) {
+			return nil, errors.New("el jugador que ingresa no pertenece al plantel del equipo")
+		}
+		related := input.RelatedUserID
+		event.RelatedUserID = &related
+	case domain.MatchEventMVP:
+		existing, err := s.eventRepo.GetByMatchID(ctx, input.ClubID, match.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range existing {
+			if e.Type == domain.MatchEventMVP {
+				return nil, errors.New("el partido ya tiene una figura asignada")
+			}
+		}
+	}
+
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		return nil, err
+	}
+
+	for _, listener := range s.listeners {
+		if err := listener.OnMatchEventRecorded(ctx, event); err != nil {
+			log.Printf("Match event listener failed for event %s: %v", event.ID, err)
+		}
+	}
+
+	return event, nil
+}
+
+// GetMatchEvents obtiene las incidencias de un partido
+func (s *MatchEventService) GetMatchEvents(ctx context.Context, clubID, matchID string) ([]domain.MatchEvent, error) {
+	mID, err := uuid.Parse(matchID)
+	if err != nil {
+		return nil, errors.New("ID de partido inválido")
+	}
+	return s.eventRepo.GetByMatchID(ctx, clubID, mID)
+}
+
+// DeleteEvent elimina una incidencia cargada por error y avisa a los listeners que la deshacen
+func (s *MatchEventService) DeleteEvent(ctx context.Context, clubID string, eventID uuid.UUID) error {
+	event, err := s.eventRepo.GetByID(ctx, clubID, eventID)
+	if err != nil || event == nil {
+		return errors.New("evento no encontrado")
+	}
+	if err := s.eventRepo.Delete(ctx, clubID, eventID); err != nil {
+		return err
+	}
+
+	for _, listener := range s.listeners {
+		if deletion, ok := listener.(domain.MatchEventDeletionListener); ok {
+			if err := deletion.OnMatchEventDeleted(ctx, event); err != nil {
+				log.Printf("Match event deletion listener failed for event %s: %v", event.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// GetPlayerStats obtiene las estadísticas acumuladas de todos los jugadores del torneo
+func (s *MatchEventService) GetPlayerStats(ctx context.Context, clubID, tournamentID string) ([]domain.PlayerTournamentStats, error) {
+	tID, err := uuid.Parse(tournamentID)
+	if err != nil {
+		return nil, errors.New("ID de torneo inválido")
+	}
+	events, err := s.eventRepo.GetByTournamentID(ctx, clubID, tID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.AggregatePlayerStats(events), nil
+}
+
+// GetTopScorers obtiene la tabla de goleadores del torneo (limit <= 0 devuelve todos)
+func (s *MatchEventService) GetTopScorers(ctx context.Context, clubID, tournamentID string, limit int) ([]domain.PlayerTournamentStats, error) {
+	stats, err := s.GetPlayerStats(ctx, clubID, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	scorers := make([]domain.PlayerTournamentStats, 0, len(stats))
+	for _, st := range stats {
+		if st.Goals > 0 {
+			scorers = append(scorers, st)
+		}
+	}
+	domain.SortTopScorers(scorers)
+
+	if limit > 0 && len(scorers) > limit {
+		scorers = scorers[:limit]
+	}
+	return scorers, nil
+}
+
+// GetDisciplineTable obtiene la tabla de disciplina (tarjetas) del torneo
+func (s *MatchEventService) GetDisciplineTable(ctx context.Context, clubID, tournamentID string) ([]domain.PlayerTournamentStats, error) {
+	stats, err := s.GetPlayerStats(ctx, clubID, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	table := make([]domain.PlayerTournamentStats, 0, len(stats))
+	for _, st := range stats {
+		if st.DisciplinePoints() > 0 {
+			table = append(table, st)
+		}
+	}
+	domain.SortDiscipline(table)
+	return table, nil
+}
+
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockMatchEventRepo struct {
+	mock.Mock
+}
+
+func (m *MockMatchEventRepo) Create(ctx context.Context, e *domain.MatchEvent) error {
+	return m.Called(ctx, e).Error(0)
+}
+
+func (m *MockMatchEventRepo) GetByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.MatchEvent, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MatchEvent), args.Error(1)
+}
+
+func (m *MockMatchEventRepo) GetByMatchID(ctx context.Context, clubID string, matchID uuid.UUID) ([]domain.MatchEvent, error) {
+	args := m.Called(ctx, clubID, matchID)
+	var res []domain.MatchEvent
+	if args.Get(0) != nil {
+		res = args.Get(0).([]domain.MatchEvent)
+	}
+	return res, args.Error(1)
+}
+
+func (m *MockMatchEventRepo) GetByTournamentID(ctx context.Context, clubID string, tournamentID uuid.UUID) ([]domain.MatchEvent, error) {
+	args := m.Called(ctx, clubID, tournamentID)
+	var res []domain.MatchEvent
+	if args.Get(0) != nil {
+		res = args.Get(0).([]domain.MatchEvent)
+	}
+	return res, args.Error(1)
+}
+
+func (m *MockMatchEventRepo) Delete(ctx context.Context, clubID string, id uuid.UUID) error {
+	return m.Called(ctx, clubID, id).Error(0)
+}
+
+func TestMatchEventService_RecordEvent(t *testing.T) {
+	repo := new(MockChampionshipRepo)
+	eventRepo := new(MockMatchEventRepo)
+	svc := application.NewMatchEventService(repo, eventRepo)
+	ctx := context.TODO()
+	cID := "club-1"
+
+	match := &domain.TournamentMatch{
+		ID:           uuid.New(),
+		TournamentID: uuid.New(),
+		HomeTeamID:   uuid.New(),
+		AwayTeamID:   uuid.New(),
+		Status:       domain.MatchScheduled,
+	}
+	mID := match.ID.String()
+	repo.On("GetMatch", ctx, cID, mID).Return(match, nil)
+	repo.On("GetTeamMembers", ctx, match.HomeTeamID.String()).Return([]string{"u1", "u2"}, nil)
+
+	t.Run("Goal from roster player", func(t *testing.T) {
+		eventRepo.On("Create", ctx, mock.Anything).Return(nil).Once()
+		event, err := svc.RecordEvent(ctx, application.RecordMatchEventInput{
+			ClubID: cID, MatchID: mID, TeamID: match.HomeTeamID.String(), UserID: "u1", Type: domain.MatchEventGoal, Minute: 12,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, match.TournamentID, event.TournamentID)
+	})
+
+	t.Run("Player outside roster", func(t *testing.T) {
+		_, err := svc.RecordEvent(ctx, application.RecordMatchEventInput{
+			ClubID: cID, MatchID: mID, TeamID: match.HomeTeamID.String(), UserID: "intruder", Type: domain.MatchEventGoal,
+		})
+		assert.ErrorContains(t, err, "plantel")
+	})
+
+	t.Run("Team not in match", func(t *testing.T) {
+		_, err := svc.RecordEvent(ctx, application.RecordMatchEventInput{
+			ClubID: cID, MatchID: mID, TeamID: uuid.New().String(), UserID: "u1", Type: domain.MatchEventGoal,
+		})
+		assert.ErrorContains(t, err, "no participa")
+	})
+
+	t.Run("Invalid type", func(t *testing.T) {
+		_, err := svc.RecordEvent(ctx, application.RecordMatchEventInput{
+			ClubID: cID, MatchID: mID, TeamID: match.HomeTeamID.String(), UserID: "u1", Type: "OFFSIDE",
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Substitution requires incoming player", func(t *testing.T) {
+		_, err := svc.RecordEvent(ctx, application.RecordMatchEventInput{
+			ClubID: cID, MatchID: mID, TeamID: match.HomeTeamID.String(), UserID: "u1", Type: domain.MatchEventSubstitution,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Only one MVP per match", func(t *testing.T) {
+		eventRepo.On("GetByMatchID", ctx, cID, match.ID).Return([]domain.MatchEvent{{Type: domain.MatchEventMVP, UserID: "u2"}}, nil).Once()
+		_, err := svc.RecordEvent(ctx, application.RecordMatchEventInput{
+			ClubID: cID, MatchID: mID, TeamID: match.HomeTeamID.String(), UserID: "u1", Type: domain.MatchEventMVP,
+		})
+		assert.ErrorContains(t, err, "figura")
+	})
+}
+
+func TestMatchEventService_IsAssignedToMatch(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	matchID := uuid.New()
+	refereeUser := "referee-user"
+	referee := newReferee("Pérez")
+	referee.UserID = &refereeUser
+	unlinked := newReferee("Gómez")
+
+	volunteerRepo := new(MockVolunteerRepo)
+	officialRepo := new(MockOfficialRepo)
+	volunteerRepo.On("GetByMatchID", ctx, cID, matchID).Return([]domain.VolunteerAssignment{{UserID: "table-volunteer"}}, nil)
+	officialRepo.On("GetAssignmentsByMatch", ctx, cID, matchID).Return([]domain.OfficialAssignment{
+		{OfficialID: unlinked.ID}, {OfficialID: referee.ID},
+	}, nil)
+	officialRepo.On("GetOfficial", ctx, cID, unlinked.ID).Return(&unlinked, nil)
+	officialRepo.On("GetOfficial", ctx, cID, referee.ID).Return(&referee, nil)
+
+	svc := application.NewMatchEventService(new(MockChampionshipRepo), new(MockMatchEventRepo))
+	assert.False(t, svc.IsAssignedToMatch(ctx, cID, matchID.String(), "table-volunteer"), "sin repositorios nadie está designado")
+
+	svc.SetAssignmentRepositories(volunteerRepo, officialRepo)
+	assert.True(t, svc.IsAssignedToMatch(ctx, cID, matchID.String(), "table-volunteer"))
+	assert.True(t, svc.IsAssignedToMatch(ctx, cID, matchID.String(), refereeUser))
+	assert.False(t, svc.IsAssignedToMatch(ctx, cID, matchID.String(), "spectator"))
+	assert.False(t, svc.IsAssignedToMatch(ctx, cID, "not-a-uuid", refereeUser))
+}
+
+func TestMatchEventService_Tables(t *testing.T) {
+	repo := new(MockChampionshipRepo)
+	eventRepo := new(MockMatchEventRepo)
+	svc := application.NewMatchEventService(repo, eventRepo)
+	ctx := context.TODO()
+	cID := "club-1"
+	tID := uuid.New()
+	m1, m2 := uuid.New(), uuid.New()
+
+	events := []domain.MatchEvent{
+		{MatchID: m1, UserID: "u1", Type: domain.MatchEventGoal},
+		{MatchID: m1, UserID: "u1", Type: domain.MatchEventGoal},
+		{MatchID: m1, UserID: "u2", Type: domain.MatchEventAssist},
+		{MatchID: m2, UserID: "u2", Type: domain.MatchEventGoal},
+		{MatchID: m2, UserID: "u2", Type: domain.MatchEventGoal},
+		{MatchID: m2, UserID: "u3", Type: domain.MatchEventYellowCard},
+		{MatchID: m2, UserID: "u4", Type: domain.MatchEventRedCard},
+	}
+	eventRepo.On("GetByTournamentID", ctx, cID, tID).Return(events, nil)
+
+	t.Run("Top scorers tie broken by assists", func(t *testing.T) {
+		scorers, err := svc.GetTopScorers(ctx, cID, tID.String(), 10)
+		assert.NoError(t, err)
+		assert.Len(t, scorers, 2)
+		assert.Equal(t, "u2", scorers[0].UserID)
+		assert.Equal(t, 2, scorers[0].MatchesPlayed)
+	})
+
+	t.Run("Discipline table excludes clean players", func(t *testing.T) {
+		table, err := svc.GetDisciplineTable(ctx, cID, tID.String())
+		assert.NoError(t, err)
+		assert.Len(t, table, 2)
+		assert.Equal(t, "u4", table[0].UserID)
+	})
+}
+
+func TestChampionshipUseCases_ResultXPFromEvents(t *testing.T) {
+	repo := new(MockChampionshipRepo)
+	eventRepo := new(MockMatchEventRepo)
+	userSvc := new(MockUserService)
+	uc := application.NewChampionshipUseCases(repo, nil, userSvc, eventRepo)
+	cID := "club-1"
+	match := &domain.TournamentMatch{ID: uuid.New(), HomeTeamID: uuid.New(), AwayTeamID: uuid.New()}
+	mID := match.ID.String()
+
+	repo.On("UpdateMatchResult", mock.Anything, cID, mID, 1.0, 0.0).Return(nil).Once()
+	repo.On("GetMatch", mock.Anything, cID, mID).Return(match, nil).Once()
+	repo.On("GetTeamMembers", mock.Anything, match.HomeTeamID.String()).Return([]string{"scorer"}, nil).Once()
+	repo.On("GetTeamMembers", mock.Anything, match.AwayTeamID.String()).Return([]string{"sent-off"}, nil).Once()
+	eventRepo.On("GetByMatchID", mock.Anything, cID, match.ID).Return([]domain.MatchEvent{
+		{UserID: "scorer", Type: domain.MatchEventGoal},
+		{UserID: "scorer", Type: domain.MatchEventMVP},
+		{UserID: "sent-off", Type: domain.MatchEventRedCard},
+	}, nil).Once()
+
+	userSvc.On("UpdateMatchStats", mock.Anything, cID, "scorer", true, domain.MatchPlayedXP+domain.GoalXP+domain.MVPXP).Return(nil).Once()
+	userSvc.On("UpdateMatchStats", mock.Anything, cID, "sent-off", false, domain.MatchPlayedXP+domain.RedCardXP).Return(nil).Once()
+
+	err := uc.UpdateMatchResult(context.TODO(), application.UpdateMatchResultInput{ClubID: cID, MatchID: mID, HomeScore: 1, AwayScore: 0})
+	assert.NoError(t, err)
+	userSvc.AssertExpectations(t)
+}
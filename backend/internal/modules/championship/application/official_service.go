@@ -0,0 +1,552 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"github.com/shopspring/decimal"
+)
+
+// officialRoleOrder es el orden en que se cubren los puestos al designar automáticamente
+var officialRoleOrder = []domain.OfficialRole{
+	domain.OfficialRoleReferee,
+	domain.OfficialRoleLineJudge,
+	domain.OfficialRoleTableOfficial,
+}
+
+// OfficialService gestiona el registro de árbitros y oficiales, su disponibilidad, las designaciones y los honorarios
+type OfficialService struct {
+	repo         domain.ChampionshipRepository
+	officialRepo domain.OfficialRepository
+}
+
+// NewOfficialService crea una nueva instancia del servicio
+func NewOfficialService(repo domain.ChampionshipRepository, officialRepo domain.OfficialRepository) *OfficialService {
+	return &OfficialService{
+		repo:         repo,
+		officialRepo: officialRepo,
+	}
+}
+
+// RegisterOfficialInput contiene los datos de alta de un oficial
+type RegisterOfficialInput struct {
+	ClubID         string                         `json:"-"`
+	UserID         *string                        `json:"user_id,omitempty"`
+	FirstName      string                         `json:"first_name" binding:"required"`
+	LastName       string                         `json:"last_name" binding:"required"`
+	Email          string                         `json:"email"`
+	Phone          string                         `json:"phone"`
+	Qualifications []domain.OfficialQualification `json:"qualifications" binding:"required"`
+}
+
+// RegisterOfficial da de alta un oficial en el registro del club
+func (s *OfficialService) RegisterOfficial(ctx context.Context, input RegisterOfficialInput) (*domain.Official, error) {
+	if len(input.Qualifications) == 0 {
+		return nil, errors.New("el oficial debe tener al menos una habilitación")
+	}
+	for _, q := range input.Qualifications {
+		if !q.Role.IsValid() {
+			return nil, fmt.Errorf("rol de oficial inválido: %s", q.Role)
+		}
+	}
+
+	official := &domain.Official{
+		ID:             uuid.New(),
+		ClubID:         input.ClubID,
+		UserID:         input.UserID,
+		FirstName:      input.FirstName,
+		LastName:       input.LastName,
+		Email:          input.Email,
+		Phone:          input.Phone,
+		Qualifications: input.Qualifications,
+		IsActive:       true,
+	}
+	if err := s.officialRepo.CreateOfficial(ctx, official); err != nil {
+		return nil, err
+	}
+	return official, nil
+}
+
+// ListOfficials lista el registro, opcionalmente filtrando por habilitación (deporte y rol)
+func (s *OfficialService) ListOfficials(ctx context.Context, clubID, sport string, role domain.OfficialRole) ([]domain.Official, error) {
+	officials, err := s.officialRepo.ListOfficials(ctx, clubID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []domain.Official{}
+	for _, o := range officials {
+		if role != "" && !o.IsQualified(sport, role) {
+			continue
+		}
+		result = append(result, o)
+	}
+	return result, nil
+}
+
+// SetOfficialActive da de baja (o reactiva) a un oficial sin perder su historial
+func (s *OfficialService) SetOfficialActive(ctx context.Context, clubID, officialID string, active bool) (*domain.Official, error) {
+	official, err := s.getOfficial(ctx, clubID, officialID)
+	if err != nil {
+		return nil, err
+	}
+	official.IsActive = active
+	if err := s.officialRepo.UpdateOfficial(ctx, official); err != nil {
+		return nil, err
+	}
+	return official, nil
+}
+
+// AddAvailabilityInput contiene una franja de disponibilidad
+type AddAvailabilityInput struct {
+	ClubID     string    `json:"-"`
+	OfficialID string    `json:"-"`
+	StartTime  time.Time `json:"start_time" binding:"required"`
+	EndTime    time.Time `json:"end_time" binding:"required"`
+	Notes      string    `json:"notes"`
+}
+
+// AddAvailability registra una franja en el calendario de disponibilidad del oficial
+func (s *OfficialService) AddAvailability(ctx context.Context, input AddAvailabilityInput) (*domain.OfficialAvailability, error) {
+	if !input.EndTime.After(input.StartTime) {
+		return nil, errors.New("la franja debe terminar después de empezar")
+	}
+	official, err := s.getOfficial(ctx, input.ClubID, input.OfficialID)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := &domain.OfficialAvailability{
+		ID:         uuid.New(),
+		ClubID:     input.ClubID,
+		OfficialID: official.ID,
+		StartTime:  input.StartTime,
+		EndTime:    input.EndTime,
+		Notes:      input.Notes,
+	}
+	if err := s.officialRepo.CreateAvailability(ctx, availability); err != nil {
+		return nil, err
+	}
+	return availability, nil
+}
+
+// ListAvailability obtiene el calendario del oficial en el período
+func (s *OfficialService) ListAvailability(ctx context.Context, clubID, officialID string, from, to time.Time) ([]domain.OfficialAvailability, error) {
+	official, err := s.getOfficial(ctx, clubID, officialID)
+	if err != nil {
+		return nil, err
+	}
+	return s.officialRepo.ListAvailability(ctx, clubID, &official.ID, from, to)
+}
+
+// RemoveAvailability elimina una franja de disponibilidad
+func (s *OfficialService) RemoveAvailability(ctx context.Context, clubID, availabilityID string) error {
+	id, err := uuid.Parse(availabilityID)
+	if err != nil {
+		return errors.New("ID de disponibilidad inválido")
+	}
+	return s.officialRepo.DeleteAvailability(ctx, clubID, id)
+}
+
+// AssignOfficialInput contiene una designación manual
+type AssignOfficialInput struct {
+	ClubID     string              `json:"-"`
+	MatchID    string              `json:"-"`
+	AssignedBy string              `json:"-"`
+	OfficialID string              `json:"official_id" binding:"required"`
+	Role       domain.OfficialRole `json:"role" binding:"required"`
+}
+
+// AssignOfficial designa un oficial para un partido verificando habilitación, disponibilidad y superposiciones
+func (s *OfficialService) AssignOfficial(ctx context.Context, input AssignOfficialInput) (*domain.OfficialAssignment, error) {
+	if !input.Role.IsValid() {
+		return nil, fmt.Errorf("rol de oficial inválido: %s", input.Role)
+	}
+	match, err := s.repo.GetMatch(ctx, input.ClubID, input.MatchID)
+	if err != nil || match == nil {
+		return nil, errors.New("partido no encontrado")
+	}
+	if match.Status == domain.MatchCompleted || match.Status == domain.MatchCancelled {
+		return nil, errors.New("el partido ya no admite designaciones")
+	}
+	official, err := s.getOfficial(ctx, input.ClubID, input.OfficialID)
+	if err != nil {
+		return nil, err
+	}
+
+	tournament, err := s.repo.GetTournament(ctx, input.ClubID, match.TournamentID.String())
+	if err != nil || tournament == nil {
+		return nil, errors.New("torneo no encontrado")
+	}
+	settings := domain.ParseOfficialSettings(tournament.Settings)
+	start, end := settings.MatchWindow(match.Date)
+
+	availability, err := s.officialRepo.ListAvailability(ctx, input.ClubID, &official.ID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := s.officialRepo.ListAssignments(ctx, input.ClubID, &official.ID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if reason := officialUnavailableReason(official, tournament.Sport, input.Role, match.ID, start, end, availability, assignments); reason != "" {
+		return nil, errors.New(reason)
+	}
+
+	assignment := newOfficialAssignment(input.ClubID, match, official, input.Role, start, end, settings, input.AssignedBy)
+	if err := s.officialRepo.CreateAssignment(ctx, assignment); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+// AutoAssignInput define el período (y opcionalmente el torneo) a designar
+type AutoAssignInput struct {
+	ClubID       string    `json:"-"`
+	AssignedBy   string    `json:"-"`
+	From         time.Time `json:"from" binding:"required"`
+	To           time.Time `json:"to" binding:"required"`
+	TournamentID string    `json:"tournament_id"`
+}
+
+// UnfilledOfficialSlot es un puesto que no pudo cubrirse
+type UnfilledOfficialSlot struct {
+	MatchID uuid.UUID           `json:"match_id"`
+	Role    domain.OfficialRole `json:"role"`
+	Missing int                 `json:"missing"`
+}
+
+// AutoAssignResult resume la designación automática
+type AutoAssignResult struct {
+	Assigned []domain.OfficialAssignment `json:"assigned"`
+	Unfilled []UnfilledOfficialSlot      `json:"unfilled"`
+}
+
+// AutoAssign cubre la terna requerida de los partidos programados del período sin superposiciones:
+// solo oficiales habilitados, con disponibilidad declarada, priorizando a quienes tienen menos partidos.
+func (s *OfficialService) AutoAssign(ctx context.Context, input AutoAssignInput) (*AutoAssignResult, error) {
+	if !input.To.After(input.From) {
+		return nil, errors.New("período inválido")
+	}
+
+	matches, err := s.repo.GetUpcomingMatches(ctx, input.ClubID, input.From, input.To)
+	if err != nil {
+		return nil, err
+	}
+	officials, err := s.officialRepo.ListOfficials(ctx, input.ClubID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Margen de un día para contemplar partidos que terminan fuera del período
+	windowFrom, windowTo := input.From.Add(-24*time.Hour), input.To.Add(24*time.Hour)
+	availability, err := s.officialRepo.ListAvailability(ctx, input.ClubID, nil, windowFrom, windowTo)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.officialRepo.ListAssignments(ctx, input.ClubID, nil, windowFrom, windowTo)
+	if err != nil {
+		return nil, err
+	}
+
+	availabilityByOfficial := make(map[uuid.UUID][]domain.OfficialAvailability)
+	for _, a := range availability {
+		availabilityByOfficial[a.OfficialID] = append(availabilityByOfficial[a.OfficialID], a)
+	}
+	assignmentsByOfficial := make(map[uuid.UUID][]domain.OfficialAssignment)
+	for _, a := range existing {
+		assignmentsByOfficial[a.OfficialID] = append(assignmentsByOfficial[a.OfficialID], a)
+	}
+
+	tournaments := make(map[uuid.UUID]*domain.Tournament)
+	result := &AutoAssignResult{Assigned: []domain.OfficialAssignment{}, Unfilled: []UnfilledOfficialSlot{}}
+
+	for i := range matches {
+		match := &matches[i]
+		if input.TournamentID != "" && match.TournamentID.String() != input.TournamentID {
+			continue
+		}
+
+		tournament, ok := tournaments[match.TournamentID]
+		if !ok {
+			tournament, err = s.repo.GetTournament(ctx, input.ClubID, match.TournamentID.String())
+			if err != nil {
+				return nil, err
+			}
+			tournaments[match.TournamentID] = tournament
+		}
+		settings := domain.ParseOfficialSettings(tournament.Settings)
+		start, end := settings.MatchWindow(match.Date)
+
+		assignedToMatch := make(map[domain.OfficialRole]int)
+		for _, list := range assignmentsByOfficial {
+			for _, a := range list {
+				if a.MatchID == match.ID {
+					assignedToMatch[a.Role]++
+				}
+			}
+		}
+
+		for _, role := range officialRoleOrder {
+			missing := settings.Required[role] - assignedToMatch[role]
+			if missing <= 0 {
+				continue
+			}
+
+			candidates := []*domain.Official{}
+			for j := range officials {
+				o := &officials[j]
+				if officialUnavailableReason(o, tournament.Sport, role, match.ID, start, end, availabilityByOfficial[o.ID], assignmentsByOfficial[o.ID]) == "" {
+					candidates = append(candidates, o)
+				}
+			}
+			// Reparto equitativo: primero quien menos partidos tiene en el período
+			sort.SliceStable(candidates, func(a, b int) bool {
+				la, lb := len(assignmentsByOfficial[candidates[a].ID]), len(assignmentsByOfficial[candidates[b].ID])
+				if la != lb {
+					return la < lb
+				}
+				return candidates[a].FullName() < candidates[b].FullName()
+			})
+
+			for _, official := range candidates {
+				if missing == 0 {
+					break
+				}
+				assignment := newOfficialAssignment(input.ClubID, match, official, role, start, end, settings, input.AssignedBy)
+				assignment.AutoAssigned = true
+				if err := s.officialRepo.CreateAssignment(ctx, assignment); err != nil {
+					return nil, err
+				}
+				assignmentsByOfficial[official.ID] = append(assignmentsByOfficial[official.ID], *assignment)
+				result.Assigned = append(result.Assigned, *assignment)
+				missing--
+			}
+			if missing > 0 {
+				result.Unfilled = append(result.Unfilled, UnfilledOfficialSlot{MatchID: match.ID, Role: role, Missing: missing})
+			}
+		}
+	}
+	return result, nil
+}
+
+// GetMatchOfficials obtiene la terna designada para un partido
+func (s *OfficialService) GetMatchOfficials(ctx context.Context, clubID, matchID string) ([]domain.OfficialAssignment, error) {
+	mID, err := uuid.Parse(matchID)
+	if err != nil {
+		return nil, errors.New("ID de partido inválido")
+	}
+	assignments, err := s.officialRepo.GetAssignmentsByMatch(ctx, clubID, mID)
+	if err != nil {
+		return nil, err
+	}
+	names, err := s.officialNames(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range assignments {
+		assignments[i].OfficialName = names[assignments[i].OfficialID]
+	}
+	if assignments == nil {
+		assignments = []domain.OfficialAssignment{}
+	}
+	return assignments, nil
+}
+
+// RemoveAssignment elimina una designación
+func (s *OfficialService) RemoveAssignment(ctx context.Context, clubID, assignmentID string) error {
+	id, err := uuid.Parse(assignmentID)
+	if err != nil {
+		return errors.New("ID de designación inválido")
+	}
+	return s.officialRepo.DeleteAssignment(ctx, clubID, id)
+}
+
+// GetPayoutReport resume lo devengado, pagado y adeudado a cada oficial por los partidos jugados en el período
+func (s *OfficialService) GetPayoutReport(ctx context.Context, clubID string, from, to time.Time) ([]domain.OfficialPayout, error) {
+	return s.payouts(ctx, clubID, nil, from, to)
+}
+
+// SettlePayouts registra el pago de todo lo adeudado al oficial en el período
+func (s *OfficialService) SettlePayouts(ctx context.Context, clubID, officialID string, from, to time.Time) (*domain.OfficialPayout, error) {
+	official, err := s.getOfficial(ctx, clubID, officialID)
+	if err != nil {
+		return nil, err
+	}
+	payouts, err := s.payouts(ctx, clubID, &official.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if len(payouts) == 0 || payouts[0].Owed.IsZero() {
+		return nil, errors.New("no hay honorarios pendientes en el período")
+	}
+
+	payout := &payouts[0]
+	now := time.Now()
+	ids := []uuid.UUID{}
+	for i := range payout.Assignments {
+		if payout.Assignments[i].PaidAt == nil {
+			ids = append(ids, payout.Assignments[i].ID)
+			payout.Assignments[i].PaidAt = &now
+		}
+	}
+	if err := s.officialRepo.MarkAssignmentsPaid(ctx, clubID, ids, now); err != nil {
+		return nil, err
+	}
+	payout.TotalPaid = payout.TotalEarned
+	payout.Owed = decimal.Zero
+	return payout, nil
+}
+
+func (s *OfficialService) payouts(ctx context.Context, clubID string, officialID *uuid.UUID, from, to time.Time) ([]domain.OfficialPayout, error) {
+	if !to.After(from) {
+		return nil, errors.New("período inválido")
+	}
+	assignments, err := s.officialRepo.ListAssignments(ctx, clubID, officialID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	names, err := s.officialNames(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	completed := make(map[uuid.UUID]bool)
+	byOfficial := make(map[uuid.UUID]*domain.OfficialPayout)
+	order := []uuid.UUID{}
+	for _, a := range assignments {
+		// Solo se devengan los partidos del período que ya se jugaron
+		if a.StartTime.Before(from) || !a.StartTime.Before(to) || a.EndTime.After(now) {
+			continue
+		}
+		// Los partidos suspendidos o cancelados no generan honorarios (lo ya pagado se sigue mostrando)
+		played, ok := completed[a.MatchID]
+		if !ok {
+			match, err := s.repo.GetMatch(ctx, clubID, a.MatchID.String())
+			if err != nil {
+				return nil, err
+			}
+			played = match != nil && match.Status == domain.MatchCompleted
+			completed[a.MatchID] = played
+		}
+		if !played && a.PaidAt == nil {
+			continue
+		}
+		payout, ok := byOfficial[a.OfficialID]
+		if !ok {
+			payout = &domain.OfficialPayout{
+				OfficialID:  a.OfficialID,
+				Name:        names[a.OfficialID],
+				TotalEarned: decimal.Zero,
+				TotalPaid:   decimal.Zero,
+				Assignments: []domain.OfficialAssignment{},
+			}
+			byOfficial[a.OfficialID] = payout
+			order = append(order, a.OfficialID)
+		}
+		a.OfficialName = payout.Name
+		payout.Matches++
+		payout.TotalEarned = payout.TotalEarned.Add(a.Fee)
+		if a.PaidAt != nil {
+			payout.TotalPaid = payout.TotalPaid.Add(a.Fee)
+		}
+		payout.Assignments = append(payout.Assignments, a)
+	}
+
+	result := make([]domain.OfficialPayout, 0, len(order))
+	for _, id := range order {
+		payout := byOfficial[id]
+		payout.Owed = payout.TotalEarned.Sub(payout.TotalPaid)
+		result = append(result, *payout)
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+func (s *OfficialService) officialNames(ctx context.Context, clubID string) (map[uuid.UUID]string, error) {
+	officials, err := s.officialRepo.ListOfficials(ctx, clubID, false)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[uuid.UUID]string, len(officials))
+	for i := range officials {
+		names[officials[i].ID] = officials[i].FullName()
+	}
+	return names, nil
+}
+
+func (s *OfficialService) getOfficial(ctx context.Context, clubID, officialID string) (*domain.Official, error) {
+	id, err := uuid.Parse(officialID)
+	if err != nil {
+		return nil, errors.New("ID de oficial inválido")
+	}
+	official, err := s.officialRepo.GetOfficial(ctx, clubID, id)
+	if err != nil {
+		return nil, err
+	}
+	if official == nil {
+		return nil, errors.New("oficial no encontrado")
+	}
+	return official, nil
+}
+
+// officialUnavailableReason devuelve por qué el oficial no puede cubrir el puesto ("" si puede)
+func officialUnavailableReason(
+	official *domain.Official,
+	sport string,
+	role domain.OfficialRole,
+	matchID uuid.UUID,
+	start, end time.Time,
+	availability []domain.OfficialAvailability,
+	assignments []domain.OfficialAssignment,
+) string {
+	if !official.IsActive {
+		return "el oficial está dado de baja"
+	}
+	if !official.IsQualified(sport, role) {
+		return fmt.Sprintf("el oficial no está habilitado como %s en %s", role, sport)
+	}
+
+	available := false
+	for i := range availability {
+		if availability[i].Covers(start, end) {
+			available = true
+			break
+		}
+	}
+	if !available {
+		return "el oficial no declaró disponibilidad para el horario del partido"
+	}
+
+	for i := range assignments {
+		if assignments[i].MatchID == matchID {
+			return "el oficial ya está designado en este partido"
+		}
+		if assignments[i].Overlaps(start, end) {
+			return "el oficial tiene otro partido designado en ese horario"
+		}
+	}
+	return ""
+}
+
+func newOfficialAssignment(clubID string, match *domain.TournamentMatch, official *domain.Official, role domain.OfficialRole, start, end time.Time, settings domain.OfficialSettings, assignedBy string) *domain.OfficialAssignment {
+	return &domain.OfficialAssignment{
+		ID:           uuid.New(),
+		ClubID:       clubID,
+		TournamentID: match.TournamentID,
+		MatchID:      match.ID,
+		OfficialID:   official.ID,
+		Role:         role,
+		StartTime:    start,
+		EndTime:      end,
+		Fee:          settings.FeeFor(role),
+		AssignedBy:   assignedBy,
+		OfficialName: official.FullName(),
+	}
+}
@@ -0,0 +1,269 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/datatypes"
+)
+
+type MockOfficialRepo struct {
+	mock.Mock
+}
+
+func (m *MockOfficialRepo) CreateOfficial(ctx context.Context, o *domain.Official) error {
+	return m.Called(ctx, o).Error(0)
+}
+func (m *MockOfficialRepo) UpdateOfficial(ctx context.Context, o *domain.Official) error {
+	return m.Called(ctx, o).Error(0)
+}
+func (m *MockOfficialRepo) GetOfficial(ctx context.Context, clubID string, id uuid.UUID) (*domain.Official, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Official), args.Error(1)
+}
+func (m *MockOfficialRepo) ListOfficials(ctx context.Context, clubID string, activeOnly bool) ([]domain.Official, error) {
+	args := m.Called(ctx, clubID, activeOnly)
+	return args.Get(0).([]domain.Official), args.Error(1)
+}
+func (m *MockOfficialRepo) CreateAvailability(ctx context.Context, a *domain.OfficialAvailability) error {
+	return m.Called(ctx, a).Error(0)
+}
+func (m *MockOfficialRepo) DeleteAvailability(ctx context.Context, clubID string, id uuid.UUID) error {
+	return m.Called(ctx, clubID, id).Error(0)
+}
+func (m *MockOfficialRepo) ListAvailability(ctx context.Context, clubID string, officialID *uuid.UUID, from, to time.Time) ([]domain.OfficialAvailability, error) {
+	args := m.Called(ctx, clubID, officialID, from, to)
+	return args.Get(0).([]domain.OfficialAvailability), args.Error(1)
+}
+func (m *MockOfficialRepo) CreateAssignment(ctx context.Context, a *domain.OfficialAssignment) error {
+	return m.Called(ctx, a).Error(0)
+}
+func (m *MockOfficialRepo) DeleteAssignment(ctx context.Context, clubID string, id uuid.UUID) error {
+	return m.Called(ctx, clubID, id).Error(0)
+}
+func (m *MockOfficialRepo) GetAssignmentsByMatch(ctx context.Context, clubID string, matchID uuid.UUID) ([]domain.OfficialAssignment, error) {
+	args := m.Called(ctx, clubID, matchID)
+	return args.Get(0).([]domain.OfficialAssignment), args.Error(1)
+}
+func (m *MockOfficialRepo) ListAssignments(ctx context.Context, clubID string, officialID *uuid.UUID, from, to time.Time) ([]domain.OfficialAssignment, error) {
+	args := m.Called(ctx, clubID, officialID, from, to)
+	return args.Get(0).([]domain.OfficialAssignment), args.Error(1)
+}
+func (m *MockOfficialRepo) MarkAssignmentsPaid(ctx context.Context, clubID string, ids []uuid.UUID, paidAt time.Time) error {
+	return m.Called(ctx, clubID, ids, paidAt).Error(0)
+}
+
+func newReferee(lastName string) domain.Official {
+	return domain.Official{
+		ID:             uuid.New(),
+		FirstName:      "Árbitro",
+		LastName:       lastName,
+		IsActive:       true,
+		Qualifications: []domain.OfficialQualification{{Sport: "FUTBOL", Role: domain.OfficialRoleReferee}},
+	}
+}
+
+func officialsTournament() *domain.Tournament {
+	return &domain.Tournament{
+		ID:       uuid.New(),
+		Sport:    "FUTBOL",
+		Settings: datatypes.JSON(`{"officials": {"match_duration_minutes": 60, "fees": {"REFEREE": "15000"}}}`),
+	}
+}
+
+func TestOfficialService_AssignOfficial(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	tournament := officialsTournament()
+	kickoff := time.Date(2026, 11, 7, 15, 0, 0, 0, time.UTC)
+	match := &domain.TournamentMatch{ID: uuid.New(), TournamentID: tournament.ID, Date: kickoff, Status: domain.MatchScheduled}
+
+	setup := func(official domain.Official, availability []domain.OfficialAvailability, assignments []domain.OfficialAssignment) (*application.OfficialService, *MockOfficialRepo) {
+		repo := new(MockChampionshipRepo)
+		officialRepo := new(MockOfficialRepo)
+		repo.On("GetMatch", ctx, cID, match.ID.String()).Return(match, nil)
+		repo.On("GetTournament", ctx, cID, tournament.ID.String()).Return(tournament, nil)
+		officialRepo.On("GetOfficial", ctx, cID, official.ID).Return(&official, nil)
+		officialRepo.On("ListAvailability", ctx, cID, mock.Anything, mock.Anything, mock.Anything).Return(availability, nil)
+		officialRepo.On("ListAssignments", ctx, cID, mock.Anything, mock.Anything, mock.Anything).Return(assignments, nil)
+		return application.NewOfficialService(repo, officialRepo), officialRepo
+	}
+	input := func(official domain.Official, role domain.OfficialRole) application.AssignOfficialInput {
+		return application.AssignOfficialInput{ClubID: cID, MatchID: match.ID.String(), OfficialID: official.ID.String(), Role: role, AssignedBy: "admin"}
+	}
+	available := func(o domain.Official) []domain.OfficialAvailability {
+		return []domain.OfficialAvailability{{OfficialID: o.ID, StartTime: kickoff.Add(-2 * time.Hour), EndTime: kickoff.Add(4 * time.Hour)}}
+	}
+
+	t.Run("Snapshots the fee configured for the role", func(t *testing.T) {
+		official := newReferee("Pérez")
+		svc, officialRepo := setup(official, available(official), []domain.OfficialAssignment{})
+		officialRepo.On("CreateAssignment", ctx, mock.Anything).Return(nil)
+
+		assignment, err := svc.AssignOfficial(ctx, input(official, domain.OfficialRoleReferee))
+		assert.NoError(t, err)
+		assert.True(t, assignment.Fee.Equal(decimal.NewFromInt(15000)))
+		assert.Equal(t, kickoff.Add(60*time.Minute), assignment.EndTime)
+	})
+
+	t.Run("Rejects official not qualified for the role", func(t *testing.T) {
+		official := newReferee("Pérez")
+		svc, officialRepo := setup(official, available(official), []domain.OfficialAssignment{})
+
+		_, err := svc.AssignOfficial(ctx, input(official, domain.OfficialRoleLineJudge))
+		assert.ErrorContains(t, err, "no está habilitado")
+		officialRepo.AssertNotCalled(t, "CreateAssignment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Rejects official without availability", func(t *testing.T) {
+		official := newReferee("Pérez")
+		svc, _ := setup(official, []domain.OfficialAvailability{}, []domain.OfficialAssignment{})
+
+		_, err := svc.AssignOfficial(ctx, input(official, domain.OfficialRoleReferee))
+		assert.ErrorContains(t, err, "disponibilidad")
+	})
+
+	t.Run("Rejects overlapping assignment", func(t *testing.T) {
+		official := newReferee("Pérez")
+		other := domain.OfficialAssignment{OfficialID: official.ID, MatchID: uuid.New(), StartTime: kickoff.Add(30 * time.Minute), EndTime: kickoff.Add(90 * time.Minute)}
+		svc, _ := setup(official, available(official), []domain.OfficialAssignment{other})
+
+		_, err := svc.AssignOfficial(ctx, input(official, domain.OfficialRoleReferee))
+		assert.ErrorContains(t, err, "otro partido")
+	})
+}
+
+func TestOfficialService_AutoAssign(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	tournament := officialsTournament()
+	day := time.Date(2026, 11, 7, 0, 0, 0, 0, time.UTC)
+	from, to := day, day.AddDate(0, 0, 1)
+
+	// Dos partidos simultáneos y uno posterior
+	m1 := domain.TournamentMatch{ID: uuid.New(), TournamentID: tournament.ID, Date: day.Add(10 * time.Hour), Status: domain.MatchScheduled}
+	m2 := domain.TournamentMatch{ID: uuid.New(), TournamentID: tournament.ID, Date: day.Add(10 * time.Hour), Status: domain.MatchScheduled}
+	m3 := domain.TournamentMatch{ID: uuid.New(), TournamentID: tournament.ID, Date: day.Add(12 * time.Hour), Status: domain.MatchScheduled}
+
+	busy := newReferee("Alvarez")   // Ya tiene un partido designado en el período
+	free := newReferee("Benitez")   // Sin partidos
+	absent := newReferee("Castro")  // No declaró disponibilidad
+	lineJudge := newReferee("Diaz") // Habilitado solo como asistente
+	lineJudge.Qualifications = []domain.OfficialQualification{{Sport: "FUTBOL", Role: domain.OfficialRoleLineJudge}}
+
+	fullDay := func(o domain.Official) domain.OfficialAvailability {
+		return domain.OfficialAvailability{OfficialID: o.ID, StartTime: day.Add(8 * time.Hour), EndTime: day.Add(20 * time.Hour)}
+	}
+	previous := domain.OfficialAssignment{OfficialID: busy.ID, MatchID: uuid.New(), Role: domain.OfficialRoleReferee, StartTime: day.Add(18 * time.Hour), EndTime: day.Add(19 * time.Hour)}
+
+	repo := new(MockChampionshipRepo)
+	officialRepo := new(MockOfficialRepo)
+	svc := application.NewOfficialService(repo, officialRepo)
+
+	repo.On("GetUpcomingMatches", ctx, cID, from, to).Return([]domain.TournamentMatch{m1, m2, m3}, nil)
+	repo.On("GetTournament", ctx, cID, tournament.ID.String()).Return(tournament, nil)
+	officialRepo.On("ListOfficials", ctx, cID, true).Return([]domain.Official{busy, free, absent, lineJudge}, nil)
+	officialRepo.On("ListAvailability", ctx, cID, (*uuid.UUID)(nil), mock.Anything, mock.Anything).
+		Return([]domain.OfficialAvailability{fullDay(busy), fullDay(free), fullDay(lineJudge)}, nil)
+	officialRepo.On("ListAssignments", ctx, cID, (*uuid.UUID)(nil), mock.Anything, mock.Anything).
+		Return([]domain.OfficialAssignment{previous}, nil)
+	officialRepo.On("CreateAssignment", ctx, mock.Anything).Return(nil)
+
+	result, err := svc.AutoAssign(ctx, application.AutoAssignInput{ClubID: cID, From: from, To: to})
+	assert.NoError(t, err)
+	assert.Len(t, result.Assigned, 3)
+	assert.Empty(t, result.Unfilled)
+
+	byMatch := map[uuid.UUID]uuid.UUID{}
+	for _, a := range result.Assigned {
+		assert.True(t, a.AutoAssigned)
+		assert.Equal(t, domain.OfficialRoleReferee, a.Role)
+		byMatch[a.MatchID] = a.OfficialID
+	}
+	// El menos cargado toma el primer partido; el simultáneo queda para el otro disponible
+	assert.Equal(t, free.ID, byMatch[m1.ID])
+	assert.Equal(t, busy.ID, byMatch[m2.ID])
+	assert.NotEqual(t, absent.ID, byMatch[m3.ID])
+	assert.NotEqual(t, lineJudge.ID, byMatch[m3.ID])
+
+	t.Run("Reports unfilled slots", func(t *testing.T) {
+		repo := new(MockChampionshipRepo)
+		officialRepo := new(MockOfficialRepo)
+		svc := application.NewOfficialService(repo, officialRepo)
+
+		repo.On("GetUpcomingMatches", ctx, cID, from, to).Return([]domain.TournamentMatch{m1, m2}, nil)
+		repo.On("GetTournament", ctx, cID, tournament.ID.String()).Return(tournament, nil)
+		officialRepo.On("ListOfficials", ctx, cID, true).Return([]domain.Official{free}, nil)
+		officialRepo.On("ListAvailability", ctx, cID, (*uuid.UUID)(nil), mock.Anything, mock.Anything).
+			Return([]domain.OfficialAvailability{fullDay(free)}, nil)
+		officialRepo.On("ListAssignments", ctx, cID, (*uuid.UUID)(nil), mock.Anything, mock.Anything).
+			Return([]domain.OfficialAssignment{}, nil)
+		officialRepo.On("CreateAssignment", ctx, mock.Anything).Return(nil)
+
+		result, err := svc.AutoAssign(ctx, application.AutoAssignInput{ClubID: cID, From: from, To: to})
+		assert.NoError(t, err)
+		assert.Len(t, result.Assigned, 1)
+		assert.Len(t, result.Unfilled, 1)
+		assert.Equal(t, m2.ID, result.Unfilled[0].MatchID)
+	})
+}
+
+func TestOfficialService_PayoutReport(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	from := time.Now().AddDate(0, -1, 0)
+	to := time.Now().AddDate(0, 0, 7)
+
+	referee := newReferee("Pérez")
+	paidAt := time.Now().AddDate(0, 0, -3)
+	repo := new(MockChampionshipRepo)
+	matchWithStatus := func(status domain.MatchStatus) uuid.UUID {
+		match := &domain.TournamentMatch{ID: uuid.New(), Status: status}
+		repo.On("GetMatch", ctx, cID, match.ID.String()).Return(match, nil)
+		return match.ID
+	}
+	played := func(daysAgo int, fee int64, paid *time.Time, status domain.MatchStatus) domain.OfficialAssignment {
+		start := time.Now().AddDate(0, 0, -daysAgo)
+		return domain.OfficialAssignment{ID: uuid.New(), MatchID: matchWithStatus(status), OfficialID: referee.ID, StartTime: start, EndTime: start.Add(90 * time.Minute), Fee: decimal.NewFromInt(fee), PaidAt: paid}
+	}
+	upcoming := domain.OfficialAssignment{ID: uuid.New(), OfficialID: referee.ID, StartTime: time.Now().AddDate(0, 0, 2), EndTime: time.Now().AddDate(0, 0, 2).Add(time.Hour), Fee: decimal.NewFromInt(15000)}
+	assignments := []domain.OfficialAssignment{
+		played(10, 15000, &paidAt, domain.MatchCompleted),
+		played(5, 15000, nil, domain.MatchCompleted),
+		played(2, 8000, nil, domain.MatchCompleted),
+		played(3, 15000, nil, domain.MatchPostponed),
+		played(4, 15000, nil, domain.MatchCancelled),
+		upcoming,
+	}
+
+	officialRepo := new(MockOfficialRepo)
+	svc := application.NewOfficialService(repo, officialRepo)
+	officialRepo.On("ListOfficials", ctx, cID, false).Return([]domain.Official{referee}, nil)
+	officialRepo.On("ListAssignments", ctx, cID, mock.Anything, from, to).Return(assignments, nil)
+	officialRepo.On("GetOfficial", ctx, cID, referee.ID).Return(&referee, nil)
+	officialRepo.On("MarkAssignmentsPaid", ctx, cID, []uuid.UUID{assignments[1].ID, assignments[2].ID}, mock.Anything).Return(nil)
+
+	report, err := svc.GetPayoutReport(ctx, cID, from, to)
+	assert.NoError(t, err)
+	assert.Len(t, report, 1)
+	assert.Equal(t, 3, report[0].Matches) // Ni el partido futuro ni los suspendidos o cancelados se devengan
+	assert.True(t, report[0].TotalEarned.Equal(decimal.NewFromInt(38000)))
+	assert.True(t, report[0].TotalPaid.Equal(decimal.NewFromInt(15000)))
+	assert.True(t, report[0].Owed.Equal(decimal.NewFromInt(23000)))
+	assert.Equal(t, "Árbitro Pérez", report[0].Name)
+
+	payout, err := svc.SettlePayouts(ctx, cID, referee.ID.String(), from, to)
+	assert.NoError(t, err)
+	assert.True(t, payout.Owed.IsZero())
+	officialRepo.AssertCalled(t, "MarkAssignmentsPaid", ctx, cID, []uuid.UUID{assignments[1].ID, assignments[2].ID}, mock.Anything)
+}
@@ -0,0 +1,298 @@
+package application
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/jung-kurt/gofpdf"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+)
+
+// defaultWidgetMatches es la cantidad de próximos partidos y resultados que muestra el widget
+const defaultWidgetMatches = 5
+
+// PublicTournamentService arma las vistas públicas de un torneo: llave eliminatoria, fixture exportable y widget embebible
+type PublicTournamentService struct {
+	repo     domain.ChampionshipRepository
+	fixtures domain.TournamentFixtureRepository
+}
+
+// NewPublicTournamentService crea una nueva instancia del servicio
+func NewPublicTournamentService(repo domain.ChampionshipRepository, fixtures domain.TournamentFixtureRepository) *PublicTournamentService {
+	return &PublicTournamentService{
+		repo:     repo,
+		fixtures: fixtures,
+	}
+}
+
+// GetBrackets arma el árbol de cada fase eliminatoria del torneo
+func (s *PublicTournamentService) GetBrackets(ctx context.Context, clubID, tournamentID string) ([]domain.Bracket, error) {
+	tournament, matches, err := s.load(ctx, clubID, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	brackets := []domain.Bracket{}
+	for _, stage := range sortedStages(tournament) {
+		if stage.Type == domain.StageKnockout {
+			brackets = append(brackets, domain.BuildBracket(stage, matches))
+		}
+	}
+	return brackets, nil
+}
+
+// GetFixture obtiene el fixture completo del torneo ordenado por fase y fecha
+func (s *PublicTournamentService) GetFixture(ctx context.Context, clubID, tournamentID string) (*domain.TournamentFixture, error) {
+	tournament, matches, err := s.load(ctx, clubID, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TournamentFixture{
+		TournamentID:   tournament.ID,
+		TournamentName: tournament.Name,
+		Sport:          tournament.Sport,
+		Category:       tournament.Category,
+		Rows:           fixtureRows(tournament, matches),
+	}, nil
+}
+
+// GetWidget arma el payload embebible: tablas de los grupos, próximos partidos, últimos resultados y campeón
+func (s *PublicTournamentService) GetWidget(ctx context.Context, clubID, tournamentID string, limit int) (*domain.TournamentWidget, error) {
+	if limit <= 0 {
+		limit = defaultWidgetMatches
+	}
+	tournament, matches, err := s.load(ctx, clubID, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	widget := &domain.TournamentWidget{
+		TournamentID:   tournament.ID,
+		TournamentName: tournament.Name,
+		Sport:          tournament.Sport,
+		Category:       tournament.Category,
+		Status:         tournament.Status,
+		LogoURL:        tournament.LogoURL,
+		Standings:      []domain.WidgetStandings{},
+		Upcoming:       []domain.FixtureRow{},
+		Results:        []domain.FixtureRow{},
+	}
+
+	for _, stage := range sortedStages(tournament) {
+		switch stage.Type {
+		case domain.StageGroup:
+			for _, group := range stage.Groups {
+				standings, err := s.repo.GetStandings(ctx, clubID, group.ID.String())
+				if err != nil {
+					return nil, err
+				}
+				widget.Standings = append(widget.Standings, domain.WidgetStandings{GroupID: group.ID, GroupName: group.Name, Rows: standings})
+			}
+		case domain.StageKnockout:
+			if bracket := domain.BuildBracket(stage, matches); bracket.ChampionName != "" {
+				widget.Champion = bracket.ChampionName
+			}
+		}
+	}
+
+	// Las filas vienen ordenadas por fase; el widget ordena solo por fecha
+	rows := fixtureRows(tournament, matches)
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].Date.Before(rows[j].Date) })
+	for _, row := range rows {
+		if (row.Status == domain.MatchScheduled || row.Status == domain.MatchInProgress) && len(widget.Upcoming) < limit {
+			widget.Upcoming = append(widget.Upcoming, row)
+		}
+	}
+	for i := len(rows) - 1; i >= 0 && len(widget.Results) < limit; i-- {
+		if rows[i].Status == domain.MatchCompleted {
+			widget.Results = append(widget.Results, rows[i])
+		}
+	}
+	return widget, nil
+}
+
+// WriteFixtureCSV escribe el fixture en formato CSV
+func (s *PublicTournamentService) WriteFixtureCSV(fixture *domain.TournamentFixture, w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"Fase", "Grupo", "Ronda", "Fecha", "Hora", "Local", "Visitante", "Goles Local", "Goles Visitante", "Estado", "Lugar"}); err != nil {
+		return err
+	}
+	for _, row := range fixture.Rows {
+		if err := writer.Write([]string{
+			row.Stage,
+			row.Group,
+			row.Round,
+			row.Date.Format("02/01/2006"),
+			row.Date.Format("15:04"),
+			row.HomeTeam,
+			row.AwayTeam,
+			formatScore(row.HomeScore),
+			formatScore(row.AwayScore),
+			string(row.Status),
+			row.Location,
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteFixturePDF escribe el fixture imprimible, con una tabla por fase y grupo
+func (s *PublicTournamentService) WriteFixturePDF(fixture *domain.TournamentFixture, w io.Writer) error {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	tr := pdf.UnicodeTranslatorFromDescriptor("") // Las fuentes core no soportan UTF-8
+	pdf.AddPage()
+
+	// Título
+	pdf.SetFont("Arial", "B", 16)
+	pdf.CellFormat(0, 10, tr(fixture.TournamentName), "", 1, "C", false, 0, "")
+	pdf.SetFont("Arial", "", 10)
+	subtitle := fixture.Sport
+	if fixture.Category != "" {
+		subtitle += " - " + fixture.Category
+	}
+	pdf.CellFormat(0, 6, tr(subtitle), "", 1, "C", false, 0, "")
+	pdf.Ln(6)
+
+	section := ""
+	for _, row := range fixture.Rows {
+		title := row.Stage
+		if row.Group != "" {
+			title += " - " + row.Group
+		}
+		if title != section {
+			section = title
+			pdf.Ln(3)
+			pdf.SetFont("Arial", "B", 11)
+			pdf.CellFormat(0, 8, tr(section), "", 1, "L", false, 0, "")
+
+			pdf.SetFont("Arial", "B", 9)
+			pdf.SetFillColor(200, 200, 200)
+			pdf.CellFormat(22, 7, "Fecha", "1", 0, "C", true, 0, "")
+			pdf.CellFormat(14, 7, "Hora", "1", 0, "C", true, 0, "")
+			pdf.CellFormat(16, 7, "Ronda", "1", 0, "C", true, 0, "")
+			pdf.CellFormat(46, 7, "Local", "1", 0, "C", true, 0, "")
+			pdf.CellFormat(16, 7, "Res.", "1", 0, "C", true, 0, "")
+			pdf.CellFormat(46, 7, "Visitante", "1", 0, "C", true, 0, "")
+			pdf.CellFormat(20, 7, "Lugar", "1", 1, "C", true, 0, "")
+			pdf.SetFont("Arial", "", 9)
+		}
+
+		result := "-"
+		if row.HomeScore != nil && row.AwayScore != nil {
+			result = formatScore(row.HomeScore) + " - " + formatScore(row.AwayScore)
+		}
+		if row.Status == domain.MatchCancelled {
+			result = "Susp."
+		}
+
+		pdf.CellFormat(22, 7, row.Date.Format("02/01/2006"), "1", 0, "C", false, 0, "")
+		pdf.CellFormat(14, 7, row.Date.Format("15:04"), "1", 0, "C", false, 0, "")
+		pdf.CellFormat(16, 7, tr(row.Round), "1", 0, "C", false, 0, "")
+		pdf.CellFormat(46, 7, tr(row.HomeTeam), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(16, 7, result, "1", 0, "C", false, 0, "")
+		pdf.CellFormat(46, 7, tr(row.AwayTeam), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(20, 7, tr(row.Location), "1", 1, "L", false, 0, "")
+	}
+
+	if len(fixture.Rows) == 0 {
+		pdf.SetFont("Arial", "I", 10)
+		pdf.CellFormat(0, 8, "Fixture sin partidos programados", "", 1, "C", false, 0, "")
+	}
+
+	return pdf.Output(w)
+}
+
+func (s *PublicTournamentService) load(ctx context.Context, clubID, tournamentID string) (*domain.Tournament, []domain.TournamentMatch, error) {
+	if _, err := uuid.Parse(tournamentID); err != nil {
+		return nil, nil, errors.New("ID de torneo inválido")
+	}
+	tournament, err := s.repo.GetTournament(ctx, clubID, tournamentID)
+	if err != nil || tournament == nil {
+		return nil, nil, errors.New("torneo no encontrado")
+	}
+	matches, err := s.fixtures.GetMatchesByTournament(ctx, clubID, tournamentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tournament, matches, nil
+}
+
+func sortedStages(tournament *domain.Tournament) []domain.TournamentStage {
+	stages := append([]domain.TournamentStage{}, tournament.Stages...)
+	sort.SliceStable(stages, func(i, j int) bool { return stages[i].Order < stages[j].Order })
+	return stages
+}
+
+func fixtureRows(tournament *domain.Tournament, matches []domain.TournamentMatch) []domain.FixtureRow {
+	stageNames := make(map[uuid.UUID]string)
+	stageOrder := make(map[uuid.UUID]int)
+	groupNames := make(map[uuid.UUID]string)
+	for _, stage := range tournament.Stages {
+		stageNames[stage.ID] = stage.Name
+		stageOrder[stage.ID] = stage.Order
+		for _, group := range stage.Groups {
+			groupNames[group.ID] = group.Name
+		}
+	}
+
+	sorted := append([]domain.TournamentMatch{}, matches...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if stageOrder[sorted[i].StageID] != stageOrder[sorted[j].StageID] {
+			return stageOrder[sorted[i].StageID] < stageOrder[sorted[j].StageID]
+		}
+		gi, gj := "", ""
+		if sorted[i].GroupID != nil {
+			gi = groupNames[*sorted[i].GroupID]
+		}
+		if sorted[j].GroupID != nil {
+			gj = groupNames[*sorted[j].GroupID]
+		}
+		if gi != gj {
+			return gi < gj
+		}
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+
+	rows := make([]domain.FixtureRow, 0, len(sorted))
+	for _, m := range sorted {
+		row := domain.FixtureRow{
+			MatchID:   m.ID,
+			Stage:     stageNames[m.StageID],
+			Round:     m.Round,
+			Date:      m.Date,
+			HomeTeam:  m.HomeTeamName,
+			AwayTeam:  m.AwayTeamName,
+			HomeScore: m.HomeScore,
+			AwayScore: m.AwayScore,
+			Status:    m.Status,
+			Location:  m.Location,
+		}
+		if m.GroupID != nil {
+			row.Group = groupNames[*m.GroupID]
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func formatScore(score *float64) string {
+	if score == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*score, 'f', -1, 64)
+}
+
+// FixtureFilename devuelve el nombre de archivo de la exportación
+func FixtureFilename(fixture *domain.TournamentFixture, extension string) string {
+	return fmt.Sprintf("fixture_%s.%s", fixture.TournamentID.String()[:8], extension)
+}
@@ -0,0 +1,177 @@
+package application_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockFixtureRepo struct {
+	mock.Mock
+}
+
+func (m *MockFixtureRepo) GetMatchesByTournament(ctx context.Context, clubID, tournamentID string) ([]domain.TournamentMatch, error) {
+	args := m.Called(ctx, clubID, tournamentID)
+	return args.Get(0).([]domain.TournamentMatch), args.Error(1)
+}
+
+func knockoutMatch(stageID uuid.UUID, home, away uuid.UUID, homeName, awayName string, date time.Time, homeScore, awayScore *float64) domain.TournamentMatch {
+	status := domain.MatchScheduled
+	if homeScore != nil {
+		status = domain.MatchCompleted
+	}
+	return domain.TournamentMatch{
+		ID: uuid.New(), StageID: stageID, HomeTeamID: home, AwayTeamID: away,
+		HomeTeamName: homeName, AwayTeamName: awayName,
+		HomeScore: homeScore, AwayScore: awayScore, Status: status, Date: date,
+	}
+}
+
+func score(v float64) *float64 { return &v }
+
+func TestPublicTournamentService(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "test-club"
+	tournamentID := uuid.New()
+
+	groupStageID, knockoutStageID, groupID := uuid.New(), uuid.New(), uuid.New()
+	teamA, teamB, teamC, teamD := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	day := time.Date(2026, 11, 7, 15, 0, 0, 0, time.UTC)
+
+	tournament := &domain.Tournament{
+		ID: tournamentID, Name: "Apertura", Sport: "FUTBOL", Category: "Libre", Status: domain.TournamentActive,
+		Stages: []domain.TournamentStage{
+			{ID: knockoutStageID, Order: 2, Name: "Playoffs", Type: domain.StageKnockout},
+			{ID: groupStageID, Order: 1, Name: "Fase de Grupos", Type: domain.StageGroup, Groups: []domain.Group{{ID: groupID, Name: "Zona A"}}},
+		},
+	}
+
+	groupMatch := domain.TournamentMatch{
+		ID: uuid.New(), StageID: groupStageID, GroupID: &groupID, HomeTeamID: teamA, AwayTeamID: teamB,
+		HomeTeamName: "Leones", AwayTeamName: "Tigres", HomeScore: score(2), AwayScore: score(1),
+		Status: domain.MatchCompleted, Date: day.AddDate(0, 0, -14), Round: "1",
+	}
+	semi1 := knockoutMatch(knockoutStageID, teamA, teamB, "Leones", "Tigres", day, score(3), score(1))
+	semi2 := knockoutMatch(knockoutStageID, teamC, teamD, "Pumas", "Halcones", day.Add(2*time.Hour), score(0), score(2))
+
+	t.Run("Bracket With Pending Final", func(t *testing.T) {
+		repo, fixtures := new(MockChampionshipRepo), new(MockFixtureRepo)
+		svc := application.NewPublicTournamentService(repo, fixtures)
+		repo.On("GetTournament", ctx, clubID, tournamentID.String()).Return(tournament, nil)
+		fixtures.On("GetMatchesByTournament", ctx, clubID, tournamentID.String()).Return([]domain.TournamentMatch{groupMatch, semi2, semi1}, nil)
+
+		brackets, err := svc.GetBrackets(ctx, clubID, tournamentID.String())
+		assert.NoError(t, err)
+		assert.Len(t, brackets, 1)
+
+		rounds := brackets[0].Rounds
+		assert.Len(t, rounds, 2)
+		assert.Equal(t, "Semifinal", rounds[0].Name)
+		assert.Equal(t, "Final", rounds[1].Name)
+		assert.Equal(t, semi1.ID, *rounds[0].Matches[0].MatchID)
+		assert.True(t, rounds[0].Matches[0].Home.IsWinner)
+		assert.True(t, rounds[0].Matches[1].Away.IsWinner)
+
+		// La final aún no existe: muestra a los ganadores de cada semifinal
+		final := rounds[1].Matches[0]
+		assert.Nil(t, final.MatchID)
+		assert.Equal(t, "Leones", final.Home.TeamName)
+		assert.Equal(t, "Halcones", final.Away.TeamName)
+		assert.Nil(t, brackets[0].ChampionID)
+	})
+
+	t.Run("Bracket Champion From Final", func(t *testing.T) {
+		repo, fixtures := new(MockChampionshipRepo), new(MockFixtureRepo)
+		svc := application.NewPublicTournamentService(repo, fixtures)
+		// Final cargada sin ronda explícita: se ubica por el recorrido de los equipos
+		final := knockoutMatch(knockoutStageID, teamD, teamA, "Halcones", "Leones", day.AddDate(0, 0, 7), score(1), score(1))
+		semi1NoScore := semi1
+		semi1NoScore.HomeScore, semi1NoScore.AwayScore, semi1NoScore.Status = nil, nil, domain.MatchCompleted
+
+		repo.On("GetTournament", ctx, clubID, tournamentID.String()).Return(tournament, nil)
+		fixtures.On("GetMatchesByTournament", ctx, clubID, tournamentID.String()).Return([]domain.TournamentMatch{semi1NoScore, semi2, final}, nil)
+
+		brackets, err := svc.GetBrackets(ctx, clubID, tournamentID.String())
+		assert.NoError(t, err)
+		rounds := brackets[0].Rounds
+		assert.Equal(t, final.ID, *rounds[1].Matches[0].MatchID)
+		// Semifinal sin resultado: el ganador se deduce de quién jugó la final
+		assert.True(t, rounds[0].Matches[0].Home.IsWinner)
+		// Final empatada sin definición: no hay campeón
+		assert.Nil(t, brackets[0].ChampionID)
+
+		decided := final
+		decided.HomeScore, decided.AwayScore = score(2), score(1)
+		fixtures.ExpectedCalls = nil
+		fixtures.On("GetMatchesByTournament", ctx, clubID, tournamentID.String()).Return([]domain.TournamentMatch{semi1, semi2, decided}, nil)
+
+		brackets, err = svc.GetBrackets(ctx, clubID, tournamentID.String())
+		assert.NoError(t, err)
+		assert.Equal(t, teamD, *brackets[0].ChampionID)
+		assert.Equal(t, "Halcones", brackets[0].ChampionName)
+	})
+
+	t.Run("Fixture CSV", func(t *testing.T) {
+		repo, fixtures := new(MockChampionshipRepo), new(MockFixtureRepo)
+		svc := application.NewPublicTournamentService(repo, fixtures)
+		repo.On("GetTournament", ctx, clubID, tournamentID.String()).Return(tournament, nil)
+		fixtures.On("GetMatchesByTournament", ctx, clubID, tournamentID.String()).Return([]domain.TournamentMatch{semi1, groupMatch}, nil)
+
+		fixture, err := svc.GetFixture(ctx, clubID, tournamentID.String())
+		assert.NoError(t, err)
+
+		var buf bytes.Buffer
+		assert.NoError(t, svc.WriteFixtureCSV(fixture, &buf))
+		records, err := csv.NewReader(&buf).ReadAll()
+		assert.NoError(t, err)
+		assert.Len(t, records, 3)
+		assert.Equal(t, "Fase", records[0][0])
+		// La fase de grupos va primero aunque se haya cargado después
+		assert.Equal(t, []string{"Fase de Grupos", "Zona A", "1", "24/10/2026", "15:00", "Leones", "Tigres", "2", "1", "COMPLETED", ""}, records[1])
+		assert.Equal(t, "Playoffs", records[2][0])
+
+		var pdf bytes.Buffer
+		assert.NoError(t, svc.WriteFixturePDF(fixture, &pdf))
+		assert.True(t, bytes.HasPrefix(pdf.Bytes(), []byte("%PDF")))
+	})
+
+	t.Run("Widget", func(t *testing.T) {
+		repo, fixtures := new(MockChampionshipRepo), new(MockFixtureRepo)
+		svc := application.NewPublicTournamentService(repo, fixtures)
+		upcoming := knockoutMatch(knockoutStageID, teamA, teamD, "Leones", "Halcones", day.AddDate(0, 0, 7), nil, nil)
+		repo.On("GetTournament", ctx, clubID, tournamentID.String()).Return(tournament, nil)
+		repo.On("GetStandings", ctx, clubID, groupID.String()).Return([]domain.Standing{{TeamID: teamA, TeamName: "Leones", Points: 3}}, nil)
+		fixtures.On("GetMatchesByTournament", ctx, clubID, tournamentID.String()).Return([]domain.TournamentMatch{groupMatch, semi1, semi2, upcoming}, nil)
+
+		widget, err := svc.GetWidget(ctx, clubID, tournamentID.String(), 2)
+		assert.NoError(t, err)
+		assert.Len(t, widget.Standings, 1)
+		assert.Equal(t, "Zona A", widget.Standings[0].GroupName)
+		assert.Len(t, widget.Upcoming, 1)
+		assert.Equal(t, upcoming.ID, widget.Upcoming[0].MatchID)
+		// Resultados del más reciente al más antiguo, recortados al límite
+		assert.Len(t, widget.Results, 2)
+		assert.Equal(t, semi2.ID, widget.Results[0].MatchID)
+		assert.Equal(t, semi1.ID, widget.Results[1].MatchID)
+		assert.Empty(t, widget.Champion)
+	})
+
+	t.Run("Invalid Tournament", func(t *testing.T) {
+		repo, fixtures := new(MockChampionshipRepo), new(MockFixtureRepo)
+		svc := application.NewPublicTournamentService(repo, fixtures)
+		_, err := svc.GetFixture(ctx, clubID, "not-a-uuid")
+		assert.Error(t, err)
+
+		repo.On("GetTournament", ctx, clubID, tournamentID.String()).Return(nil, nil)
+		_, err = svc.GetBrackets(ctx, clubID, tournamentID.String())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,373 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+)
+
+// maxRescheduleSlots limita las opciones de horario de una misma propuesta
+const maxRescheduleSlots = 5
+
+// RescheduleBookingService reserva y libera canchas en el módulo Booking
+type RescheduleBookingService interface {
+	CreateSystemBooking(clubID, courtID string, startTime, endTime time.Time, notes string) (*uuid.UUID, error)
+	CancelSystemBooking(clubID string, bookingID uuid.UUID) error
+}
+
+// MatchRescheduleService gestiona las reprogramaciones y postergaciones de partidos.
+// Al aceptar un horario se reserva la cancha nueva, se mueve el partido y se libera la reserva anterior;
+// si algún paso falla se deshacen los anteriores para no dejar reservas huérfanas.
+type MatchRescheduleService struct {
+	repo          domain.ChampionshipRepository
+	reschedules   domain.MatchRescheduleRepository
+	volunteerRepo domain.VolunteerRepository
+	bookings      RescheduleBookingService
+	notifier      notificationSvc.NotificationSender
+}
+
+// NewMatchRescheduleService crea una nueva instancia del servicio
+func NewMatchRescheduleService(
+	repo domain.ChampionshipRepository,
+	reschedules domain.MatchRescheduleRepository,
+	volunteerRepo domain.VolunteerRepository,
+	bookings RescheduleBookingService,
+	notifier notificationSvc.NotificationSender,
+) *MatchRescheduleService {
+	return &MatchRescheduleService{
+		repo:          repo,
+		reschedules:   reschedules,
+		volunteerRepo: volunteerRepo,
+		bookings:      bookings,
+		notifier:      notifier,
+	}
+}
+
+// RequestRescheduleInput contiene la propuesta de un capitán
+type RequestRescheduleInput struct {
+	ClubID   string                  `json:"-"`
+	MatchID  string                  `json:"-"`
+	UserID   string                  `json:"-"`
+	Reason   string                  `json:"reason"`
+	Slots    []domain.RescheduleSlot `json:"slots" binding:"required,min=1,dive"`
+	Postpone bool                    `json:"postpone"` // Libera ya la cancha: el partido no se juega en la fecha original
+}
+
+// RequestReschedule registra la propuesta de nuevos horarios de uno de los capitanes
+func (s *MatchRescheduleService) RequestReschedule(ctx context.Context, input RequestRescheduleInput) (*domain.MatchRescheduleRequest, error) {
+	if len(input.Slots) == 0 || len(input.Slots) > maxRescheduleSlots {
+		return nil, fmt.Errorf("se deben proponer entre 1 y %d horarios", maxRescheduleSlots)
+	}
+	now := time.Now()
+	for _, slot := range input.Slots {
+		if !slot.Date.After(now) {
+			return nil, errors.New("los horarios propuestos deben ser futuros")
+		}
+	}
+
+	match, err := s.getReschedulableMatch(ctx, input.ClubID, input.MatchID)
+	if err != nil {
+		return nil, err
+	}
+	requestingTeamID, respondingTeamID, err := s.captainTeams(ctx, match, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &domain.MatchRescheduleRequest{
+		ID:                uuid.New(),
+		ClubID:            input.ClubID,
+		MatchID:           match.ID,
+		RequestingTeamID:  requestingTeamID,
+		RespondingTeamID:  respondingTeamID,
+		RequestedBy:       input.UserID,
+		Reason:            input.Reason,
+		ProposedSlots:     input.Slots,
+		Status:            domain.ReschedulePending,
+		PreviousDate:      match.Date,
+		PreviousBookingID: match.BookingID,
+	}
+
+	// La propuesta y la postergación se guardan juntas; la reserva se libera después y, si falla, se deshace todo
+	postpone := input.Postpone && match.Status == domain.MatchScheduled
+	if err := s.reschedules.CreateRescheduleRequest(ctx, request, postpone); err != nil {
+		return nil, err
+	}
+	if postpone {
+		if match.BookingID != nil {
+			if err := s.bookings.CancelSystemBooking(input.ClubID, *match.BookingID); err != nil {
+				previous := *match
+				request.Status = domain.RescheduleWithdrawn
+				if revertErr := s.reschedules.RevertReschedule(ctx, request, &previous); revertErr != nil {
+					log.Printf("[Reschedule] could not revert postponement of match %s: %v", match.ID, revertErr)
+				}
+				return nil, errors.New("no se pudo liberar la reserva: " + err.Error())
+			}
+		}
+		match.Status = domain.MatchPostponed
+		match.BookingID = nil
+		s.notifyMatch(ctx, input.ClubID, match, "Partido postergado", fmt.Sprintf("El partido del %s fue postergado. La nueva fecha se confirmará a la brevedad.", match.Date.Format("02/01 15:04")))
+	}
+
+	if captain := s.teamCaptain(ctx, respondingTeamID); captain != "" {
+		s.send(ctx, captain, "Propuesta de reprogramación", fmt.Sprintf("El equipo rival propone %d horario(s) nuevo(s) para el partido del %s.", len(input.Slots), match.Date.Format("02/01 15:04")))
+	}
+	return request, nil
+}
+
+// RespondRescheduleInput contiene la respuesta del capitán rival (o de un administrador)
+type RespondRescheduleInput struct {
+	ClubID      string `json:"-"`
+	RequestID   string `json:"-"`
+	UserID      string `json:"-"`
+	IsOrganizer bool   `json:"-"`
+	Accept      bool   `json:"accept"`
+	SlotIndex   int    `json:"slot_index"`
+	Note        string `json:"note"`
+}
+
+// RespondReschedule acepta uno de los horarios propuestos o rechaza la propuesta
+func (s *MatchRescheduleService) RespondReschedule(ctx context.Context, input RespondRescheduleInput) (*domain.MatchRescheduleRequest, error) {
+	request, err := s.getPendingRequest(ctx, input.ClubID, input.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	if !input.IsOrganizer && s.teamCaptain(ctx, request.RespondingTeamID) != input.UserID {
+		return nil, errors.New("solo el capitán del equipo rival puede responder la propuesta")
+	}
+	match, err := s.getReschedulableMatch(ctx, input.ClubID, request.MatchID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	request.RespondedBy = &input.UserID
+	request.RespondedAt = &now
+	request.ResponseNote = input.Note
+
+	if !input.Accept {
+		request.Status = domain.RescheduleRejected
+		if err := s.reschedules.UpdateRescheduleRequest(ctx, request); err != nil {
+			return nil, err
+		}
+		s.send(ctx, request.RequestedBy, "Reprogramación rechazada", "El equipo rival rechazó los horarios propuestos para el partido.")
+		return request, nil
+	}
+
+	if input.SlotIndex < 0 || input.SlotIndex >= len(request.ProposedSlots) {
+		return nil, errors.New("horario propuesto inválido")
+	}
+	slot := request.ProposedSlots[input.SlotIndex]
+	if !slot.Date.After(now) {
+		return nil, errors.New("el horario elegido ya pasó")
+	}
+
+	// 1. Reservar la cancha nueva
+	var newBookingID *uuid.UUID
+	if slot.CourtID != "" {
+		tournament, err := s.repo.GetTournament(ctx, input.ClubID, match.TournamentID.String())
+		if err != nil || tournament == nil {
+			return nil, errors.New("torneo no encontrado")
+		}
+		start, end := domain.ParseOfficialSettings(tournament.Settings).MatchWindow(slot.Date)
+		newBookingID, err = s.bookings.CreateSystemBooking(input.ClubID, slot.CourtID, start, end, "Championship Match (rescheduled)")
+		if err != nil {
+			return nil, errors.New("failed to book court: " + err.Error())
+		}
+	}
+
+	// 2. Mover el partido y cerrar la solicitud
+	previous := *match
+	request.Status = domain.RescheduleAccepted
+	request.AcceptedSlot = &input.SlotIndex
+	request.NewBookingID = newBookingID
+	if err := s.reschedules.ApplyReschedule(ctx, request, slot, newBookingID); err != nil {
+		s.releaseBooking(input.ClubID, newBookingID)
+		return nil, err
+	}
+
+	// 3. Liberar la reserva anterior; si no se puede, se vuelve todo atrás
+	if previous.BookingID != nil {
+		if err := s.bookings.CancelSystemBooking(input.ClubID, *previous.BookingID); err != nil {
+			request.Status = domain.ReschedulePending
+			request.AcceptedSlot, request.NewBookingID = nil, nil
+			request.RespondedBy, request.RespondedAt, request.ResponseNote = nil, nil, ""
+			if revertErr := s.reschedules.RevertReschedule(ctx, request, &previous); revertErr != nil {
+				log.Printf("[Reschedule] could not revert match %s after booking release failure: %v", match.ID, revertErr)
+			}
+			s.releaseBooking(input.ClubID, newBookingID)
+			return nil, errors.New("no se pudo liberar la reserva anterior: " + err.Error())
+		}
+	}
+
+	s.notifyMatch(ctx, input.ClubID, match, "Partido reprogramado", fmt.Sprintf("El partido se jugará el %s.", slot.Date.Format("02/01 15:04")))
+	return request, nil
+}
+
+// WithdrawReschedule retira una propuesta pendiente (solo quien la hizo)
+func (s *MatchRescheduleService) WithdrawReschedule(ctx context.Context, clubID, requestID, userID string) (*domain.MatchRescheduleRequest, error) {
+	request, err := s.getPendingRequest(ctx, clubID, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request.RequestedBy != userID {
+		return nil, errors.New("solo quien propuso la reprogramación puede retirarla")
+	}
+	request.Status = domain.RescheduleWithdrawn
+	if err := s.reschedules.UpdateRescheduleRequest(ctx, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// ListRescheduleRequests obtiene el historial de reprogramaciones del partido
+func (s *MatchRescheduleService) ListRescheduleRequests(ctx context.Context, clubID, matchID string) ([]domain.MatchRescheduleRequest, error) {
+	id, err := uuid.Parse(matchID)
+	if err != nil {
+		return nil, errors.New("ID de partido inválido")
+	}
+	return s.reschedules.ListRescheduleRequests(ctx, clubID, id)
+}
+
+// PostponeMatch posterga un partido sin nueva fecha (decisión del organizador, ej. por lluvia)
+func (s *MatchRescheduleService) PostponeMatch(ctx context.Context, clubID, matchID, reason string) (*domain.TournamentMatch, error) {
+	match, err := s.repo.GetMatch(ctx, clubID, matchID)
+	if err != nil || match == nil {
+		return nil, errors.New("partido no encontrado")
+	}
+	if match.Status != domain.MatchScheduled {
+		return nil, errors.New("solo se pueden postergar partidos programados")
+	}
+	if err := s.postpone(ctx, clubID, match); err != nil {
+		return nil, err
+	}
+
+	body := fmt.Sprintf("El partido del %s fue postergado.", match.Date.Format("02/01 15:04"))
+	if reason != "" {
+		body += " Motivo: " + reason + "."
+	}
+	s.notifyMatch(ctx, clubID, match, "Partido postergado", body)
+	return match, nil
+}
+
+func (s *MatchRescheduleService) postpone(ctx context.Context, clubID string, match *domain.TournamentMatch) error {
+	if match.BookingID != nil {
+		if err := s.bookings.CancelSystemBooking(clubID, *match.BookingID); err != nil {
+			return errors.New("no se pudo liberar la reserva: " + err.Error())
+		}
+	}
+	if err := s.reschedules.PostponeMatch(ctx, clubID, match.ID); err != nil {
+		return err
+	}
+	match.Status = domain.MatchPostponed
+	match.BookingID = nil
+	return nil
+}
+
+func (s *MatchRescheduleService) getReschedulableMatch(ctx context.Context, clubID, matchID string) (*domain.TournamentMatch, error) {
+	match, err := s.repo.GetMatch(ctx, clubID, matchID)
+	if err != nil || match == nil {
+		return nil, errors.New("partido no encontrado")
+	}
+	if !match.CanBeRescheduled() {
+		return nil, errors.New("el partido ya se jugó o fue cancelado")
+	}
+	return match, nil
+}
+
+func (s *MatchRescheduleService) getPendingRequest(ctx context.Context, clubID, requestID string) (*domain.MatchRescheduleRequest, error) {
+	id, err := uuid.Parse(requestID)
+	if err != nil {
+		return nil, errors.New("ID de solicitud inválido")
+	}
+	request, err := s.reschedules.GetRescheduleRequest(ctx, clubID, id)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, errors.New("solicitud no encontrada")
+	}
+	if request.Status != domain.ReschedulePending {
+		return nil, errors.New("la solicitud ya fue respondida")
+	}
+	return request, nil
+}
+
+// captainTeams devuelve el equipo del capitán que propone y el equipo rival
+func (s *MatchRescheduleService) captainTeams(ctx context.Context, match *domain.TournamentMatch, userID string) (uuid.UUID, uuid.UUID, error) {
+	switch userID {
+	case s.teamCaptain(ctx, match.HomeTeamID):
+		return match.HomeTeamID, match.AwayTeamID, nil
+	case s.teamCaptain(ctx, match.AwayTeamID):
+		return match.AwayTeamID, match.HomeTeamID, nil
+	}
+	return uuid.Nil, uuid.Nil, errors.New("solo los capitanes de los equipos pueden proponer una reprogramación")
+}
+
+func (s *MatchRescheduleService) teamCaptain(ctx context.Context, teamID uuid.UUID) string {
+	team, err := s.reschedules.GetTeam(ctx, teamID)
+	if err != nil || team == nil || team.CaptainID == nil {
+		return ""
+	}
+	return *team.CaptainID
+}
+
+func (s *MatchRescheduleService) releaseBooking(clubID string, bookingID *uuid.UUID) {
+	if bookingID == nil {
+		return
+	}
+	if err := s.bookings.CancelSystemBooking(clubID, *bookingID); err != nil {
+		log.Printf("[Reschedule] could not release booking %s: %v", bookingID, err)
+	}
+}
+
+// notifyMatch avisa a ambos planteles y a los oficiales y voluntarios asignados al partido
+func (s *MatchRescheduleService) notifyMatch(ctx context.Context, clubID string, match *domain.TournamentMatch, title, body string) {
+	recipients := make(map[string]bool)
+	for _, teamID := range []uuid.UUID{match.HomeTeamID, match.AwayTeamID} {
+		if captain := s.teamCaptain(ctx, teamID); captain != "" {
+			recipients[captain] = true
+		}
+		members, err := s.repo.GetTeamMembers(ctx, teamID.String())
+		if err != nil {
+			continue
+		}
+		for _, member := range members {
+			recipients[member] = true
+		}
+	}
+	if officials, err := s.reschedules.GetMatchOfficialUserIDs(ctx, clubID, match.ID); err == nil {
+		for _, userID := range officials {
+			recipients[userID] = true
+		}
+	}
+	if s.volunteerRepo != nil {
+		if assignments, err := s.volunteerRepo.GetByMatchID(ctx, clubID, match.ID); err == nil {
+			for _, a := range assignments {
+				recipients[a.UserID] = true
+			}
+		}
+	}
+
+	for userID := range recipients {
+		s.send(ctx, userID, title, body)
+	}
+}
+
+func (s *MatchRescheduleService) send(ctx context.Context, userID, title, body string) {
+	if s.notifier == nil || userID == "" {
+		return
+	}
+	_ = s.notifier.Send(ctx, notificationSvc.Notification{
+		RecipientID: userID,
+		Type:        notificationSvc.NotificationTypePush,
+		Title:       title,
+		Body:        body,
+	})
+}
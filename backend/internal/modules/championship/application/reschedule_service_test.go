@@ -0,0 +1,288 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRescheduleRepo struct {
+	mock.Mock
+}
+
+func (m *MockRescheduleRepo) CreateRescheduleRequest(ctx context.Context, r *domain.MatchRescheduleRequest, postpone bool) error {
+	return m.Called(ctx, r, postpone).Error(0)
+}
+func (m *MockRescheduleRepo) UpdateRescheduleRequest(ctx context.Context, r *domain.MatchRescheduleRequest) error {
+	return m.Called(ctx, r).Error(0)
+}
+func (m *MockRescheduleRepo) GetRescheduleRequest(ctx context.Context, clubID string, id uuid.UUID) (*domain.MatchRescheduleRequest, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MatchRescheduleRequest), args.Error(1)
+}
+func (m *MockRescheduleRepo) GetPendingRescheduleRequest(ctx context.Context, clubID string, matchID uuid.UUID) (*domain.MatchRescheduleRequest, error) {
+	args := m.Called(ctx, clubID, matchID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MatchRescheduleRequest), args.Error(1)
+}
+func (m *MockRescheduleRepo) ListRescheduleRequests(ctx context.Context, clubID string, matchID uuid.UUID) ([]domain.MatchRescheduleRequest, error) {
+	args := m.Called(ctx, clubID, matchID)
+	return args.Get(0).([]domain.MatchRescheduleRequest), args.Error(1)
+}
+func (m *MockRescheduleRepo) GetTeam(ctx context.Context, teamID uuid.UUID) (*domain.Team, error) {
+	args := m.Called(ctx, teamID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Team), args.Error(1)
+}
+func (m *MockRescheduleRepo) GetMatchOfficialUserIDs(ctx context.Context, clubID string, matchID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, clubID, matchID)
+	return args.Get(0).([]string), args.Error(1)
+}
+func (m *MockRescheduleRepo) PostponeMatch(ctx context.Context, clubID string, matchID uuid.UUID) error {
+	return m.Called(ctx, clubID, matchID).Error(0)
+}
+func (m *MockRescheduleRepo) ApplyReschedule(ctx context.Context, r *domain.MatchRescheduleRequest, slot domain.RescheduleSlot, bookingID *uuid.UUID) error {
+	return m.Called(ctx, r, slot, bookingID).Error(0)
+}
+func (m *MockRescheduleRepo) RevertReschedule(ctx context.Context, r *domain.MatchRescheduleRequest, previous *domain.TournamentMatch) error {
+	return m.Called(ctx, r, previous).Error(0)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) Send(ctx context.Context, n notificationSvc.Notification) error {
+	return m.Called(ctx, n).Error(0)
+}
+
+func TestMatchRescheduleService(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	homeID, awayID := uuid.New(), uuid.New()
+	homeCaptain, awayCaptain := "captain-home", "captain-away"
+	oldBooking, newBooking := uuid.New(), uuid.New()
+	tournament := &domain.Tournament{ID: uuid.New()}
+	slotDate := time.Now().Add(72 * time.Hour).Truncate(time.Minute)
+
+	newMatch := func() *domain.TournamentMatch {
+		booking := oldBooking
+		return &domain.TournamentMatch{
+			ID: uuid.New(), TournamentID: tournament.ID, HomeTeamID: homeID, AwayTeamID: awayID,
+			Status: domain.MatchScheduled, Date: time.Now().Add(24 * time.Hour), BookingID: &booking,
+		}
+	}
+	setup := func() (*MockChampionshipRepo, *MockRescheduleRepo, *MockVolunteerRepo, *MockBookingService, *MockNotifier, *application.MatchRescheduleService) {
+		repo, reschedules, volunteers := new(MockChampionshipRepo), new(MockRescheduleRepo), new(MockVolunteerRepo)
+		bookings, notifier := new(MockBookingService), new(MockNotifier)
+		reschedules.On("GetTeam", ctx, homeID).Return(&domain.Team{ID: homeID, CaptainID: &homeCaptain}, nil).Maybe()
+		reschedules.On("GetTeam", ctx, awayID).Return(&domain.Team{ID: awayID, CaptainID: &awayCaptain}, nil).Maybe()
+		notifier.On("Send", ctx, mock.Anything).Return(nil)
+		return repo, reschedules, volunteers, bookings, notifier, application.NewMatchRescheduleService(repo, reschedules, volunteers, bookings, notifier)
+	}
+	pendingRequest := func(match *domain.TournamentMatch) *domain.MatchRescheduleRequest {
+		return &domain.MatchRescheduleRequest{
+			ID: uuid.New(), ClubID: clubID, MatchID: match.ID, RequestingTeamID: homeID, RespondingTeamID: awayID,
+			RequestedBy: homeCaptain, Status: domain.ReschedulePending,
+			ProposedSlots: []domain.RescheduleSlot{{Date: slotDate, CourtID: "court-2"}},
+		}
+	}
+
+	t.Run("Captain Proposes Slots", func(t *testing.T) {
+		repo, reschedules, _, _, notifier, svc := setup()
+		match := newMatch()
+		repo.On("GetMatch", ctx, clubID, match.ID.String()).Return(match, nil)
+		reschedules.On("CreateRescheduleRequest", ctx, mock.Anything, false).Return(nil)
+
+		request, err := svc.RequestReschedule(ctx, application.RequestRescheduleInput{
+			ClubID: clubID, MatchID: match.ID.String(), UserID: awayCaptain,
+			Slots: []domain.RescheduleSlot{{Date: slotDate}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, awayID, request.RequestingTeamID)
+		assert.Equal(t, homeID, request.RespondingTeamID)
+		assert.Equal(t, oldBooking, *request.PreviousBookingID)
+		// Solo se avisa al capitán rival
+		notifier.AssertCalled(t, "Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool { return n.RecipientID == homeCaptain }))
+		notifier.AssertNumberOfCalls(t, "Send", 1)
+	})
+
+	t.Run("Only Captains Can Propose", func(t *testing.T) {
+		repo, _, _, _, _, svc := setup()
+		match := newMatch()
+		repo.On("GetMatch", ctx, clubID, match.ID.String()).Return(match, nil)
+
+		_, err := svc.RequestReschedule(ctx, application.RequestRescheduleInput{
+			ClubID: clubID, MatchID: match.ID.String(), UserID: "player",
+			Slots: []domain.RescheduleSlot{{Date: slotDate}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Completed Match Cannot Be Rescheduled", func(t *testing.T) {
+		repo, _, _, _, _, svc := setup()
+		match := newMatch()
+		match.Status = domain.MatchCompleted
+		repo.On("GetMatch", ctx, clubID, match.ID.String()).Return(match, nil)
+
+		_, err := svc.RequestReschedule(ctx, application.RequestRescheduleInput{
+			ClubID: clubID, MatchID: match.ID.String(), UserID: homeCaptain,
+			Slots: []domain.RescheduleSlot{{Date: slotDate}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Propose And Postpone Releases Court", func(t *testing.T) {
+		repo, reschedules, volunteers, bookings, notifier, svc := setup()
+		match := newMatch()
+		repo.On("GetMatch", ctx, clubID, match.ID.String()).Return(match, nil)
+		repo.On("GetTeamMembers", ctx, mock.Anything).Return([]string{}, nil)
+		volunteers.On("GetByMatchID", ctx, clubID, match.ID).Return([]domain.VolunteerAssignment{{UserID: "volunteer-1"}}, nil)
+		reschedules.On("GetMatchOfficialUserIDs", ctx, clubID, match.ID).Return([]string{"referee-1"}, nil)
+		reschedules.On("CreateRescheduleRequest", ctx, mock.Anything, true).Return(nil)
+		bookings.On("CancelSystemBooking", clubID, oldBooking).Return(nil)
+
+		request, err := svc.RequestReschedule(ctx, application.RequestRescheduleInput{
+			ClubID: clubID, MatchID: match.ID.String(), UserID: homeCaptain, Postpone: true,
+			Slots: []domain.RescheduleSlot{{Date: slotDate}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, oldBooking, *request.PreviousBookingID)
+		assert.Equal(t, domain.MatchPostponed, match.Status)
+		bookings.AssertExpectations(t)
+		reschedules.AssertNotCalled(t, "PostponeMatch", mock.Anything, mock.Anything, mock.Anything)
+		notifier.AssertCalled(t, "Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool { return n.RecipientID == "volunteer-1" }))
+		notifier.AssertCalled(t, "Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool { return n.RecipientID == "referee-1" }))
+	})
+
+	t.Run("Postpone Is Undone When Court Cannot Be Released", func(t *testing.T) {
+		repo, reschedules, _, bookings, notifier, svc := setup()
+		match := newMatch()
+		repo.On("GetMatch", ctx, clubID, match.ID.String()).Return(match, nil)
+		reschedules.On("CreateRescheduleRequest", ctx, mock.Anything, true).Return(nil)
+		bookings.On("CancelSystemBooking", clubID, oldBooking).Return(errors.New("booking locked"))
+		reschedules.On("RevertReschedule", ctx, mock.MatchedBy(func(r *domain.MatchRescheduleRequest) bool {
+			return r.Status == domain.RescheduleWithdrawn
+		}), mock.MatchedBy(func(m *domain.TournamentMatch) bool {
+			return *m.BookingID == oldBooking && m.Status == domain.MatchScheduled
+		})).Return(nil)
+
+		_, err := svc.RequestReschedule(ctx, application.RequestRescheduleInput{
+			ClubID: clubID, MatchID: match.ID.String(), UserID: homeCaptain, Postpone: true,
+			Slots: []domain.RescheduleSlot{{Date: slotDate}},
+		})
+		assert.Error(t, err)
+		reschedules.AssertExpectations(t)
+		notifier.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Second Open Proposal Is Rejected", func(t *testing.T) {
+		repo, reschedules, _, _, notifier, svc := setup()
+		match := newMatch()
+		repo.On("GetMatch", ctx, clubID, match.ID.String()).Return(match, nil)
+		reschedules.On("CreateRescheduleRequest", ctx, mock.Anything, false).Return(domain.ErrReschedulePending)
+
+		_, err := svc.RequestReschedule(ctx, application.RequestRescheduleInput{
+			ClubID: clubID, MatchID: match.ID.String(), UserID: awayCaptain,
+			Slots: []domain.RescheduleSlot{{Date: slotDate}},
+		})
+		assert.ErrorIs(t, err, domain.ErrReschedulePending)
+		notifier.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Accept Swaps Bookings And Notifies", func(t *testing.T) {
+		repo, reschedules, volunteers, bookings, notifier, svc := setup()
+		match := newMatch()
+		request := pendingRequest(match)
+		repo.On("GetMatch", ctx, clubID, match.ID.String()).Return(match, nil)
+		repo.On("GetTournament", ctx, clubID, tournament.ID.String()).Return(tournament, nil)
+		repo.On("GetTeamMembers", ctx, homeID.String()).Return([]string{"player-1", homeCaptain}, nil)
+		repo.On("GetTeamMembers", ctx, awayID.String()).Return([]string{"player-2"}, nil)
+		volunteers.On("GetByMatchID", ctx, clubID, match.ID).Return([]domain.VolunteerAssignment{{UserID: "volunteer-1"}}, nil)
+		reschedules.On("GetMatchOfficialUserIDs", ctx, clubID, match.ID).Return([]string{"referee-1"}, nil)
+		reschedules.On("GetRescheduleRequest", ctx, clubID, request.ID).Return(request, nil)
+		bookings.On("CreateSystemBooking", clubID, "court-2", slotDate, slotDate.Add(90*time.Minute), mock.Anything).Return(newBooking, nil)
+		reschedules.On("ApplyReschedule", ctx, request, request.ProposedSlots[0], mock.Anything).Return(nil)
+		bookings.On("CancelSystemBooking", clubID, oldBooking).Return(nil)
+
+		result, err := svc.RespondReschedule(ctx, application.RespondRescheduleInput{
+			ClubID: clubID, RequestID: request.ID.String(), UserID: awayCaptain, Accept: true, SlotIndex: 0,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.RescheduleAccepted, result.Status)
+		assert.Equal(t, newBooking, *result.NewBookingID)
+		bookings.AssertExpectations(t)
+		// Ambos planteles (capitanes incluidos), el árbitro y el voluntario, sin duplicados
+		notifier.AssertNumberOfCalls(t, "Send", 6)
+	})
+
+	t.Run("Accept Rolls Back When Old Booking Cannot Be Released", func(t *testing.T) {
+		repo, reschedules, _, bookings, notifier, svc := setup()
+		match := newMatch()
+		request := pendingRequest(match)
+		repo.On("GetMatch", ctx, clubID, match.ID.String()).Return(match, nil)
+		repo.On("GetTournament", ctx, clubID, tournament.ID.String()).Return(tournament, nil)
+		reschedules.On("GetRescheduleRequest", ctx, clubID, request.ID).Return(request, nil)
+		bookings.On("CreateSystemBooking", clubID, "court-2", mock.Anything, mock.Anything, mock.Anything).Return(newBooking, nil)
+		reschedules.On("ApplyReschedule", ctx, request, mock.Anything, mock.Anything).Return(nil)
+		bookings.On("CancelSystemBooking", clubID, oldBooking).Return(errors.New("booking locked"))
+		reschedules.On("RevertReschedule", ctx, request, mock.MatchedBy(func(m *domain.TournamentMatch) bool {
+			return *m.BookingID == oldBooking && m.Status == domain.MatchScheduled
+		})).Return(nil)
+		bookings.On("CancelSystemBooking", clubID, newBooking).Return(nil)
+
+		_, err := svc.RespondReschedule(ctx, application.RespondRescheduleInput{
+			ClubID: clubID, RequestID: request.ID.String(), UserID: awayCaptain, Accept: true,
+		})
+		assert.Error(t, err)
+		assert.Equal(t, domain.ReschedulePending, request.Status)
+		reschedules.AssertExpectations(t)
+		bookings.AssertExpectations(t)
+		notifier.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Requester Cannot Accept Own Proposal", func(t *testing.T) {
+		_, reschedules, _, _, _, svc := setup()
+		match := newMatch()
+		request := pendingRequest(match)
+		reschedules.On("GetRescheduleRequest", ctx, clubID, request.ID).Return(request, nil)
+
+		_, err := svc.RespondReschedule(ctx, application.RespondRescheduleInput{
+			ClubID: clubID, RequestID: request.ID.String(), UserID: homeCaptain, Accept: true,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Reject Notifies Requester", func(t *testing.T) {
+		repo, reschedules, _, bookings, notifier, svc := setup()
+		match := newMatch()
+		request := pendingRequest(match)
+		repo.On("GetMatch", ctx, clubID, match.ID.String()).Return(match, nil)
+		reschedules.On("GetRescheduleRequest", ctx, clubID, request.ID).Return(request, nil)
+		reschedules.On("UpdateRescheduleRequest", ctx, request).Return(nil)
+
+		// El organizador puede responder en nombre del rival
+		result, err := svc.RespondReschedule(ctx, application.RespondRescheduleInput{
+			ClubID: clubID, RequestID: request.ID.String(), UserID: "admin", IsOrganizer: true, Accept: false, Note: "Sin canchas",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.RescheduleRejected, result.Status)
+		bookings.AssertNotCalled(t, "CancelSystemBooking", mock.Anything, mock.Anything)
+		notifier.AssertCalled(t, "Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool { return n.RecipientID == homeCaptain }))
+	})
+}
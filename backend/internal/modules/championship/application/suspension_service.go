@@ -0,0 +1,461 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+)
+
+// ErrPlayerNotInTournament indica que el jugador no juega en ningún equipo del torneo
+var ErrPlayerNotInTournament = errors.New("el jugador no participa del torneo")
+
+// PlayerEligibilityChecker abstrae las verificaciones de documentación (DNI, apto médico)
+// y estado del jugador (deuda) que viven en los módulos User y Team.
+// El torneo define la disciplina y categoría cuya regla de elegibilidad se aplica.
+type PlayerEligibilityChecker interface {
+	GetPlayerIssues(ctx context.Context, clubID, userID string, tournament *domain.Tournament) ([]string, error)
+}
+
+// SuspensionService aplica el reglamento disciplinario del torneo y valida la habilitación de jugadores
+type SuspensionService struct {
+	repo           domain.ChampionshipRepository
+	eventRepo      domain.MatchEventRepository
+	suspensionRepo domain.SuspensionRepository
+	eligibility    PlayerEligibilityChecker
+}
+
+// NewSuspensionService crea una nueva instancia del servicio
+func NewSuspensionService(
+	repo domain.ChampionshipRepository,
+	eventRepo domain.MatchEventRepository,
+	suspensionRepo domain.SuspensionRepository,
+	eligibility PlayerEligibilityChecker,
+) *SuspensionService {
+	return &SuspensionService{
+		repo:           repo,
+		eventRepo:      eventRepo,
+		suspensionRepo: suspensionRepo,
+		eligibility:    eligibility,
+	}
+}
+
+// OnMatchEventRecorded genera la suspensión automática que corresponda a una tarjeta
+func (s *SuspensionService) OnMatchEventRecorded(ctx context.Context, event *domain.MatchEvent) error {
+	if event.Type != domain.MatchEventYellowCard && event.Type != domain.MatchEventRedCard {
+		return nil
+	}
+
+	tournament, err := s.repo.GetTournament(ctx, event.ClubID, event.TournamentID.String())
+	if err != nil {
+		return err
+	}
+	rules := domain.ParseDisciplinaryRules(tournament.Settings)
+
+	// Una expulsión por partido: si ya existe sanción por roja o doble amarilla no se duplica
+	existing, err := s.suspensionRepo.GetBySourceMatch(ctx, event.ClubID, event.MatchID)
+	if err != nil {
+		return err
+	}
+	for _, susp := range existing {
+		if susp.UserID == event.UserID && susp.RevokedAt == nil && (susp.Reason == domain.SuspensionRedCard || susp.Reason == domain.SuspensionDoubleYellow) {
+			return nil
+		}
+	}
+
+	if event.Type == domain.MatchEventRedCard {
+		return s.createAutomatic(ctx, event, domain.SuspensionRedCard, rules.RedCardSuspensionMatches)
+	}
+
+	matchEvents, err := s.eventRepo.GetByMatchID(ctx, event.ClubID, event.MatchID)
+	if err != nil {
+		return err
+	}
+	if countCards(matchEvents, event.UserID, domain.MatchEventYellowCard) >= 2 {
+		return s.createAutomatic(ctx, event, domain.SuspensionDoubleYellow, rules.RedCardSuspensionMatches)
+	}
+
+	tournamentEvents, err := s.eventRepo.GetByTournamentID(ctx, event.ClubID, event.TournamentID)
+	if err != nil {
+		return err
+	}
+	yellows := countCards(tournamentEvents, event.UserID, domain.MatchEventYellowCard)
+	if yellows > 0 && yellows%rules.YellowCardThreshold == 0 {
+		return s.createAutomatic(ctx, event, domain.SuspensionYellowAccumulation, rules.YellowSuspensionMatches)
+	}
+	return nil
+}
+
+// OnMatchEventDeleted anula las suspensiones automáticas que generó una tarjeta eliminada por error
+func (s *SuspensionService) OnMatchEventDeleted(ctx context.Context, event *domain.MatchEvent) error {
+	if event.Type != domain.MatchEventYellowCard && event.Type != domain.MatchEventRedCard {
+		return nil
+	}
+
+	suspensions, err := s.suspensionRepo.GetBySourceMatch(ctx, event.ClubID, event.MatchID)
+	if err != nil {
+		return err
+	}
+	for i := range suspensions {
+		susp := &suspensions[i]
+		if !susp.IsTriggeredBy(event) || !susp.Revoke(time.Now()) {
+			continue
+		}
+		if err := s.suspensionRepo.Update(ctx, susp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnMatchCompleted descuenta una fecha a las sanciones vigentes de los equipos que jugaron el partido.
+// Solo cuentan los partidos jugados después del que originó la sanción (o de su carga, si es manual).
+func (s *SuspensionService) OnMatchCompleted(ctx context.Context, clubID string, match *domain.TournamentMatch) error {
+	suspensions, err := s.suspensionRepo.GetByTournament(ctx, clubID, match.TournamentID)
+	if err != nil {
+		return err
+	}
+
+	sourceDates := map[uuid.UUID]time.Time{}
+	for i := range suspensions {
+		susp := &suspensions[i]
+		if susp.TeamID != match.HomeTeamID && susp.TeamID != match.AwayTeamID {
+			continue
+		}
+		since, err := s.suspensionStart(ctx, clubID, susp, sourceDates)
+		if err != nil {
+			return err
+		}
+		if !match.Date.After(since) {
+			continue
+		}
+		if !susp.Serve(match.ID) {
+			continue
+		}
+		if err := s.suspensionRepo.Update(ctx, susp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// suspensionStart devuelve desde cuándo corre la sanción: la fecha del partido que la originó o,
+// si no tiene partido de origen, el momento en que se cargó
+func (s *SuspensionService) suspensionStart(ctx context.Context, clubID string, susp *domain.PlayerSuspension, cache map[uuid.UUID]time.Time) (time.Time, error) {
+	if susp.SourceMatchID == nil {
+		return susp.CreatedAt, nil
+	}
+	if date, ok := cache[*susp.SourceMatchID]; ok {
+		return date, nil
+	}
+	source, err := s.repo.GetMatch(ctx, clubID, susp.SourceMatchID.String())
+	if err != nil {
+		return time.Time{}, err
+	}
+	date := susp.CreatedAt
+	if source != nil {
+		date = source.Date
+	}
+	cache[*susp.SourceMatchID] = date
+	return date, nil
+}
+
+// CreateSuspensionInput contiene los datos de una sanción del tribunal de disciplina
+type CreateSuspensionInput struct {
+	ClubID           string `json:"-"`
+	TournamentID     string `json:"-"`
+	TeamID           string `json:"team_id" binding:"required"`
+	UserID           string `json:"user_id" binding:"required"`
+	MatchesSuspended int    `json:"matches_suspended" binding:"required"`
+	SourceMatchID    string `json:"source_match_id"`
+	Notes            string `json:"notes"`
+	CreatedBy        string `json:"-"`
+}
+
+// CreateSuspension registra una sanción manual
+func (s *SuspensionService) CreateSuspension(ctx context.Context, input CreateSuspensionInput) (*domain.PlayerSuspension, error) {
+	if input.MatchesSuspended <= 0 {
+		return nil, errors.New("la sanción debe ser de al menos una fecha")
+	}
+
+	tID, err := uuid.Parse(input.TournamentID)
+	if err != nil {
+		return nil, errors.New("ID de torneo inválido")
+	}
+	teamID, err := uuid.Parse(input.TeamID)
+	if err != nil {
+		return nil, errors.New("ID de equipo inválido")
+	}
+	tournament, err := s.getTournament(ctx, input.ClubID, input.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	registered, err := s.teamRegisteredIn(ctx, input.ClubID, tournament, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if !registered {
+		return nil, errors.New("el equipo no está inscripto en el torneo")
+	}
+
+	roster, err := s.repo.GetTeamMembers(ctx, teamID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !containsString(roster, input.UserID) {
+		return nil, errors.New("el jugador no pertenece al plantel del equipo")
+	}
+
+	suspension := &domain.PlayerSuspension{
+		ID:               uuid.New(),
+		ClubID:           input.ClubID,
+		TournamentID:     tID,
+		TeamID:           teamID,
+		UserID:           input.UserID,
+		Reason:           domain.SuspensionManual,
+		MatchesSuspended: input.MatchesSuspended,
+		ServedMatchIDs:   []string{},
+		Notes:            input.Notes,
+		CreatedBy:        input.CreatedBy,
+	}
+	if input.SourceMatchID != "" {
+		mID, err := uuid.Parse(input.SourceMatchID)
+		if err != nil {
+			return nil, errors.New("ID de partido inválido")
+		}
+		suspension.SourceMatchID = &mID
+	}
+
+	if err := s.suspensionRepo.Create(ctx, suspension); err != nil {
+		return nil, err
+	}
+	return suspension, nil
+}
+
+// ListSuspensions obtiene las sanciones del torneo (activeOnly filtra las cumplidas)
+func (s *SuspensionService) ListSuspensions(ctx context.Context, clubID, tournamentID string, activeOnly bool) ([]domain.PlayerSuspension, error) {
+	tID, err := uuid.Parse(tournamentID)
+	if err != nil {
+		return nil, errors.New("ID de torneo inválido")
+	}
+	suspensions, err := s.suspensionRepo.GetByTournament(ctx, clubID, tID)
+	if err != nil {
+		return nil, err
+	}
+	if !activeOnly {
+		return suspensions, nil
+	}
+
+	active := make([]domain.PlayerSuspension, 0, len(suspensions))
+	for _, susp := range suspensions {
+		if susp.IsActive() {
+			active = append(active, susp)
+		}
+	}
+	return active, nil
+}
+
+// PlayerMatchEligibility resume si un jugador puede ser alineado en un partido del torneo
+type PlayerMatchEligibility struct {
+	UserID      string                    `json:"user_id"`
+	IsEligible  bool                      `json:"is_eligible"`
+	Issues      []string                  `json:"issues,omitempty"`
+	Suspensions []domain.PlayerSuspension `json:"suspensions,omitempty"`
+}
+
+// GetPlayerEligibility es la consulta de habilitación de un jugador: el torneo tiene que ser del club
+// y el jugador tiene que jugar en alguno de sus partidos
+func (s *SuspensionService) GetPlayerEligibility(ctx context.Context, clubID, tournamentID, userID string) (*PlayerMatchEligibility, error) {
+	tID, err := uuid.Parse(tournamentID)
+	if err != nil {
+		return nil, errors.New("ID de torneo inválido")
+	}
+	tournament, err := s.getTournament(ctx, clubID, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.repo.GetMatchesByUserID(ctx, clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+	participates := false
+	for _, m := range matches {
+		if m.TournamentID == tID {
+			participates = true
+			break
+		}
+	}
+	if !participates {
+		return nil, ErrPlayerNotInTournament
+	}
+	return s.checkPlayerEligibility(ctx, clubID, tournament, userID)
+}
+
+// CheckPlayerEligibility combina sanciones vigentes con documentación y estado del jugador
+func (s *SuspensionService) CheckPlayerEligibility(ctx context.Context, clubID, tournamentID, userID string) (*PlayerMatchEligibility, error) {
+	if _, err := uuid.Parse(tournamentID); err != nil {
+		return nil, errors.New("ID de torneo inválido")
+	}
+	tournament, err := s.getTournament(ctx, clubID, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	return s.checkPlayerEligibility(ctx, clubID, tournament, userID)
+}
+
+// teamRegisteredIn indica si el equipo tiene una fila de tabla en alguno de los grupos del torneo
+func (s *SuspensionService) teamRegisteredIn(ctx context.Context, clubID string, tournament *domain.Tournament, teamID uuid.UUID) (bool, error) {
+	groups := make(map[uuid.UUID]bool)
+	for _, stage := range tournament.Stages {
+		for _, group := range stage.Groups {
+			groups[group.ID] = true
+		}
+	}
+	standings, err := s.repo.GetTeamStandings(ctx, clubID, teamID.String())
+	if err != nil {
+		return false, err
+	}
+	for _, standing := range standings {
+		if groups[standing.GroupID] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *SuspensionService) getTournament(ctx context.Context, clubID, tournamentID string) (*domain.Tournament, error) {
+	tournament, err := s.repo.GetTournament(ctx, clubID, tournamentID)
+	if err != nil || tournament == nil {
+		return nil, errors.New("torneo no encontrado")
+	}
+	return tournament, nil
+}
+
+func (s *SuspensionService) checkPlayerEligibility(ctx context.Context, clubID string, tournament *domain.Tournament, userID string) (*PlayerMatchEligibility, error) {
+	result := &PlayerMatchEligibility{UserID: userID, Issues: []string{}}
+
+	suspensions, err := s.suspensionRepo.GetByUser(ctx, clubID, tournament.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, susp := range suspensions {
+		if susp.IsActive() {
+			result.Suspensions = append(result.Suspensions, susp)
+		}
+	}
+	if len(result.Suspensions) > 0 {
+		result.Issues = append(result.Issues, "Jugador suspendido")
+	}
+
+	if s.eligibility != nil {
+		issues, err := s.eligibility.GetPlayerIssues(ctx, clubID, userID, tournament)
+		if err != nil {
+			return nil, err
+		}
+		result.Issues = append(result.Issues, issues...)
+	}
+
+	result.IsEligible = len(result.Issues) == 0
+	return result, nil
+}
+
+// ValidateLineupInput contiene la alineación propuesta por un equipo
+type ValidateLineupInput struct {
+	ClubID    string   `json:"-"`
+	MatchID   string   `json:"-"`
+	TeamID    string   `json:"team_id" binding:"required"`
+	PlayerIDs []string `json:"player_ids" binding:"required"`
+}
+
+// LineupValidationResult indica qué jugadores de la alineación no pueden jugar
+type LineupValidationResult struct {
+	MatchID  string                   `json:"match_id"`
+	TeamID   string                   `json:"team_id"`
+	IsValid  bool                     `json:"is_valid"`
+	Players  []PlayerMatchEligibility `json:"players"`
+	Rejected []string                 `json:"rejected"`
+}
+
+// ValidateLineup verifica antes del partido que cada jugador pertenezca al plantel y esté habilitado
+func (s *SuspensionService) ValidateLineup(ctx context.Context, input ValidateLineupInput) (*LineupValidationResult, error) {
+	if len(input.PlayerIDs) == 0 {
+		return nil, errors.New("la alineación no puede estar vacía")
+	}
+
+	match, err := s.repo.GetMatch(ctx, input.ClubID, input.MatchID)
+	if err != nil || match == nil {
+		return nil, errors.New("partido no encontrado")
+	}
+
+	teamID, err := uuid.Parse(input.TeamID)
+	if err != nil {
+		return nil, errors.New("ID de equipo inválido")
+	}
+	if teamID != match.HomeTeamID && teamID != match.AwayTeamID {
+		return nil, errors.New("el equipo no participa de este partido")
+	}
+
+	roster, err := s.repo.GetTeamMembers(ctx, teamID.String())
+	if err != nil {
+		return nil, err
+	}
+	tournament, err := s.getTournament(ctx, input.ClubID, match.TournamentID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LineupValidationResult{
+		MatchID:  match.ID.String(),
+		TeamID:   teamID.String(),
+		Players:  make([]PlayerMatchEligibility, 0, len(input.PlayerIDs)),
+		Rejected: []string{},
+	}
+
+	for _, userID := range input.PlayerIDs {
+		eligibility, err := s.checkPlayerEligibility(ctx, input.ClubID, tournament, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !containsString(roster, userID) {
+			eligibility.Issues = append(eligibility.Issues, "No pertenece al plantel del equipo")
+			eligibility.IsEligible = false
+		}
+		if !eligibility.IsEligible {
+			result.Rejected = append(result.Rejected, userID)
+		}
+		result.Players = append(result.Players, *eligibility)
+	}
+
+	result.IsValid = len(result.Rejected) == 0
+	return result, nil
+}
+
+func (s *SuspensionService) createAutomatic(ctx context.Context, event *domain.MatchEvent, reason domain.SuspensionReason, matches int) error {
+	matchID, eventID := event.MatchID, event.ID
+	return s.suspensionRepo.Create(ctx, &domain.PlayerSuspension{
+		ID:               uuid.New(),
+		ClubID:           event.ClubID,
+		TournamentID:     event.TournamentID,
+		TeamID:           event.TeamID,
+		UserID:           event.UserID,
+		Reason:           reason,
+		SourceMatchID:    &matchID,
+		SourceEventID:    &eventID,
+		MatchesSuspended: matches,
+		ServedMatchIDs:   []string{},
+		CreatedBy:        event.RecordedBy,
+	})
+}
+
+func countCards(events []domain.MatchEvent, userID string, cardType domain.MatchEventType) int {
+	count := 0
+	for _, e := range events {
+		if e.UserID == userID && e.Type == cardType {
+			count++
+		}
+	}
+	return count
+}
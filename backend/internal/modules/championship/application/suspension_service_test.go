@@ -0,0 +1,307 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/datatypes"
+)
+
+type MockSuspensionRepo struct {
+	mock.Mock
+}
+
+func (m *MockSuspensionRepo) Create(ctx context.Context, s *domain.PlayerSuspension) error {
+	return m.Called(ctx, s).Error(0)
+}
+
+func (m *MockSuspensionRepo) GetByTournament(ctx context.Context, clubID string, tournamentID uuid.UUID) ([]domain.PlayerSuspension, error) {
+	args := m.Called(ctx, clubID, tournamentID)
+	var res []domain.PlayerSuspension
+	if args.Get(0) != nil {
+		res = args.Get(0).([]domain.PlayerSuspension)
+	}
+	return res, args.Error(1)
+}
+
+func (m *MockSuspensionRepo) GetByUser(ctx context.Context, clubID string, tournamentID uuid.UUID, userID string) ([]domain.PlayerSuspension, error) {
+	args := m.Called(ctx, clubID, tournamentID, userID)
+	var res []domain.PlayerSuspension
+	if args.Get(0) != nil {
+		res = args.Get(0).([]domain.PlayerSuspension)
+	}
+	return res, args.Error(1)
+}
+
+func (m *MockSuspensionRepo) GetBySourceMatch(ctx context.Context, clubID string, matchID uuid.UUID) ([]domain.PlayerSuspension, error) {
+	args := m.Called(ctx, clubID, matchID)
+	var res []domain.PlayerSuspension
+	if args.Get(0) != nil {
+		res = args.Get(0).([]domain.PlayerSuspension)
+	}
+	return res, args.Error(1)
+}
+
+func (m *MockSuspensionRepo) Update(ctx context.Context, s *domain.PlayerSuspension) error {
+	return m.Called(ctx, s).Error(0)
+}
+
+type MockEligibilityChecker struct {
+	mock.Mock
+}
+
+func (m *MockEligibilityChecker) GetPlayerIssues(ctx context.Context, clubID, userID string, tournament *domain.Tournament) ([]string, error) {
+	args := m.Called(ctx, clubID, userID, tournament)
+	var res []string
+	if args.Get(0) != nil {
+		res = args.Get(0).([]string)
+	}
+	return res, args.Error(1)
+}
+
+func TestSuspensionService_OnMatchEventRecorded(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	tournament := &domain.Tournament{
+		ID:       uuid.New(),
+		Settings: datatypes.JSON(`{"disciplinary": {"yellow_card_threshold": 3}}`),
+	}
+	matchID := uuid.New()
+	card := func(cardType domain.MatchEventType) *domain.MatchEvent {
+		return &domain.MatchEvent{ClubID: cID, TournamentID: tournament.ID, MatchID: matchID, TeamID: uuid.New(), UserID: "u1", Type: cardType}
+	}
+
+	setup := func() (*MockChampionshipRepo, *MockMatchEventRepo, *MockSuspensionRepo, *application.SuspensionService) {
+		repo := new(MockChampionshipRepo)
+		eventRepo := new(MockMatchEventRepo)
+		suspRepo := new(MockSuspensionRepo)
+		repo.On("GetTournament", ctx, cID, tournament.ID.String()).Return(tournament, nil)
+		suspRepo.On("GetBySourceMatch", ctx, cID, matchID).Return(nil, nil)
+		return repo, eventRepo, suspRepo, application.NewSuspensionService(repo, eventRepo, suspRepo, nil)
+	}
+
+	t.Run("Red card suspends with default matches", func(t *testing.T) {
+		_, _, suspRepo, svc := setup()
+		suspRepo.On("Create", ctx, mock.MatchedBy(func(s *domain.PlayerSuspension) bool {
+			return s.Reason == domain.SuspensionRedCard && s.MatchesSuspended == 2 && *s.SourceMatchID == matchID
+		})).Return(nil).Once()
+
+		assert.NoError(t, svc.OnMatchEventRecorded(ctx, card(domain.MatchEventRedCard)))
+		suspRepo.AssertExpectations(t)
+	})
+
+	t.Run("Second yellow in match is a double yellow", func(t *testing.T) {
+		_, eventRepo, suspRepo, svc := setup()
+		eventRepo.On("GetByMatchID", ctx, cID, matchID).Return([]domain.MatchEvent{
+			{UserID: "u1", Type: domain.MatchEventYellowCard},
+			{UserID: "u1", Type: domain.MatchEventYellowCard},
+		}, nil)
+		suspRepo.On("Create", ctx, mock.MatchedBy(func(s *domain.PlayerSuspension) bool {
+			return s.Reason == domain.SuspensionDoubleYellow
+		})).Return(nil).Once()
+
+		assert.NoError(t, svc.OnMatchEventRecorded(ctx, card(domain.MatchEventYellowCard)))
+		suspRepo.AssertExpectations(t)
+	})
+
+	t.Run("Yellow accumulation reaches threshold", func(t *testing.T) {
+		_, eventRepo, suspRepo, svc := setup()
+		eventRepo.On("GetByMatchID", ctx, cID, matchID).Return([]domain.MatchEvent{{UserID: "u1", Type: domain.MatchEventYellowCard}}, nil)
+		eventRepo.On("GetByTournamentID", ctx, cID, tournament.ID).Return([]domain.MatchEvent{
+			{UserID: "u1", Type: domain.MatchEventYellowCard},
+			{UserID: "u1", Type: domain.MatchEventYellowCard},
+			{UserID: "u1", Type: domain.MatchEventYellowCard},
+		}, nil)
+		suspRepo.On("Create", ctx, mock.MatchedBy(func(s *domain.PlayerSuspension) bool {
+			return s.Reason == domain.SuspensionYellowAccumulation && s.MatchesSuspended == 1
+		})).Return(nil).Once()
+
+		assert.NoError(t, svc.OnMatchEventRecorded(ctx, card(domain.MatchEventYellowCard)))
+		suspRepo.AssertExpectations(t)
+	})
+
+	t.Run("Yellow below threshold does not suspend", func(t *testing.T) {
+		_, eventRepo, suspRepo, svc := setup()
+		eventRepo.On("GetByMatchID", ctx, cID, matchID).Return([]domain.MatchEvent{{UserID: "u1", Type: domain.MatchEventYellowCard}}, nil)
+		eventRepo.On("GetByTournamentID", ctx, cID, tournament.ID).Return([]domain.MatchEvent{{UserID: "u1", Type: domain.MatchEventYellowCard}}, nil)
+
+		assert.NoError(t, svc.OnMatchEventRecorded(ctx, card(domain.MatchEventYellowCard)))
+		suspRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSuspensionService_OnMatchCompleted(t *testing.T) {
+	repo := new(MockChampionshipRepo)
+	suspRepo := new(MockSuspensionRepo)
+	svc := application.NewSuspensionService(repo, nil, suspRepo, nil)
+	ctx := context.TODO()
+	cID := "club-1"
+
+	now := time.Now()
+	sourceMatch := uuid.New()
+	laterSource := uuid.New()
+	match := &domain.TournamentMatch{ID: uuid.New(), TournamentID: uuid.New(), HomeTeamID: uuid.New(), AwayTeamID: uuid.New(), Date: now}
+	suspensions := []domain.PlayerSuspension{
+		{UserID: "home-player", TeamID: match.HomeTeamID, MatchesSuspended: 2, SourceMatchID: &sourceMatch},
+		{UserID: "other-team", TeamID: uuid.New(), MatchesSuspended: 1},
+		{UserID: "served", TeamID: match.AwayTeamID, MatchesSuspended: 1, ServedMatchIDs: []string{uuid.NewString()}},
+		// La fecha se cargó tarde: el partido completado se jugó antes de la tarjeta
+		{UserID: "booked-later", TeamID: match.AwayTeamID, MatchesSuspended: 1, SourceMatchID: &laterSource},
+		{UserID: "revoked", TeamID: match.HomeTeamID, MatchesSuspended: 1, RevokedAt: &now, CreatedAt: now.Add(-time.Hour)},
+	}
+	suspRepo.On("GetByTournament", ctx, cID, match.TournamentID).Return(suspensions, nil)
+	repo.On("GetMatch", ctx, cID, sourceMatch.String()).Return(&domain.TournamentMatch{ID: sourceMatch, Date: now.AddDate(0, 0, -7)}, nil)
+	repo.On("GetMatch", ctx, cID, laterSource.String()).Return(&domain.TournamentMatch{ID: laterSource, Date: now.AddDate(0, 0, 7)}, nil)
+	suspRepo.On("Update", ctx, mock.MatchedBy(func(s *domain.PlayerSuspension) bool {
+		return s.UserID == "home-player" && s.MatchesRemaining() == 1
+	})).Return(nil).Once()
+
+	assert.NoError(t, svc.OnMatchCompleted(ctx, cID, match))
+	suspRepo.AssertExpectations(t)
+}
+
+func TestSuspensionService_OnMatchEventDeleted(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	matchID := uuid.New()
+	first := domain.MatchEvent{ID: uuid.New(), ClubID: cID, MatchID: matchID, UserID: "u1", Type: domain.MatchEventYellowCard}
+	second := domain.MatchEvent{ID: uuid.New(), ClubID: cID, MatchID: matchID, UserID: "u1", Type: domain.MatchEventYellowCard}
+	red := domain.MatchEvent{ID: uuid.New(), ClubID: cID, MatchID: matchID, UserID: "u2", Type: domain.MatchEventRedCard}
+
+	setup := func() (*MockSuspensionRepo, *application.SuspensionService) {
+		suspRepo := new(MockSuspensionRepo)
+		suspRepo.On("GetBySourceMatch", ctx, cID, matchID).Return([]domain.PlayerSuspension{
+			{UserID: "u1", Reason: domain.SuspensionDoubleYellow, MatchesSuspended: 2, SourceMatchID: &matchID, SourceEventID: &second.ID},
+			{UserID: "u2", Reason: domain.SuspensionRedCard, MatchesSuspended: 2, SourceMatchID: &matchID, SourceEventID: &red.ID},
+			{UserID: "u1", Reason: domain.SuspensionManual, MatchesSuspended: 3, SourceMatchID: &matchID},
+		}, nil)
+		return suspRepo, application.NewSuspensionService(nil, nil, suspRepo, nil)
+	}
+
+	t.Run("Deleting the red card revokes its suspension", func(t *testing.T) {
+		suspRepo, svc := setup()
+		suspRepo.On("Update", ctx, mock.MatchedBy(func(s *domain.PlayerSuspension) bool {
+			return s.Reason == domain.SuspensionRedCard && s.RevokedAt != nil && !s.IsActive()
+		})).Return(nil).Once()
+
+		assert.NoError(t, svc.OnMatchEventDeleted(ctx, &red))
+		suspRepo.AssertExpectations(t)
+	})
+
+	t.Run("Deleting either yellow revokes the double yellow but not the manual suspension", func(t *testing.T) {
+		suspRepo, svc := setup()
+		suspRepo.On("Update", ctx, mock.MatchedBy(func(s *domain.PlayerSuspension) bool {
+			return s.Reason == domain.SuspensionDoubleYellow && s.RevokedAt != nil
+		})).Return(nil).Once()
+
+		assert.NoError(t, svc.OnMatchEventDeleted(ctx, &first))
+		suspRepo.AssertExpectations(t)
+		suspRepo.AssertNumberOfCalls(t, "Update", 1)
+	})
+}
+
+func TestSuspensionService_GetPlayerEligibility(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	tournamentID := uuid.New()
+	repo := new(MockChampionshipRepo)
+	suspRepo := new(MockSuspensionRepo)
+	svc := application.NewSuspensionService(repo, nil, suspRepo, nil)
+
+	repo.On("GetTournament", ctx, cID, tournamentID.String()).Return(&domain.Tournament{ID: tournamentID}, nil)
+	repo.On("GetMatchesByUserID", ctx, cID, "player").Return([]domain.TournamentMatch{{TournamentID: tournamentID}}, nil)
+	repo.On("GetMatchesByUserID", ctx, cID, "stranger").Return([]domain.TournamentMatch{{TournamentID: uuid.New()}}, nil)
+	suspRepo.On("GetByUser", ctx, cID, tournamentID, "player").Return(nil, nil)
+
+	result, err := svc.GetPlayerEligibility(ctx, cID, tournamentID.String(), "player")
+	assert.NoError(t, err)
+	assert.True(t, result.IsEligible)
+
+	_, err = svc.GetPlayerEligibility(ctx, cID, tournamentID.String(), "stranger")
+	assert.ErrorIs(t, err, application.ErrPlayerNotInTournament)
+}
+
+func TestSuspensionService_CreateSuspension(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	groupID := uuid.New()
+	tournament := &domain.Tournament{ID: uuid.New(), Stages: []domain.TournamentStage{{ID: uuid.New(), Groups: []domain.Group{{ID: groupID}}}}}
+	teamID := uuid.New()
+	foreignTeamID := uuid.New()
+
+	repo := new(MockChampionshipRepo)
+	suspRepo := new(MockSuspensionRepo)
+	svc := application.NewSuspensionService(repo, nil, suspRepo, nil)
+
+	repo.On("GetTournament", ctx, cID, tournament.ID.String()).Return(tournament, nil)
+	repo.On("GetTeamStandings", ctx, cID, teamID.String()).Return([]domain.Standing{{GroupID: groupID, TeamID: teamID}}, nil)
+	repo.On("GetTeamStandings", ctx, cID, foreignTeamID.String()).Return([]domain.Standing{{GroupID: uuid.New(), TeamID: foreignTeamID}}, nil)
+	repo.On("GetTeamMembers", ctx, teamID.String()).Return([]string{"u1"}, nil)
+	suspRepo.On("Create", ctx, mock.Anything).Return(nil)
+
+	input := application.CreateSuspensionInput{ClubID: cID, TournamentID: tournament.ID.String(), TeamID: teamID.String(), UserID: "u1", MatchesSuspended: 2}
+	suspension, err := svc.CreateSuspension(ctx, input)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.SuspensionManual, suspension.Reason)
+
+	// A team that only has standings in another tournament's groups is rejected before saving
+	input.TeamID = foreignTeamID.String()
+	_, err = svc.CreateSuspension(ctx, input)
+	assert.ErrorContains(t, err, "no está inscripto en el torneo")
+	suspRepo.AssertNumberOfCalls(t, "Create", 1)
+	repo.AssertNotCalled(t, "GetTeamMembers", ctx, foreignTeamID.String())
+}
+
+func TestSuspensionService_ValidateLineup(t *testing.T) {
+	repo := new(MockChampionshipRepo)
+	suspRepo := new(MockSuspensionRepo)
+	checker := new(MockEligibilityChecker)
+	svc := application.NewSuspensionService(repo, nil, suspRepo, checker)
+	ctx := context.TODO()
+	cID := "club-1"
+
+	match := &domain.TournamentMatch{ID: uuid.New(), TournamentID: uuid.New(), HomeTeamID: uuid.New(), AwayTeamID: uuid.New()}
+	mID := match.ID.String()
+	tournament := &domain.Tournament{ID: match.TournamentID, Sport: "Fútbol", Category: "2012"}
+	repo.On("GetMatch", ctx, cID, mID).Return(match, nil)
+	repo.On("GetTournament", ctx, cID, match.TournamentID.String()).Return(tournament, nil)
+	repo.On("GetTeamMembers", ctx, match.HomeTeamID.String()).Return([]string{"ok", "suspended", "no-emmac"}, nil)
+
+	suspRepo.On("GetByUser", ctx, cID, match.TournamentID, "ok").Return(nil, nil)
+	suspRepo.On("GetByUser", ctx, cID, match.TournamentID, "suspended").Return([]domain.PlayerSuspension{{MatchesSuspended: 1}}, nil)
+	suspRepo.On("GetByUser", ctx, cID, match.TournamentID, "no-emmac").Return(nil, nil)
+	suspRepo.On("GetByUser", ctx, cID, match.TournamentID, "intruder").Return(nil, nil)
+	// La regla de elegibilidad se resuelve con la disciplina y categoría del torneo
+	checker.On("GetPlayerIssues", ctx, cID, "no-emmac", tournament).Return([]string{"Apto físico faltante"}, nil)
+	checker.On("GetPlayerIssues", ctx, cID, mock.Anything, tournament).Return([]string{}, nil)
+
+	t.Run("All eligible", func(t *testing.T) {
+		result, err := svc.ValidateLineup(ctx, application.ValidateLineupInput{
+			ClubID: cID, MatchID: mID, TeamID: match.HomeTeamID.String(), PlayerIDs: []string{"ok"},
+		})
+		assert.NoError(t, err)
+		assert.True(t, result.IsValid)
+	})
+
+	t.Run("Rejects suspended, undocumented and foreign players", func(t *testing.T) {
+		result, err := svc.ValidateLineup(ctx, application.ValidateLineupInput{
+			ClubID: cID, MatchID: mID, TeamID: match.HomeTeamID.String(), PlayerIDs: []string{"ok", "suspended", "no-emmac", "intruder"},
+		})
+		assert.NoError(t, err)
+		assert.False(t, result.IsValid)
+		assert.Equal(t, []string{"suspended", "no-emmac", "intruder"}, result.Rejected)
+	})
+
+	t.Run("Team not in match", func(t *testing.T) {
+		_, err := svc.ValidateLineup(ctx, application.ValidateLineupInput{
+			ClubID: cID, MatchID: mID, TeamID: uuid.NewString(), PlayerIDs: []string{"ok"},
+		})
+		assert.ErrorContains(t, err, "no participa")
+	})
+}
@@ -0,0 +1,654 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	paymentDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/payment/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/storage"
+)
+
+// EntryFeeGateway abstrae el cobro y la devolución del arancel de inscripción (módulo Payment)
+type EntryFeeGateway interface {
+	CreateEntryFeeCheckout(ctx context.Context, registration *domain.TeamRegistration, description string) (paymentID uuid.UUID, checkoutURL string, err error)
+	RefundEntryFee(ctx context.Context, clubID string, registrationID uuid.UUID) error
+}
+
+// RosterDocumentEncryptor cifra los aptos médicos con la clave de datos del club (ver encryption.Envelope)
+type RosterDocumentEncryptor interface {
+	Encrypt(ctx context.Context, clubID string, plaintext []byte) ([]byte, error)
+	Decrypt(ctx context.Context, clubID string, data []byte) ([]byte, error)
+}
+
+// MaxRosterDocumentSize es el tamaño máximo de un documento de la lista de buena fe (10 MB)
+const MaxRosterDocumentSize int64 = 10 << 20
+
+// rosterDocumentContentTypes son los formatos aceptados (detectados por contenido)
+var rosterDocumentContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+}
+
+var (
+	ErrRosterDocumentNotFound    = errors.New("documento no encontrado")
+	ErrRosterStorageUnavailable  = errors.New("el almacenamiento de documentos no está configurado")
+	ErrRosterDocumentUnsupported = errors.New("formato de archivo no soportado (solo PDF, JPG o PNG)")
+	ErrRosterDocumentTooLarge    = errors.New("el archivo supera el tamaño máximo permitido")
+	ErrRosterDocumentInfected    = errors.New("el archivo fue rechazado por el antivirus")
+)
+
+// TeamRegistrationService gestiona la inscripción de equipos de otros clubes a torneos interclubes
+type TeamRegistrationService struct {
+	repo             domain.ChampionshipRepository
+	registrationRepo domain.TeamRegistrationRepository
+	payments         EntryFeeGateway
+	files            storage.FileStorage
+	scanner          storage.Scanner
+	encryptor        RosterDocumentEncryptor
+}
+
+// NewTeamRegistrationService crea una nueva instancia del servicio
+func NewTeamRegistrationService(
+	repo domain.ChampionshipRepository,
+	registrationRepo domain.TeamRegistrationRepository,
+	payments EntryFeeGateway,
+) *TeamRegistrationService {
+	return &TeamRegistrationService{
+		repo:             repo,
+		registrationRepo: registrationRepo,
+		payments:         payments,
+	}
+}
+
+// SetDocumentStorage habilita la subida de documentos de la lista de buena fe al FileStorage.
+// Sin scanner se aceptan todos los archivos.
+func (s *TeamRegistrationService) SetDocumentStorage(files storage.FileStorage, scanner storage.Scanner) {
+	if scanner == nil {
+		scanner = storage.NoopScanner{}
+	}
+	s.files = files
+	s.scanner = scanner
+}
+
+// SetEncryptor habilita el cifrado en reposo de los aptos médicos
+func (s *TeamRegistrationService) SetEncryptor(encryptor RosterDocumentEncryptor) {
+	s.encryptor = encryptor
+}
+
+// RosterPlayerInput es un jugador de la lista de buena fe enviada en el formulario
+type RosterPlayerInput struct {
+	DNI       string     `json:"dni" binding:"required"`
+	FirstName string     `json:"first_name" binding:"required"`
+	LastName  string     `json:"last_name" binding:"required"`
+	BirthDate *time.Time `json:"birth_date,omitempty"`
+}
+
+// RegisterExternalTeamInput contiene el formulario público de inscripción
+type RegisterExternalTeamInput struct {
+	ClubID       string              `json:"-"`
+	TournamentID string              `json:"-"`
+	TeamName     string              `json:"team_name" binding:"required"`
+	OriginClub   string              `json:"origin_club"`
+	ContactName  string              `json:"contact_name" binding:"required"`
+	ContactEmail string              `json:"contact_email" binding:"required,email"`
+	ContactPhone string              `json:"contact_phone"`
+	Roster       []RosterPlayerInput `json:"roster" binding:"required"`
+}
+
+// TeamRegistrationResult devuelve la inscripción creada, el token para gestionarla y el link de pago
+type TeamRegistrationResult struct {
+	Registration *domain.TeamRegistration `json:"registration"`
+	AccessToken  string                   `json:"access_token"`
+	CheckoutURL  string                   `json:"checkout_url,omitempty"`
+}
+
+// RegisterExternalTeam crea la inscripción y, si el torneo tiene arancel, inicia el cobro
+func (s *TeamRegistrationService) RegisterExternalTeam(ctx context.Context, input RegisterExternalTeamInput) (*TeamRegistrationResult, error) {
+	tournamentID, err := uuid.Parse(input.TournamentID)
+	if err != nil {
+		return nil, errors.New("ID de torneo inválido")
+	}
+	tournament, err := s.repo.GetTournament(ctx, input.ClubID, input.TournamentID)
+	if err != nil || tournament == nil {
+		return nil, errors.New("torneo no encontrado")
+	}
+
+	settings := domain.ParseRegistrationSettings(tournament.Settings)
+	if !settings.IsOpenAt(time.Now()) {
+		return nil, errors.New("el torneo no tiene la inscripción abierta")
+	}
+	if len(input.Roster) < settings.MinRoster || len(input.Roster) > settings.MaxRoster {
+		return nil, fmt.Errorf("la lista de buena fe debe tener entre %d y %d jugadores", settings.MinRoster, settings.MaxRoster)
+	}
+
+	roster := make([]domain.RosterPlayer, 0, len(input.Roster))
+	seen := make(map[string]bool)
+	for _, p := range input.Roster {
+		dni := normalizeDNI(p.DNI)
+		if dni == "" {
+			return nil, errors.New("todos los jugadores deben tener DNI")
+		}
+		if seen[dni] {
+			return nil, fmt.Errorf("el DNI %s está repetido en la lista", dni)
+		}
+		seen[dni] = true
+		roster = append(roster, domain.RosterPlayer{DNI: dni, FirstName: p.FirstName, LastName: p.LastName, BirthDate: p.BirthDate})
+	}
+
+	// Un jugador solo puede estar en un equipo del torneo
+	existing, err := s.registrationRepo.ListByTournament(ctx, input.ClubID, tournamentID, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range existing {
+		if other.Status == domain.RegistrationRejected {
+			continue
+		}
+		for _, p := range other.Roster {
+			if seen[p.DNI] {
+				return nil, fmt.Errorf("el DNI %s ya está inscripto en otro equipo del torneo", p.DNI)
+			}
+		}
+	}
+
+	registration := &domain.TeamRegistration{
+		ID:           uuid.New(),
+		ClubID:       input.ClubID,
+		TournamentID: tournamentID,
+		TeamName:     input.TeamName,
+		OriginClub:   input.OriginClub,
+		ContactName:  input.ContactName,
+		ContactEmail: input.ContactEmail,
+		ContactPhone: input.ContactPhone,
+		Roster:       roster,
+		Status:       domain.RegistrationPendingApproval,
+		EntryFee:     settings.EntryFee,
+		AccessToken:  uuid.NewString(),
+	}
+	if settings.EntryFee.IsPositive() {
+		registration.Status = domain.RegistrationPendingPayment
+	}
+
+	result := &TeamRegistrationResult{Registration: registration, AccessToken: registration.AccessToken}
+	if registration.Status == domain.RegistrationPendingPayment {
+		// El cobro se inicia antes de guardar: si falla, no queda una inscripción que reserve los DNI de la lista
+		url, err := s.startEntryFeeCheckout(ctx, registration, tournament)
+		if err != nil {
+			return nil, err
+		}
+		result.CheckoutURL = url
+	}
+
+	if err := s.registrationRepo.Create(ctx, registration); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RetryEntryFeeCheckout genera un nuevo link de pago para una inscripción que sigue pendiente de pago
+// (por ejemplo, si el club visitante abandonó el checkout)
+func (s *TeamRegistrationService) RetryEntryFeeCheckout(ctx context.Context, clubID, registrationID, token string) (*TeamRegistrationResult, error) {
+	registration, err := s.GetRegistrationWithToken(ctx, clubID, registrationID, token)
+	if err != nil {
+		return nil, err
+	}
+	if registration.Status != domain.RegistrationPendingPayment {
+		return nil, fmt.Errorf("la inscripción no está pendiente de pago (estado %s)", registration.Status)
+	}
+	tournament, err := s.repo.GetTournament(ctx, clubID, registration.TournamentID.String())
+	if err != nil || tournament == nil {
+		return nil, errors.New("torneo no encontrado")
+	}
+
+	url, err := s.startEntryFeeCheckout(ctx, registration, tournament)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.registrationRepo.Update(ctx, registration); err != nil {
+		return nil, err
+	}
+	return &TeamRegistrationResult{Registration: registration, AccessToken: registration.AccessToken, CheckoutURL: url}, nil
+}
+
+// startEntryFeeCheckout crea el cobro del arancel y lo asocia a la inscripción (sin guardarla)
+func (s *TeamRegistrationService) startEntryFeeCheckout(ctx context.Context, registration *domain.TeamRegistration, tournament *domain.Tournament) (string, error) {
+	description := fmt.Sprintf("Inscripción %s - %s", registration.TeamName, tournament.Name)
+	paymentID, url, err := s.payments.CreateEntryFeeCheckout(ctx, registration, description)
+	if err != nil {
+		return "", err
+	}
+	registration.PaymentID = &paymentID
+	return url, nil
+}
+
+// GetRegistrationWithToken obtiene la inscripción para el club visitante (validado por token)
+func (s *TeamRegistrationService) GetRegistrationWithToken(ctx context.Context, clubID, registrationID, token string) (*domain.TeamRegistration, error) {
+	id, err := uuid.Parse(registrationID)
+	if err != nil {
+		return nil, errors.New("ID de inscripción inválido")
+	}
+	registration, err := s.registrationRepo.GetByID(ctx, clubID, id)
+	if err != nil {
+		return nil, err
+	}
+	if registration == nil || subtle.ConstantTimeCompare([]byte(registration.AccessToken), []byte(token)) != 1 {
+		return nil, errors.New("inscripción no encontrada")
+	}
+	return registration, nil
+}
+
+// AttachRosterDocumentInput contiene un documento subido para un jugador de la lista
+type AttachRosterDocumentInput struct {
+	ClubID         string
+	RegistrationID string
+	Token          string
+	DNI            string
+	Type           string
+	FileName       string
+	Size           int64 // Tamaño declarado por el cliente, se vuelve a verificar al leer
+	Body           io.Reader
+}
+
+// AttachRosterDocument guarda (o reemplaza) un documento de un jugador de la lista de buena fe.
+// Queda pendiente hasta que el organizador lo revise y, si es el apto médico, cargue su vencimiento.
+func (s *TeamRegistrationService) AttachRosterDocument(ctx context.Context, input AttachRosterDocumentInput) (*domain.TeamRegistration, error) {
+	if !domain.IsValidRosterDocumentType(input.Type) {
+		return nil, errors.New("tipo de documento inválido")
+	}
+	if s.files == nil {
+		return nil, ErrRosterStorageUnavailable
+	}
+	if input.Size > MaxRosterDocumentSize {
+		return nil, ErrRosterDocumentTooLarge
+	}
+
+	registration, err := s.GetRegistrationWithToken(ctx, input.ClubID, input.RegistrationID, input.Token)
+	if err != nil {
+		return nil, err
+	}
+	if registration.Status == domain.RegistrationRejected || registration.Status == domain.RegistrationApproved {
+		return nil, fmt.Errorf("la inscripción ya fue revisada (estado %s)", registration.Status)
+	}
+
+	player := registration.FindPlayer(normalizeDNI(input.DNI))
+	if player == nil {
+		return nil, errors.New("el jugador no está en la lista de buena fe")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(input.Body, MaxRosterDocumentSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo archivo: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, errors.New("el archivo está vacío")
+	}
+	if int64(len(data)) > MaxRosterDocumentSize {
+		return nil, ErrRosterDocumentTooLarge
+	}
+	contentType := http.DetectContentType(data)
+	if !rosterDocumentContentTypes[contentType] {
+		return nil, ErrRosterDocumentUnsupported
+	}
+	if err := s.scanner.Scan(ctx, bytes.NewReader(data)); err != nil {
+		if errors.Is(err, storage.ErrInfected) {
+			return nil, ErrRosterDocumentInfected
+		}
+		return nil, fmt.Errorf("error en el análisis antivirus: %w", err)
+	}
+
+	doc := domain.RosterDocument{
+		Type:        input.Type,
+		FileURL:     fmt.Sprintf("/championships/registrations/%s/players/%s/documents/%s/file", registration.ID, player.DNI, input.Type),
+		ContentType: contentType,
+		SizeBytes:   int64(len(data)),
+		Status:      domain.RosterDocumentPending,
+		UploadedAt:  time.Now(),
+	}
+
+	stored := data
+	if s.encryptor != nil && doc.IsHealthData() {
+		if stored, err = s.encryptor.Encrypt(ctx, registration.ClubID, data); err != nil {
+			return nil, fmt.Errorf("error cifrando archivo: %w", err)
+		}
+		doc.Encrypted = true
+	}
+	key := registration.RosterDocumentKey(player.DNI, input.Type)
+	if err := s.files.Put(ctx, key, bytes.NewReader(stored), int64(len(stored)), contentType); err != nil {
+		return nil, fmt.Errorf("error guardando archivo: %w", err)
+	}
+
+	if existing := player.FindDocument(input.Type); existing != nil {
+		*existing = doc
+	} else {
+		player.Documents = append(player.Documents, doc)
+	}
+
+	if err := s.registrationRepo.Update(ctx, registration); err != nil {
+		return nil, err
+	}
+	return registration, nil
+}
+
+// OpenRosterDocument abre el archivo de un documento de la lista para la revisión del organizador
+func (s *TeamRegistrationService) OpenRosterDocument(ctx context.Context, clubID, registrationID, dni, docType string) (io.ReadCloser, *domain.RosterDocument, error) {
+	if s.files == nil {
+		return nil, nil, ErrRosterStorageUnavailable
+	}
+	registration, doc, err := s.findRosterDocument(ctx, clubID, registrationID, dni, docType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, _, err := s.files.Get(ctx, registration.RosterDocumentKey(normalizeDNI(dni), docType))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, ErrRosterDocumentNotFound
+		}
+		return nil, nil, fmt.Errorf("error leyendo archivo: %w", err)
+	}
+	if !doc.Encrypted {
+		return body, doc, nil
+	}
+
+	defer body.Close()
+	if s.encryptor == nil {
+		return nil, nil, errors.New("el documento está cifrado y el cifrado no está configurado")
+	}
+	sealed, err := io.ReadAll(body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error leyendo archivo: %w", err)
+	}
+	plaintext, err := s.encryptor.Decrypt(ctx, registration.ClubID, sealed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error descifrando archivo: %w", err)
+	}
+	return io.NopCloser(bytes.NewReader(plaintext)), doc, nil
+}
+
+// ReviewRosterDocumentInput contiene la revisión del organizador de un documento de la lista
+type ReviewRosterDocumentInput struct {
+	ClubID         string
+	RegistrationID string
+	DNI            string
+	Type           string
+	ReviewerID     string
+	Approve        bool
+	ExpirationDate *time.Time // Obligatorio al aprobar el apto médico
+	Notes          string
+}
+
+// ReviewRosterDocument aprueba o rechaza un documento de la lista. El vencimiento del apto médico
+// lo carga el organizador a partir del certificado.
+func (s *TeamRegistrationService) ReviewRosterDocument(ctx context.Context, input ReviewRosterDocumentInput) (*domain.TeamRegistration, error) {
+	registration, doc, err := s.findRosterDocument(ctx, input.ClubID, input.RegistrationID, input.DNI, input.Type)
+	if err != nil {
+		return nil, err
+	}
+	if registration.Status == domain.RegistrationRejected || registration.Status == domain.RegistrationApproved {
+		return nil, fmt.Errorf("la inscripción ya fue revisada (estado %s)", registration.Status)
+	}
+
+	now := time.Now()
+	doc.ReviewedBy = &input.ReviewerID
+	doc.ReviewedAt = &now
+	if input.Approve {
+		if doc.IsHealthData() {
+			if input.ExpirationDate == nil {
+				return nil, errors.New("el apto médico requiere la fecha de vencimiento")
+			}
+			if !input.ExpirationDate.After(now) {
+				return nil, errors.New("el apto médico está vencido")
+			}
+		}
+		doc.Status = domain.RosterDocumentValid
+		doc.ExpirationDate = input.ExpirationDate
+		doc.RejectionNotes = ""
+	} else {
+		if strings.TrimSpace(input.Notes) == "" {
+			return nil, errors.New("el rechazo requiere un motivo")
+		}
+		doc.Status = domain.RosterDocumentRejected
+		doc.ExpirationDate = nil
+		doc.RejectionNotes = input.Notes
+	}
+
+	if err := s.registrationRepo.Update(ctx, registration); err != nil {
+		return nil, err
+	}
+	return registration, nil
+}
+
+func (s *TeamRegistrationService) findRosterDocument(ctx context.Context, clubID, registrationID, dni, docType string) (*domain.TeamRegistration, *domain.RosterDocument, error) {
+	registration, err := s.getRegistration(ctx, clubID, registrationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	player := registration.FindPlayer(normalizeDNI(dni))
+	if player == nil {
+		return nil, nil, errors.New("el jugador no está en la lista de buena fe")
+	}
+	doc := player.FindDocument(docType)
+	if doc == nil {
+		return nil, nil, ErrRosterDocumentNotFound
+	}
+	return registration, doc, nil
+}
+
+// ListRegistrations lista las inscripciones de un torneo (vista del organizador)
+func (s *TeamRegistrationService) ListRegistrations(ctx context.Context, clubID, tournamentID string, status domain.RegistrationStatus) ([]domain.TeamRegistration, error) {
+	tID, err := uuid.Parse(tournamentID)
+	if err != nil {
+		return nil, errors.New("ID de torneo inválido")
+	}
+	registrations, err := s.registrationRepo.ListByTournament(ctx, clubID, tID, status)
+	if err != nil {
+		return nil, err
+	}
+	if registrations == nil {
+		registrations = []domain.TeamRegistration{}
+	}
+	return registrations, nil
+}
+
+// CheckRosterEligibility verifica la documentación de cada jugador a la fecha de inicio del torneo
+func (s *TeamRegistrationService) CheckRosterEligibility(ctx context.Context, clubID, registrationID string) (*domain.RosterEligibility, error) {
+	registration, err := s.getRegistration(ctx, clubID, registrationID)
+	if err != nil {
+		return nil, err
+	}
+	tournament, err := s.repo.GetTournament(ctx, clubID, registration.TournamentID.String())
+	if err != nil || tournament == nil {
+		return nil, errors.New("torneo no encontrado")
+	}
+	return s.rosterEligibility(registration, tournament), nil
+}
+
+func (s *TeamRegistrationService) rosterEligibility(registration *domain.TeamRegistration, tournament *domain.Tournament) *domain.RosterEligibility {
+	// El apto médico debe cubrir el inicio del torneo (o hoy, si ya empezó)
+	at := time.Now()
+	if tournament.StartDate.After(at) {
+		at = tournament.StartDate
+	}
+
+	result := &domain.RosterEligibility{
+		RegistrationID: registration.ID,
+		IsEligible:     true,
+		Players:        make([]domain.RosterPlayerEligibility, 0, len(registration.Roster)),
+	}
+
+	settings := domain.ParseRegistrationSettings(tournament.Settings)
+	if len(registration.Roster) < settings.MinRoster {
+		result.IsEligible = false
+		result.Issues = append(result.Issues, fmt.Sprintf("La lista tiene menos de %d jugadores", settings.MinRoster))
+	}
+
+	for i := range registration.Roster {
+		player := &registration.Roster[i]
+		issues := player.DocumentIssues(at)
+		result.Players = append(result.Players, domain.RosterPlayerEligibility{
+			DNI:        player.DNI,
+			Name:       strings.TrimSpace(player.FirstName + " " + player.LastName),
+			IsEligible: len(issues) == 0,
+			Issues:     issues,
+		})
+		if len(issues) > 0 {
+			result.IsEligible = false
+		}
+	}
+	return result
+}
+
+// ApproveRegistrationInput contiene la aprobación del organizador
+type ApproveRegistrationInput struct {
+	ClubID         string `json:"-"`
+	RegistrationID string `json:"-"`
+	ReviewerID     string `json:"-"`
+	GroupID        string `json:"group_id" binding:"required"`
+}
+
+// ApproveRegistration crea el equipo y lo inscribe en el grupo indicado. Requiere el pago
+// acreditado y la documentación completa del plantel.
+func (s *TeamRegistrationService) ApproveRegistration(ctx context.Context, input ApproveRegistrationInput) (*domain.TeamRegistration, error) {
+	registration, err := s.getRegistration(ctx, input.ClubID, input.RegistrationID)
+	if err != nil {
+		return nil, err
+	}
+	if registration.Status != domain.RegistrationPendingApproval {
+		return nil, fmt.Errorf("la inscripción no está pendiente de aprobación (estado %s)", registration.Status)
+	}
+
+	groupID, err := uuid.Parse(input.GroupID)
+	if err != nil {
+		return nil, errors.New("ID de grupo inválido")
+	}
+	group, err := s.repo.GetGroup(ctx, input.ClubID, input.GroupID)
+	if err != nil || group == nil {
+		return nil, errors.New("grupo no encontrado")
+	}
+	stage, err := s.repo.GetStage(ctx, input.ClubID, group.StageID.String())
+	if err != nil || stage == nil || stage.TournamentID != registration.TournamentID {
+		return nil, errors.New("el grupo no pertenece al torneo")
+	}
+
+	tournament, err := s.repo.GetTournament(ctx, input.ClubID, registration.TournamentID.String())
+	if err != nil || tournament == nil {
+		return nil, errors.New("torneo no encontrado")
+	}
+	if eligibility := s.rosterEligibility(registration, tournament); !eligibility.IsEligible {
+		return nil, errors.New("la lista de buena fe tiene jugadores sin documentación habilitante")
+	}
+
+	team := &domain.Team{
+		ID:      uuid.New(),
+		Name:    registration.TeamName,
+		Contact: strings.TrimSpace(registration.ContactName + " " + registration.ContactEmail + " " + registration.ContactPhone),
+	}
+	if err := s.repo.CreateTeam(ctx, team); err != nil {
+		return nil, err
+	}
+	standing := &domain.Standing{ID: uuid.New(), GroupID: groupID, TeamID: team.ID}
+	if err := s.repo.RegisterTeam(ctx, input.ClubID, standing); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	registration.Status = domain.RegistrationApproved
+	registration.TeamID = &team.ID
+	registration.GroupID = &groupID
+	registration.ReviewedBy = &input.ReviewerID
+	registration.ReviewedAt = &now
+	if err := s.registrationRepo.Update(ctx, registration); err != nil {
+		return nil, err
+	}
+	return registration, nil
+}
+
+// RejectRegistration rechaza la inscripción y devuelve el arancel si ya fue pagado
+func (s *TeamRegistrationService) RejectRegistration(ctx context.Context, clubID, registrationID, reviewerID, reason string) (*domain.TeamRegistration, error) {
+	registration, err := s.getRegistration(ctx, clubID, registrationID)
+	if err != nil {
+		return nil, err
+	}
+	if registration.Status == domain.RegistrationApproved || registration.Status == domain.RegistrationRejected {
+		return nil, fmt.Errorf("la inscripción ya fue revisada (estado %s)", registration.Status)
+	}
+
+	now := time.Now()
+	registration.Status = domain.RegistrationRejected
+	registration.RejectionReason = reason
+	registration.ReviewedBy = &reviewerID
+	registration.ReviewedAt = &now
+	if err := s.registrationRepo.Update(ctx, registration); err != nil {
+		return nil, err
+	}
+
+	if registration.PaidAt != nil {
+		if err := s.payments.RefundEntryFee(ctx, clubID, registration.ID); err != nil {
+			log.Printf("Entry fee refund failed for registration %s: %v", registration.ID, err)
+		}
+	}
+	return registration, nil
+}
+
+// OnPaymentStatusChanged habilita la revisión de la inscripción cuando se acredita el arancel.
+// Si la inscripción se rechazó antes de que llegara el pago, el arancel se devuelve.
+func (s *TeamRegistrationService) OnPaymentStatusChanged(ctx context.Context, clubID string, referenceID uuid.UUID, status paymentDomain.PaymentStatus) error {
+	registration, err := s.registrationRepo.GetByID(ctx, clubID, referenceID)
+	if err != nil {
+		return err
+	}
+	if registration == nil {
+		return nil // Not a registration reference
+	}
+	if status != paymentDomain.PaymentStatusCompleted || registration.PaidAt != nil {
+		return nil
+	}
+
+	now := time.Now()
+	switch registration.Status {
+	case domain.RegistrationPendingPayment:
+		registration.Status = domain.RegistrationPendingApproval
+		registration.PaidAt = &now
+		return s.registrationRepo.Update(ctx, registration)
+	case domain.RegistrationRejected:
+		registration.PaidAt = &now
+		if err := s.registrationRepo.Update(ctx, registration); err != nil {
+			return err
+		}
+		if err := s.payments.RefundEntryFee(ctx, clubID, registration.ID); err != nil {
+			return fmt.Errorf("el pago llegó después del rechazo y no se pudo devolver: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *TeamRegistrationService) getRegistration(ctx context.Context, clubID, registrationID string) (*domain.TeamRegistration, error) {
+	id, err := uuid.Parse(registrationID)
+	if err != nil {
+		return nil, errors.New("ID de inscripción inválido")
+	}
+	registration, err := s.registrationRepo.GetByID(ctx, clubID, id)
+	if err != nil {
+		return nil, err
+	}
+	if registration == nil {
+		return nil, errors.New("inscripción no encontrada")
+	}
+	return registration, nil
+}
+
+// normalizeDNI quita puntos y espacios ("30.123.456" -> "30123456")
+func normalizeDNI(dni string) string {
+	return strings.NewReplacer(".", "", " ", "", "-", "").Replace(strings.TrimSpace(dni))
+}
@@ -0,0 +1,428 @@
+package application_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	paymentDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/payment/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/storage"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/datatypes"
+)
+
+type MockTeamRegistrationRepo struct {
+	mock.Mock
+}
+
+func (m *MockTeamRegistrationRepo) Create(ctx context.Context, r *domain.TeamRegistration) error {
+	return m.Called(ctx, r).Error(0)
+}
+func (m *MockTeamRegistrationRepo) Update(ctx context.Context, r *domain.TeamRegistration) error {
+	return m.Called(ctx, r).Error(0)
+}
+func (m *MockTeamRegistrationRepo) GetByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.TeamRegistration, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TeamRegistration), args.Error(1)
+}
+func (m *MockTeamRegistrationRepo) ListByTournament(ctx context.Context, clubID string, tournamentID uuid.UUID, status domain.RegistrationStatus) ([]domain.TeamRegistration, error) {
+	args := m.Called(ctx, clubID, tournamentID, status)
+	return args.Get(0).([]domain.TeamRegistration), args.Error(1)
+}
+
+type MockEntryFeeGateway struct {
+	mock.Mock
+}
+
+func (m *MockEntryFeeGateway) CreateEntryFeeCheckout(ctx context.Context, r *domain.TeamRegistration, description string) (uuid.UUID, string, error) {
+	args := m.Called(ctx, r, description)
+	return args.Get(0).(uuid.UUID), args.String(1), args.Error(2)
+}
+func (m *MockEntryFeeGateway) RefundEntryFee(ctx context.Context, clubID string, registrationID uuid.UUID) error {
+	return m.Called(ctx, clubID, registrationID).Error(0)
+}
+
+func interClubTournament(fee string) *domain.Tournament {
+	return &domain.Tournament{
+		ID:        uuid.New(),
+		Name:      "Copa Interclubes",
+		StartDate: time.Now().AddDate(0, 0, 10),
+		Settings:  datatypes.JSON(`{"registration": {"open": true, "entry_fee": "` + fee + `", "min_roster": 2, "max_roster": 3}}`),
+	}
+}
+
+func TestTeamRegistrationService_RegisterExternalTeam(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+
+	input := func(tournamentID uuid.UUID, dnis ...string) application.RegisterExternalTeamInput {
+		roster := []application.RosterPlayerInput{}
+		for _, dni := range dnis {
+			roster = append(roster, application.RosterPlayerInput{DNI: dni, FirstName: "Juan", LastName: "Pérez"})
+		}
+		return application.RegisterExternalTeamInput{
+			ClubID: cID, TournamentID: tournamentID.String(), TeamName: "Visitantes FC",
+			ContactName: "Ana", ContactEmail: "ana@otroclub.com", Roster: roster,
+		}
+	}
+
+	t.Run("Charges entry fee via checkout", func(t *testing.T) {
+		repo := new(MockChampionshipRepo)
+		regRepo := new(MockTeamRegistrationRepo)
+		payments := new(MockEntryFeeGateway)
+		svc := application.NewTeamRegistrationService(repo, regRepo, payments)
+
+		tournament := interClubTournament("25000")
+		paymentID := uuid.New()
+		repo.On("GetTournament", ctx, cID, tournament.ID.String()).Return(tournament, nil)
+		regRepo.On("ListByTournament", ctx, cID, tournament.ID, domain.RegistrationStatus("")).Return([]domain.TeamRegistration{}, nil)
+		regRepo.On("Create", ctx, mock.MatchedBy(func(r *domain.TeamRegistration) bool {
+			return r.Status == domain.RegistrationPendingPayment && r.EntryFee.Equal(decimal.NewFromInt(25000)) && r.Roster[0].DNI == "30123456" &&
+				r.PaymentID != nil && *r.PaymentID == paymentID
+		})).Return(nil)
+		payments.On("CreateEntryFeeCheckout", ctx, mock.Anything, mock.Anything).Return(paymentID, "https://pay/checkout", nil)
+
+		result, err := svc.RegisterExternalTeam(ctx, input(tournament.ID, "30.123.456", "31000111"))
+		assert.NoError(t, err)
+		assert.Equal(t, "https://pay/checkout", result.CheckoutURL)
+		assert.Equal(t, paymentID, *result.Registration.PaymentID)
+		assert.NotEmpty(t, result.AccessToken)
+	})
+
+	t.Run("Failed checkout leaves nothing registered so the roster can retry", func(t *testing.T) {
+		repo := new(MockChampionshipRepo)
+		regRepo := new(MockTeamRegistrationRepo)
+		payments := new(MockEntryFeeGateway)
+		svc := application.NewTeamRegistrationService(repo, regRepo, payments)
+
+		tournament := interClubTournament("25000")
+		repo.On("GetTournament", ctx, cID, tournament.ID.String()).Return(tournament, nil)
+		regRepo.On("ListByTournament", ctx, cID, tournament.ID, domain.RegistrationStatus("")).Return([]domain.TeamRegistration{}, nil)
+		payments.On("CreateEntryFeeCheckout", ctx, mock.Anything, mock.Anything).Return(uuid.Nil, "", errors.New("failed to contact payment gateway")).Once()
+
+		_, err := svc.RegisterExternalTeam(ctx, input(tournament.ID, "30123456", "31000111"))
+		assert.ErrorContains(t, err, "payment gateway")
+		regRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+
+		paymentID := uuid.New()
+		payments.On("CreateEntryFeeCheckout", ctx, mock.Anything, mock.Anything).Return(paymentID, "https://pay/checkout", nil).Once()
+		regRepo.On("Create", ctx, mock.Anything).Return(nil).Once()
+
+		result, err := svc.RegisterExternalTeam(ctx, input(tournament.ID, "30123456", "31000111"))
+		assert.NoError(t, err)
+		assert.Equal(t, "https://pay/checkout", result.CheckoutURL)
+		assert.Equal(t, paymentID, *result.Registration.PaymentID)
+		regRepo.AssertExpectations(t)
+	})
+
+	t.Run("Free tournament goes straight to approval", func(t *testing.T) {
+		repo := new(MockChampionshipRepo)
+		regRepo := new(MockTeamRegistrationRepo)
+		svc := application.NewTeamRegistrationService(repo, regRepo, nil)
+
+		tournament := interClubTournament("0")
+		repo.On("GetTournament", ctx, cID, tournament.ID.String()).Return(tournament, nil)
+		regRepo.On("ListByTournament", ctx, cID, tournament.ID, domain.RegistrationStatus("")).Return([]domain.TeamRegistration{}, nil)
+		regRepo.On("Create", ctx, mock.Anything).Return(nil)
+
+		result, err := svc.RegisterExternalTeam(ctx, input(tournament.ID, "1", "2"))
+		assert.NoError(t, err)
+		assert.Equal(t, domain.RegistrationPendingApproval, result.Registration.Status)
+		assert.Empty(t, result.CheckoutURL)
+	})
+
+	t.Run("Rejects player already registered in another team", func(t *testing.T) {
+		repo := new(MockChampionshipRepo)
+		regRepo := new(MockTeamRegistrationRepo)
+		svc := application.NewTeamRegistrationService(repo, regRepo, nil)
+
+		tournament := interClubTournament("0")
+		repo.On("GetTournament", ctx, cID, tournament.ID.String()).Return(tournament, nil)
+		regRepo.On("ListByTournament", ctx, cID, tournament.ID, domain.RegistrationStatus("")).Return([]domain.TeamRegistration{
+			{Status: domain.RegistrationApproved, Roster: []domain.RosterPlayer{{DNI: "2"}}},
+		}, nil)
+
+		_, err := svc.RegisterExternalTeam(ctx, input(tournament.ID, "1", "2"))
+		assert.ErrorContains(t, err, "ya está inscripto")
+	})
+
+	t.Run("Rejects roster outside limits and closed registration", func(t *testing.T) {
+		repo := new(MockChampionshipRepo)
+		svc := application.NewTeamRegistrationService(repo, nil, nil)
+
+		tournament := interClubTournament("0")
+		closed := &domain.Tournament{ID: uuid.New()}
+		repo.On("GetTournament", ctx, cID, tournament.ID.String()).Return(tournament, nil)
+		repo.On("GetTournament", ctx, cID, closed.ID.String()).Return(closed, nil)
+
+		_, err := svc.RegisterExternalTeam(ctx, input(tournament.ID, "1"))
+		assert.ErrorContains(t, err, "entre 2 y 3")
+
+		_, err = svc.RegisterExternalTeam(ctx, input(closed.ID, "1", "2"))
+		assert.ErrorContains(t, err, "inscripción abierta")
+	})
+}
+
+func TestTeamRegistrationService_RetryEntryFeeCheckout(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	tournament := interClubTournament("25000")
+
+	newRegistration := func(status domain.RegistrationStatus) *domain.TeamRegistration {
+		return &domain.TeamRegistration{
+			ID: uuid.New(), ClubID: cID, TournamentID: tournament.ID, TeamName: "Visitantes FC",
+			Status: status, EntryFee: decimal.NewFromInt(25000), AccessToken: "token-1",
+		}
+	}
+
+	t.Run("Issues a new checkout for a registration pending payment", func(t *testing.T) {
+		repo := new(MockChampionshipRepo)
+		regRepo := new(MockTeamRegistrationRepo)
+		payments := new(MockEntryFeeGateway)
+		svc := application.NewTeamRegistrationService(repo, regRepo, payments)
+
+		registration := newRegistration(domain.RegistrationPendingPayment)
+		paymentID := uuid.New()
+		regRepo.On("GetByID", ctx, cID, registration.ID).Return(registration, nil)
+		repo.On("GetTournament", ctx, cID, tournament.ID.String()).Return(tournament, nil)
+		payments.On("CreateEntryFeeCheckout", ctx, registration, "Inscripción Visitantes FC - Copa Interclubes").Return(paymentID, "https://pay/retry", nil)
+		regRepo.On("Update", ctx, mock.MatchedBy(func(r *domain.TeamRegistration) bool {
+			return r.PaymentID != nil && *r.PaymentID == paymentID
+		})).Return(nil)
+
+		result, err := svc.RetryEntryFeeCheckout(ctx, cID, registration.ID.String(), "token-1")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://pay/retry", result.CheckoutURL)
+		regRepo.AssertExpectations(t)
+	})
+
+	t.Run("Requires the token and a registration pending payment", func(t *testing.T) {
+		regRepo := new(MockTeamRegistrationRepo)
+		svc := application.NewTeamRegistrationService(new(MockChampionshipRepo), regRepo, new(MockEntryFeeGateway))
+
+		pending := newRegistration(domain.RegistrationPendingPayment)
+		paid := newRegistration(domain.RegistrationPendingApproval)
+		regRepo.On("GetByID", ctx, cID, pending.ID).Return(pending, nil)
+		regRepo.On("GetByID", ctx, cID, paid.ID).Return(paid, nil)
+
+		_, err := svc.RetryEntryFeeCheckout(ctx, cID, pending.ID.String(), "wrong")
+		assert.ErrorContains(t, err, "no encontrada")
+
+		_, err = svc.RetryEntryFeeCheckout(ctx, cID, paid.ID.String(), "token-1")
+		assert.ErrorContains(t, err, "no está pendiente de pago")
+	})
+}
+
+func TestTeamRegistrationService_ApproveRegistration(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	tournament := interClubTournament("0")
+	stage := &domain.TournamentStage{ID: uuid.New(), TournamentID: tournament.ID}
+	group := &domain.Group{ID: uuid.New(), StageID: stage.ID}
+	expires := tournament.StartDate.AddDate(1, 0, 0)
+
+	newRegistration := func(docs []domain.RosterDocument) *domain.TeamRegistration {
+		return &domain.TeamRegistration{
+			ID: uuid.New(), ClubID: cID, TournamentID: tournament.ID, TeamName: "Visitantes FC",
+			Status: domain.RegistrationPendingApproval,
+			Roster: []domain.RosterPlayer{{DNI: "1", Documents: docs}, {DNI: "2", Documents: docs}},
+		}
+	}
+
+	t.Run("Creates team and registers it in the group", func(t *testing.T) {
+		repo := new(MockChampionshipRepo)
+		regRepo := new(MockTeamRegistrationRepo)
+		svc := application.NewTeamRegistrationService(repo, regRepo, nil)
+
+		registration := newRegistration([]domain.RosterDocument{
+			{Type: domain.RosterDocumentDNIFront, Status: domain.RosterDocumentValid},
+			{Type: domain.RosterDocumentMedical, Status: domain.RosterDocumentValid, ExpirationDate: &expires},
+		})
+		regRepo.On("GetByID", ctx, cID, registration.ID).Return(registration, nil)
+		repo.On("GetGroup", ctx, cID, group.ID.String()).Return(group, nil)
+		repo.On("GetStage", ctx, cID, stage.ID.String()).Return(stage, nil)
+		repo.On("GetTournament", ctx, cID, tournament.ID.String()).Return(tournament, nil)
+		repo.On("CreateTeam", ctx, mock.MatchedBy(func(team *domain.Team) bool { return team.Name == "Visitantes FC" })).Return(nil)
+		repo.On("RegisterTeam", ctx, cID, mock.MatchedBy(func(s *domain.Standing) bool { return s.GroupID == group.ID })).Return(nil)
+		regRepo.On("Update", ctx, registration).Return(nil)
+
+		approved, err := svc.ApproveRegistration(ctx, application.ApproveRegistrationInput{
+			ClubID: cID, RegistrationID: registration.ID.String(), ReviewerID: "admin", GroupID: group.ID.String(),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.RegistrationApproved, approved.Status)
+		assert.NotNil(t, approved.TeamID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Rejects roster without valid documents", func(t *testing.T) {
+		repo := new(MockChampionshipRepo)
+		regRepo := new(MockTeamRegistrationRepo)
+		svc := application.NewTeamRegistrationService(repo, regRepo, nil)
+
+		expired := tournament.StartDate.AddDate(0, 0, -1)
+		registration := newRegistration([]domain.RosterDocument{
+			{Type: domain.RosterDocumentDNIFront, Status: domain.RosterDocumentValid},
+			{Type: domain.RosterDocumentMedical, Status: domain.RosterDocumentValid, ExpirationDate: &expired},
+		})
+		regRepo.On("GetByID", ctx, cID, registration.ID).Return(registration, nil)
+		repo.On("GetGroup", ctx, cID, group.ID.String()).Return(group, nil)
+		repo.On("GetStage", ctx, cID, stage.ID.String()).Return(stage, nil)
+		repo.On("GetTournament", ctx, cID, tournament.ID.String()).Return(tournament, nil)
+
+		_, err := svc.ApproveRegistration(ctx, application.ApproveRegistrationInput{
+			ClubID: cID, RegistrationID: registration.ID.String(), GroupID: group.ID.String(),
+		})
+		assert.ErrorContains(t, err, "documentación")
+		repo.AssertNotCalled(t, "CreateTeam", mock.Anything, mock.Anything)
+
+		eligibility, err := svc.CheckRosterEligibility(ctx, cID, registration.ID.String())
+		assert.NoError(t, err)
+		assert.False(t, eligibility.IsEligible)
+		assert.Contains(t, eligibility.Players[0].Issues, "Apto médico vencido")
+	})
+}
+
+func TestTeamRegistrationService_PaymentAndRejection(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+
+	t.Run("Completed payment moves registration to approval", func(t *testing.T) {
+		regRepo := new(MockTeamRegistrationRepo)
+		svc := application.NewTeamRegistrationService(nil, regRepo, nil)
+
+		registration := &domain.TeamRegistration{ID: uuid.New(), Status: domain.RegistrationPendingPayment}
+		regRepo.On("GetByID", ctx, cID, registration.ID).Return(registration, nil)
+		regRepo.On("Update", ctx, registration).Return(nil).Once()
+
+		assert.NoError(t, svc.OnPaymentStatusChanged(ctx, cID, registration.ID, paymentDomain.PaymentStatusCompleted))
+		assert.Equal(t, domain.RegistrationPendingApproval, registration.Status)
+		assert.NotNil(t, registration.PaidAt)
+	})
+
+	t.Run("Rejecting a paid registration refunds the fee", func(t *testing.T) {
+		regRepo := new(MockTeamRegistrationRepo)
+		payments := new(MockEntryFeeGateway)
+		svc := application.NewTeamRegistrationService(nil, regRepo, payments)
+
+		paidAt := time.Now()
+		registration := &domain.TeamRegistration{ID: uuid.New(), Status: domain.RegistrationPendingApproval, PaidAt: &paidAt}
+		regRepo.On("GetByID", ctx, cID, registration.ID).Return(registration, nil)
+		regRepo.On("Update", ctx, registration).Return(nil)
+		payments.On("RefundEntryFee", ctx, cID, registration.ID).Return(nil).Once()
+
+		rejected, err := svc.RejectRegistration(ctx, cID, registration.ID.String(), "admin", "Cupo completo")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.RegistrationRejected, rejected.Status)
+		payments.AssertExpectations(t)
+	})
+
+	t.Run("Payment arriving after rejection is refunded once", func(t *testing.T) {
+		regRepo := new(MockTeamRegistrationRepo)
+		payments := new(MockEntryFeeGateway)
+		svc := application.NewTeamRegistrationService(nil, regRepo, payments)
+
+		registration := &domain.TeamRegistration{ID: uuid.New(), Status: domain.RegistrationRejected}
+		regRepo.On("GetByID", ctx, cID, registration.ID).Return(registration, nil)
+		regRepo.On("Update", ctx, registration).Return(nil).Once()
+		payments.On("RefundEntryFee", ctx, cID, registration.ID).Return(nil).Once()
+
+		assert.NoError(t, svc.OnPaymentStatusChanged(ctx, cID, registration.ID, paymentDomain.PaymentStatusCompleted))
+		assert.Equal(t, domain.RegistrationRejected, registration.Status)
+		assert.NotNil(t, registration.PaidAt)
+
+		// A repeated webhook does not refund again
+		assert.NoError(t, svc.OnPaymentStatusChanged(ctx, cID, registration.ID, paymentDomain.PaymentStatusCompleted))
+		payments.AssertExpectations(t)
+		regRepo.AssertExpectations(t)
+	})
+}
+
+func TestTeamRegistrationService_RosterDocuments(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	pdf := []byte("%PDF-1.4 apto medico")
+
+	setup := func(t *testing.T) (*application.TeamRegistrationService, *MockTeamRegistrationRepo, *domain.TeamRegistration) {
+		files, err := storage.NewLocalStorage(t.TempDir())
+		assert.NoError(t, err)
+		regRepo := new(MockTeamRegistrationRepo)
+		svc := application.NewTeamRegistrationService(nil, regRepo, nil)
+		svc.SetDocumentStorage(files, nil)
+
+		registration := &domain.TeamRegistration{
+			ID: uuid.New(), ClubID: cID, Status: domain.RegistrationPendingApproval, AccessToken: "token",
+			Roster: []domain.RosterPlayer{{DNI: "30123456"}},
+		}
+		regRepo.On("GetByID", ctx, cID, registration.ID).Return(registration, nil)
+		regRepo.On("Update", ctx, registration).Return(nil)
+		return svc, regRepo, registration
+	}
+
+	upload := func(svc *application.TeamRegistrationService, registration *domain.TeamRegistration, docType string, body []byte) error {
+		_, err := svc.AttachRosterDocument(ctx, application.AttachRosterDocumentInput{
+			ClubID: cID, RegistrationID: registration.ID.String(), Token: "token", DNI: "30.123.456",
+			Type: docType, FileName: "apto.pdf", Size: int64(len(body)), Body: bytes.NewReader(body),
+		})
+		return err
+	}
+
+	t.Run("Uploaded file is stored and pending review", func(t *testing.T) {
+		svc, _, registration := setup(t)
+
+		assert.NoError(t, upload(svc, registration, domain.RosterDocumentMedical, pdf))
+		doc := registration.Roster[0].FindDocument(domain.RosterDocumentMedical)
+		assert.Equal(t, domain.RosterDocumentPending, doc.Status)
+		assert.Nil(t, doc.ExpirationDate)
+		assert.Contains(t, registration.Roster[0].DocumentIssues(time.Now()), "Apto médico pendiente de revisión")
+
+		body, stored, err := svc.OpenRosterDocument(ctx, cID, registration.ID.String(), "30123456", domain.RosterDocumentMedical)
+		assert.NoError(t, err)
+		defer body.Close()
+		data, _ := io.ReadAll(body)
+		assert.Equal(t, pdf, data)
+		assert.Equal(t, "application/pdf", stored.ContentType)
+
+		assert.ErrorIs(t, upload(svc, registration, domain.RosterDocumentDNIFront, []byte("plain text")), application.ErrRosterDocumentUnsupported)
+	})
+
+	t.Run("Medical approval requires an expiration date set by the organizer", func(t *testing.T) {
+		svc, _, registration := setup(t)
+		assert.NoError(t, upload(svc, registration, domain.RosterDocumentMedical, pdf))
+
+		review := application.ReviewRosterDocumentInput{
+			ClubID: cID, RegistrationID: registration.ID.String(), DNI: "30123456",
+			Type: domain.RosterDocumentMedical, ReviewerID: "admin", Approve: true,
+		}
+		_, err := svc.ReviewRosterDocument(ctx, review)
+		assert.ErrorContains(t, err, "vencimiento")
+
+		expires := time.Now().AddDate(1, 0, 0)
+		review.ExpirationDate = &expires
+		_, err = svc.ReviewRosterDocument(ctx, review)
+		assert.NoError(t, err)
+
+		doc := registration.Roster[0].FindDocument(domain.RosterDocumentMedical)
+		assert.Equal(t, domain.RosterDocumentValid, doc.Status)
+		assert.Equal(t, []string{"Falta DNI"}, registration.Roster[0].DocumentIssues(time.Now()))
+	})
+
+	t.Run("Uploads are rejected without a configured storage", func(t *testing.T) {
+		svc := application.NewTeamRegistrationService(nil, new(MockTeamRegistrationRepo), nil)
+		_, err := svc.AttachRosterDocument(ctx, application.AttachRosterDocumentInput{
+			ClubID: cID, RegistrationID: uuid.NewString(), Type: domain.RosterDocumentMedical, Body: bytes.NewReader(pdf),
+		})
+		assert.ErrorIs(t, err, application.ErrRosterStorageUnavailable)
+	})
+}
@@ -3,10 +3,12 @@ package application
 import (
 	"context"
 	"errors"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"gorm.io/datatypes"
 )
 
 // BookingService defines the dependency on the Booking module
@@ -22,22 +24,42 @@ type ChampionshipUseCases struct {
 	repo           domain.ChampionshipRepository
 	bookingService BookingService
 	userService    UserService
+	eventRepo      domain.MatchEventRepository
+	listeners      []domain.MatchResultListener
 }
 
-func NewChampionshipUseCases(repo domain.ChampionshipRepository, bookingService BookingService, userService UserService) *ChampionshipUseCases {
+func NewChampionshipUseCases(repo domain.ChampionshipRepository, bookingService BookingService, userService UserService, eventRepo domain.MatchEventRepository) *ChampionshipUseCases {
 	return &ChampionshipUseCases{
 		repo:           repo,
 		bookingService: bookingService,
 		userService:    userService,
+		eventRepo:      eventRepo,
 	}
 }
 
+// RegisterResultListener registers a module to be notified when a match result is stored.
+func (uc *ChampionshipUseCases) RegisterResultListener(listener domain.MatchResultListener) {
+	uc.listeners = append(uc.listeners, listener)
+}
+
 type CreateTournamentInput struct {
-	ClubID    string    `json:"club_id"`
-	Name      string    `json:"name" binding:"required"`
-	Sport     string    `json:"sport" binding:"required"`
-	Category  string    `json:"category"`
-	StartDate time.Time `json:"start_date" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
+	ClubID    string         `json:"club_id"`
+	Name      string         `json:"name" binding:"required"`
+	Sport     string         `json:"sport" binding:"required"`
+	Category  string         `json:"category"`
+	StartDate time.Time      `json:"start_date" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
+	EndDate   *time.Time     `json:"end_date,omitempty"`
+	Settings  datatypes.JSON `json:"settings,omitempty"`
+
+	// Stages se crean junto con el torneo, en la misma transacción
+	Stages []InitialStageInput `json:"stages,omitempty"`
+}
+
+// InitialStageInput describe una fase (y sus grupos) creada con el torneo; el orden es el de la lista
+type InitialStageInput struct {
+	Name   string   `json:"name" binding:"required"`
+	Type   string   `json:"type" binding:"required"` // "GROUP" or "KNOCKOUT"
+	Groups []string `json:"groups,omitempty"`
 }
 
 func (uc *ChampionshipUseCases) CreateTournament(ctx context.Context, input CreateTournamentInput) (*domain.Tournament, error) {
@@ -49,6 +71,22 @@ func (uc *ChampionshipUseCases) CreateTournament(ctx context.Context, input Crea
 		Category:  input.Category,
 		Status:    domain.TournamentDraft, // Initial status
 		StartDate: input.StartDate,
+		EndDate:   input.EndDate,
+		Settings:  input.Settings,
+	}
+	for i, in := range input.Stages {
+		stage := domain.TournamentStage{
+			ID:           uuid.New(),
+			TournamentID: tournament.ID,
+			Order:        i + 1,
+			Name:         in.Name,
+			Type:         domain.StageType(in.Type),
+			Status:       domain.StagePending,
+		}
+		for _, name := range in.Groups {
+			stage.Groups = append(stage.Groups, domain.Group{ID: uuid.New(), StageID: stage.ID, Name: name})
+		}
+		tournament.Stages = append(tournament.Stages, stage)
 	}
 
 	if err := uc.repo.CreateTournament(ctx, tournament); err != nil {
@@ -216,57 +254,7 @@ func (uc *ChampionshipUseCases) recalculateStandings(ctx context.Context, clubID
 		return err
 	}
 
-	standingMap := make(map[uuid.UUID]*domain.Standing)
-	for i := range standings {
-		s := &standings[i]
-		// Reset stats
-		s.Points = 0
-		s.Played = 0
-		s.Won = 0
-		s.Drawn = 0
-		s.Lost = 0
-		s.GoalsFor = 0
-		s.GoalsAgainst = 0
-		s.GoalDifference = 0
-		standingMap[s.TeamID] = s
-	}
-
-	for _, m := range matches {
-		if m.Status != domain.MatchCompleted || m.HomeScore == nil || m.AwayScore == nil {
-			continue
-		}
-
-		home, okH := standingMap[m.HomeTeamID]
-		away, okA := standingMap[m.AwayTeamID]
-		if !okH || !okA {
-			continue // Should not happen if referential integrity holds
-		}
-
-		home.Played++
-		away.Played++
-		home.GoalsFor += *m.HomeScore
-		home.GoalsAgainst += *m.AwayScore
-		away.GoalsFor += *m.AwayScore
-		away.GoalsAgainst += *m.HomeScore
-
-		home.GoalDifference = home.GoalsFor - home.GoalsAgainst
-		away.GoalDifference = away.GoalsFor - away.GoalsAgainst
-
-		if *m.HomeScore > *m.AwayScore {
-			home.Points += 3
-			home.Won++
-			away.Lost++
-		} else if *m.AwayScore > *m.HomeScore {
-			away.Points += 3
-			away.Won++
-			home.Lost++
-		} else {
-			home.Points += 1
-			away.Points += 1
-			home.Drawn++
-			away.Drawn++
-		}
-	}
+	domain.CalculateStandings(standings, matches, false)
 
 	// Identify changed standings
 	var standingsToUpdate []domain.Standing
@@ -378,23 +366,45 @@ func (uc *ChampionshipUseCases) UpdateMatchResult(ctx context.Context, input Upd
 		}
 	}
 
-	// XP Update
+	// XP Update (weighted by recorded match events when available)
 	homeMembers, _ := uc.repo.GetTeamMembers(ctx, match.HomeTeamID.String())
 	awayMembers, _ := uc.repo.GetTeamMembers(ctx, match.AwayTeamID.String())
 
 	homeWon := input.HomeScore > input.AwayScore
 	awayWon := input.AwayScore > input.HomeScore
 
+	performances := uc.matchPerformances(ctx, input.ClubID, match.ID)
+
 	for _, uid := range homeMembers {
-		_ = uc.userService.UpdateMatchStats(ctx, input.ClubID, uid, homeWon, 100)
+		_ = uc.userService.UpdateMatchStats(ctx, input.ClubID, uid, homeWon, performances[uid].XP())
 	}
 	for _, uid := range awayMembers {
-		_ = uc.userService.UpdateMatchStats(ctx, input.ClubID, uid, awayWon, 100)
+		_ = uc.userService.UpdateMatchStats(ctx, input.ClubID, uid, awayWon, performances[uid].XP())
+	}
+
+	// Notify listeners (suspensions, live scoring...). A failing listener must not undo the result.
+	for _, listener := range uc.listeners {
+		if err := listener.OnMatchCompleted(ctx, input.ClubID, match); err != nil {
+			log.Printf("Match result listener failed for match %s: %v", match.ID, err)
+		}
 	}
 
 	return nil
 }
 
+// matchPerformances returns per-player performance for a match. Players without
+// events (or when events are not enabled) get the zero value, i.e. base XP only.
+func (uc *ChampionshipUseCases) matchPerformances(ctx context.Context, clubID string, matchID uuid.UUID) map[string]domain.PlayerMatchPerformance {
+	if uc.eventRepo == nil {
+		return map[string]domain.PlayerMatchPerformance{}
+	}
+	events, err := uc.eventRepo.GetByMatchID(ctx, clubID, matchID)
+	if err != nil {
+		return map[string]domain.PlayerMatchPerformance{}
+	}
+	return domain.BuildMatchPerformances(events)
+}
+
 type ScheduleMatchInput struct {
 	ClubID  string    `json:"club_id"`
 	MatchID string    `json:"match_id"`
@@ -420,14 +430,16 @@ func (uc *ChampionshipUseCases) ScheduleMatch(ctx context.Context, input Schedul
 }
 
 type CreateTeamInput struct {
-	ClubID string `json:"club_id"`
-	Name   string `json:"name"`
+	ClubID    string  `json:"club_id"`
+	Name      string  `json:"name"`
+	CaptainID *string `json:"captain_id,omitempty"`
 }
 
 func (uc *ChampionshipUseCases) CreateTeam(ctx context.Context, input CreateTeamInput) (*domain.Team, error) {
 	team := &domain.Team{
-		ID:   uuid.New(),
-		Name: input.Name,
+		ID:        uuid.New(),
+		Name:      input.Name,
+		CaptainID: input.CaptainID,
 	}
 	if err := uc.repo.CreateTeam(ctx, team); err != nil {
 		return nil, err
@@ -439,6 +451,83 @@ func (uc *ChampionshipUseCases) AddMember(ctx context.Context, clubID, teamID, u
 	return uc.repo.AddMember(ctx, teamID, userID)
 }
 
+func (uc *ChampionshipUseCases) GetTeamMembers(ctx context.Context, teamID string) ([]string, error) {
+	return uc.repo.GetTeamMembers(ctx, teamID)
+}
+
+// GetTeamsMembers obtiene los integrantes de varios equipos en una sola consulta
+func (uc *ChampionshipUseCases) GetTeamsMembers(ctx context.Context, teamIDs []string) (map[string][]string, error) {
+	return uc.repo.GetTeamsMembers(ctx, teamIDs)
+}
+
+// GetTeamStandings obtiene las inscripciones (filas de tabla) de un equipo en los torneos del club
+func (uc *ChampionshipUseCases) GetTeamStandings(ctx context.Context, clubID, teamID string) ([]domain.Standing, error) {
+	return uc.repo.GetTeamStandings(ctx, clubID, teamID)
+}
+
+func (uc *ChampionshipUseCases) GetMatch(ctx context.Context, clubID, id string) (*domain.TournamentMatch, error) {
+	return uc.repo.GetMatch(ctx, clubID, id)
+}
+
+type AddMatchInput struct {
+	ClubID       string    `json:"club_id"`
+	TournamentID string    `json:"tournament_id"`
+	StageID      string    `json:"stage_id"`
+	GroupID      *string   `json:"group_id,omitempty"`
+	HomeTeamID   string    `json:"home_team_id"`
+	AwayTeamID   string    `json:"away_team_id"`
+	Date         time.Time `json:"date"`
+	Round        string    `json:"round"`
+	Location     string    `json:"location"`
+}
+
+// AddMatch creates a single match outside the generated fixture (no court booking involved).
+func (uc *ChampionshipUseCases) AddMatch(ctx context.Context, input AddMatchInput) (*domain.TournamentMatch, error) {
+	tournamentID, err := uuid.Parse(input.TournamentID)
+	if err != nil {
+		return nil, errors.New("invalid tournament ID")
+	}
+	stageID, err := uuid.Parse(input.StageID)
+	if err != nil {
+		return nil, errors.New("invalid stage ID")
+	}
+	homeID, err := uuid.Parse(input.HomeTeamID)
+	if err != nil {
+		return nil, errors.New("invalid team ID")
+	}
+	awayID, err := uuid.Parse(input.AwayTeamID)
+	if err != nil {
+		return nil, errors.New("invalid team ID")
+	}
+	if homeID == awayID {
+		return nil, errors.New("a team cannot play against itself")
+	}
+
+	match := &domain.TournamentMatch{
+		ID:           uuid.New(),
+		TournamentID: tournamentID,
+		StageID:      stageID,
+		HomeTeamID:   homeID,
+		AwayTeamID:   awayID,
+		Status:       domain.MatchScheduled,
+		Date:         input.Date,
+		Round:        input.Round,
+		Location:     input.Location,
+	}
+	if input.GroupID != nil {
+		groupID, err := uuid.Parse(*input.GroupID)
+		if err != nil {
+			return nil, errors.New("invalid group ID")
+		}
+		match.GroupID = &groupID
+	}
+
+	if err := uc.repo.CreateMatch(ctx, input.ClubID, match); err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
 func (uc *ChampionshipUseCases) GetMyMatches(ctx context.Context, clubID, userID string) ([]domain.TournamentMatch, error) {
 	return uc.repo.GetMatchesByUserID(ctx, clubID, userID)
 }
@@ -137,6 +137,16 @@ func (m *MockChampionshipRepo) GetTeamMembers(ctx context.Context, id string) ([
 	return res, args.Error(1)
 }
 
+func (m *MockChampionshipRepo) GetTeamsMembers(ctx context.Context, teamIDs []string) (map[string][]string, error) {
+	args := m.Called(ctx, teamIDs)
+	return args.Get(0).(map[string][]string), args.Error(1)
+}
+
+func (m *MockChampionshipRepo) GetTeamStandings(ctx context.Context, clubID, teamID string) ([]domain.Standing, error) {
+	args := m.Called(ctx, clubID, teamID)
+	return args.Get(0).([]domain.Standing), args.Error(1)
+}
+
 func (m *MockChampionshipRepo) CreateTeam(ctx context.Context, team *domain.Team) error {
 	args := m.Called(ctx, team)
 	return args.Error(0)
@@ -170,6 +180,10 @@ func (m *MockBookingService) CreateSystemBooking(clubID, courtID string, start,
 	return &id, args.Error(1)
 }
 
+func (m *MockBookingService) CancelSystemBooking(clubID string, bookingID uuid.UUID) error {
+	return m.Called(clubID, bookingID).Error(0)
+}
+
 type MockUserService struct {
 	mock.Mock
 }
@@ -183,7 +197,7 @@ func (m *MockUserService) UpdateMatchStats(ctx context.Context, clubID, userID s
 
 func TestChampionshipUseCases_Tournament(t *testing.T) {
 	repo := new(MockChampionshipRepo)
-	uc := application.NewChampionshipUseCases(repo, nil, nil)
+	uc := application.NewChampionshipUseCases(repo, nil, nil, nil)
 	clubID := uuid.New().String()
 
 	t.Run("CreateTournament Success", func(t *testing.T) {
@@ -218,7 +232,7 @@ func TestChampionshipUseCases_Tournament(t *testing.T) {
 
 func TestChampionshipUseCases_StagesAndGroups(t *testing.T) {
 	repo := new(MockChampionshipRepo)
-	uc := application.NewChampionshipUseCases(repo, nil, nil)
+	uc := application.NewChampionshipUseCases(repo, nil, nil, nil)
 	cID := "club-1"
 	tID := uuid.New().String()
 
@@ -272,7 +286,7 @@ func TestChampionshipUseCases_StagesAndGroups(t *testing.T) {
 
 func TestChampionshipUseCases_Teams(t *testing.T) {
 	repo := new(MockChampionshipRepo)
-	uc := application.NewChampionshipUseCases(repo, nil, nil)
+	uc := application.NewChampionshipUseCases(repo, nil, nil, nil)
 	cID := "club-1"
 	gID := uuid.New().String()
 
@@ -301,7 +315,7 @@ func TestChampionshipUseCases_Teams(t *testing.T) {
 
 func TestChampionshipUseCases_FixtureLogic(t *testing.T) {
 	repo := new(MockChampionshipRepo)
-	uc := application.NewChampionshipUseCases(repo, nil, nil)
+	uc := application.NewChampionshipUseCases(repo, nil, nil, nil)
 	cID := "club-1"
 	gID := uuid.New().String()
 
@@ -347,7 +361,7 @@ func TestChampionshipUseCases_FixtureLogic(t *testing.T) {
 func TestChampionshipUseCases_Results(t *testing.T) {
 	repo := new(MockChampionshipRepo)
 	userSvc := new(MockUserService)
-	uc := application.NewChampionshipUseCases(repo, nil, userSvc)
+	uc := application.NewChampionshipUseCases(repo, nil, userSvc, nil)
 	cID := "club-1"
 	mID := uuid.New().String()
 
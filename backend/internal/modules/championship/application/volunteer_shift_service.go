@@ -0,0 +1,431 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"github.com/shopspring/decimal"
+)
+
+// checkInEarlyWindow es cuánto antes del turno se permite hacer check-in
+const checkInEarlyWindow = time.Hour
+
+// VolunteerCreditGateway registra las horas acreditadas como pago en especie (LABOR_EXCHANGE)
+type VolunteerCreditGateway interface {
+	CreditVolunteerHours(ctx context.Context, clubID, userID string, amount decimal.Decimal, referenceID uuid.UUID, referenceType, notes string) (uuid.UUID, error)
+}
+
+// VolunteerShiftService gestiona los turnos abiertos de voluntariado, la asistencia y las horas acumuladas
+type VolunteerShiftService struct {
+	repo          domain.ChampionshipRepository
+	volunteerRepo domain.VolunteerRepository
+	shiftRepo     domain.VolunteerShiftRepository
+	volunteers    VolunteerServiceInterface
+	credits       VolunteerCreditGateway
+}
+
+// NewVolunteerShiftService crea una nueva instancia del servicio
+func NewVolunteerShiftService(
+	repo domain.ChampionshipRepository,
+	volunteerRepo domain.VolunteerRepository,
+	shiftRepo domain.VolunteerShiftRepository,
+	volunteers VolunteerServiceInterface,
+	credits VolunteerCreditGateway,
+) *VolunteerShiftService {
+	return &VolunteerShiftService{
+		repo:          repo,
+		volunteerRepo: volunteerRepo,
+		shiftRepo:     shiftRepo,
+		volunteers:    volunteers,
+		credits:       credits,
+	}
+}
+
+// CreateShiftInput contiene los datos de un turno abierto
+type CreateShiftInput struct {
+	ClubID      string               `json:"-"`
+	MatchID     string               `json:"-"`
+	CreatedBy   string               `json:"-"`
+	Role        domain.VolunteerRole `json:"role" binding:"required"`
+	StartTime   time.Time            `json:"start_time" binding:"required"`
+	EndTime     time.Time            `json:"end_time" binding:"required"`
+	Capacity    int                  `json:"capacity" binding:"required,min=1"`
+	Description string               `json:"description"`
+}
+
+// CreateShift abre un turno de voluntariado en un partido
+func (s *VolunteerShiftService) CreateShift(ctx context.Context, input CreateShiftInput) (*domain.VolunteerShift, error) {
+	if !input.EndTime.After(input.StartTime) {
+		return nil, errors.New("el turno debe terminar después de empezar")
+	}
+	match, err := s.repo.GetMatch(ctx, input.ClubID, input.MatchID)
+	if err != nil || match == nil {
+		return nil, errors.New("partido no encontrado")
+	}
+
+	shift := &domain.VolunteerShift{
+		ID:          uuid.New(),
+		ClubID:      input.ClubID,
+		MatchID:     match.ID,
+		Role:        input.Role,
+		StartTime:   input.StartTime,
+		EndTime:     input.EndTime,
+		Capacity:    input.Capacity,
+		Description: input.Description,
+		CreatedBy:   input.CreatedBy,
+	}
+	if err := s.shiftRepo.CreateShift(ctx, shift); err != nil {
+		return nil, err
+	}
+	return shift, nil
+}
+
+// DeleteShift elimina un turno y sus inscripciones; no se puede si alguien ya registró asistencia
+func (s *VolunteerShiftService) DeleteShift(ctx context.Context, clubID, shiftID string) error {
+	id, err := uuid.Parse(shiftID)
+	if err != nil {
+		return errors.New("ID de turno inválido")
+	}
+	return s.shiftRepo.DeleteShift(ctx, clubID, id)
+}
+
+// ListMatchShifts obtiene los turnos de un partido con la cantidad de anotados
+func (s *VolunteerShiftService) ListMatchShifts(ctx context.Context, clubID, matchID string) ([]domain.VolunteerShift, error) {
+	mID, err := uuid.Parse(matchID)
+	if err != nil {
+		return nil, errors.New("ID de partido inválido")
+	}
+	shifts, err := s.shiftRepo.ListShiftsByMatch(ctx, clubID, mID)
+	if err != nil {
+		return nil, err
+	}
+	return s.withSignUps(ctx, clubID, shifts)
+}
+
+// ListOpenShifts obtiene los turnos del período que todavía tienen lugares libres
+func (s *VolunteerShiftService) ListOpenShifts(ctx context.Context, clubID string, from, to time.Time) ([]domain.VolunteerShift, error) {
+	shifts, err := s.shiftRepo.ListShifts(ctx, clubID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	shifts, err = s.withSignUps(ctx, clubID, shifts)
+	if err != nil {
+		return nil, err
+	}
+
+	open := []domain.VolunteerShift{}
+	for _, shift := range shifts {
+		if shift.Available() > 0 {
+			open = append(open, shift)
+		}
+	}
+	return open, nil
+}
+
+// SignUp anota al socio en un turno abierto respetando el cupo del turno y del rol en el partido
+func (s *VolunteerShiftService) SignUp(ctx context.Context, clubID, shiftID, userID string) (*domain.VolunteerAssignment, error) {
+	shift, err := s.getShift(ctx, clubID, shiftID)
+	if err != nil {
+		return nil, err
+	}
+	if !shift.StartTime.After(time.Now()) {
+		return nil, errors.New("el turno ya comenzó")
+	}
+
+	// El cupo del rol en el partido es la suma de los turnos; también cuenta a los asignados por un administrador
+	matchShifts, err := s.shiftRepo.ListShiftsByMatch(ctx, clubID, shift.MatchID)
+	if err != nil {
+		return nil, err
+	}
+	roleCapacity := 0
+	for _, ms := range matchShifts {
+		if ms.Role == shift.Role {
+			roleCapacity += ms.Capacity
+		}
+	}
+	if err := s.volunteers.ValidateAssignment(ctx, clubID, shift.MatchID, shift.Role, roleCapacity); err != nil {
+		return nil, err
+	}
+
+	assignment := &domain.VolunteerAssignment{
+		ID:         uuid.New(),
+		ClubID:     clubID,
+		MatchID:    shift.MatchID,
+		UserID:     userID,
+		Role:       shift.Role,
+		ShiftID:    &shift.ID,
+		AssignedBy: userID,
+		AssignedAt: time.Now(),
+	}
+	// El cupo del turno y la inscripción duplicada se controlan con el turno bloqueado
+	if err := s.shiftRepo.SignUp(ctx, assignment); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+// CancelSignUp da de baja la inscripción propia antes del comienzo del turno
+func (s *VolunteerShiftService) CancelSignUp(ctx context.Context, clubID, assignmentID, userID string) error {
+	assignment, shift, err := s.getOwnShiftAssignment(ctx, clubID, assignmentID, userID)
+	if err != nil {
+		return err
+	}
+	if !shift.StartTime.After(time.Now()) || assignment.CheckInAt != nil {
+		return errors.New("el turno ya comenzó")
+	}
+	return s.volunteerRepo.Delete(ctx, clubID, assignment.ID)
+}
+
+// CheckIn registra la llegada del voluntario (desde una hora antes del turno hasta su fin)
+func (s *VolunteerShiftService) CheckIn(ctx context.Context, clubID, assignmentID, userID string) (*domain.VolunteerAssignment, error) {
+	assignment, shift, err := s.getOwnShiftAssignment(ctx, clubID, assignmentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if assignment.CheckInAt != nil {
+		return nil, errors.New("ya registraste tu llegada")
+	}
+
+	now := time.Now()
+	if now.Before(shift.StartTime.Add(-checkInEarlyWindow)) || now.After(shift.EndTime) {
+		return nil, errors.New("el check-in solo está habilitado durante el turno")
+	}
+
+	assignment.CheckInAt = &now
+	if err := s.volunteerRepo.Update(ctx, assignment); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+// CheckOut registra la salida y deja las horas trabajadas pendientes de aprobación
+func (s *VolunteerShiftService) CheckOut(ctx context.Context, clubID, assignmentID, userID string) (*domain.VolunteerAssignment, error) {
+	assignment, _, err := s.getOwnShiftAssignment(ctx, clubID, assignmentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if assignment.CheckInAt == nil {
+		return nil, errors.New("primero tenés que registrar tu llegada")
+	}
+	if assignment.CheckOutAt != nil {
+		return nil, errors.New("ya registraste tu salida")
+	}
+
+	now := time.Now()
+	assignment.CheckOutAt = &now
+	assignment.Hours = decimal.NewFromFloat(now.Sub(*assignment.CheckInAt).Hours()).Round(2)
+	assignment.HoursStatus = domain.VolunteerHoursPendingApproval
+	if err := s.volunteerRepo.Update(ctx, assignment); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+// ListPendingHours lista las horas registradas que esperan aprobación
+func (s *VolunteerShiftService) ListPendingHours(ctx context.Context, clubID string) ([]domain.VolunteerAssignment, error) {
+	return s.shiftRepo.ListAssignmentsByHoursStatus(ctx, clubID, "", domain.VolunteerHoursPendingApproval)
+}
+
+// ReviewHoursInput contiene la revisión de un administrador sobre las horas registradas
+type ReviewHoursInput struct {
+	ClubID       string           `json:"-"`
+	AssignmentID string           `json:"-"`
+	ReviewerID   string           `json:"-"`
+	Approve      bool             `json:"approve"`
+	Hours        *decimal.Decimal `json:"hours,omitempty"` // Corrige las horas registradas antes de aprobar
+}
+
+// ReviewHours aprueba (opcionalmente corrigiendo) o rechaza las horas de una asignación
+func (s *VolunteerShiftService) ReviewHours(ctx context.Context, input ReviewHoursInput) (*domain.VolunteerAssignment, error) {
+	assignment, err := s.getAssignment(ctx, input.ClubID, input.AssignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if assignment.HoursStatus != domain.VolunteerHoursPendingApproval {
+		return nil, errors.New("las horas no están pendientes de aprobación")
+	}
+
+	now := time.Now()
+	if input.Approve {
+		if input.Hours != nil {
+			if input.Hours.IsNegative() {
+				return nil, errors.New("las horas no pueden ser negativas")
+			}
+			assignment.Hours = input.Hours.Round(2)
+		}
+		assignment.HoursStatus = domain.VolunteerHoursApproved
+	} else {
+		assignment.HoursStatus = domain.VolunteerHoursRejected
+	}
+	assignment.HoursReviewedBy = input.ReviewerID
+	assignment.HoursReviewedAt = &now
+
+	if err := s.volunteerRepo.Update(ctx, assignment); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+// GetVolunteerHours resume las horas pendientes, aprobadas y acreditadas del socio
+func (s *VolunteerShiftService) GetVolunteerHours(ctx context.Context, clubID, userID string) (*domain.VolunteerHoursSummary, error) {
+	assignments, err := s.shiftRepo.ListAssignmentsByHoursStatus(ctx, clubID, userID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &domain.VolunteerHoursSummary{
+		UserID:      userID,
+		Pending:     decimal.Zero,
+		Approved:    decimal.Zero,
+		Credited:    decimal.Zero,
+		Assignments: []domain.VolunteerAssignment{},
+	}
+	for _, a := range assignments {
+		switch a.HoursStatus {
+		case domain.VolunteerHoursPendingApproval:
+			summary.Pending = summary.Pending.Add(a.Hours)
+		case domain.VolunteerHoursApproved, domain.VolunteerHoursCrediting:
+			summary.Approved = summary.Approved.Add(a.Hours)
+		case domain.VolunteerHoursCredited:
+			summary.Credited = summary.Credited.Add(a.Hours)
+		}
+		summary.Assignments = append(summary.Assignments, a)
+	}
+	return summary, nil
+}
+
+// CreditHoursInput define contra qué cuota se acreditan las horas aprobadas del socio
+type CreditHoursInput struct {
+	ClubID        string          `json:"-"`
+	ApprovedBy    string          `json:"-"`
+	UserID        string          `json:"user_id" binding:"required"`
+	HourlyRate    decimal.Decimal `json:"hourly_rate" binding:"required"`
+	ReferenceID   string          `json:"reference_id" binding:"required"` // Cuota / membresía a la que se imputa
+	ReferenceType string          `json:"reference_type" binding:"required"`
+}
+
+// CreditHours acredita todas las horas aprobadas del socio como un pago LABOR_EXCHANGE
+func (s *VolunteerShiftService) CreditHours(ctx context.Context, input CreditHoursInput) (*domain.VolunteerCredit, error) {
+	if !input.HourlyRate.IsPositive() {
+		return nil, errors.New("el valor de la hora debe ser positivo")
+	}
+	referenceID, err := uuid.Parse(input.ReferenceID)
+	if err != nil {
+		return nil, errors.New("ID de referencia inválido")
+	}
+
+	// Las horas se reservan antes de generar el pago: una segunda acreditación simultánea no las encuentra
+	approved, err := s.shiftRepo.ClaimApprovedHours(ctx, input.ClubID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	hours := decimal.Zero
+	ids := make([]uuid.UUID, 0, len(approved))
+	for _, a := range approved {
+		hours = hours.Add(a.Hours)
+		ids = append(ids, a.ID)
+	}
+	if !hours.IsPositive() {
+		if err := s.shiftRepo.ReleaseHoursClaim(ctx, input.ClubID, ids); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrNoHoursToCredit
+	}
+
+	amount := hours.Mul(input.HourlyRate).Round(2)
+	notes := fmt.Sprintf("Voluntariado: %s horas a %s (aprobado por %s)", hours.String(), input.HourlyRate.StringFixed(2), input.ApprovedBy)
+	paymentID, err := s.credits.CreditVolunteerHours(ctx, input.ClubID, input.UserID, amount, referenceID, input.ReferenceType, notes)
+	if err != nil {
+		if releaseErr := s.shiftRepo.ReleaseHoursClaim(ctx, input.ClubID, ids); releaseErr != nil {
+			log.Printf("Failed to release volunteer hours claim for user %s: %v", input.UserID, releaseErr)
+		}
+		return nil, err
+	}
+
+	if err := s.shiftRepo.CompleteHoursCredit(ctx, input.ClubID, ids, paymentID); err != nil {
+		return nil, err
+	}
+	for i := range approved {
+		approved[i].HoursStatus = domain.VolunteerHoursCredited
+		approved[i].CreditPaymentID = &paymentID
+	}
+
+	return &domain.VolunteerCredit{
+		UserID:      input.UserID,
+		PaymentID:   paymentID,
+		Hours:       hours,
+		HourlyRate:  input.HourlyRate,
+		Amount:      amount,
+		Assignments: approved,
+	}, nil
+}
+
+func (s *VolunteerShiftService) withSignUps(ctx context.Context, clubID string, shifts []domain.VolunteerShift) ([]domain.VolunteerShift, error) {
+	for i := range shifts {
+		signedUp, err := s.shiftRepo.ListAssignmentsByShift(ctx, clubID, shifts[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		shifts[i].SignedUp = len(signedUp)
+	}
+	if shifts == nil {
+		shifts = []domain.VolunteerShift{}
+	}
+	return shifts, nil
+}
+
+func (s *VolunteerShiftService) getShift(ctx context.Context, clubID, shiftID string) (*domain.VolunteerShift, error) {
+	id, err := uuid.Parse(shiftID)
+	if err != nil {
+		return nil, errors.New("ID de turno inválido")
+	}
+	shift, err := s.shiftRepo.GetShift(ctx, clubID, id)
+	if err != nil {
+		return nil, err
+	}
+	if shift == nil {
+		return nil, errors.New("turno no encontrado")
+	}
+	return shift, nil
+}
+
+func (s *VolunteerShiftService) getAssignment(ctx context.Context, clubID, assignmentID string) (*domain.VolunteerAssignment, error) {
+	id, err := uuid.Parse(assignmentID)
+	if err != nil {
+		return nil, errors.New("ID de asignación inválido")
+	}
+	assignment, err := s.shiftRepo.GetAssignment(ctx, clubID, id)
+	if err != nil {
+		return nil, err
+	}
+	if assignment == nil {
+		return nil, errors.New("asignación no encontrada")
+	}
+	return assignment, nil
+}
+
+func (s *VolunteerShiftService) getOwnShiftAssignment(ctx context.Context, clubID, assignmentID, userID string) (*domain.VolunteerAssignment, *domain.VolunteerShift, error) {
+	assignment, err := s.getAssignment(ctx, clubID, assignmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if assignment.UserID != userID {
+		return nil, nil, errors.New("la asignación no te pertenece")
+	}
+	if assignment.ShiftID == nil {
+		return nil, nil, errors.New("la asignación no corresponde a un turno")
+	}
+	shift, err := s.shiftRepo.GetShift(ctx, clubID, *assignment.ShiftID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if shift == nil {
+		return nil, nil, errors.New("turno no encontrado")
+	}
+	return assignment, shift, nil
+}
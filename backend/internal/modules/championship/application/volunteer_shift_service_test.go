@@ -0,0 +1,229 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockVolunteerShiftRepo struct {
+	mock.Mock
+}
+
+func (m *MockVolunteerShiftRepo) CreateShift(ctx context.Context, s *domain.VolunteerShift) error {
+	return m.Called(ctx, s).Error(0)
+}
+func (m *MockVolunteerShiftRepo) GetShift(ctx context.Context, clubID string, id uuid.UUID) (*domain.VolunteerShift, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VolunteerShift), args.Error(1)
+}
+func (m *MockVolunteerShiftRepo) DeleteShift(ctx context.Context, clubID string, id uuid.UUID) error {
+	return m.Called(ctx, clubID, id).Error(0)
+}
+func (m *MockVolunteerShiftRepo) ListShiftsByMatch(ctx context.Context, clubID string, matchID uuid.UUID) ([]domain.VolunteerShift, error) {
+	args := m.Called(ctx, clubID, matchID)
+	return args.Get(0).([]domain.VolunteerShift), args.Error(1)
+}
+func (m *MockVolunteerShiftRepo) ListShifts(ctx context.Context, clubID string, from, to time.Time) ([]domain.VolunteerShift, error) {
+	args := m.Called(ctx, clubID, from, to)
+	return args.Get(0).([]domain.VolunteerShift), args.Error(1)
+}
+func (m *MockVolunteerShiftRepo) GetAssignment(ctx context.Context, clubID string, id uuid.UUID) (*domain.VolunteerAssignment, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VolunteerAssignment), args.Error(1)
+}
+func (m *MockVolunteerShiftRepo) ListAssignmentsByShift(ctx context.Context, clubID string, shiftID uuid.UUID) ([]domain.VolunteerAssignment, error) {
+	args := m.Called(ctx, clubID, shiftID)
+	return args.Get(0).([]domain.VolunteerAssignment), args.Error(1)
+}
+func (m *MockVolunteerShiftRepo) ListAssignmentsByHoursStatus(ctx context.Context, clubID, userID string, status domain.VolunteerHoursStatus) ([]domain.VolunteerAssignment, error) {
+	args := m.Called(ctx, clubID, userID, status)
+	return args.Get(0).([]domain.VolunteerAssignment), args.Error(1)
+}
+
+func (m *MockVolunteerShiftRepo) SignUp(ctx context.Context, a *domain.VolunteerAssignment) error {
+	return m.Called(ctx, a).Error(0)
+}
+func (m *MockVolunteerShiftRepo) ClaimApprovedHours(ctx context.Context, clubID, userID string) ([]domain.VolunteerAssignment, error) {
+	args := m.Called(ctx, clubID, userID)
+	return args.Get(0).([]domain.VolunteerAssignment), args.Error(1)
+}
+func (m *MockVolunteerShiftRepo) CompleteHoursCredit(ctx context.Context, clubID string, ids []uuid.UUID, paymentID uuid.UUID) error {
+	return m.Called(ctx, clubID, ids, paymentID).Error(0)
+}
+func (m *MockVolunteerShiftRepo) ReleaseHoursClaim(ctx context.Context, clubID string, ids []uuid.UUID) error {
+	return m.Called(ctx, clubID, ids).Error(0)
+}
+
+type MockVolunteerCreditGateway struct {
+	mock.Mock
+}
+
+func (m *MockVolunteerCreditGateway) CreditVolunteerHours(ctx context.Context, clubID, userID string, amount decimal.Decimal, referenceID uuid.UUID, referenceType, notes string) (uuid.UUID, error) {
+	args := m.Called(ctx, clubID, userID, amount, referenceID, referenceType, notes)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func TestVolunteerShiftService_SignUp(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	matchID := uuid.New()
+	shift := &domain.VolunteerShift{
+		ID: uuid.New(), ClubID: cID, MatchID: matchID, Role: domain.VolunteerRoleBuffet,
+		StartTime: time.Now().Add(48 * time.Hour), EndTime: time.Now().Add(51 * time.Hour), Capacity: 2,
+	}
+
+	setup := func() (*application.VolunteerShiftService, *MockVolunteerRepo, *MockVolunteerShiftRepo) {
+		volunteerRepo := new(MockVolunteerRepo)
+		shiftRepo := new(MockVolunteerShiftRepo)
+		svc := application.NewVolunteerShiftService(new(MockChampionshipRepo), volunteerRepo, shiftRepo, application.NewVolunteerService(volunteerRepo), nil)
+
+		shiftRepo.On("GetShift", ctx, cID, shift.ID).Return(shift, nil)
+		shiftRepo.On("ListShiftsByMatch", ctx, cID, matchID).Return([]domain.VolunteerShift{*shift}, nil)
+		return svc, volunteerRepo, shiftRepo
+	}
+
+	t.Run("Creates a self sign-up linked to the shift", func(t *testing.T) {
+		svc, volunteerRepo, shiftRepo := setup()
+		volunteerRepo.On("GetByRoleAndMatch", ctx, cID, matchID, domain.VolunteerRoleBuffet).Return([]domain.VolunteerAssignment{}, nil)
+		shiftRepo.On("SignUp", ctx, mock.MatchedBy(func(a *domain.VolunteerAssignment) bool {
+			return a.UserID == "parent-1" && *a.ShiftID == shift.ID && a.AssignedBy == "parent-1"
+		})).Return(nil)
+
+		assignment, err := svc.SignUp(ctx, cID, shift.ID.String(), "parent-1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.VolunteerRoleBuffet, assignment.Role)
+	})
+
+	t.Run("Rejects full shift", func(t *testing.T) {
+		svc, volunteerRepo, shiftRepo := setup()
+		volunteerRepo.On("GetByRoleAndMatch", ctx, cID, matchID, domain.VolunteerRoleBuffet).Return([]domain.VolunteerAssignment{}, nil)
+		shiftRepo.On("SignUp", ctx, mock.Anything).Return(domain.ErrShiftFull)
+
+		_, err := svc.SignUp(ctx, cID, shift.ID.String(), "parent-1")
+		assert.ErrorIs(t, err, domain.ErrShiftFull)
+	})
+
+	t.Run("Admin-assigned volunteers count against role capacity", func(t *testing.T) {
+		assigned := []domain.VolunteerAssignment{{UserID: "a", Role: domain.VolunteerRoleBuffet}, {UserID: "b", Role: domain.VolunteerRoleBuffet}}
+		svc, volunteerRepo, shiftRepo := setup()
+		volunteerRepo.On("GetByRoleAndMatch", ctx, cID, matchID, domain.VolunteerRoleBuffet).Return(assigned, nil)
+
+		_, err := svc.SignUp(ctx, cID, shift.ID.String(), "parent-1")
+		assert.ErrorContains(t, err, "máximo de voluntarios")
+		shiftRepo.AssertNotCalled(t, "SignUp", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Rejects second sign-up in the same match", func(t *testing.T) {
+		svc, volunteerRepo, shiftRepo := setup()
+		volunteerRepo.On("GetByRoleAndMatch", ctx, cID, matchID, domain.VolunteerRoleBuffet).Return([]domain.VolunteerAssignment{}, nil)
+		shiftRepo.On("SignUp", ctx, mock.Anything).Return(domain.ErrAlreadyVolunteer)
+
+		_, err := svc.SignUp(ctx, cID, shift.ID.String(), "parent-1")
+		assert.ErrorContains(t, err, "ya estás anotado")
+	})
+}
+
+func TestVolunteerShiftService_Attendance(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	shift := &domain.VolunteerShift{ID: uuid.New(), StartTime: time.Now().Add(-30 * time.Minute), EndTime: time.Now().Add(2 * time.Hour), Capacity: 3}
+	checkIn := time.Now().Add(-150 * time.Minute)
+	assignment := &domain.VolunteerAssignment{ID: uuid.New(), UserID: "parent-1", ShiftID: &shift.ID, CheckInAt: &checkIn}
+
+	volunteerRepo := new(MockVolunteerRepo)
+	shiftRepo := new(MockVolunteerShiftRepo)
+	svc := application.NewVolunteerShiftService(new(MockChampionshipRepo), volunteerRepo, shiftRepo, nil, nil)
+
+	shiftRepo.On("GetAssignment", ctx, cID, assignment.ID).Return(assignment, nil)
+	shiftRepo.On("GetShift", ctx, cID, shift.ID).Return(shift, nil)
+	volunteerRepo.On("Update", ctx, mock.Anything).Return(nil)
+
+	_, err := svc.CheckOut(ctx, cID, assignment.ID.String(), "someone-else")
+	assert.ErrorContains(t, err, "no te pertenece")
+
+	result, err := svc.CheckOut(ctx, cID, assignment.ID.String(), "parent-1")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.VolunteerHoursPendingApproval, result.HoursStatus)
+	assert.True(t, result.Hours.Equal(decimal.NewFromFloat(2.5)))
+
+	_, err = svc.CheckOut(ctx, cID, assignment.ID.String(), "parent-1")
+	assert.ErrorContains(t, err, "ya registraste")
+
+	reviewed, err := svc.ReviewHours(ctx, application.ReviewHoursInput{ClubID: cID, AssignmentID: assignment.ID.String(), ReviewerID: "admin", Approve: true})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.VolunteerHoursApproved, reviewed.HoursStatus)
+	assert.Equal(t, "admin", reviewed.HoursReviewedBy)
+}
+
+func TestVolunteerShiftService_CreditHours(t *testing.T) {
+	ctx := context.TODO()
+	cID := "club-1"
+	userID := uuid.New().String()
+	membershipID := uuid.New()
+	paymentID := uuid.New()
+	approved := []domain.VolunteerAssignment{
+		{ID: uuid.New(), UserID: userID, Hours: decimal.NewFromFloat(2.5), HoursStatus: domain.VolunteerHoursApproved},
+		{ID: uuid.New(), UserID: userID, Hours: decimal.NewFromInt(3), HoursStatus: domain.VolunteerHoursApproved},
+	}
+
+	ids := []uuid.UUID{approved[0].ID, approved[1].ID}
+	input := application.CreditHoursInput{
+		ClubID: cID, ApprovedBy: "admin", UserID: userID, HourlyRate: decimal.NewFromInt(2000),
+		ReferenceID: membershipID.String(), ReferenceType: "MEMBERSHIP",
+	}
+
+	t.Run("Credits the claimed hours with one payment", func(t *testing.T) {
+		shiftRepo := new(MockVolunteerShiftRepo)
+		credits := new(MockVolunteerCreditGateway)
+		svc := application.NewVolunteerShiftService(new(MockChampionshipRepo), new(MockVolunteerRepo), shiftRepo, nil, credits)
+
+		shiftRepo.On("ClaimApprovedHours", ctx, cID, userID).Return(approved, nil).Once()
+		credits.On("CreditVolunteerHours", ctx, cID, userID, mock.MatchedBy(func(amount decimal.Decimal) bool {
+			return amount.Equal(decimal.NewFromInt(11000)) // 5.5 horas x 2000
+		}), membershipID, "MEMBERSHIP", mock.Anything).Return(paymentID, nil).Once()
+		shiftRepo.On("CompleteHoursCredit", ctx, cID, ids, paymentID).Return(nil).Once()
+
+		credit, err := svc.CreditHours(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, paymentID, credit.PaymentID)
+		assert.True(t, credit.Hours.Equal(decimal.NewFromFloat(5.5)))
+		assert.Equal(t, domain.VolunteerHoursCredited, credit.Assignments[0].HoursStatus)
+
+		// Un segundo pedido (o uno simultáneo) ya no encuentra horas aprobadas
+		shiftRepo.On("ClaimApprovedHours", ctx, cID, userID).Return([]domain.VolunteerAssignment{}, nil).Once()
+		shiftRepo.On("ReleaseHoursClaim", ctx, cID, []uuid.UUID{}).Return(nil).Once()
+		_, err = svc.CreditHours(ctx, input)
+		assert.ErrorIs(t, err, domain.ErrNoHoursToCredit)
+		credits.AssertNumberOfCalls(t, "CreditVolunteerHours", 1)
+	})
+
+	t.Run("Releases the hours when the payment fails", func(t *testing.T) {
+		shiftRepo := new(MockVolunteerShiftRepo)
+		credits := new(MockVolunteerCreditGateway)
+		svc := application.NewVolunteerShiftService(new(MockChampionshipRepo), new(MockVolunteerRepo), shiftRepo, nil, credits)
+
+		shiftRepo.On("ClaimApprovedHours", ctx, cID, userID).Return(approved, nil)
+		credits.On("CreditVolunteerHours", ctx, cID, userID, mock.Anything, membershipID, "MEMBERSHIP", mock.Anything).Return(uuid.Nil, errors.New("payment error"))
+		shiftRepo.On("ReleaseHoursClaim", ctx, cID, ids).Return(nil).Once()
+
+		_, err := svc.CreditHours(ctx, input)
+		assert.Error(t, err)
+		shiftRepo.AssertExpectations(t)
+		shiftRepo.AssertNotCalled(t, "CompleteHoursCredit", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,301 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TournamentFixtureRepository lee todos los partidos de un torneo (con nombres de equipos) para las vistas públicas
+type TournamentFixtureRepository interface {
+	GetMatchesByTournament(ctx context.Context, clubID, tournamentID string) ([]TournamentMatch, error)
+}
+
+// BracketSlot es un lugar de un cruce: un equipo ya definido o pendiente del cruce anterior
+type BracketSlot struct {
+	TeamID   *uuid.UUID `json:"team_id,omitempty"`
+	TeamName string     `json:"team_name,omitempty"`
+	Score    *float64   `json:"score,omitempty"`
+	IsWinner bool       `json:"is_winner"`
+}
+
+// BracketMatch es un cruce de la llave. MatchID es nil mientras el partido no fue creado.
+type BracketMatch struct {
+	Position int         `json:"position"` // Posición dentro de la ronda (0 arriba)
+	MatchID  *uuid.UUID  `json:"match_id,omitempty"`
+	Status   MatchStatus `json:"status,omitempty"`
+	Date     *time.Time  `json:"date,omitempty"`
+	Location string      `json:"location,omitempty"`
+	Home     BracketSlot `json:"home"`
+	Away     BracketSlot `json:"away"`
+	WinnerID *uuid.UUID  `json:"winner_id,omitempty"`
+}
+
+// BracketRound agrupa los cruces de una ronda de eliminación
+type BracketRound struct {
+	Number  int            `json:"number"`
+	Name    string         `json:"name"` // "Cuartos de final", "Semifinal", "Final"
+	Matches []BracketMatch `json:"matches"`
+}
+
+// Bracket es el árbol de una fase eliminatoria
+type Bracket struct {
+	StageID      uuid.UUID      `json:"stage_id"`
+	StageName    string         `json:"stage_name"`
+	Rounds       []BracketRound `json:"rounds"`
+	ChampionID   *uuid.UUID     `json:"champion_id,omitempty"`
+	ChampionName string         `json:"champion_name,omitempty"`
+}
+
+// BuildBracket arma el árbol de la fase a partir de sus partidos. La ronda de cada partido se deduce
+// del recorrido de los equipos (un equipo juega una vez por ronda), así que las rondas posteriores
+// cargadas a mano quedan ubicadas debajo de los cruces que las alimentan. Las rondas aún no jugadas
+// se completan con cruces vacíos que ya muestran a los ganadores conocidos.
+func BuildBracket(stage TournamentStage, matches []TournamentMatch) Bracket {
+	bracket := Bracket{StageID: stage.ID, StageName: stage.Name, Rounds: []BracketRound{}}
+
+	stageMatches := []TournamentMatch{}
+	for _, m := range matches {
+		if m.StageID == stage.ID && m.Status != MatchCancelled {
+			stageMatches = append(stageMatches, m)
+		}
+	}
+	if len(stageMatches) == 0 {
+		return bracket
+	}
+	sort.SliceStable(stageMatches, func(i, j int) bool {
+		if !stageMatches[i].Date.Equal(stageMatches[j].Date) {
+			return stageMatches[i].Date.Before(stageMatches[j].Date)
+		}
+		return stageMatches[i].ID.String() < stageMatches[j].ID.String()
+	})
+
+	// Ronda de cada partido según la última ronda jugada por sus equipos
+	teamRound := make(map[uuid.UUID]int)
+	byRound := make(map[int][]TournamentMatch)
+	maxRound := 0
+	for _, m := range stageMatches {
+		round := 1 + maxInt(teamRound[m.HomeTeamID], teamRound[m.AwayTeamID])
+		teamRound[m.HomeTeamID], teamRound[m.AwayTeamID] = round, round
+		byRound[round] = append(byRound[round], m)
+		if round > maxRound {
+			maxRound = round
+		}
+	}
+
+	totalRounds := 1
+	for n := len(byRound[1]); n > 1; n = (n + 1) / 2 {
+		totalRounds++
+	}
+	if maxRound > totalRounds {
+		totalRounds = maxRound
+	}
+
+	size := len(byRound[1])
+	for number := 1; number <= totalRounds; number++ {
+		round := BracketRound{Number: number, Name: bracketRoundName(number, totalRounds), Matches: make([]BracketMatch, size)}
+		for p := range round.Matches {
+			round.Matches[p].Position = p
+		}
+
+		var previous []BracketMatch
+		if number > 1 {
+			previous = bracket.Rounds[number-2].Matches
+		}
+		for _, m := range byRound[number] {
+			position := -1
+			if number == 1 {
+				position = firstFreePosition(round.Matches)
+			} else {
+				for _, teamID := range []uuid.UUID{m.HomeTeamID, m.AwayTeamID} {
+					if feeder := feederPosition(previous, teamID); feeder >= 0 && round.Matches[feeder/2].MatchID == nil {
+						position = feeder / 2
+						break
+					}
+				}
+				if position < 0 {
+					position = firstFreePosition(round.Matches)
+				}
+			}
+			if position < 0 {
+				continue // Más partidos que cruces posibles: no se pueden ubicar en la llave
+			}
+			round.Matches[position] = newBracketMatch(position, m)
+		}
+
+		bracket.Rounds = append(bracket.Rounds, round)
+		size = (size + 1) / 2
+	}
+
+	// Ganadores: por el resultado o, si no es concluyente, por quién jugó la ronda siguiente
+	for r := range bracket.Rounds {
+		for p := range bracket.Rounds[r].Matches {
+			bm := &bracket.Rounds[r].Matches[p]
+			if bm.MatchID == nil || bm.Home.TeamID == nil || bm.Away.TeamID == nil {
+				continue
+			}
+			var winner *uuid.UUID
+			if bm.Status == MatchCompleted && bm.Home.Score != nil && bm.Away.Score != nil && *bm.Home.Score != *bm.Away.Score {
+				if *bm.Home.Score > *bm.Away.Score {
+					winner = bm.Home.TeamID
+				} else {
+					winner = bm.Away.TeamID
+				}
+			} else if r+1 < len(bracket.Rounds) {
+				for _, next := range bracket.Rounds[r+1].Matches {
+					for _, slot := range []BracketSlot{next.Home, next.Away} {
+						if slot.TeamID != nil && (*slot.TeamID == *bm.Home.TeamID || *slot.TeamID == *bm.Away.TeamID) {
+							winner = slot.TeamID
+						}
+					}
+				}
+			}
+			if winner != nil {
+				bm.WinnerID = winner
+				bm.Home.IsWinner = *winner == *bm.Home.TeamID
+				bm.Away.IsWinner = *winner == *bm.Away.TeamID
+			}
+		}
+	}
+
+	// Cruces aún no creados: se muestran los ganadores ya conocidos de la ronda anterior
+	for r := 1; r < len(bracket.Rounds); r++ {
+		previous := bracket.Rounds[r-1].Matches
+		for p := range bracket.Rounds[r].Matches {
+			bm := &bracket.Rounds[r].Matches[p]
+			if bm.MatchID != nil {
+				continue
+			}
+			if 2*p < len(previous) {
+				bm.Home = winnerSlot(previous[2*p])
+			}
+			if 2*p+1 < len(previous) {
+				bm.Away = winnerSlot(previous[2*p+1])
+			}
+		}
+	}
+
+	final := bracket.Rounds[len(bracket.Rounds)-1].Matches
+	if len(final) == 1 && final[0].WinnerID != nil {
+		bracket.ChampionID = final[0].WinnerID
+		if final[0].Home.IsWinner {
+			bracket.ChampionName = final[0].Home.TeamName
+		} else {
+			bracket.ChampionName = final[0].Away.TeamName
+		}
+	}
+	return bracket
+}
+
+func newBracketMatch(position int, m TournamentMatch) BracketMatch {
+	id, homeID, awayID, date := m.ID, m.HomeTeamID, m.AwayTeamID, m.Date
+	return BracketMatch{
+		Position: position,
+		MatchID:  &id,
+		Status:   m.Status,
+		Date:     &date,
+		Location: m.Location,
+		Home:     BracketSlot{TeamID: &homeID, TeamName: m.HomeTeamName, Score: m.HomeScore},
+		Away:     BracketSlot{TeamID: &awayID, TeamName: m.AwayTeamName, Score: m.AwayScore},
+	}
+}
+
+func winnerSlot(m BracketMatch) BracketSlot {
+	switch {
+	case m.Home.IsWinner:
+		return BracketSlot{TeamID: m.Home.TeamID, TeamName: m.Home.TeamName}
+	case m.Away.IsWinner:
+		return BracketSlot{TeamID: m.Away.TeamID, TeamName: m.Away.TeamName}
+	}
+	return BracketSlot{}
+}
+
+func feederPosition(previous []BracketMatch, teamID uuid.UUID) int {
+	for _, m := range previous {
+		if (m.Home.TeamID != nil && *m.Home.TeamID == teamID) || (m.Away.TeamID != nil && *m.Away.TeamID == teamID) {
+			return m.Position
+		}
+	}
+	return -1
+}
+
+func firstFreePosition(matches []BracketMatch) int {
+	for p := range matches {
+		if matches[p].MatchID == nil {
+			return p
+		}
+	}
+	return -1
+}
+
+func bracketRoundName(number, total int) string {
+	switch total - number {
+	case 0:
+		return "Final"
+	case 1:
+		return "Semifinal"
+	case 2:
+		return "Cuartos de final"
+	case 3:
+		return "Octavos de final"
+	}
+	return fmt.Sprintf("Ronda %d", number)
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// FixtureRow es una fila del fixture exportable de un torneo
+type FixtureRow struct {
+	MatchID   uuid.UUID   `json:"match_id"`
+	Stage     string      `json:"stage"`
+	Group     string      `json:"group,omitempty"`
+	Round     string      `json:"round,omitempty"`
+	Date      time.Time   `json:"date"`
+	HomeTeam  string      `json:"home_team"`
+	AwayTeam  string      `json:"away_team"`
+	HomeScore *float64    `json:"home_score,omitempty"`
+	AwayScore *float64    `json:"away_score,omitempty"`
+	Status    MatchStatus `json:"status"`
+	Location  string      `json:"location,omitempty"`
+}
+
+// TournamentFixture es el fixture completo de un torneo ordenado por fase y fecha
+type TournamentFixture struct {
+	TournamentID   uuid.UUID    `json:"tournament_id"`
+	TournamentName string       `json:"tournament_name"`
+	Sport          string       `json:"sport"`
+	Category       string       `json:"category,omitempty"`
+	Rows           []FixtureRow `json:"rows"`
+}
+
+// WidgetStandings es la tabla resumida de un grupo para el widget
+type WidgetStandings struct {
+	GroupID   uuid.UUID  `json:"group_id"`
+	GroupName string     `json:"group_name"`
+	Rows      []Standing `json:"rows"`
+}
+
+// TournamentWidget es el payload embebible en las webs de los clubes: tablas, próximos partidos y últimos resultados
+type TournamentWidget struct {
+	ClubName       string            `json:"club_name"`
+	ClubLogoURL    string            `json:"club_logo_url,omitempty"`
+	PrimaryColor   string            `json:"primary_color,omitempty"`
+	TournamentID   uuid.UUID         `json:"tournament_id"`
+	TournamentName string            `json:"tournament_name"`
+	Sport          string            `json:"sport"`
+	Category       string            `json:"category,omitempty"`
+	Status         TournamentStatus  `json:"status"`
+	LogoURL        string            `json:"logo_url,omitempty"`
+	Standings      []WidgetStandings `json:"standings"`
+	Upcoming       []FixtureRow      `json:"upcoming"`
+	Results        []FixtureRow      `json:"results"`
+	Champion       string            `json:"champion,omitempty"`
+}
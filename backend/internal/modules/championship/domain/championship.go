@@ -77,6 +77,7 @@ type Team struct {
 	Name      string    `json:"name" gorm:"not null"`
 	LogoURL   string    `json:"logo_url,omitempty"`
 	Contact   string    `json:"contact,omitempty"`
+	CaptainID *string   `json:"captain_id,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -103,9 +104,11 @@ type Standing struct {
 type MatchStatus string
 
 const (
-	MatchScheduled MatchStatus = "SCHEDULED"
-	MatchCompleted MatchStatus = "COMPLETED"
-	MatchCancelled MatchStatus = "CANCELLED"
+	MatchScheduled  MatchStatus = "SCHEDULED"
+	MatchInProgress MatchStatus = "IN_PROGRESS" // Live scoring in progress (partial score)
+	MatchCompleted  MatchStatus = "COMPLETED"
+	MatchCancelled  MatchStatus = "CANCELLED"
+	MatchPostponed  MatchStatus = "POSTPONED" // Suspended until a new date is agreed
 )
 
 type TournamentMatch struct {
@@ -123,6 +126,8 @@ type TournamentMatch struct {
 	BookingID *uuid.UUID  `json:"booking_id,omitempty" gorm:"type:uuid;index"` // Link to Booking system
 	Status    MatchStatus `json:"status" gorm:"default:'SCHEDULED'"`
 	Date      time.Time   `json:"date"`
+	Round     string      `json:"round,omitempty"`    // "1", "Semifinal", etc.
+	Location  string      `json:"location,omitempty"` // Free text when the match has no booking
 
 	// Enriched Fields (Filled via Joins)
 	HomeTeamName string `json:"home_team_name,omitempty" gorm:"-"`
@@ -134,7 +139,7 @@ func (TournamentMatch) TableName() string {
 }
 
 type ChampionshipRepository interface {
-	CreateTournament(ctx context.Context, tournament *Tournament) error
+	CreateTournament(ctx context.Context, tournament *Tournament) error // Crea también sus fases y grupos
 	GetTournament(ctx context.Context, clubID, id string) (*Tournament, error)
 	ListTournaments(ctx context.Context, clubID string) ([]Tournament, error)
 	CreateStage(ctx context.Context, stage *TournamentStage) error
@@ -152,8 +157,15 @@ type ChampionshipRepository interface {
 	UpdateStanding(ctx context.Context, standing *Standing) error
 	UpdateStandingsBatch(ctx context.Context, clubID string, standings []Standing) error
 	GetTeamMembers(ctx context.Context, teamID string) ([]string, error)
+	GetTeamsMembers(ctx context.Context, teamIDs []string) (map[string][]string, error)
+	GetTeamStandings(ctx context.Context, clubID, teamID string) ([]Standing, error)
 	CreateTeam(ctx context.Context, team *Team) error
 	AddMember(ctx context.Context, teamID, userID string) error
 	GetMatchesByUserID(ctx context.Context, clubID, userID string) ([]TournamentMatch, error)
 	GetUpcomingMatches(ctx context.Context, clubID string, from, to time.Time) ([]TournamentMatch, error)
 }
+
+// MatchResultListener is notified after a match result is stored and standings are recalculated.
+type MatchResultListener interface {
+	OnMatchCompleted(ctx context.Context, clubID string, match *TournamentMatch) error
+}
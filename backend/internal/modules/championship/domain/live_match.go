@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de mensaje publicados por WebSocket durante un partido en vivo
+const (
+	LiveMessageScore     = "match.score"     // Marcador parcial actualizado
+	LiveMessageEvent     = "match.event"     // Incidencia registrada (gol, tarjeta...)
+	LiveMessageCompleted = "match.completed" // Resultado final cargado
+	LiveMessageStandings = "standings.live"  // Proyección de la tabla con los marcadores en vivo
+)
+
+// MatchTopic devuelve el tópico WebSocket de un partido
+func MatchTopic(matchID uuid.UUID) string {
+	return "match:" + matchID.String()
+}
+
+// TournamentTopic devuelve el tópico WebSocket de un torneo (páginas públicas)
+func TournamentTopic(tournamentID uuid.UUID) string {
+	return "tournament:" + tournamentID.String()
+}
+
+// LiveMatchSnapshot es el estado actual de un partido para clientes que se conectan tarde
+type LiveMatchSnapshot struct {
+	Match  *TournamentMatch `json:"match"`
+	Events []MatchEvent     `json:"events"`
+}
+
+// LiveStandings es la tabla proyectada de un grupo con los partidos en curso
+type LiveStandings struct {
+	GroupID   uuid.UUID  `json:"group_id"`
+	Standings []Standing `json:"standings"`
+}
+
+// LiveScoreRepository define la persistencia del marcador parcial
+type LiveScoreRepository interface {
+	UpdateLiveScore(ctx context.Context, clubID, matchID string, homeScore, awayScore float64) error
+}
+
+// CalculateStandings recalcula la tabla a partir de los partidos del grupo.
+// Con includeLive también computa los partidos IN_PROGRESS con su marcador parcial.
+func CalculateStandings(standings []Standing, matches []TournamentMatch, includeLive bool) []Standing {
+	standingMap := make(map[uuid.UUID]*Standing)
+	for i := range standings {
+		s := &standings[i]
+		// Reset stats
+		s.Points = 0
+		s.Played = 0
+		s.Won = 0
+		s.Drawn = 0
+		s.Lost = 0
+		s.GoalsFor = 0
+		s.GoalsAgainst = 0
+		s.GoalDifference = 0
+		standingMap[s.TeamID] = s
+	}
+
+	for _, m := range matches {
+		counts := m.Status == MatchCompleted || (includeLive && m.Status == MatchInProgress)
+		if !counts || m.HomeScore == nil || m.AwayScore == nil {
+			continue
+		}
+
+		home, okH := standingMap[m.HomeTeamID]
+		away, okA := standingMap[m.AwayTeamID]
+		if !okH || !okA {
+			continue // Should not happen if referential integrity holds
+		}
+
+		home.Played++
+		away.Played++
+		home.GoalsFor += *m.HomeScore
+		home.GoalsAgainst += *m.AwayScore
+		away.GoalsFor += *m.AwayScore
+		away.GoalsAgainst += *m.HomeScore
+
+		home.GoalDifference = home.GoalsFor - home.GoalsAgainst
+		away.GoalDifference = away.GoalsFor - away.GoalsAgainst
+
+		if *m.HomeScore > *m.AwayScore {
+			home.Points += 3
+			home.Won++
+			away.Lost++
+		} else if *m.AwayScore > *m.HomeScore {
+			away.Points += 3
+			away.Won++
+			home.Lost++
+		} else {
+			home.Points += 1
+			away.Points += 1
+			home.Drawn++
+			away.Drawn++
+		}
+	}
+
+	return standings
+}
+
+// RankStandings ordena la tabla (puntos, diferencia, goles a favor) y asigna la posición
+func RankStandings(standings []Standing) {
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].Points != standings[j].Points {
+			return standings[i].Points > standings[j].Points
+		}
+		if standings[i].GoalDifference != standings[j].GoalDifference {
+			return standings[i].GoalDifference > standings[j].GoalDifference
+		}
+		return standings[i].GoalsFor > standings[j].GoalsFor
+	})
+	for i := range standings {
+		standings[i].Position = i + 1
+	}
+}
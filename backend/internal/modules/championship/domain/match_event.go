@@ -0,0 +1,227 @@
+package domain
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MatchEventType define el tipo de incidencia registrada durante un partido
+type MatchEventType string
+
+const (
+	MatchEventGoal         MatchEventType = "GOAL"         // Gol
+	MatchEventAssist       MatchEventType = "ASSIST"       // Asistencia
+	MatchEventYellowCard   MatchEventType = "YELLOW_CARD"  // Tarjeta amarilla
+	MatchEventRedCard      MatchEventType = "RED_CARD"     // Tarjeta roja
+	MatchEventMVP          MatchEventType = "MVP"          // Figura del partido
+	MatchEventSubstitution MatchEventType = "SUBSTITUTION" // Cambio (UserID sale, RelatedUserID entra)
+)
+
+// IsValid verifica que el tipo de evento sea uno de los soportados
+func (t MatchEventType) IsValid() bool {
+	switch t {
+	case MatchEventGoal, MatchEventAssist, MatchEventYellowCard, MatchEventRedCard, MatchEventMVP, MatchEventSubstitution:
+		return true
+	}
+	return false
+}
+
+// XP otorgada por incidencia, adicional a la participación base del partido
+const (
+	MatchPlayedXP = 100
+	GoalXP        = 30
+	AssistXP      = 15
+	MVPXP         = 50
+	RedCardXP     = -25
+)
+
+// MatchEvent representa una incidencia individual de un jugador en un partido
+type MatchEvent struct {
+	ID           uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ClubID       string         `json:"club_id" gorm:"index;not null"`
+	TournamentID uuid.UUID      `json:"tournament_id" gorm:"type:uuid;not null;index"`
+	MatchID      uuid.UUID      `json:"match_id" gorm:"type:uuid;not null;index"`
+	TeamID       uuid.UUID      `json:"team_id" gorm:"type:uuid;not null;index"`
+	UserID       string         `json:"user_id" gorm:"not null;index"`
+	Type         MatchEventType `json:"type" gorm:"not null"`
+	Minute       int            `json:"minute"`
+
+	// RelatedUserID es el jugador que ingresa en un SUBSTITUTION
+	RelatedUserID *string `json:"related_user_id,omitempty"`
+	Notes         string  `json:"notes,omitempty"`
+
+	// Metadata
+	RecordedBy string    `json:"recorded_by"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+
+	// Enriched Fields
+	PlayerName string `json:"player_name,omitempty" gorm:"->"`
+	TeamName   string `json:"team_name,omitempty" gorm:"->"`
+}
+
+func (MatchEvent) TableName() string {
+	return "match_events"
+}
+
+// PlayerMatchPerformance resume las incidencias de un jugador en un único partido
+type PlayerMatchPerformance struct {
+	UserID      string `json:"user_id"`
+	Goals       int    `json:"goals"`
+	Assists     int    `json:"assists"`
+	YellowCards int    `json:"yellow_cards"`
+	RedCards    int    `json:"red_cards"`
+	MVP         bool   `json:"mvp"`
+}
+
+// XP calcula la experiencia del partido según el rendimiento real del jugador.
+// Nunca devuelve menos que cero.
+func (p PlayerMatchPerformance) XP() int {
+	xp := MatchPlayedXP + p.Goals*GoalXP + p.Assists*AssistXP + p.RedCards*RedCardXP
+	if p.MVP {
+		xp += MVPXP
+	}
+	if xp < 0 {
+		return 0
+	}
+	return xp
+}
+
+// PlayerTournamentStats acumula las incidencias de un jugador en todo el torneo
+type PlayerTournamentStats struct {
+	UserID        string    `json:"user_id"`
+	PlayerName    string    `json:"player_name,omitempty"`
+	TeamID        uuid.UUID `json:"team_id"`
+	TeamName      string    `json:"team_name,omitempty"`
+	MatchesPlayed int       `json:"matches_played"`
+	Goals         int       `json:"goals"`
+	Assists       int       `json:"assists"`
+	YellowCards   int       `json:"yellow_cards"`
+	RedCards      int       `json:"red_cards"`
+	MVPs          int       `json:"mvps"`
+}
+
+// DisciplinePoints pondera las tarjetas para la tabla de fair play (amarilla 1, roja 3)
+func (s PlayerTournamentStats) DisciplinePoints() int {
+	return s.YellowCards + s.RedCards*3
+}
+
+// BuildMatchPerformances agrupa los eventos de un partido por jugador
+func BuildMatchPerformances(events []MatchEvent) map[string]PlayerMatchPerformance {
+	perf := make(map[string]PlayerMatchPerformance)
+	for _, e := range events {
+		p := perf[e.UserID]
+		p.UserID = e.UserID
+		switch e.Type {
+		case MatchEventGoal:
+			p.Goals++
+		case MatchEventAssist:
+			p.Assists++
+		case MatchEventYellowCard:
+			p.YellowCards++
+		case MatchEventRedCard:
+			p.RedCards++
+		case MatchEventMVP:
+			p.MVP = true
+		}
+		perf[e.UserID] = p
+	}
+	return perf
+}
+
+// AggregatePlayerStats acumula los eventos de un torneo por jugador.
+// MatchesPlayed cuenta los partidos en los que el jugador registró al menos un evento.
+func AggregatePlayerStats(events []MatchEvent) []PlayerTournamentStats {
+	byUser := make(map[string]*PlayerTournamentStats)
+	matchesByUser := make(map[string]map[uuid.UUID]bool)
+	var order []string
+
+	touch := func(userID string, e MatchEvent) *PlayerTournamentStats {
+		s, ok := byUser[userID]
+		if !ok {
+			s = &PlayerTournamentStats{UserID: userID, TeamID: e.TeamID, TeamName: e.TeamName}
+			byUser[userID] = s
+			matchesByUser[userID] = make(map[uuid.UUID]bool)
+			order = append(order, userID)
+		}
+		if !matchesByUser[userID][e.MatchID] {
+			matchesByUser[userID][e.MatchID] = true
+			s.MatchesPlayed++
+		}
+		return s
+	}
+
+	for _, e := range events {
+		s := touch(e.UserID, e)
+		if s.PlayerName == "" {
+			s.PlayerName = e.PlayerName
+		}
+		switch e.Type {
+		case MatchEventGoal:
+			s.Goals++
+		case MatchEventAssist:
+			s.Assists++
+		case MatchEventYellowCard:
+			s.YellowCards++
+		case MatchEventRedCard:
+			s.RedCards++
+		case MatchEventMVP:
+			s.MVPs++
+		case MatchEventSubstitution:
+			if e.RelatedUserID != nil {
+				touch(*e.RelatedUserID, e)
+			}
+		}
+	}
+
+	stats := make([]PlayerTournamentStats, 0, len(order))
+	for _, userID := range order {
+		stats = append(stats, *byUser[userID])
+	}
+	return stats
+}
+
+// SortTopScorers ordena por goles, luego asistencias y por último menos partidos jugados
+func SortTopScorers(stats []PlayerTournamentStats) {
+	sort.SliceStable(stats, func(i, j int) bool {
+		if stats[i].Goals != stats[j].Goals {
+			return stats[i].Goals > stats[j].Goals
+		}
+		if stats[i].Assists != stats[j].Assists {
+			return stats[i].Assists > stats[j].Assists
+		}
+		return stats[i].MatchesPlayed < stats[j].MatchesPlayed
+	})
+}
+
+// SortDiscipline ordena de peor a mejor conducta (más puntos de disciplina primero)
+func SortDiscipline(stats []PlayerTournamentStats) {
+	sort.SliceStable(stats, func(i, j int) bool {
+		if stats[i].DisciplinePoints() != stats[j].DisciplinePoints() {
+			return stats[i].DisciplinePoints() > stats[j].DisciplinePoints()
+		}
+		return stats[i].RedCards > stats[j].RedCards
+	})
+}
+
+// MatchEventRepository define las operaciones de persistencia para eventos de partido
+type MatchEventRepository interface {
+	Create(ctx context.Context, event *MatchEvent) error
+	GetByID(ctx context.Context, clubID string, id uuid.UUID) (*MatchEvent, error)
+	GetByMatchID(ctx context.Context, clubID string, matchID uuid.UUID) ([]MatchEvent, error)
+	GetByTournamentID(ctx context.Context, clubID string, tournamentID uuid.UUID) ([]MatchEvent, error)
+	Delete(ctx context.Context, clubID string, id uuid.UUID) error
+}
+
+// MatchEventListener es notificado cuando se registra una incidencia
+type MatchEventListener interface {
+	OnMatchEventRecorded(ctx context.Context, event *MatchEvent) error
+}
+
+// MatchEventDeletionListener lo implementan los listeners que deben deshacer lo que generó una
+// incidencia eliminada por error (ej. la suspensión automática de una tarjeta)
+type MatchEventDeletionListener interface {
+	OnMatchEventDeleted(ctx context.Context, event *MatchEvent) error
+}
@@ -0,0 +1,199 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+)
+
+// OfficialRole define la función de un oficial de partido
+type OfficialRole string
+
+const (
+	OfficialRoleReferee       OfficialRole = "REFEREE"        // Árbitro principal
+	OfficialRoleLineJudge     OfficialRole = "LINE_JUDGE"     // Árbitro asistente / juez de línea
+	OfficialRoleTableOfficial OfficialRole = "TABLE_OFFICIAL" // Mesa de control / planillero
+)
+
+// IsValid indica si el rol es uno de los soportados
+func (r OfficialRole) IsValid() bool {
+	switch r {
+	case OfficialRoleReferee, OfficialRoleLineJudge, OfficialRoleTableOfficial:
+		return true
+	}
+	return false
+}
+
+// OfficialQualification habilita a un oficial para un rol en un deporte
+type OfficialQualification struct {
+	Sport string       `json:"sport"` // Mismo valor que Tournament.Sport ("FUTBOL", "HOCKEY")
+	Role  OfficialRole `json:"role"`
+	Level string       `json:"level,omitempty"` // Categoría/licencia ("Nacional", "Regional")
+}
+
+// Official es un árbitro u oficial de mesa del registro del club (puede no ser socio)
+type Official struct {
+	ID             uuid.UUID               `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ClubID         string                  `json:"club_id" gorm:"index;not null"`
+	UserID         *string                 `json:"user_id,omitempty" gorm:"index"` // Vínculo opcional con un usuario del club
+	FirstName      string                  `json:"first_name" gorm:"not null"`
+	LastName       string                  `json:"last_name" gorm:"not null"`
+	Email          string                  `json:"email,omitempty"`
+	Phone          string                  `json:"phone,omitempty"`
+	Qualifications []OfficialQualification `json:"qualifications" gorm:"serializer:json"`
+	IsActive       bool                    `json:"is_active" gorm:"default:true"`
+	CreatedAt      time.Time               `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time               `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// FullName devuelve el nombre para listados y reportes
+func (o *Official) FullName() string {
+	return o.FirstName + " " + o.LastName
+}
+
+// IsQualified indica si el oficial está habilitado para el rol en el deporte
+func (o *Official) IsQualified(sport string, role OfficialRole) bool {
+	for _, q := range o.Qualifications {
+		if q.Role == role && (q.Sport == "" || q.Sport == sport) {
+			return true
+		}
+	}
+	return false
+}
+
+// OfficialAvailability es una franja en la que el oficial declaró estar disponible
+type OfficialAvailability struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ClubID     string    `json:"club_id" gorm:"index;not null"`
+	OfficialID uuid.UUID `json:"official_id" gorm:"type:uuid;not null;index"`
+	StartTime  time.Time `json:"start_time" gorm:"not null"`
+	EndTime    time.Time `json:"end_time" gorm:"not null"`
+	Notes      string    `json:"notes,omitempty"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (OfficialAvailability) TableName() string {
+	return "official_availability"
+}
+
+// Covers indica si la franja cubre el intervalo completo
+func (a *OfficialAvailability) Covers(start, end time.Time) bool {
+	return !a.StartTime.After(start) && !a.EndTime.Before(end)
+}
+
+// OfficialAssignment es la designación de un oficial para un partido; el honorario queda
+// fijado al momento de la designación como monto a pagar.
+type OfficialAssignment struct {
+	ID           uuid.UUID       `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ClubID       string          `json:"club_id" gorm:"index;not null"`
+	TournamentID uuid.UUID       `json:"tournament_id" gorm:"type:uuid;not null;index"`
+	MatchID      uuid.UUID       `json:"match_id" gorm:"type:uuid;not null;index"`
+	OfficialID   uuid.UUID       `json:"official_id" gorm:"type:uuid;not null;index"`
+	Role         OfficialRole    `json:"role" gorm:"not null"`
+	StartTime    time.Time       `json:"start_time" gorm:"not null"` // Horario del partido (para detectar superposiciones)
+	EndTime      time.Time       `json:"end_time" gorm:"not null"`
+	Fee          decimal.Decimal `json:"fee" gorm:"type:decimal(10,2);not null;default:0"`
+	PaidAt       *time.Time      `json:"paid_at,omitempty"`
+	AssignedBy   string          `json:"assigned_by"`
+	AutoAssigned bool            `json:"auto_assigned"`
+	CreatedAt    time.Time       `json:"created_at" gorm:"autoCreateTime"`
+
+	// Enriched Fields
+	OfficialName string `json:"official_name,omitempty" gorm:"-"`
+}
+
+// Overlaps indica si la designación se superpone con el intervalo
+func (a *OfficialAssignment) Overlaps(start, end time.Time) bool {
+	return a.StartTime.Before(end) && start.Before(a.EndTime)
+}
+
+// OfficialSettings define la terna requerida por partido y los honorarios por rol.
+// Se configura por torneo en Tournament.Settings bajo la clave "officials".
+type OfficialSettings struct {
+	MatchDurationMinutes int                              `json:"match_duration_minutes"`
+	Required             map[OfficialRole]int             `json:"required"`
+	Fees                 map[OfficialRole]decimal.Decimal `json:"fees"`
+}
+
+// DefaultOfficialSettings devuelve la configuración por defecto: un árbitro sin honorario, partidos de 90'
+func DefaultOfficialSettings() OfficialSettings {
+	return OfficialSettings{
+		MatchDurationMinutes: 90,
+		Required:             map[OfficialRole]int{OfficialRoleReferee: 1},
+		Fees:                 map[OfficialRole]decimal.Decimal{},
+	}
+}
+
+// ParseOfficialSettings lee la configuración de oficiales del torneo,
+// completando con los valores por defecto los campos no configurados.
+func ParseOfficialSettings(settings datatypes.JSON) OfficialSettings {
+	result := DefaultOfficialSettings()
+	if len(settings) == 0 {
+		return result
+	}
+
+	var wrapper struct {
+		Officials *OfficialSettings `json:"officials"`
+	}
+	if err := json.Unmarshal(settings, &wrapper); err != nil || wrapper.Officials == nil {
+		return result
+	}
+
+	if wrapper.Officials.MatchDurationMinutes > 0 {
+		result.MatchDurationMinutes = wrapper.Officials.MatchDurationMinutes
+	}
+	if len(wrapper.Officials.Required) > 0 {
+		result.Required = wrapper.Officials.Required
+	}
+	if wrapper.Officials.Fees != nil {
+		result.Fees = wrapper.Officials.Fees
+	}
+	return result
+}
+
+// FeeFor devuelve el honorario por partido del rol (cero si no está configurado)
+func (s OfficialSettings) FeeFor(role OfficialRole) decimal.Decimal {
+	if fee, ok := s.Fees[role]; ok {
+		return fee
+	}
+	return decimal.Zero
+}
+
+// MatchWindow devuelve el intervalo que ocupa un partido
+func (s OfficialSettings) MatchWindow(date time.Time) (time.Time, time.Time) {
+	return date, date.Add(time.Duration(s.MatchDurationMinutes) * time.Minute)
+}
+
+// OfficialPayout resume lo adeudado a un oficial en un período
+type OfficialPayout struct {
+	OfficialID  uuid.UUID            `json:"official_id"`
+	Name        string               `json:"name"`
+	Matches     int                  `json:"matches"`
+	TotalEarned decimal.Decimal      `json:"total_earned"`
+	TotalPaid   decimal.Decimal      `json:"total_paid"`
+	Owed        decimal.Decimal      `json:"owed"`
+	Assignments []OfficialAssignment `json:"assignments"`
+}
+
+// OfficialRepository define la persistencia del registro de oficiales, su disponibilidad y designaciones
+type OfficialRepository interface {
+	CreateOfficial(ctx context.Context, official *Official) error
+	UpdateOfficial(ctx context.Context, official *Official) error
+	GetOfficial(ctx context.Context, clubID string, id uuid.UUID) (*Official, error)
+	ListOfficials(ctx context.Context, clubID string, activeOnly bool) ([]Official, error)
+
+	CreateAvailability(ctx context.Context, availability *OfficialAvailability) error
+	DeleteAvailability(ctx context.Context, clubID string, id uuid.UUID) error
+	ListAvailability(ctx context.Context, clubID string, officialID *uuid.UUID, from, to time.Time) ([]OfficialAvailability, error)
+
+	CreateAssignment(ctx context.Context, assignment *OfficialAssignment) error
+	DeleteAssignment(ctx context.Context, clubID string, id uuid.UUID) error
+	GetAssignmentsByMatch(ctx context.Context, clubID string, matchID uuid.UUID) ([]OfficialAssignment, error)
+	// ListAssignments devuelve las designaciones cuyo partido se superpone con [from, to], opcionalmente de un oficial
+	ListAssignments(ctx context.Context, clubID string, officialID *uuid.UUID, from, to time.Time) ([]OfficialAssignment, error)
+	MarkAssignmentsPaid(ctx context.Context, clubID string, ids []uuid.UUID, paidAt time.Time) error
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RescheduleStatus define el estado de una solicitud de reprogramación
+type RescheduleStatus string
+
+const (
+	ReschedulePending   RescheduleStatus = "PENDING"   // Esperando respuesta del otro equipo
+	RescheduleAccepted  RescheduleStatus = "ACCEPTED"  // Partido movido al horario elegido
+	RescheduleRejected  RescheduleStatus = "REJECTED"  // El otro equipo no aceptó ninguna opción
+	RescheduleWithdrawn RescheduleStatus = "WITHDRAWN" // Retirada por quien la propuso
+)
+
+// ErrReschedulePending se devuelve al proponer una reprogramación si el partido ya tiene una abierta
+var ErrReschedulePending = errors.New("el partido ya tiene una reprogramación pendiente de respuesta")
+
+// RescheduleSlot es un horario propuesto para jugar el partido. Con CourtID se reserva la cancha en Booking.
+type RescheduleSlot struct {
+	Date     time.Time `json:"date" binding:"required"`
+	CourtID  string    `json:"court_id,omitempty"`
+	Location string    `json:"location,omitempty"`
+}
+
+// MatchRescheduleRequest es la propuesta de un capitán para mover un partido a otro horario.
+// El capitán del equipo rival (o un administrador) elige una de las opciones o la rechaza.
+type MatchRescheduleRequest struct {
+	ID                uuid.UUID        `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID            string           `json:"club_id" gorm:"index;not null"`
+	MatchID           uuid.UUID        `json:"match_id" gorm:"type:uuid;index;not null"`
+	RequestingTeamID  uuid.UUID        `json:"requesting_team_id" gorm:"type:uuid;not null"`
+	RespondingTeamID  uuid.UUID        `json:"responding_team_id" gorm:"type:uuid;not null"`
+	RequestedBy       string           `json:"requested_by" gorm:"not null"`
+	Reason            string           `json:"reason,omitempty"`
+	ProposedSlots     []RescheduleSlot `json:"proposed_slots" gorm:"serializer:json"`
+	Status            RescheduleStatus `json:"status" gorm:"default:'PENDING'"`
+	AcceptedSlot      *int             `json:"accepted_slot,omitempty"` // Índice de ProposedSlots elegido
+	RespondedBy       *string          `json:"responded_by,omitempty"`
+	RespondedAt       *time.Time       `json:"responded_at,omitempty"`
+	ResponseNote      string           `json:"response_note,omitempty"`
+	PreviousDate      time.Time        `json:"previous_date"`
+	PreviousBookingID *uuid.UUID       `json:"previous_booking_id,omitempty" gorm:"type:uuid"`
+	NewBookingID      *uuid.UUID       `json:"new_booking_id,omitempty" gorm:"type:uuid"`
+	CreatedAt         time.Time        `json:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at"`
+}
+
+func (MatchRescheduleRequest) TableName() string {
+	return "match_reschedule_requests"
+}
+
+// CanBeRescheduled indica si el partido todavía puede moverse de horario
+func (m *TournamentMatch) CanBeRescheduled() bool {
+	return m.Status == MatchScheduled || m.Status == MatchPostponed
+}
+
+// MatchRescheduleRepository define la persistencia de las reprogramaciones
+type MatchRescheduleRepository interface {
+	// CreateRescheduleRequest registra la propuesta (y, con postpone, posterga el partido liberando su reserva)
+	// en una misma transacción; devuelve ErrReschedulePending si el partido ya tiene una propuesta abierta
+	CreateRescheduleRequest(ctx context.Context, request *MatchRescheduleRequest, postpone bool) error
+	UpdateRescheduleRequest(ctx context.Context, request *MatchRescheduleRequest) error
+	GetRescheduleRequest(ctx context.Context, clubID string, id uuid.UUID) (*MatchRescheduleRequest, error)
+	GetPendingRescheduleRequest(ctx context.Context, clubID string, matchID uuid.UUID) (*MatchRescheduleRequest, error)
+	ListRescheduleRequests(ctx context.Context, clubID string, matchID uuid.UUID) ([]MatchRescheduleRequest, error)
+	GetTeam(ctx context.Context, teamID uuid.UUID) (*Team, error)
+	// GetMatchOfficialUserIDs devuelve los usuarios vinculados a los oficiales designados para el partido
+	GetMatchOfficialUserIDs(ctx context.Context, clubID string, matchID uuid.UUID) ([]string, error)
+
+	// PostponeMatch deja el partido sin fecha confirmada (POSTPONED) y libera su reserva
+	PostponeMatch(ctx context.Context, clubID string, matchID uuid.UUID) error
+	// ApplyReschedule mueve el partido al horario aceptado, junto con las designaciones de oficiales y los turnos
+	// de voluntarios, y cierra la solicitud en una misma transacción
+	ApplyReschedule(ctx context.Context, request *MatchRescheduleRequest, slot RescheduleSlot, bookingID *uuid.UUID) error
+	// RevertReschedule devuelve el partido (y su staff) a su estado anterior y guarda la solicitud (compensación si no se pudo liberar una reserva)
+	RevertReschedule(ctx context.Context, request *MatchRescheduleRequest, previous *TournamentMatch) error
+}
@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// SuspensionReason define el origen de una sanción
+type SuspensionReason string
+
+const (
+	SuspensionYellowAccumulation SuspensionReason = "YELLOW_ACCUMULATION" // Acumulación de amarillas
+	SuspensionDoubleYellow       SuspensionReason = "DOUBLE_YELLOW"       // Doble amarilla en un partido
+	SuspensionRedCard            SuspensionReason = "RED_CARD"            // Roja directa
+	SuspensionManual             SuspensionReason = "MANUAL"              // Sanción del tribunal de disciplina
+)
+
+// DisciplinaryRules define cuántas fechas de suspensión genera cada tipo de tarjeta.
+// Se configura por torneo en Tournament.Settings bajo la clave "disciplinary".
+type DisciplinaryRules struct {
+	YellowCardThreshold      int `json:"yellow_card_threshold"`       // Amarillas acumuladas que generan suspensión
+	YellowSuspensionMatches  int `json:"yellow_suspension_matches"`   // Fechas por acumulación
+	RedCardSuspensionMatches int `json:"red_card_suspension_matches"` // Fechas por roja directa o doble amarilla
+}
+
+// DefaultDisciplinaryRules devuelve el reglamento estándar de liga
+func DefaultDisciplinaryRules() DisciplinaryRules {
+	return DisciplinaryRules{
+		YellowCardThreshold:      5,
+		YellowSuspensionMatches:  1,
+		RedCardSuspensionMatches: 2,
+	}
+}
+
+// ParseDisciplinaryRules lee el reglamento desde la configuración del torneo,
+// completando con los valores por defecto los campos no configurados.
+func ParseDisciplinaryRules(settings datatypes.JSON) DisciplinaryRules {
+	rules := DefaultDisciplinaryRules()
+	if len(settings) == 0 {
+		return rules
+	}
+
+	var wrapper struct {
+		Disciplinary *DisciplinaryRules `json:"disciplinary"`
+	}
+	if err := json.Unmarshal(settings, &wrapper); err != nil || wrapper.Disciplinary == nil {
+		return rules
+	}
+
+	if wrapper.Disciplinary.YellowCardThreshold > 0 {
+		rules.YellowCardThreshold = wrapper.Disciplinary.YellowCardThreshold
+	}
+	if wrapper.Disciplinary.YellowSuspensionMatches > 0 {
+		rules.YellowSuspensionMatches = wrapper.Disciplinary.YellowSuspensionMatches
+	}
+	if wrapper.Disciplinary.RedCardSuspensionMatches > 0 {
+		rules.RedCardSuspensionMatches = wrapper.Disciplinary.RedCardSuspensionMatches
+	}
+	return rules
+}
+
+// PlayerSuspension representa una sanción de N fechas para un jugador en un torneo
+type PlayerSuspension struct {
+	ID               uuid.UUID        `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ClubID           string           `json:"club_id" gorm:"index;not null"`
+	TournamentID     uuid.UUID        `json:"tournament_id" gorm:"type:uuid;not null;index"`
+	TeamID           uuid.UUID        `json:"team_id" gorm:"type:uuid;not null;index"`
+	UserID           string           `json:"user_id" gorm:"not null;index"`
+	Reason           SuspensionReason `json:"reason" gorm:"not null"`
+	SourceMatchID    *uuid.UUID       `json:"source_match_id,omitempty" gorm:"type:uuid;index"`
+	SourceEventID    *uuid.UUID       `json:"source_event_id,omitempty" gorm:"type:uuid;index"` // Tarjeta que generó la sanción automática
+	MatchesSuspended int              `json:"matches_suspended" gorm:"not null"`
+
+	// ServedMatchIDs registra las fechas cumplidas (idempotente ante recargas de resultado)
+	ServedMatchIDs []string `json:"served_match_ids" gorm:"serializer:json"`
+	Notes          string   `json:"notes,omitempty"`
+
+	// RevokedAt se completa cuando se elimina la tarjeta que originó la sanción
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+
+	// Metadata
+	CreatedBy string    `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (PlayerSuspension) TableName() string {
+	return "player_suspensions"
+}
+
+// MatchesRemaining devuelve las fechas que aún debe cumplir
+func (s *PlayerSuspension) MatchesRemaining() int {
+	remaining := s.MatchesSuspended - len(s.ServedMatchIDs)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// IsActive indica si la sanción sigue vigente
+func (s *PlayerSuspension) IsActive() bool {
+	return s.RevokedAt == nil && s.MatchesRemaining() > 0
+}
+
+// IsTriggeredBy indica si la sanción automática depende de la incidencia: la tarjeta que la generó o,
+// en una doble amarilla, cualquiera de las dos amarillas del partido.
+func (s *PlayerSuspension) IsTriggeredBy(event *MatchEvent) bool {
+	if s.Reason == SuspensionManual || s.UserID != event.UserID {
+		return false
+	}
+	if s.SourceEventID != nil && *s.SourceEventID == event.ID {
+		return true
+	}
+	return s.Reason == SuspensionDoubleYellow && event.Type == MatchEventYellowCard &&
+		s.SourceMatchID != nil && *s.SourceMatchID == event.MatchID
+}
+
+// Revoke anula la sanción; las fechas ya cumplidas quedan registradas
+func (s *PlayerSuspension) Revoke(now time.Time) bool {
+	if s.RevokedAt != nil {
+		return false
+	}
+	s.RevokedAt = &now
+	return true
+}
+
+// Serve marca un partido como fecha cumplida. Devuelve false si no corresponde
+// (sanción ya cumplida, partido de origen o partido ya computado).
+func (s *PlayerSuspension) Serve(matchID uuid.UUID) bool {
+	if !s.IsActive() {
+		return false
+	}
+	if s.SourceMatchID != nil && *s.SourceMatchID == matchID {
+		return false
+	}
+	for _, id := range s.ServedMatchIDs {
+		if id == matchID.String() {
+			return false
+		}
+	}
+	s.ServedMatchIDs = append(s.ServedMatchIDs, matchID.String())
+	return true
+}
+
+// SuspensionRepository define las operaciones de persistencia para sanciones
+type SuspensionRepository interface {
+	Create(ctx context.Context, suspension *PlayerSuspension) error
+	GetByTournament(ctx context.Context, clubID string, tournamentID uuid.UUID) ([]PlayerSuspension, error)
+	GetByUser(ctx context.Context, clubID string, tournamentID uuid.UUID, userID string) ([]PlayerSuspension, error)
+	GetBySourceMatch(ctx context.Context, clubID string, matchID uuid.UUID) ([]PlayerSuspension, error)
+	Update(ctx context.Context, suspension *PlayerSuspension) error
+}
@@ -0,0 +1,266 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+)
+
+// RegistrationReferenceType identifica los pagos de inscripción en el módulo de pagos
+const RegistrationReferenceType = "TEAM_REGISTRATION"
+
+// RegistrationStatus define el estado de la inscripción de un equipo externo
+type RegistrationStatus string
+
+const (
+	RegistrationPendingPayment  RegistrationStatus = "PENDING_PAYMENT"  // Esperando el pago del arancel
+	RegistrationPendingApproval RegistrationStatus = "PENDING_APPROVAL" // Pagada (o sin arancel), falta revisión del organizador
+	RegistrationApproved        RegistrationStatus = "APPROVED"         // Equipo creado e inscripto en un grupo
+	RegistrationRejected        RegistrationStatus = "REJECTED"
+)
+
+// RegistrationSettings define la inscripción abierta a equipos de otros clubes.
+// Se configura por torneo en Tournament.Settings bajo la clave "registration".
+type RegistrationSettings struct {
+	Open      bool            `json:"open"`
+	EntryFee  decimal.Decimal `json:"entry_fee"` // Arancel por equipo (0 = sin cargo)
+	MinRoster int             `json:"min_roster"`
+	MaxRoster int             `json:"max_roster"`
+	Deadline  *time.Time      `json:"deadline,omitempty"`
+}
+
+// DefaultRegistrationSettings devuelve la configuración por defecto: inscripción cerrada
+func DefaultRegistrationSettings() RegistrationSettings {
+	return RegistrationSettings{
+		Open:      false,
+		EntryFee:  decimal.Zero,
+		MinRoster: 1,
+		MaxRoster: 30,
+	}
+}
+
+// ParseRegistrationSettings lee la configuración de inscripción del torneo,
+// completando con los valores por defecto los campos no configurados.
+func ParseRegistrationSettings(settings datatypes.JSON) RegistrationSettings {
+	result := DefaultRegistrationSettings()
+	if len(settings) == 0 {
+		return result
+	}
+
+	var wrapper struct {
+		Registration *RegistrationSettings `json:"registration"`
+	}
+	if err := json.Unmarshal(settings, &wrapper); err != nil || wrapper.Registration == nil {
+		return result
+	}
+
+	result.Open = wrapper.Registration.Open
+	result.Deadline = wrapper.Registration.Deadline
+	if wrapper.Registration.EntryFee.IsPositive() {
+		result.EntryFee = wrapper.Registration.EntryFee
+	}
+	if wrapper.Registration.MinRoster > 0 {
+		result.MinRoster = wrapper.Registration.MinRoster
+	}
+	if wrapper.Registration.MaxRoster > 0 {
+		result.MaxRoster = wrapper.Registration.MaxRoster
+	}
+	return result
+}
+
+// IsOpenAt indica si se aceptan inscripciones en el momento dado
+func (s RegistrationSettings) IsOpenAt(at time.Time) bool {
+	return s.Open && (s.Deadline == nil || !at.After(*s.Deadline))
+}
+
+// Tipos de documento aceptados para la lista de buena fe (mismos códigos que los documentos de usuario)
+const (
+	RosterDocumentDNIFront = "DNI_FRONT"
+	RosterDocumentDNIBack  = "DNI_BACK"
+	RosterDocumentMedical  = "EMMAC_MEDICAL"
+)
+
+// IsValidRosterDocumentType indica si el tipo se acepta en la lista de buena fe
+func IsValidRosterDocumentType(docType string) bool {
+	switch docType {
+	case RosterDocumentDNIFront, RosterDocumentDNIBack, RosterDocumentMedical:
+		return true
+	}
+	return false
+}
+
+// RosterDocumentStatus es el resultado de la revisión del organizador
+type RosterDocumentStatus string
+
+const (
+	RosterDocumentPending  RosterDocumentStatus = "PENDING"
+	RosterDocumentValid    RosterDocumentStatus = "VALID"
+	RosterDocumentRejected RosterDocumentStatus = "REJECTED"
+)
+
+// RosterDocument es un documento subido por el club visitante para un jugador de la lista.
+// El vencimiento lo carga el organizador al revisarlo, no el club que lo sube.
+type RosterDocument struct {
+	Type           string               `json:"type"`
+	FileURL        string               `json:"file_url"` // Descarga para el organizador
+	ContentType    string               `json:"content_type,omitempty"`
+	SizeBytes      int64                `json:"size_bytes,omitempty"`
+	Encrypted      bool                 `json:"encrypted,omitempty"`
+	Status         RosterDocumentStatus `json:"status"`
+	ExpirationDate *time.Time           `json:"expiration_date,omitempty"`
+	UploadedAt     time.Time            `json:"uploaded_at"`
+	ReviewedBy     *string              `json:"reviewed_by,omitempty"`
+	ReviewedAt     *time.Time           `json:"reviewed_at,omitempty"`
+	RejectionNotes string               `json:"rejection_notes,omitempty"`
+}
+
+// IsHealthData indica si el documento es un dato de salud (se cifra en reposo)
+func (d *RosterDocument) IsHealthData() bool {
+	return d.Type == RosterDocumentMedical
+}
+
+// IsValidated indica si el organizador aprobó el documento; el apto médico además necesita vencimiento
+func (d *RosterDocument) IsValidated() bool {
+	if d.Status != RosterDocumentValid {
+		return false
+	}
+	return d.Type != RosterDocumentMedical || d.ExpirationDate != nil
+}
+
+// RosterPlayer es un jugador de un equipo externo; se identifica por DNI porque no es socio del club
+type RosterPlayer struct {
+	DNI       string           `json:"dni"`
+	FirstName string           `json:"first_name"`
+	LastName  string           `json:"last_name"`
+	BirthDate *time.Time       `json:"birth_date,omitempty"`
+	Documents []RosterDocument `json:"documents,omitempty"`
+}
+
+// FindDocument devuelve el documento del tipo indicado
+func (p *RosterPlayer) FindDocument(docType string) *RosterDocument {
+	for i := range p.Documents {
+		if p.Documents[i].Type == docType {
+			return &p.Documents[i]
+		}
+	}
+	return nil
+}
+
+// DocumentIssues devuelve los problemas de documentación del jugador a la fecha indicada.
+// Solo cuentan los documentos aprobados por el organizador.
+func (p *RosterPlayer) DocumentIssues(at time.Time) []string {
+	issues := []string{}
+	hasDNI, dniPending, medicalPending := false, false, false
+	var medical *RosterDocument
+	for i := range p.Documents {
+		doc := &p.Documents[i]
+		pending := doc.Status != RosterDocumentRejected && !doc.IsValidated()
+		switch doc.Type {
+		case RosterDocumentDNIFront, RosterDocumentDNIBack:
+			hasDNI = hasDNI || doc.IsValidated()
+			dniPending = dniPending || pending
+		case RosterDocumentMedical:
+			medicalPending = medicalPending || pending
+			if doc.IsValidated() && (medical == nil || doc.ExpirationDate.After(*medical.ExpirationDate)) {
+				medical = doc
+			}
+		}
+	}
+
+	switch {
+	case hasDNI:
+	case dniPending:
+		issues = append(issues, "DNI pendiente de revisión")
+	default:
+		issues = append(issues, "Falta DNI")
+	}
+	switch {
+	case medical != nil:
+		if at.After(*medical.ExpirationDate) {
+			issues = append(issues, "Apto médico vencido")
+		}
+	case medicalPending:
+		issues = append(issues, "Apto médico pendiente de revisión")
+	default:
+		issues = append(issues, "Falta apto médico (EMMAC)")
+	}
+	return issues
+}
+
+// TeamRegistration es la solicitud de inscripción de un equipo de otro club a un torneo
+type TeamRegistration struct {
+	ID           uuid.UUID          `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ClubID       string             `json:"club_id" gorm:"index;not null"` // Club organizador
+	TournamentID uuid.UUID          `json:"tournament_id" gorm:"type:uuid;not null;index"`
+	TeamName     string             `json:"team_name" gorm:"not null"`
+	OriginClub   string             `json:"origin_club"` // Club de procedencia del equipo
+	ContactName  string             `json:"contact_name" gorm:"not null"`
+	ContactEmail string             `json:"contact_email" gorm:"not null"`
+	ContactPhone string             `json:"contact_phone,omitempty"`
+	Roster       []RosterPlayer     `json:"roster" gorm:"serializer:json"`
+	Status       RegistrationStatus `json:"status" gorm:"not null;default:'PENDING_PAYMENT'"`
+
+	EntryFee  decimal.Decimal `json:"entry_fee" gorm:"type:decimal(10,2);not null;default:0"`
+	PaymentID *uuid.UUID      `json:"payment_id,omitempty" gorm:"type:uuid"`
+	PaidAt    *time.Time      `json:"paid_at,omitempty"`
+
+	// AccessToken permite al club visitante consultar la inscripción y subir documentos sin cuenta en el club
+	AccessToken string `json:"-" gorm:"not null"`
+
+	TeamID          *uuid.UUID `json:"team_id,omitempty" gorm:"type:uuid"`
+	GroupID         *uuid.UUID `json:"group_id,omitempty" gorm:"type:uuid"`
+	ReviewedBy      *string    `json:"reviewed_by,omitempty"`
+	ReviewedAt      *time.Time `json:"reviewed_at,omitempty"`
+	RejectionReason string     `json:"rejection_reason,omitempty"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (TeamRegistration) TableName() string {
+	return "team_registrations"
+}
+
+// FindPlayer devuelve el jugador de la lista con el DNI indicado
+func (r *TeamRegistration) FindPlayer(dni string) *RosterPlayer {
+	for i := range r.Roster {
+		if r.Roster[i].DNI == dni {
+			return &r.Roster[i]
+		}
+	}
+	return nil
+}
+
+// RosterDocumentKey es la clave del archivo en el FileStorage. Un documento nuevo del mismo tipo reemplaza al anterior.
+func (r *TeamRegistration) RosterDocumentKey(dni, docType string) string {
+	return path.Join(r.ClubID, "team-registrations", r.ID.String(), dni, docType)
+}
+
+// RosterPlayerEligibility es el resultado de la verificación de un jugador de la lista
+type RosterPlayerEligibility struct {
+	DNI        string   `json:"dni"`
+	Name       string   `json:"name"`
+	IsEligible bool     `json:"is_eligible"`
+	Issues     []string `json:"issues,omitempty"`
+}
+
+// RosterEligibility es el resultado de la verificación de la lista de buena fe completa
+type RosterEligibility struct {
+	RegistrationID uuid.UUID                 `json:"registration_id"`
+	IsEligible     bool                      `json:"is_eligible"`
+	Issues         []string                  `json:"issues,omitempty"` // Problemas del plantel (cantidad de jugadores)
+	Players        []RosterPlayerEligibility `json:"players"`
+}
+
+// TeamRegistrationRepository define la persistencia de inscripciones de equipos externos
+type TeamRegistrationRepository interface {
+	Create(ctx context.Context, registration *TeamRegistration) error
+	Update(ctx context.Context, registration *TeamRegistration) error
+	GetByID(ctx context.Context, clubID string, id uuid.UUID) (*TeamRegistration, error)
+	ListByTournament(ctx context.Context, clubID string, tournamentID uuid.UUID, status RegistrationStatus) ([]TeamRegistration, error)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // VolunteerRole define el rol de un voluntario
@@ -27,6 +28,17 @@ type VolunteerAssignment struct {
 	Role    VolunteerRole `json:"role" gorm:"not null"`
 	Notes   string        `json:"notes"`
 
+	// Turno y asistencia (solo para auto-inscripciones a un VolunteerShift)
+	ShiftID         *uuid.UUID           `json:"shift_id,omitempty" gorm:"type:uuid;index"`
+	CheckInAt       *time.Time           `json:"check_in_at,omitempty"`
+	CheckOutAt      *time.Time           `json:"check_out_at,omitempty"`
+	Hours           decimal.Decimal      `json:"hours" gorm:"type:decimal(6,2);not null;default:0"`
+	HoursStatus     VolunteerHoursStatus `json:"hours_status,omitempty"`
+	HoursReviewedBy string               `json:"hours_reviewed_by,omitempty"`
+	HoursReviewedAt *time.Time           `json:"hours_reviewed_at,omitempty"`
+	CreditPaymentID *uuid.UUID           `json:"credit_payment_id,omitempty" gorm:"type:uuid"` // Pago LABOR_EXCHANGE que acreditó las horas
+	ReminderSentAt  *time.Time           `json:"reminder_sent_at,omitempty"`
+
 	// Metadata
 	AssignedBy string    `json:"assigned_by"`
 	AssignedAt time.Time `json:"assigned_at" gorm:"not null"`
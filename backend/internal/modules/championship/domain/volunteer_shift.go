@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// VolunteerHoursStatus define el estado de las horas de voluntariado de una asignación
+type VolunteerHoursStatus string
+
+const (
+	VolunteerHoursPendingApproval VolunteerHoursStatus = "PENDING_APPROVAL" // Registradas al hacer check-out
+	VolunteerHoursApproved        VolunteerHoursStatus = "APPROVED"         // Aprobadas por un administrador, disponibles para acreditar
+	VolunteerHoursRejected        VolunteerHoursStatus = "REJECTED"
+	VolunteerHoursCrediting       VolunteerHoursStatus = "CREDITING" // Reservadas por una acreditación en curso
+	VolunteerHoursCredited        VolunteerHoursStatus = "CREDITED"  // Descontadas de una cuota como pago LABOR_EXCHANGE
+)
+
+var (
+	ErrShiftFull        = errors.New("el turno no tiene lugares disponibles")
+	ErrAlreadyVolunteer = errors.New("ya estás anotado como voluntario en este partido")
+	ErrShiftHasHours    = errors.New("el turno tiene horas registradas y no se puede eliminar")
+	ErrNoHoursToCredit  = errors.New("el socio no tiene horas aprobadas para acreditar")
+)
+
+// VolunteerShift es un turno abierto de voluntariado en un partido al que los socios se anotan solos
+type VolunteerShift struct {
+	ID          uuid.UUID     `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ClubID      string        `json:"club_id" gorm:"index;not null"`
+	MatchID     uuid.UUID     `json:"match_id" gorm:"type:uuid;not null;index"`
+	Role        VolunteerRole `json:"role" gorm:"not null"`
+	StartTime   time.Time     `json:"start_time" gorm:"not null"`
+	EndTime     time.Time     `json:"end_time" gorm:"not null"`
+	Capacity    int           `json:"capacity" gorm:"not null"`
+	Description string        `json:"description"`
+	CreatedBy   string        `json:"created_by"`
+	CreatedAt   time.Time     `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time     `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// Enriched Fields
+	SignedUp int `json:"signed_up" gorm:"-"`
+}
+
+// Available devuelve los lugares libres del turno
+func (s *VolunteerShift) Available() int {
+	if s.SignedUp >= s.Capacity {
+		return 0
+	}
+	return s.Capacity - s.SignedUp
+}
+
+// VolunteerHoursSummary resume las horas de voluntariado de un socio
+type VolunteerHoursSummary struct {
+	UserID      string                `json:"user_id"`
+	Pending     decimal.Decimal       `json:"pending"`
+	Approved    decimal.Decimal       `json:"approved"` // Disponibles para acreditar
+	Credited    decimal.Decimal       `json:"credited"`
+	Assignments []VolunteerAssignment `json:"assignments"`
+}
+
+// VolunteerCredit es el resultado de acreditar horas aprobadas contra una cuota
+type VolunteerCredit struct {
+	UserID      string                `json:"user_id"`
+	PaymentID   uuid.UUID             `json:"payment_id"`
+	Hours       decimal.Decimal       `json:"hours"`
+	HourlyRate  decimal.Decimal       `json:"hourly_rate"`
+	Amount      decimal.Decimal       `json:"amount"`
+	Assignments []VolunteerAssignment `json:"assignments"`
+}
+
+// VolunteerShiftRepository define la persistencia de turnos y del seguimiento de horas
+type VolunteerShiftRepository interface {
+	CreateShift(ctx context.Context, shift *VolunteerShift) error
+	GetShift(ctx context.Context, clubID string, id uuid.UUID) (*VolunteerShift, error)
+	// DeleteShift borra el turno y sus inscripciones; devuelve ErrShiftHasHours si alguien ya hizo check-in
+	DeleteShift(ctx context.Context, clubID string, id uuid.UUID) error
+	ListShiftsByMatch(ctx context.Context, clubID string, matchID uuid.UUID) ([]VolunteerShift, error)
+	// ListShifts devuelve los turnos que empiezan en [from, to]
+	ListShifts(ctx context.Context, clubID string, from, to time.Time) ([]VolunteerShift, error)
+
+	GetAssignment(ctx context.Context, clubID string, id uuid.UUID) (*VolunteerAssignment, error)
+	ListAssignmentsByShift(ctx context.Context, clubID string, shiftID uuid.UUID) ([]VolunteerAssignment, error)
+	// ListAssignmentsByHoursStatus filtra por estado de horas y, opcionalmente, por socio
+	ListAssignmentsByHoursStatus(ctx context.Context, clubID, userID string, status VolunteerHoursStatus) ([]VolunteerAssignment, error)
+
+	// SignUp crea la inscripción al turno con el turno bloqueado; devuelve ErrShiftFull sin cupo
+	// y ErrAlreadyVolunteer si el socio ya está en el partido
+	SignUp(ctx context.Context, assignment *VolunteerAssignment) error
+	// ClaimApprovedHours pasa las horas aprobadas del socio a CREDITING y las devuelve, para que
+	// dos acreditaciones simultáneas no tomen las mismas horas
+	ClaimApprovedHours(ctx context.Context, clubID, userID string) ([]VolunteerAssignment, error)
+	// CompleteHoursCredit marca como acreditadas las horas reservadas con el pago que las imputó
+	CompleteHoursCredit(ctx context.Context, clubID string, ids []uuid.UUID, paymentID uuid.UUID) error
+	// ReleaseHoursClaim devuelve las horas reservadas a APPROVED cuando el pago falla
+	ReleaseHoursClaim(ctx context.Context, clubID string, ids []uuid.UUID) error
+}
@@ -139,6 +139,16 @@ func (m *MockChampionshipRepo) GetTeamMembers(ctx context.Context, id string) ([
 	return args.Get(0).([]string), args.Error(1)
 }
 
+func (m *MockChampionshipRepo) GetTeamsMembers(ctx context.Context, teamIDs []string) (map[string][]string, error) {
+	args := m.Called(ctx, teamIDs)
+	return args.Get(0).(map[string][]string), args.Error(1)
+}
+
+func (m *MockChampionshipRepo) GetTeamStandings(ctx context.Context, clubID, teamID string) ([]domain.Standing, error) {
+	args := m.Called(ctx, clubID, teamID)
+	return args.Get(0).([]domain.Standing), args.Error(1)
+}
+
 func (m *MockChampionshipRepo) CreateTeam(ctx context.Context, team *domain.Team) error {
 	args := m.Called(ctx, team)
 	return args.Error(0)
@@ -314,7 +324,7 @@ func setupRouter(h *handler.ChampionshipHandler, clubID, userID, role string) *g
 
 func TestChampionshipHandler_Tournaments(t *testing.T) {
 	mockRepo := new(MockChampionshipRepo)
-	uc := application.NewChampionshipUseCases(mockRepo, nil, nil)
+	uc := application.NewChampionshipUseCases(mockRepo, nil, nil, nil)
 	h := handler.NewChampionshipHandler(uc, nil, nil)
 	cID := uuid.New().String()
 	uID := uuid.New().String()
@@ -346,7 +356,7 @@ func TestChampionshipHandler_Tournaments(t *testing.T) {
 
 func TestChampionshipHandler_StandingsAndFixture(t *testing.T) {
 	mockRepo := new(MockChampionshipRepo)
-	uc := application.NewChampionshipUseCases(mockRepo, nil, nil)
+	uc := application.NewChampionshipUseCases(mockRepo, nil, nil, nil)
 	h := handler.NewChampionshipHandler(uc, nil, nil)
 	cID := uuid.New().String()
 	uID := uuid.New().String()
@@ -408,7 +418,7 @@ func TestChampionshipHandler_Volunteers(t *testing.T) {
 func TestChampionshipHandler_Public(t *testing.T) {
 	mockRepo := new(MockChampionshipRepo)
 	mockClubRepo := new(MockClubRepo)
-	uc := application.NewChampionshipUseCases(mockRepo, nil, nil)
+	uc := application.NewChampionshipUseCases(mockRepo, nil, nil, nil)
 	clubSvc := clubApp.NewClubUseCases(nil, mockClubRepo, nil, nil)
 	h := handler.NewChampionshipHandler(uc, nil, clubSvc)
 
@@ -446,7 +456,7 @@ func TestChampionshipHandler_StagesAndResults(t *testing.T) {
 	mockRepo := new(MockChampionshipRepo)
 	mockVolunteerSvc := new(MockVolunteerService)
 	mockBookingSvc := new(MockBookingService)
-	uc := application.NewChampionshipUseCases(mockRepo, mockBookingSvc, nil)
+	uc := application.NewChampionshipUseCases(mockRepo, mockBookingSvc, nil, nil)
 	h := handler.NewChampionshipHandler(uc, mockVolunteerSvc, nil)
 	cID := uuid.New().String()
 	uID := uuid.New().String()
@@ -539,7 +549,7 @@ func TestChampionshipHandler_DetailedErrors(t *testing.T) {
 	mockRepo := new(MockChampionshipRepo)
 	mockVolunteerSvc := new(MockVolunteerService)
 	mockBookingSvc := new(MockBookingService)
-	uc := application.NewChampionshipUseCases(mockRepo, mockBookingSvc, nil)
+	uc := application.NewChampionshipUseCases(mockRepo, mockBookingSvc, nil, nil)
 	h := handler.NewChampionshipHandler(uc, mockVolunteerSvc, nil)
 	cID := uuid.New().String()
 	uID := uuid.New().String()
@@ -0,0 +1,127 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	clubApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/club/application"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// LiveMatchHandler expone el marcador en vivo. Las actualizaciones se difunden por WebSocket
+// en los tópicos "match:<id>" y "tournament:<id>".
+type LiveMatchHandler struct {
+	liveService  *application.LiveMatchService
+	clubUseCases *clubApp.ClubUseCases
+}
+
+// NewLiveMatchHandler crea una nueva instancia del handler
+func NewLiveMatchHandler(liveService *application.LiveMatchService, clubUseCases *clubApp.ClubUseCases) *LiveMatchHandler {
+	return &LiveMatchHandler{
+		liveService:  liveService,
+		clubUseCases: clubUseCases,
+	}
+}
+
+func (h *LiveMatchHandler) RegisterRoutes(r *gin.RouterGroup, authMiddleware gin.HandlerFunc, tenantMiddleware gin.HandlerFunc) {
+	group := r.Group("/championships")
+	group.Use(authMiddleware, tenantMiddleware)
+	{
+		group.PUT("/matches/:id/live", h.UpdateLiveScore)
+		group.GET("/matches/:id/live", h.GetLiveMatch)
+		group.GET("/groups/:id/live-standings", h.GetLiveStandings)
+	}
+
+	// Public Routes (páginas públicas del torneo)
+	public := r.Group("/public/clubs/:slug/championships")
+	{
+		public.GET("/matches/:id/live", h.GetPublicLiveMatch)
+		public.GET("/groups/:id/live-standings", h.GetPublicLiveStandings)
+	}
+}
+
+// UpdateLiveScore actualiza el marcador parcial del partido (árbitro, mesa de control o staff)
+// PUT /championships/matches/:id/live
+func (h *LiveMatchHandler) UpdateLiveScore(c *gin.Context) {
+	clubID := c.GetString("clubID")
+	matchID := c.Param("id")
+
+	role, _ := c.Get("userRole")
+	isStaff := role == userDomain.RoleAdmin || role == userDomain.RoleSuperAdmin || role == userDomain.RoleCoach || role == "STAFF"
+	if !isStaff && !h.liveService.IsMatchVolunteer(c.Request.Context(), clubID, matchID, c.GetString("userID")) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN, COACH, STAFF role or a volunteer assigned to the match"})
+		return
+	}
+
+	var input application.LiveScoreInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = clubID
+	input.MatchID = matchID
+
+	match, err := h.liveService.UpdateLiveScore(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, match)
+}
+
+// GetLiveMatch obtiene el marcador e incidencias actuales del partido
+// GET /championships/matches/:id/live
+func (h *LiveMatchHandler) GetLiveMatch(c *gin.Context) {
+	snapshot, err := h.liveService.GetLiveMatch(c.Request.Context(), c.GetString("clubID"), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, snapshot)
+}
+
+// GetLiveStandings obtiene la tabla del grupo proyectada con los partidos en curso
+// GET /championships/groups/:id/live-standings
+func (h *LiveMatchHandler) GetLiveStandings(c *gin.Context) {
+	standings, err := h.liveService.GetLiveStandings(c.Request.Context(), c.GetString("clubID"), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, standings)
+}
+
+// GetPublicLiveMatch obtiene el estado del partido para la página pública
+// GET /public/clubs/:slug/championships/matches/:id/live
+func (h *LiveMatchHandler) GetPublicLiveMatch(c *gin.Context) {
+	club, err := h.clubUseCases.GetClubBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Club not found"})
+		return
+	}
+
+	snapshot, err := h.liveService.GetLiveMatch(c.Request.Context(), club.ID, c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, snapshot)
+}
+
+// GetPublicLiveStandings obtiene la tabla proyectada para la página pública
+// GET /public/clubs/:slug/championships/groups/:id/live-standings
+func (h *LiveMatchHandler) GetPublicLiveStandings(c *gin.Context) {
+	club, err := h.clubUseCases.GetClubBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Club not found"})
+		return
+	}
+
+	standings, err := h.liveService.GetLiveStandings(c.Request.Context(), club.ID, c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, standings)
+}
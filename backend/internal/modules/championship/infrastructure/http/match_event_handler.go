@@ -0,0 +1,133 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// MatchEventHandler expone la carga de incidencias y las estadísticas por jugador del torneo
+type MatchEventHandler struct {
+	eventService *application.MatchEventService
+}
+
+// NewMatchEventHandler crea una nueva instancia del handler
+func NewMatchEventHandler(eventService *application.MatchEventService) *MatchEventHandler {
+	return &MatchEventHandler{
+		eventService: eventService,
+	}
+}
+
+func (h *MatchEventHandler) RegisterRoutes(r *gin.RouterGroup, authMiddleware gin.HandlerFunc, tenantMiddleware gin.HandlerFunc) {
+	group := r.Group("/championships")
+	group.Use(authMiddleware, tenantMiddleware)
+	{
+		group.POST("/matches/:id/events", h.RecordEvent)
+		group.GET("/matches/:id/events", h.GetMatchEvents)
+		group.DELETE("/events/:id", h.DeleteEvent)
+		group.GET("/:id/player-stats", h.GetPlayerStats)
+		group.GET("/:id/top-scorers", h.GetTopScorers)
+		group.GET("/:id/discipline", h.GetDisciplineTable)
+	}
+}
+
+// RecordEvent registra una incidencia (gol, asistencia, tarjeta, figura, cambio).
+// Además del staff, la cargan los voluntarios y oficiales designados en el partido.
+// POST /championships/matches/:id/events
+func (h *MatchEventHandler) RecordEvent(c *gin.Context) {
+	role, _ := c.Get("userRole")
+	isStaff := role == userDomain.RoleAdmin || role == userDomain.RoleSuperAdmin || role == userDomain.RoleCoach || role == "STAFF"
+	if !isStaff && !h.eventService.IsAssignedToMatch(c.Request.Context(), c.GetString("clubID"), c.Param("id"), c.GetString("userID")) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN, COACH, STAFF role or an official or volunteer assigned to the match"})
+		return
+	}
+
+	var input application.RecordMatchEventInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.MatchID = c.Param("id")
+	input.RecordedBy = c.GetString("userID")
+
+	event, err := h.eventService.RecordEvent(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, event)
+}
+
+// GetMatchEvents obtiene las incidencias de un partido
+// GET /championships/matches/:id/events
+func (h *MatchEventHandler) GetMatchEvents(c *gin.Context) {
+	events, err := h.eventService.GetMatchEvents(c.Request.Context(), c.GetString("clubID"), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, events)
+}
+
+// DeleteEvent elimina una incidencia cargada por error
+// DELETE /championships/events/:id
+func (h *MatchEventHandler) DeleteEvent(c *gin.Context) {
+	role, exists := c.Get("userRole")
+	if !exists || (role != userDomain.RoleAdmin && role != userDomain.RoleSuperAdmin) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	eventID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid event ID"})
+		return
+	}
+
+	if err := h.eventService.DeleteEvent(c.Request.Context(), c.GetString("clubID"), eventID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// GetPlayerStats obtiene las estadísticas por jugador del torneo
+// GET /championships/:id/player-stats
+func (h *MatchEventHandler) GetPlayerStats(c *gin.Context) {
+	stats, err := h.eventService.GetPlayerStats(c.Request.Context(), c.GetString("clubID"), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, stats)
+}
+
+// GetTopScorers obtiene la tabla de goleadores
+// GET /championships/:id/top-scorers?limit=10
+func (h *MatchEventHandler) GetTopScorers(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	scorers, err := h.eventService.GetTopScorers(c.Request.Context(), c.GetString("clubID"), c.Param("id"), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, scorers)
+}
+
+// GetDisciplineTable obtiene la tabla de tarjetas del torneo
+// GET /championships/:id/discipline
+func (h *MatchEventHandler) GetDisciplineTable(c *gin.Context) {
+	table, err := h.eventService.GetDisciplineTable(c.Request.Context(), c.GetString("clubID"), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, table)
+}
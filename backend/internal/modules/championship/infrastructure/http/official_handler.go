@@ -0,0 +1,282 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+)
+
+// OfficialHandler expone el registro de árbitros y oficiales, su disponibilidad, las designaciones y los honorarios
+type OfficialHandler struct {
+	officialService *application.OfficialService
+}
+
+// NewOfficialHandler crea una nueva instancia del handler
+func NewOfficialHandler(officialService *application.OfficialService) *OfficialHandler {
+	return &OfficialHandler{officialService: officialService}
+}
+
+func (h *OfficialHandler) RegisterRoutes(r *gin.RouterGroup, authMiddleware gin.HandlerFunc, tenantMiddleware gin.HandlerFunc) {
+	group := r.Group("/championships")
+	group.Use(authMiddleware, tenantMiddleware)
+	{
+		group.POST("/officials", h.RegisterOfficial)
+		group.GET("/officials", h.ListOfficials)
+		group.PUT("/officials/:id/status", h.SetOfficialStatus)
+		group.POST("/officials/:id/availability", h.AddAvailability)
+		group.GET("/officials/:id/availability", h.ListAvailability)
+		group.DELETE("/officials/availability/:id", h.RemoveAvailability)
+		group.POST("/officials/auto-assign", h.AutoAssign)
+		group.DELETE("/officials/assignments/:id", h.RemoveAssignment)
+		group.GET("/officials/payouts", h.GetPayoutReport)
+		group.POST("/officials/:id/payouts/settle", h.SettlePayouts)
+		group.POST("/matches/:id/officials", h.AssignOfficial)
+		group.GET("/matches/:id/officials", h.GetMatchOfficials)
+	}
+}
+
+// parsePeriod lee el período ?from=YYYY-MM-DD&to=YYYY-MM-DD (ambos días inclusive)
+func parsePeriod(c *gin.Context) (time.Time, time.Time, bool) {
+	from, err := time.Parse("2006-01-02", c.Query("from"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de fecha inválido. Use YYYY-MM-DD"})
+		return time.Time{}, time.Time{}, false
+	}
+	to, err := time.Parse("2006-01-02", c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de fecha inválido. Use YYYY-MM-DD"})
+		return time.Time{}, time.Time{}, false
+	}
+	return from, to.AddDate(0, 0, 1), true
+}
+
+// RegisterOfficial da de alta un árbitro u oficial con sus habilitaciones
+// POST /championships/officials
+func (h *OfficialHandler) RegisterOfficial(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	var input application.RegisterOfficialInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+
+	official, err := h.officialService.RegisterOfficial(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, official)
+}
+
+// ListOfficials lista los oficiales activos, opcionalmente habilitados para un deporte y rol
+// GET /championships/officials?sport=FUTBOL&role=REFEREE
+func (h *OfficialHandler) ListOfficials(c *gin.Context) {
+	officials, err := h.officialService.ListOfficials(c.Request.Context(), c.GetString("clubID"), c.Query("sport"), domain.OfficialRole(c.Query("role")))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, officials)
+}
+
+// SetOfficialStatus da de baja o reactiva a un oficial
+// PUT /championships/officials/:id/status
+func (h *OfficialHandler) SetOfficialStatus(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	var input struct {
+		IsActive bool `json:"is_active"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	official, err := h.officialService.SetOfficialActive(c.Request.Context(), c.GetString("clubID"), c.Param("id"), input.IsActive)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, official)
+}
+
+// AddAvailability registra una franja de disponibilidad del oficial
+// POST /championships/officials/:id/availability
+func (h *OfficialHandler) AddAvailability(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	var input application.AddAvailabilityInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.OfficialID = c.Param("id")
+
+	availability, err := h.officialService.AddAvailability(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, availability)
+}
+
+// ListAvailability obtiene el calendario de disponibilidad del oficial
+// GET /championships/officials/:id/availability?from=2026-10-01&to=2026-10-31
+func (h *OfficialHandler) ListAvailability(c *gin.Context) {
+	from, to, ok := parsePeriod(c)
+	if !ok {
+		return
+	}
+
+	availability, err := h.officialService.ListAvailability(c.Request.Context(), c.GetString("clubID"), c.Param("id"), from, to)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, availability)
+}
+
+// RemoveAvailability elimina una franja de disponibilidad
+// DELETE /championships/officials/availability/:id
+func (h *OfficialHandler) RemoveAvailability(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	if err := h.officialService.RemoveAvailability(c.Request.Context(), c.GetString("clubID"), c.Param("id")); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// AssignOfficial designa un oficial para el partido
+// POST /championships/matches/:id/officials
+func (h *OfficialHandler) AssignOfficial(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	var input application.AssignOfficialInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.MatchID = c.Param("id")
+	input.AssignedBy = c.GetString("userID")
+
+	assignment, err := h.officialService.AssignOfficial(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, assignment)
+}
+
+// GetMatchOfficials obtiene la terna designada para el partido
+// GET /championships/matches/:id/officials
+func (h *OfficialHandler) GetMatchOfficials(c *gin.Context) {
+	assignments, err := h.officialService.GetMatchOfficials(c.Request.Context(), c.GetString("clubID"), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, assignments)
+}
+
+// RemoveAssignment elimina una designación
+// DELETE /championships/officials/assignments/:id
+func (h *OfficialHandler) RemoveAssignment(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	if err := h.officialService.RemoveAssignment(c.Request.Context(), c.GetString("clubID"), c.Param("id")); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// AutoAssign designa automáticamente los partidos programados del período
+// POST /championships/officials/auto-assign
+func (h *OfficialHandler) AutoAssign(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	var input application.AutoAssignInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.AssignedBy = c.GetString("userID")
+
+	result, err := h.officialService.AutoAssign(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// GetPayoutReport informa lo adeudado a cada oficial en el período
+// GET /championships/officials/payouts?from=2026-10-01&to=2026-10-31
+func (h *OfficialHandler) GetPayoutReport(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+	from, to, ok := parsePeriod(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.officialService.GetPayoutReport(c.Request.Context(), c.GetString("clubID"), from, to)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+// SettlePayouts registra el pago de lo adeudado al oficial en el período
+// POST /championships/officials/:id/payouts/settle?from=2026-10-01&to=2026-10-31
+func (h *OfficialHandler) SettlePayouts(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+	from, to, ok := parsePeriod(c)
+	if !ok {
+		return
+	}
+
+	payout, err := h.officialService.SettlePayouts(c.Request.Context(), c.GetString("clubID"), c.Param("id"), from, to)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, payout)
+}
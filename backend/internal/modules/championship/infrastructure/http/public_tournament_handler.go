@@ -0,0 +1,168 @@
+package http
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	clubApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/club/application"
+)
+
+// publicCacheControl permite a navegadores y CDNs reutilizar las vistas públicas por un minuto
+const publicCacheControl = "public, max-age=60"
+
+// PublicTournamentHandler expone las vistas públicas del torneo: llave, fixture exportable y widget embebible.
+// Todas las respuestas llevan ETag calculado sobre los datos, así que un 304 no vuelve a generar el archivo.
+type PublicTournamentHandler struct {
+	service      *application.PublicTournamentService
+	clubUseCases *clubApp.ClubUseCases
+}
+
+// NewPublicTournamentHandler crea una nueva instancia del handler
+func NewPublicTournamentHandler(service *application.PublicTournamentService, clubUseCases *clubApp.ClubUseCases) *PublicTournamentHandler {
+	return &PublicTournamentHandler{
+		service:      service,
+		clubUseCases: clubUseCases,
+	}
+}
+
+func (h *PublicTournamentHandler) RegisterRoutes(r *gin.RouterGroup) {
+	public := r.Group("/public/clubs/:slug/championships")
+	{
+		public.GET("/:id/bracket", h.GetBracket)
+		public.GET("/:id/fixture.csv", h.ExportFixtureCSV)
+		public.GET("/:id/fixture.pdf", h.ExportFixturePDF)
+		public.GET("/:id/widget", h.GetWidget)
+	}
+}
+
+// GetBracket obtiene el árbol de las fases eliminatorias
+// GET /public/clubs/:slug/championships/:id/bracket
+func (h *PublicTournamentHandler) GetBracket(c *gin.Context) {
+	club, err := h.clubUseCases.GetClubBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Club not found"})
+		return
+	}
+
+	brackets, err := h.service.GetBrackets(c.Request.Context(), club.ID, c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if notModified(c, "bracket", brackets) {
+		return
+	}
+	c.JSON(http.StatusOK, brackets)
+}
+
+// ExportFixtureCSV descarga el fixture del torneo en CSV
+// GET /public/clubs/:slug/championships/:id/fixture.csv
+func (h *PublicTournamentHandler) ExportFixtureCSV(c *gin.Context) {
+	club, err := h.clubUseCases.GetClubBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Club not found"})
+		return
+	}
+
+	fixture, err := h.service.GetFixture(c.Request.Context(), club.ID, c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if notModified(c, "csv", fixture) {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := h.service.WriteFixtureCSV(fixture, &buf); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", application.FixtureFilename(fixture, "csv")))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+// ExportFixturePDF descarga el fixture del torneo en PDF imprimible
+// GET /public/clubs/:slug/championships/:id/fixture.pdf
+func (h *PublicTournamentHandler) ExportFixturePDF(c *gin.Context) {
+	club, err := h.clubUseCases.GetClubBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Club not found"})
+		return
+	}
+
+	fixture, err := h.service.GetFixture(c.Request.Context(), club.ID, c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if notModified(c, "pdf", fixture) {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := h.service.WriteFixturePDF(fixture, &buf); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=%s", application.FixtureFilename(fixture, "pdf")))
+	c.Data(http.StatusOK, "application/pdf", buf.Bytes())
+}
+
+// GetWidget obtiene el payload embebible en la web del club (tablas, próximos partidos y resultados)
+// GET /public/clubs/:slug/championships/:id/widget?limit=5
+func (h *PublicTournamentHandler) GetWidget(c *gin.Context) {
+	club, err := h.clubUseCases.GetClubBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Club not found"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	widget, err := h.service.GetWidget(c.Request.Context(), club.ID, c.Param("id"), limit)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	widget.ClubName = club.Name
+	widget.ClubLogoURL = club.LogoURL
+	widget.PrimaryColor = club.PrimaryColor
+
+	// El widget se consume desde las webs de los clubes, en otro dominio
+	c.Header("Access-Control-Allow-Origin", "*")
+	if notModified(c, "widget", widget) {
+		return
+	}
+	c.JSON(http.StatusOK, widget)
+}
+
+// notModified fija ETag y Cache-Control a partir de los datos de la respuesta y
+// contesta 304 si el cliente ya tiene esa versión
+func notModified(c *gin.Context, kind string, data interface{}) bool {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return false
+	}
+	sum := sha256.Sum256(append([]byte(kind+":"), payload...))
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", publicCacheControl)
+
+	for _, candidate := range strings.Split(c.GetHeader("If-None-Match"), ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			c.Status(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
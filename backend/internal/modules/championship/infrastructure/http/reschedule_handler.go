@@ -0,0 +1,121 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+)
+
+// RescheduleHandler expone la reprogramación de partidos: los capitanes proponen horarios,
+// el rival elige uno y el organizador puede postergar partidos.
+type RescheduleHandler struct {
+	rescheduleService *application.MatchRescheduleService
+}
+
+// NewRescheduleHandler crea una nueva instancia del handler
+func NewRescheduleHandler(rescheduleService *application.MatchRescheduleService) *RescheduleHandler {
+	return &RescheduleHandler{rescheduleService: rescheduleService}
+}
+
+func (h *RescheduleHandler) RegisterRoutes(r *gin.RouterGroup, authMiddleware gin.HandlerFunc, tenantMiddleware gin.HandlerFunc) {
+	group := r.Group("/championships")
+	group.Use(authMiddleware, tenantMiddleware)
+	{
+		group.POST("/matches/:id/reschedule-requests", h.RequestReschedule)
+		group.GET("/matches/:id/reschedule-requests", h.ListRescheduleRequests)
+		group.POST("/matches/:id/postpone", h.PostponeMatch)
+		group.POST("/reschedule-requests/:id/respond", h.RespondReschedule)
+		group.POST("/reschedule-requests/:id/withdraw", h.WithdrawReschedule)
+	}
+}
+
+// RequestReschedule propone nuevos horarios para el partido (capitanes)
+// POST /championships/matches/:id/reschedule-requests
+func (h *RescheduleHandler) RequestReschedule(c *gin.Context) {
+	var input application.RequestRescheduleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.MatchID = c.Param("id")
+	input.UserID = c.GetString("userID")
+
+	request, err := h.rescheduleService.RequestReschedule(c.Request.Context(), input)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, domain.ErrReschedulePending) {
+			status = http.StatusConflict
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, request)
+}
+
+// ListRescheduleRequests obtiene el historial de reprogramaciones del partido
+// GET /championships/matches/:id/reschedule-requests
+func (h *RescheduleHandler) ListRescheduleRequests(c *gin.Context) {
+	requests, err := h.rescheduleService.ListRescheduleRequests(c.Request.Context(), c.GetString("clubID"), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, requests)
+}
+
+// PostponeMatch posterga el partido sin nueva fecha y libera la cancha (organizador)
+// POST /championships/matches/:id/postpone
+func (h *RescheduleHandler) PostponeMatch(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	var input struct {
+		Reason string `json:"reason"`
+	}
+	_ = c.ShouldBindJSON(&input)
+
+	match, err := h.rescheduleService.PostponeMatch(c.Request.Context(), c.GetString("clubID"), c.Param("id"), input.Reason)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, match)
+}
+
+// RespondReschedule acepta uno de los horarios propuestos o rechaza la propuesta (capitán rival u organizador)
+// POST /championships/reschedule-requests/:id/respond
+func (h *RescheduleHandler) RespondReschedule(c *gin.Context) {
+	var input application.RespondRescheduleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.RequestID = c.Param("id")
+	input.UserID = c.GetString("userID")
+	input.IsOrganizer = isTournamentOrganizer(c)
+
+	request, err := h.rescheduleService.RespondReschedule(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, request)
+}
+
+// WithdrawReschedule retira una propuesta pendiente
+// POST /championships/reschedule-requests/:id/withdraw
+func (h *RescheduleHandler) WithdrawReschedule(c *gin.Context) {
+	request, err := h.rescheduleService.WithdrawReschedule(c.Request.Context(), c.GetString("clubID"), c.Param("id"), c.GetString("userID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, request)
+}
@@ -0,0 +1,125 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// SuspensionHandler expone el registro de sanciones y la validación de alineaciones
+type SuspensionHandler struct {
+	suspensionService *application.SuspensionService
+}
+
+// NewSuspensionHandler crea una nueva instancia del handler
+func NewSuspensionHandler(suspensionService *application.SuspensionService) *SuspensionHandler {
+	return &SuspensionHandler{
+		suspensionService: suspensionService,
+	}
+}
+
+func (h *SuspensionHandler) RegisterRoutes(r *gin.RouterGroup, authMiddleware gin.HandlerFunc, tenantMiddleware gin.HandlerFunc) {
+	group := r.Group("/championships")
+	group.Use(authMiddleware, tenantMiddleware)
+	{
+		group.GET("/:id/suspensions", h.ListSuspensions)
+		group.POST("/:id/suspensions", h.CreateSuspension)
+		group.GET("/:id/players/:userId/eligibility", h.CheckPlayerEligibility)
+		group.POST("/matches/:id/lineup/validate", h.ValidateLineup)
+	}
+}
+
+// ListSuspensions obtiene las sanciones del torneo
+// GET /championships/:id/suspensions?active=true
+func (h *SuspensionHandler) ListSuspensions(c *gin.Context) {
+	activeOnly := c.Query("active") == "true"
+
+	suspensions, err := h.suspensionService.ListSuspensions(c.Request.Context(), c.GetString("clubID"), c.Param("id"), activeOnly)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, suspensions)
+}
+
+// CreateSuspension registra una sanción del tribunal de disciplina
+// POST /championships/:id/suspensions
+func (h *SuspensionHandler) CreateSuspension(c *gin.Context) {
+	role, exists := c.Get("userRole")
+	if !exists || (role != userDomain.RoleAdmin && role != userDomain.RoleSuperAdmin) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	var input application.CreateSuspensionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.TournamentID = c.Param("id")
+	input.CreatedBy = c.GetString("userID")
+
+	suspension, err := h.suspensionService.CreateSuspension(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, suspension)
+}
+
+// CheckPlayerEligibility indica si un jugador puede ser alineado en el torneo.
+// El jugador consulta la suya; el staff del club, la de cualquier jugador del torneo.
+// GET /championships/:id/players/:userId/eligibility
+func (h *SuspensionHandler) CheckPlayerEligibility(c *gin.Context) {
+	role := c.GetString("userRole")
+	isStaff := role == userDomain.RoleAdmin || role == userDomain.RoleSuperAdmin || role == userDomain.RoleCoach || role == "STAFF"
+	if !isStaff && c.GetString("userID") != c.Param("userId") {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN, COACH or STAFF role"})
+		return
+	}
+
+	result, err := h.suspensionService.GetPlayerEligibility(c.Request.Context(), c.GetString("clubID"), c.Param("id"), c.Param("userId"))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, application.ErrPlayerNotInTournament) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// ValidateLineup valida la alineación de un equipo antes del partido.
+// Responde 422 con el detalle si algún jugador no está habilitado.
+// POST /championships/matches/:id/lineup/validate
+func (h *SuspensionHandler) ValidateLineup(c *gin.Context) {
+	role, exists := c.Get("userRole")
+	if !exists || (role != userDomain.RoleAdmin && role != userDomain.RoleSuperAdmin && role != userDomain.RoleCoach && role != "STAFF") {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN, COACH or STAFF role"})
+		return
+	}
+
+	var input application.ValidateLineupInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.MatchID = c.Param("id")
+
+	result, err := h.suspensionService.ValidateLineup(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !result.IsValid {
+		c.JSON(http.StatusUnprocessableEntity, result)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
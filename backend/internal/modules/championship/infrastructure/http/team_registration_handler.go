@@ -0,0 +1,328 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	clubApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/club/application"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// TeamRegistrationHandler expone la inscripción de equipos de otros clubes (torneos interclubes).
+// El club visitante gestiona su inscripción con el token devuelto al inscribirse (header X-Registration-Token).
+type TeamRegistrationHandler struct {
+	registrationService *application.TeamRegistrationService
+	clubUseCases        *clubApp.ClubUseCases
+}
+
+// NewTeamRegistrationHandler crea una nueva instancia del handler
+func NewTeamRegistrationHandler(registrationService *application.TeamRegistrationService, clubUseCases *clubApp.ClubUseCases) *TeamRegistrationHandler {
+	return &TeamRegistrationHandler{
+		registrationService: registrationService,
+		clubUseCases:        clubUseCases,
+	}
+}
+
+func (h *TeamRegistrationHandler) RegisterRoutes(r *gin.RouterGroup, authMiddleware gin.HandlerFunc, tenantMiddleware gin.HandlerFunc) {
+	group := r.Group("/championships")
+	group.Use(authMiddleware, tenantMiddleware)
+	{
+		group.GET("/:id/registrations", h.ListRegistrations)
+		group.GET("/registrations/:id/eligibility", h.CheckRosterEligibility)
+		group.POST("/registrations/:id/approve", h.ApproveRegistration)
+		group.POST("/registrations/:id/reject", h.RejectRegistration)
+		group.GET("/registrations/:id/players/:dni/documents/:type/file", h.DownloadRosterDocument)
+		group.POST("/registrations/:id/players/:dni/documents/:type/review", h.ReviewRosterDocument)
+	}
+
+	// Public Routes (formulario de inscripción para clubes visitantes)
+	public := r.Group("/public/clubs/:slug/championships")
+	{
+		public.POST("/:id/registrations", h.RegisterExternalTeam)
+		public.GET("/registrations/:id", h.GetPublicRegistration)
+		public.POST("/registrations/:id/checkout", h.RetryEntryFeeCheckout)
+		public.POST("/registrations/:id/players/:dni/documents", h.UploadRosterDocument)
+	}
+}
+
+func isTournamentOrganizer(c *gin.Context) bool {
+	role, exists := c.Get("userRole")
+	return exists && (role == userDomain.RoleAdmin || role == userDomain.RoleSuperAdmin)
+}
+
+// RegisterExternalTeam recibe el formulario de inscripción y devuelve el link de pago del arancel
+// POST /public/clubs/:slug/championships/:id/registrations
+func (h *TeamRegistrationHandler) RegisterExternalTeam(c *gin.Context) {
+	club, err := h.clubUseCases.GetClubBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Club not found"})
+		return
+	}
+
+	var input application.RegisterExternalTeamInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = club.ID
+	input.TournamentID = c.Param("id")
+
+	result, err := h.registrationService.RegisterExternalTeam(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
+
+// GetPublicRegistration muestra el estado de la inscripción al club visitante
+// GET /public/clubs/:slug/championships/registrations/:id
+func (h *TeamRegistrationHandler) GetPublicRegistration(c *gin.Context) {
+	club, err := h.clubUseCases.GetClubBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Club not found"})
+		return
+	}
+
+	registration, err := h.registrationService.GetRegistrationWithToken(c.Request.Context(), club.ID, c.Param("id"), c.GetHeader("X-Registration-Token"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, registration)
+}
+
+// RetryEntryFeeCheckout genera un nuevo link de pago para una inscripción pendiente de pago
+// POST /public/clubs/:slug/championships/registrations/:id/checkout
+func (h *TeamRegistrationHandler) RetryEntryFeeCheckout(c *gin.Context) {
+	club, err := h.clubUseCases.GetClubBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Club not found"})
+		return
+	}
+
+	result, err := h.registrationService.RetryEntryFeeCheckout(c.Request.Context(), club.ID, c.Param("id"), c.GetHeader("X-Registration-Token"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// UploadRosterDocument sube el DNI o el apto médico de un jugador de la lista de buena fe.
+// El archivo se guarda en el storage y queda pendiente de revisión del organizador.
+// POST /public/clubs/:slug/championships/registrations/:id/players/:dni/documents
+func (h *TeamRegistrationHandler) UploadRosterDocument(c *gin.Context) {
+	club, err := h.clubUseCases.GetClubBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Club not found"})
+		return
+	}
+
+	docType := c.PostForm("type")
+	if docType == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Tipo de documento requerido"})
+		return
+	}
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Archivo requerido"})
+		return
+	}
+	body, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No se pudo leer el archivo"})
+		return
+	}
+	defer body.Close()
+
+	registration, err := h.registrationService.AttachRosterDocument(c.Request.Context(), application.AttachRosterDocumentInput{
+		ClubID:         club.ID,
+		RegistrationID: c.Param("id"),
+		Token:          c.GetHeader("X-Registration-Token"),
+		DNI:            c.Param("dni"),
+		Type:           docType,
+		FileName:       file.Filename,
+		Size:           file.Size,
+		Body:           body,
+	})
+	if err != nil {
+		c.JSON(rosterDocumentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, registration)
+}
+
+func rosterDocumentErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, application.ErrRosterDocumentNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, application.ErrRosterDocumentTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, application.ErrRosterDocumentUnsupported):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, application.ErrRosterDocumentInfected):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, application.ErrRosterStorageUnavailable):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// DownloadRosterDocument descarga un documento de la lista de buena fe para revisarlo
+// GET /championships/registrations/:id/players/:dni/documents/:type/file
+func (h *TeamRegistrationHandler) DownloadRosterDocument(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	body, doc, err := h.registrationService.OpenRosterDocument(c.Request.Context(), c.GetString("clubID"), c.Param("id"), c.Param("dni"), c.Param("type"))
+	if err != nil {
+		c.JSON(rosterDocumentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	defer body.Close()
+
+	contentType := doc.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=%q", c.Param("dni")+"_"+doc.Type))
+	c.Header("Cache-Control", "private, no-store")
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.DataFromReader(http.StatusOK, doc.SizeBytes, contentType, body, nil)
+}
+
+// ReviewRosterDocument aprueba o rechaza un documento de la lista; al aprobar el apto médico
+// el organizador carga su vencimiento
+// POST /championships/registrations/:id/players/:dni/documents/:type/review
+func (h *TeamRegistrationHandler) ReviewRosterDocument(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	var req struct {
+		Approve        bool   `json:"approve"`
+		ExpirationDate string `json:"expiration_date"` // Formato: YYYY-MM-DD
+		Notes          string `json:"notes"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var expirationDate *time.Time
+	if req.ExpirationDate != "" {
+		parsed, err := time.Parse("2006-01-02", req.ExpirationDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de fecha inválido. Use YYYY-MM-DD"})
+			return
+		}
+		expirationDate = &parsed
+	}
+
+	registration, err := h.registrationService.ReviewRosterDocument(c.Request.Context(), application.ReviewRosterDocumentInput{
+		ClubID:         c.GetString("clubID"),
+		RegistrationID: c.Param("id"),
+		DNI:            c.Param("dni"),
+		Type:           c.Param("type"),
+		ReviewerID:     c.GetString("userID"),
+		Approve:        req.Approve,
+		ExpirationDate: expirationDate,
+		Notes:          req.Notes,
+	})
+	if err != nil {
+		c.JSON(rosterDocumentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, registration)
+}
+
+// ListRegistrations lista las inscripciones del torneo
+// GET /championships/:id/registrations?status=PENDING_APPROVAL
+func (h *TeamRegistrationHandler) ListRegistrations(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	registrations, err := h.registrationService.ListRegistrations(c.Request.Context(), c.GetString("clubID"), c.Param("id"), domain.RegistrationStatus(c.Query("status")))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, registrations)
+}
+
+// CheckRosterEligibility verifica la documentación de la lista de buena fe
+// GET /championships/registrations/:id/eligibility
+func (h *TeamRegistrationHandler) CheckRosterEligibility(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	result, err := h.registrationService.CheckRosterEligibility(c.Request.Context(), c.GetString("clubID"), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// ApproveRegistration crea el equipo y lo inscribe en el grupo indicado
+// POST /championships/registrations/:id/approve
+func (h *TeamRegistrationHandler) ApproveRegistration(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	var input application.ApproveRegistrationInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.RegistrationID = c.Param("id")
+	input.ReviewerID = c.GetString("userID")
+
+	registration, err := h.registrationService.ApproveRegistration(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, registration)
+}
+
+// RejectRegistration rechaza la inscripción (devuelve el arancel si fue pagado)
+// POST /championships/registrations/:id/reject
+func (h *TeamRegistrationHandler) RejectRegistration(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	var input struct {
+		Reason string `json:"reason" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	registration, err := h.registrationService.RejectRegistration(c.Request.Context(), c.GetString("clubID"), c.Param("id"), c.GetString("userID"), input.Reason)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, registration)
+}
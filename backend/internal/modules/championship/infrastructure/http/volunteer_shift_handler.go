@@ -0,0 +1,239 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+)
+
+// VolunteerShiftHandler expone los turnos abiertos de voluntariado: auto-inscripción de los socios,
+// check-in/out y la aprobación y acreditación de horas contra cuotas.
+type VolunteerShiftHandler struct {
+	shiftService *application.VolunteerShiftService
+}
+
+// NewVolunteerShiftHandler crea una nueva instancia del handler
+func NewVolunteerShiftHandler(shiftService *application.VolunteerShiftService) *VolunteerShiftHandler {
+	return &VolunteerShiftHandler{shiftService: shiftService}
+}
+
+func (h *VolunteerShiftHandler) RegisterRoutes(r *gin.RouterGroup, authMiddleware gin.HandlerFunc, tenantMiddleware gin.HandlerFunc) {
+	group := r.Group("/championships")
+	group.Use(authMiddleware, tenantMiddleware)
+	{
+		group.POST("/matches/:id/shifts", h.CreateShift)
+		group.GET("/matches/:id/shifts", h.ListMatchShifts)
+		group.GET("/shifts/open", h.ListOpenShifts)
+		group.DELETE("/shifts/:id", h.DeleteShift)
+		group.POST("/shifts/:id/signup", h.SignUp)
+		group.POST("/volunteers/:id/cancel", h.CancelSignUp)
+		group.POST("/volunteers/:id/check-in", h.CheckIn)
+		group.POST("/volunteers/:id/check-out", h.CheckOut)
+		group.GET("/volunteers/hours", h.GetVolunteerHours)
+		group.GET("/volunteers/hours/pending", h.ListPendingHours)
+		group.POST("/volunteers/:id/hours/review", h.ReviewHours)
+		group.POST("/volunteers/hours/credit", h.CreditHours)
+	}
+}
+
+// CreateShift abre un turno de voluntariado en el partido
+// POST /championships/matches/:id/shifts
+func (h *VolunteerShiftHandler) CreateShift(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	var input application.CreateShiftInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.MatchID = c.Param("id")
+	input.CreatedBy = c.GetString("userID")
+
+	shift, err := h.shiftService.CreateShift(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, shift)
+}
+
+// ListMatchShifts obtiene los turnos del partido con la cantidad de anotados
+// GET /championships/matches/:id/shifts
+func (h *VolunteerShiftHandler) ListMatchShifts(c *gin.Context) {
+	shifts, err := h.shiftService.ListMatchShifts(c.Request.Context(), c.GetString("clubID"), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, shifts)
+}
+
+// ListOpenShifts obtiene los turnos del período con lugares libres
+// GET /championships/shifts/open?from=2026-10-01&to=2026-10-31
+func (h *VolunteerShiftHandler) ListOpenShifts(c *gin.Context) {
+	from, to, ok := parsePeriod(c)
+	if !ok {
+		return
+	}
+
+	shifts, err := h.shiftService.ListOpenShifts(c.Request.Context(), c.GetString("clubID"), from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, shifts)
+}
+
+// DeleteShift elimina un turno
+// DELETE /championships/shifts/:id
+func (h *VolunteerShiftHandler) DeleteShift(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	if err := h.shiftService.DeleteShift(c.Request.Context(), c.GetString("clubID"), c.Param("id")); err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, domain.ErrShiftHasHours) {
+			status = http.StatusConflict
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// SignUp anota al usuario autenticado en el turno
+// POST /championships/shifts/:id/signup
+func (h *VolunteerShiftHandler) SignUp(c *gin.Context) {
+	assignment, err := h.shiftService.SignUp(c.Request.Context(), c.GetString("clubID"), c.Param("id"), c.GetString("userID"))
+	if err != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, assignment)
+}
+
+// CancelSignUp da de baja la inscripción propia
+// POST /championships/volunteers/:id/cancel
+func (h *VolunteerShiftHandler) CancelSignUp(c *gin.Context) {
+	if err := h.shiftService.CancelSignUp(c.Request.Context(), c.GetString("clubID"), c.Param("id"), c.GetString("userID")); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// CheckIn registra la llegada del voluntario
+// POST /championships/volunteers/:id/check-in
+func (h *VolunteerShiftHandler) CheckIn(c *gin.Context) {
+	assignment, err := h.shiftService.CheckIn(c.Request.Context(), c.GetString("clubID"), c.Param("id"), c.GetString("userID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, assignment)
+}
+
+// CheckOut registra la salida del voluntario y sus horas
+// POST /championships/volunteers/:id/check-out
+func (h *VolunteerShiftHandler) CheckOut(c *gin.Context) {
+	assignment, err := h.shiftService.CheckOut(c.Request.Context(), c.GetString("clubID"), c.Param("id"), c.GetString("userID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, assignment)
+}
+
+// GetVolunteerHours resume las horas del usuario (un administrador puede consultar las de otro socio)
+// GET /championships/volunteers/hours?user_id=...
+func (h *VolunteerShiftHandler) GetVolunteerHours(c *gin.Context) {
+	userID := c.GetString("userID")
+	if requested := c.Query("user_id"); requested != "" && requested != userID {
+		if !isTournamentOrganizer(c) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+			return
+		}
+		userID = requested
+	}
+
+	summary, err := h.shiftService.GetVolunteerHours(c.Request.Context(), c.GetString("clubID"), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, summary)
+}
+
+// ListPendingHours lista las horas que esperan aprobación
+// GET /championships/volunteers/hours/pending
+func (h *VolunteerShiftHandler) ListPendingHours(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	assignments, err := h.shiftService.ListPendingHours(c.Request.Context(), c.GetString("clubID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, assignments)
+}
+
+// ReviewHours aprueba o rechaza las horas de una asignación
+// POST /championships/volunteers/:id/hours/review
+func (h *VolunteerShiftHandler) ReviewHours(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	var input application.ReviewHoursInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.AssignmentID = c.Param("id")
+	input.ReviewerID = c.GetString("userID")
+
+	assignment, err := h.shiftService.ReviewHours(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, assignment)
+}
+
+// CreditHours acredita las horas aprobadas del socio contra una cuota (pago LABOR_EXCHANGE)
+// POST /championships/volunteers/hours/credit
+func (h *VolunteerShiftHandler) CreditHours(c *gin.Context) {
+	if !isTournamentOrganizer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+
+	var input application.CreditHoursInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.ApprovedBy = c.GetString("userID")
+
+	credit, err := h.shiftService.CreditHours(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, credit)
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"gorm.io/gorm"
+)
+
+// PostgresMatchEventRepository implementa el repositorio de eventos de partido usando PostgreSQL
+type PostgresMatchEventRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresMatchEventRepository crea una nueva instancia del repositorio
+func NewPostgresMatchEventRepository(db *gorm.DB) *PostgresMatchEventRepository {
+	return &PostgresMatchEventRepository{db: db}
+}
+
+// Create registra un nuevo evento de partido
+func (r *PostgresMatchEventRepository) Create(ctx context.Context, event *domain.MatchEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+// GetByID obtiene un evento por su ID
+func (r *PostgresMatchEventRepository) GetByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.MatchEvent, error) {
+	var event domain.MatchEvent
+	if err := r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).First(&event).Error; err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// GetByMatchID obtiene los eventos de un partido en orden cronológico
+func (r *PostgresMatchEventRepository) GetByMatchID(ctx context.Context, clubID string, matchID uuid.UUID) ([]domain.MatchEvent, error) {
+	var events []domain.MatchEvent
+	err := r.enrichedQuery(ctx).
+		Where("match_events.club_id = ? AND match_events.match_id = ?", clubID, matchID).
+		Order("match_events.minute ASC, match_events.created_at ASC").
+		Scan(&events).Error
+	return events, err
+}
+
+// GetByTournamentID obtiene todos los eventos de un torneo
+func (r *PostgresMatchEventRepository) GetByTournamentID(ctx context.Context, clubID string, tournamentID uuid.UUID) ([]domain.MatchEvent, error) {
+	var events []domain.MatchEvent
+	err := r.enrichedQuery(ctx).
+		Where("match_events.club_id = ? AND match_events.tournament_id = ?", clubID, tournamentID).
+		Order("match_events.created_at ASC").
+		Scan(&events).Error
+	return events, err
+}
+
+// Delete elimina un evento (corrección de carga)
+func (r *PostgresMatchEventRepository) Delete(ctx context.Context, clubID string, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).
+		Delete(&domain.MatchEvent{}).Error
+}
+
+func (r *PostgresMatchEventRepository) enrichedQuery(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Table("match_events").
+		Select("match_events.*, users.name as player_name, teams.name as team_name").
+		Joins("LEFT JOIN users ON users.id = match_events.user_id").
+		Joins("LEFT JOIN teams ON teams.id = match_events.team_id")
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"gorm.io/gorm"
+)
+
+// PostgresOfficialRepository implementa el repositorio de oficiales usando PostgreSQL
+type PostgresOfficialRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresOfficialRepository crea una nueva instancia del repositorio
+func NewPostgresOfficialRepository(db *gorm.DB) *PostgresOfficialRepository {
+	return &PostgresOfficialRepository{db: db}
+}
+
+// CreateOfficial registra un nuevo oficial
+func (r *PostgresOfficialRepository) CreateOfficial(ctx context.Context, official *domain.Official) error {
+	return r.db.WithContext(ctx).Create(official).Error
+}
+
+// UpdateOfficial actualiza los datos y habilitaciones de un oficial
+func (r *PostgresOfficialRepository) UpdateOfficial(ctx context.Context, official *domain.Official) error {
+	return r.db.WithContext(ctx).Save(official).Error
+}
+
+// GetOfficial obtiene un oficial; devuelve nil si no existe
+func (r *PostgresOfficialRepository) GetOfficial(ctx context.Context, clubID string, id uuid.UUID) (*domain.Official, error) {
+	var official domain.Official
+	err := r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).First(&official).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &official, nil
+}
+
+// ListOfficials obtiene el registro de oficiales del club
+func (r *PostgresOfficialRepository) ListOfficials(ctx context.Context, clubID string, activeOnly bool) ([]domain.Official, error) {
+	var officials []domain.Official
+	query := r.db.WithContext(ctx).Where("club_id = ?", clubID)
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	err := query.Order("last_name ASC, first_name ASC").Find(&officials).Error
+	return officials, err
+}
+
+// CreateAvailability registra una franja de disponibilidad
+func (r *PostgresOfficialRepository) CreateAvailability(ctx context.Context, availability *domain.OfficialAvailability) error {
+	return r.db.WithContext(ctx).Create(availability).Error
+}
+
+// DeleteAvailability elimina una franja de disponibilidad
+func (r *PostgresOfficialRepository) DeleteAvailability(ctx context.Context, clubID string, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).Delete(&domain.OfficialAvailability{}).Error
+}
+
+// ListAvailability obtiene las franjas que se superponen con [from, to]
+func (r *PostgresOfficialRepository) ListAvailability(ctx context.Context, clubID string, officialID *uuid.UUID, from, to time.Time) ([]domain.OfficialAvailability, error) {
+	var availability []domain.OfficialAvailability
+	query := r.db.WithContext(ctx).
+		Where("club_id = ? AND start_time < ? AND end_time > ?", clubID, to, from)
+	if officialID != nil {
+		query = query.Where("official_id = ?", *officialID)
+	}
+	err := query.Order("start_time ASC").Find(&availability).Error
+	return availability, err
+}
+
+// CreateAssignment registra una designación
+func (r *PostgresOfficialRepository) CreateAssignment(ctx context.Context, assignment *domain.OfficialAssignment) error {
+	return r.db.WithContext(ctx).Create(assignment).Error
+}
+
+// DeleteAssignment elimina una designación
+func (r *PostgresOfficialRepository) DeleteAssignment(ctx context.Context, clubID string, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).Delete(&domain.OfficialAssignment{}).Error
+}
+
+// GetAssignmentsByMatch obtiene la terna designada para un partido
+func (r *PostgresOfficialRepository) GetAssignmentsByMatch(ctx context.Context, clubID string, matchID uuid.UUID) ([]domain.OfficialAssignment, error) {
+	var assignments []domain.OfficialAssignment
+	err := r.db.WithContext(ctx).
+		Where("club_id = ? AND match_id = ?", clubID, matchID).
+		Order("role ASC").
+		Find(&assignments).Error
+	return assignments, err
+}
+
+// ListAssignments obtiene las designaciones que se superponen con [from, to]
+func (r *PostgresOfficialRepository) ListAssignments(ctx context.Context, clubID string, officialID *uuid.UUID, from, to time.Time) ([]domain.OfficialAssignment, error) {
+	var assignments []domain.OfficialAssignment
+	query := r.db.WithContext(ctx).
+		Where("club_id = ? AND start_time < ? AND end_time > ?", clubID, to, from)
+	if officialID != nil {
+		query = query.Where("official_id = ?", *officialID)
+	}
+	err := query.Order("start_time ASC").Find(&assignments).Error
+	return assignments, err
+}
+
+// MarkAssignmentsPaid registra el pago de los honorarios de las designaciones indicadas
+func (r *PostgresOfficialRepository) MarkAssignmentsPaid(ctx context.Context, clubID string, ids []uuid.UUID, paidAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Model(&domain.OfficialAssignment{}).
+		Where("club_id = ? AND id IN ? AND paid_at IS NULL", clubID, ids).
+		Update("paid_at", paidAt).Error
+}
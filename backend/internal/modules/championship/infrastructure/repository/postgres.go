@@ -20,8 +20,27 @@ func NewPostgresChampionshipRepository(db *gorm.DB) *PostgresChampionshipReposit
 	return &PostgresChampionshipRepository{db: db}
 }
 
+// CreateTournament crea el torneo con sus fases y grupos en una sola transacción
 func (r *PostgresChampionshipRepository) CreateTournament(ctx context.Context, tournament *domain.Tournament) error {
-	return r.db.WithContext(ctx).Create(tournament).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(tournament).Error; err != nil {
+			return err
+		}
+		for i := range tournament.Stages {
+			stage := &tournament.Stages[i]
+			stage.TournamentID = tournament.ID
+			if err := tx.Omit(clause.Associations).Create(stage).Error; err != nil {
+				return err
+			}
+			for j := range stage.Groups {
+				stage.Groups[j].StageID = stage.ID
+				if err := tx.Omit(clause.Associations).Create(&stage.Groups[j]).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
 }
 
 func (r *PostgresChampionshipRepository) GetTournament(ctx context.Context, clubID, id string) (*domain.Tournament, error) {
@@ -136,6 +155,35 @@ func (r *PostgresChampionshipRepository) GetMatchesByGroup(ctx context.Context,
 	return matches, err
 }
 
+// GetMatchesByTournament returns every match of the tournament with team names, ordered by date.
+func (r *PostgresChampionshipRepository) GetMatchesByTournament(ctx context.Context, clubID, tournamentID string) ([]domain.TournamentMatch, error) {
+	type matchRow struct {
+		domain.TournamentMatch
+		HomeName string
+		AwayName string
+	}
+	var rows []matchRow
+	err := r.db.WithContext(ctx).Table("tournament_matches").
+		Select("tournament_matches.*, h.name as home_name, a.name as away_name").
+		Joins("JOIN championships ON championships.id = tournament_matches.tournament_id").
+		Joins("LEFT JOIN teams h ON h.id = tournament_matches.home_team_id").
+		Joins("LEFT JOIN teams a ON a.id = tournament_matches.away_team_id").
+		Where("tournament_matches.tournament_id = ? AND championships.club_id = ?", tournamentID, clubID).
+		Order("tournament_matches.date ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]domain.TournamentMatch, len(rows))
+	for i, row := range rows {
+		matches[i] = row.TournamentMatch
+		matches[i].HomeTeamName = row.HomeName
+		matches[i].AwayTeamName = row.AwayName
+	}
+	return matches, nil
+}
+
 func (r *PostgresChampionshipRepository) UpdateMatchResult(ctx context.Context, clubID, matchID string, homeScore, awayScore float64) error {
 	// Verify club ownership before update
 	var count int64
@@ -174,6 +222,29 @@ func (r *PostgresChampionshipRepository) UpdateMatchScheduling(ctx context.Conte
 	}).Error
 }
 
+// UpdateLiveScore stores a partial score and flags the match as in progress.
+// Finished or cancelled matches are not touched.
+func (r *PostgresChampionshipRepository) UpdateLiveScore(ctx context.Context, clubID, matchID string, homeScore, awayScore float64) error {
+	// Verify club ownership before update
+	var count int64
+	r.db.WithContext(ctx).Table("tournament_matches").
+		Joins("JOIN championships ON championships.id = tournament_matches.tournament_id").
+		Where("tournament_matches.id = ? AND championships.club_id = ?", matchID, clubID).
+		Count(&count)
+
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return r.db.WithContext(ctx).Model(&domain.TournamentMatch{}).
+		Where("id = ? AND status IN ?", matchID, []domain.MatchStatus{domain.MatchScheduled, domain.MatchInProgress}).
+		Updates(map[string]interface{}{
+			"home_score": homeScore,
+			"away_score": awayScore,
+			"status":     domain.MatchInProgress,
+		}).Error
+}
+
 func (r *PostgresChampionshipRepository) GetStandings(ctx context.Context, clubID, groupID string) ([]domain.Standing, error) {
 	var standings []domain.Standing
 	// Join with Group -> Stage -> Tournament to check club_id
@@ -293,6 +364,43 @@ func (r *PostgresChampionshipRepository) GetTeamMembers(ctx context.Context, tea
 	return userIDs, err
 }
 
+// GetTeamsMembers obtiene los integrantes de varios equipos en una sola consulta, agrupados por equipo
+func (r *PostgresChampionshipRepository) GetTeamsMembers(ctx context.Context, teamIDs []string) (map[string][]string, error) {
+	members := make(map[string][]string, len(teamIDs))
+	if len(teamIDs) == 0 {
+		return members, nil
+	}
+	var rows []struct {
+		TeamID string
+		UserID string
+	}
+	err := r.db.WithContext(ctx).Table("team_members").
+		Select("team_id, user_id").
+		Where("team_id IN ?", teamIDs).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		members[row.TeamID] = append(members[row.TeamID], row.UserID)
+	}
+	return members, nil
+}
+
+// GetTeamStandings obtiene las filas de tabla de un equipo en los torneos del club
+func (r *PostgresChampionshipRepository) GetTeamStandings(ctx context.Context, clubID, teamID string) ([]domain.Standing, error) {
+	var standings []domain.Standing
+	err := r.db.WithContext(ctx).Table("standings").
+		Select("standings.*, teams.name as team_name").
+		Joins("JOIN groups ON groups.id = standings.group_id").
+		Joins("JOIN tournament_stages ON tournament_stages.id = groups.stage_id").
+		Joins("JOIN championships ON championships.id = tournament_stages.tournament_id").
+		Joins("LEFT JOIN teams ON teams.id = standings.team_id").
+		Where("standings.team_id = ? AND championships.club_id = ?", teamID, clubID).
+		Scan(&standings).Error
+	return standings, err
+}
+
 func (r *PostgresChampionshipRepository) CreateTeam(ctx context.Context, team *domain.Team) error {
 	return r.db.WithContext(ctx).Create(team).Error
 }
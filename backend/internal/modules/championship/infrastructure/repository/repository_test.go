@@ -83,6 +83,8 @@ type TestMatch struct {
 	BookingID    *uuid.UUID `gorm:"type:uuid;index"`
 	Status       string     `gorm:"default:'SCHEDULED'"`
 	Date         time.Time
+	Round        string
+	Location     string
 }
 
 func (TestMatch) TableName() string { return "tournament_matches" }
@@ -92,6 +94,7 @@ type TestTeam struct {
 	Name      string    `gorm:"not null"`
 	LogoURL   string
 	Contact   string
+	CaptainID *string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -99,16 +102,25 @@ type TestTeam struct {
 func (TestTeam) TableName() string { return "teams" }
 
 type TestVolunteerAssignment struct {
-	ID         uuid.UUID `gorm:"type:uuid;primary_key"`
-	ClubID     string    `gorm:"not null;index"`
-	MatchID    uuid.UUID `gorm:"type:uuid;not null;index"`
-	UserID     string    `gorm:"not null;index"`
-	Role       string    `gorm:"not null"`
-	Notes      string
-	AssignedBy string
-	AssignedAt time.Time
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	ID              uuid.UUID `gorm:"type:uuid;primary_key"`
+	ClubID          string    `gorm:"not null;index"`
+	MatchID         uuid.UUID `gorm:"type:uuid;not null;index"`
+	UserID          string    `gorm:"not null;index"`
+	Role            string    `gorm:"not null"`
+	Notes           string
+	ShiftID         *uuid.UUID `gorm:"type:uuid;index"`
+	CheckInAt       *time.Time
+	CheckOutAt      *time.Time
+	Hours           string `gorm:"default:0"`
+	HoursStatus     string
+	HoursReviewedBy string
+	HoursReviewedAt *time.Time
+	CreditPaymentID *uuid.UUID `gorm:"type:uuid"`
+	ReminderSentAt  *time.Time
+	AssignedBy      string
+	AssignedAt      time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 func (TestVolunteerAssignment) TableName() string { return "volunteer_assignments" }
@@ -214,6 +226,34 @@ func TestPostgresChampionshipRepository(t *testing.T) {
 		assert.Equal(t, "A", saved.Name)
 	})
 
+	t.Run("Matches By Tournament", func(t *testing.T) {
+		tournament := &domain.Tournament{ID: uuid.New(), ClubID: clubID, Name: "Copa"}
+		_ = repo.CreateTournament(context.TODO(), tournament)
+		stage := &domain.TournamentStage{ID: uuid.New(), TournamentID: tournament.ID, Name: "Llave"}
+		_ = repo.CreateStage(context.TODO(), stage)
+
+		home := &domain.Team{ID: uuid.New(), Name: "Leones"}
+		away := &domain.Team{ID: uuid.New(), Name: "Tigres"}
+		_ = repo.CreateTeam(context.TODO(), home)
+		_ = repo.CreateTeam(context.TODO(), away)
+
+		later := domain.TournamentMatch{ID: uuid.New(), TournamentID: tournament.ID, StageID: stage.ID, HomeTeamID: home.ID, AwayTeamID: away.ID, Date: time.Now().Add(48 * time.Hour)}
+		first := domain.TournamentMatch{ID: uuid.New(), TournamentID: tournament.ID, StageID: stage.ID, HomeTeamID: away.ID, AwayTeamID: home.ID, Date: time.Now()}
+		err := repo.CreateMatchesBatch(context.TODO(), clubID.String(), []domain.TournamentMatch{later, first})
+		assert.NoError(t, err)
+
+		matches, err := repo.GetMatchesByTournament(context.TODO(), clubID.String(), tournament.ID.String())
+		assert.NoError(t, err)
+		assert.Len(t, matches, 2)
+		assert.Equal(t, first.ID, matches[0].ID)
+		assert.Equal(t, "Tigres", matches[0].HomeTeamName)
+		assert.Equal(t, "Leones", matches[0].AwayTeamName)
+
+		other, err := repo.GetMatchesByTournament(context.TODO(), uuid.New().String(), tournament.ID.String())
+		assert.NoError(t, err)
+		assert.Empty(t, other)
+	})
+
 	t.Run("Match Operations", func(t *testing.T) {
 		tournament := &domain.Tournament{ID: uuid.New(), ClubID: clubID, Name: "Cup"}
 		_ = repo.CreateTournament(context.TODO(), tournament)
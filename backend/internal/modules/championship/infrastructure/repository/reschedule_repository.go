@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PostgresRescheduleRepository implementa el repositorio de reprogramaciones usando PostgreSQL
+type PostgresRescheduleRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresRescheduleRepository crea una nueva instancia del repositorio
+func NewPostgresRescheduleRepository(db *gorm.DB) *PostgresRescheduleRepository {
+	return &PostgresRescheduleRepository{db: db}
+}
+
+// CreateRescheduleRequest registra una propuesta de reprogramación y, si se pide, posterga el partido.
+// La fila del partido se bloquea para que dos capitanes no abran propuestas a la vez
+// (el índice único parcial sobre las pendientes es la última barrera).
+func (r *PostgresRescheduleRepository) CreateRescheduleRequest(ctx context.Context, request *domain.MatchRescheduleRequest, postpone bool) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := r.lockMatch(tx, request.ClubID, request.MatchID); err != nil {
+			return err
+		}
+		var pending int64
+		if err := tx.Model(&domain.MatchRescheduleRequest{}).
+			Where("match_id = ? AND status = ?", request.MatchID, domain.ReschedulePending).
+			Count(&pending).Error; err != nil {
+			return err
+		}
+		if pending > 0 {
+			return domain.ErrReschedulePending
+		}
+		if postpone {
+			if err := tx.Model(&domain.TournamentMatch{}).Where("id = ?", request.MatchID).Updates(map[string]interface{}{
+				"status":     domain.MatchPostponed,
+				"booking_id": nil,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(request).Error
+	})
+}
+
+// UpdateRescheduleRequest actualiza el estado de una propuesta
+func (r *PostgresRescheduleRepository) UpdateRescheduleRequest(ctx context.Context, request *domain.MatchRescheduleRequest) error {
+	return r.db.WithContext(ctx).Save(request).Error
+}
+
+// GetRescheduleRequest obtiene una propuesta; devuelve nil si no existe
+func (r *PostgresRescheduleRepository) GetRescheduleRequest(ctx context.Context, clubID string, id uuid.UUID) (*domain.MatchRescheduleRequest, error) {
+	return r.first(r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id))
+}
+
+// GetPendingRescheduleRequest obtiene la propuesta pendiente del partido; devuelve nil si no hay
+func (r *PostgresRescheduleRepository) GetPendingRescheduleRequest(ctx context.Context, clubID string, matchID uuid.UUID) (*domain.MatchRescheduleRequest, error) {
+	return r.first(r.db.WithContext(ctx).
+		Where("club_id = ? AND match_id = ? AND status = ?", clubID, matchID, domain.ReschedulePending))
+}
+
+// ListRescheduleRequests obtiene el historial de propuestas del partido, las más recientes primero
+func (r *PostgresRescheduleRepository) ListRescheduleRequests(ctx context.Context, clubID string, matchID uuid.UUID) ([]domain.MatchRescheduleRequest, error) {
+	var requests []domain.MatchRescheduleRequest
+	err := r.db.WithContext(ctx).
+		Where("club_id = ? AND match_id = ?", clubID, matchID).
+		Order("created_at DESC").
+		Find(&requests).Error
+	return requests, err
+}
+
+// GetTeam obtiene un equipo (para identificar a su capitán); devuelve nil si no existe
+func (r *PostgresRescheduleRepository) GetTeam(ctx context.Context, teamID uuid.UUID) (*domain.Team, error) {
+	var team domain.Team
+	err := r.db.WithContext(ctx).Where("id = ?", teamID).First(&team).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &team, nil
+}
+
+// GetMatchOfficialUserIDs devuelve los usuarios de los oficiales designados para el partido (los que no tienen usuario se omiten)
+func (r *PostgresRescheduleRepository) GetMatchOfficialUserIDs(ctx context.Context, clubID string, matchID uuid.UUID) ([]string, error) {
+	var userIDs []string
+	err := r.db.WithContext(ctx).Table("official_assignments").
+		Joins("JOIN officials ON officials.id = official_assignments.official_id").
+		Where("official_assignments.club_id = ? AND official_assignments.match_id = ? AND officials.user_id IS NOT NULL", clubID, matchID).
+		Distinct().
+		Pluck("officials.user_id", &userIDs).Error
+	return userIDs, err
+}
+
+// PostponeMatch marca el partido como postergado y lo desvincula de su reserva
+func (r *PostgresRescheduleRepository) PostponeMatch(ctx context.Context, clubID string, matchID uuid.UUID) error {
+	if err := r.checkMatchClub(r.db.WithContext(ctx), clubID, matchID); err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Model(&domain.TournamentMatch{}).Where("id = ?", matchID).Updates(map[string]interface{}{
+		"status":     domain.MatchPostponed,
+		"booking_id": nil,
+	}).Error
+}
+
+// ApplyReschedule mueve el partido al horario aceptado y guarda la solicitud en una misma transacción
+func (r *PostgresRescheduleRepository) ApplyReschedule(ctx context.Context, request *domain.MatchRescheduleRequest, slot domain.RescheduleSlot, bookingID *uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		match, err := r.lockMatch(tx, request.ClubID, request.MatchID)
+		if err != nil {
+			return err
+		}
+		updates := map[string]interface{}{
+			"date":       slot.Date,
+			"booking_id": bookingID,
+			"status":     domain.MatchScheduled,
+		}
+		if slot.Location != "" {
+			updates["location"] = slot.Location
+		}
+		if err := tx.Model(&domain.TournamentMatch{}).Where("id = ?", request.MatchID).Updates(updates).Error; err != nil {
+			return err
+		}
+		if err := shiftMatchStaff(tx, request.MatchID, slot.Date.Sub(match.Date)); err != nil {
+			return err
+		}
+		return tx.Save(request).Error
+	})
+}
+
+// RevertReschedule devuelve el partido a su horario, reserva y estado anteriores y guarda la solicitud
+func (r *PostgresRescheduleRepository) RevertReschedule(ctx context.Context, request *domain.MatchRescheduleRequest, previous *domain.TournamentMatch) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		match, err := r.lockMatch(tx, request.ClubID, previous.ID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&domain.TournamentMatch{}).Where("id = ?", previous.ID).Updates(map[string]interface{}{
+			"date":       previous.Date,
+			"booking_id": previous.BookingID,
+			"status":     previous.Status,
+			"location":   previous.Location,
+		}).Error; err != nil {
+			return err
+		}
+		if err := shiftMatchStaff(tx, previous.ID, previous.Date.Sub(match.Date)); err != nil {
+			return err
+		}
+		return tx.Save(request).Error
+	})
+}
+
+func (r *PostgresRescheduleRepository) first(query *gorm.DB) (*domain.MatchRescheduleRequest, error) {
+	var request domain.MatchRescheduleRequest
+	if err := query.First(&request).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &request, nil
+}
+
+// lockMatch bloquea la fila del partido (FOR UPDATE) verificando que pertenezca a un torneo del club
+func (r *PostgresRescheduleRepository) lockMatch(tx *gorm.DB, clubID string, matchID uuid.UUID) (*domain.TournamentMatch, error) {
+	if err := r.checkMatchClub(tx, clubID, matchID); err != nil {
+		return nil, err
+	}
+	var match domain.TournamentMatch
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", matchID).First(&match).Error; err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
+
+// shiftMatchStaff corre las designaciones de oficiales y los turnos de voluntarios del partido
+// lo mismo que se movió el partido, para que sigan cubriendo el nuevo horario
+func shiftMatchStaff(tx *gorm.DB, matchID uuid.UUID, delta time.Duration) error {
+	if delta == 0 {
+		return nil
+	}
+	shift := map[string]interface{}{
+		"start_time": gorm.Expr("start_time + make_interval(secs => ?)", delta.Seconds()),
+		"end_time":   gorm.Expr("end_time + make_interval(secs => ?)", delta.Seconds()),
+	}
+	if err := tx.Model(&domain.OfficialAssignment{}).Where("match_id = ?", matchID).Updates(shift).Error; err != nil {
+		return err
+	}
+	return tx.Model(&domain.VolunteerShift{}).Where("match_id = ?", matchID).Updates(shift).Error
+}
+
+// checkMatchClub verifica que el partido pertenezca a un torneo del club
+func (r *PostgresRescheduleRepository) checkMatchClub(db *gorm.DB, clubID string, matchID uuid.UUID) error {
+	var count int64
+	db.Table("tournament_matches").
+		Joins("JOIN championships ON championships.id = tournament_matches.tournament_id").
+		Where("tournament_matches.id = ? AND championships.club_id = ?", matchID, clubID).
+		Count(&count)
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
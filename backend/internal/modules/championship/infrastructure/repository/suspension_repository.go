@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"gorm.io/gorm"
+)
+
+// PostgresSuspensionRepository implementa el repositorio de sanciones usando PostgreSQL
+type PostgresSuspensionRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresSuspensionRepository crea una nueva instancia del repositorio
+func NewPostgresSuspensionRepository(db *gorm.DB) *PostgresSuspensionRepository {
+	return &PostgresSuspensionRepository{db: db}
+}
+
+// Create registra una nueva sanción
+func (r *PostgresSuspensionRepository) Create(ctx context.Context, suspension *domain.PlayerSuspension) error {
+	return r.db.WithContext(ctx).Create(suspension).Error
+}
+
+// GetByTournament obtiene las sanciones de un torneo
+func (r *PostgresSuspensionRepository) GetByTournament(ctx context.Context, clubID string, tournamentID uuid.UUID) ([]domain.PlayerSuspension, error) {
+	var suspensions []domain.PlayerSuspension
+	err := r.db.WithContext(ctx).
+		Where("club_id = ? AND tournament_id = ?", clubID, tournamentID).
+		Order("created_at ASC").
+		Find(&suspensions).Error
+	return suspensions, err
+}
+
+// GetByUser obtiene las sanciones de un jugador en un torneo
+func (r *PostgresSuspensionRepository) GetByUser(ctx context.Context, clubID string, tournamentID uuid.UUID, userID string) ([]domain.PlayerSuspension, error) {
+	var suspensions []domain.PlayerSuspension
+	err := r.db.WithContext(ctx).
+		Where("club_id = ? AND tournament_id = ? AND user_id = ?", clubID, tournamentID, userID).
+		Order("created_at ASC").
+		Find(&suspensions).Error
+	return suspensions, err
+}
+
+// GetBySourceMatch obtiene las sanciones originadas en un partido
+func (r *PostgresSuspensionRepository) GetBySourceMatch(ctx context.Context, clubID string, matchID uuid.UUID) ([]domain.PlayerSuspension, error) {
+	var suspensions []domain.PlayerSuspension
+	err := r.db.WithContext(ctx).
+		Where("club_id = ? AND source_match_id = ?", clubID, matchID).
+		Find(&suspensions).Error
+	return suspensions, err
+}
+
+// Update actualiza una sanción (fechas cumplidas, notas)
+func (r *PostgresSuspensionRepository) Update(ctx context.Context, suspension *domain.PlayerSuspension) error {
+	return r.db.WithContext(ctx).Save(suspension).Error
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"gorm.io/gorm"
+)
+
+// PostgresTeamRegistrationRepository implementa el repositorio de inscripciones usando PostgreSQL
+type PostgresTeamRegistrationRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresTeamRegistrationRepository crea una nueva instancia del repositorio
+func NewPostgresTeamRegistrationRepository(db *gorm.DB) *PostgresTeamRegistrationRepository {
+	return &PostgresTeamRegistrationRepository{db: db}
+}
+
+// Create registra una nueva inscripción
+func (r *PostgresTeamRegistrationRepository) Create(ctx context.Context, registration *domain.TeamRegistration) error {
+	return r.db.WithContext(ctx).Create(registration).Error
+}
+
+// Update actualiza una inscripción (pago, plantel, revisión)
+func (r *PostgresTeamRegistrationRepository) Update(ctx context.Context, registration *domain.TeamRegistration) error {
+	return r.db.WithContext(ctx).Save(registration).Error
+}
+
+// GetByID obtiene una inscripción; devuelve nil si no existe
+func (r *PostgresTeamRegistrationRepository) GetByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.TeamRegistration, error) {
+	var registration domain.TeamRegistration
+	err := r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).First(&registration).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &registration, nil
+}
+
+// ListByTournament obtiene las inscripciones de un torneo, opcionalmente filtradas por estado
+func (r *PostgresTeamRegistrationRepository) ListByTournament(ctx context.Context, clubID string, tournamentID uuid.UUID, status domain.RegistrationStatus) ([]domain.TeamRegistration, error) {
+	var registrations []domain.TeamRegistration
+	query := r.db.WithContext(ctx).Where("club_id = ? AND tournament_id = ?", clubID, tournamentID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("created_at ASC").Find(&registrations).Error
+	return registrations, err
+}
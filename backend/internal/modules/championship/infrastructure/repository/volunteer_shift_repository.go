@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PostgresVolunteerShiftRepository implementa el repositorio de turnos de voluntariado usando PostgreSQL
+type PostgresVolunteerShiftRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresVolunteerShiftRepository crea una nueva instancia del repositorio
+func NewPostgresVolunteerShiftRepository(db *gorm.DB) *PostgresVolunteerShiftRepository {
+	return &PostgresVolunteerShiftRepository{db: db}
+}
+
+// CreateShift crea un turno abierto
+func (r *PostgresVolunteerShiftRepository) CreateShift(ctx context.Context, shift *domain.VolunteerShift) error {
+	return r.db.WithContext(ctx).Create(shift).Error
+}
+
+// GetShift obtiene un turno; devuelve nil si no existe
+func (r *PostgresVolunteerShiftRepository) GetShift(ctx context.Context, clubID string, id uuid.UUID) (*domain.VolunteerShift, error) {
+	var shift domain.VolunteerShift
+	err := r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).First(&shift).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &shift, nil
+}
+
+// DeleteShift elimina un turno y sus inscripciones, salvo que ya tenga asistencia u horas registradas
+func (r *PostgresVolunteerShiftRepository) DeleteShift(ctx context.Context, clubID string, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var worked int64
+		if err := tx.Model(&domain.VolunteerAssignment{}).
+			Where("club_id = ? AND shift_id = ? AND (check_in_at IS NOT NULL OR hours_status <> '')", clubID, id).
+			Count(&worked).Error; err != nil {
+			return err
+		}
+		if worked > 0 {
+			return domain.ErrShiftHasHours
+		}
+		if err := tx.Where("club_id = ? AND shift_id = ?", clubID, id).Delete(&domain.VolunteerAssignment{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("club_id = ? AND id = ?", clubID, id).Delete(&domain.VolunteerShift{}).Error
+	})
+}
+
+// ListShiftsByMatch obtiene los turnos de un partido
+func (r *PostgresVolunteerShiftRepository) ListShiftsByMatch(ctx context.Context, clubID string, matchID uuid.UUID) ([]domain.VolunteerShift, error) {
+	var shifts []domain.VolunteerShift
+	err := r.db.WithContext(ctx).Where("club_id = ? AND match_id = ?", clubID, matchID).
+		Order("start_time ASC, role ASC").
+		Find(&shifts).Error
+	return shifts, err
+}
+
+// ListShifts obtiene los turnos que empiezan en el período
+func (r *PostgresVolunteerShiftRepository) ListShifts(ctx context.Context, clubID string, from, to time.Time) ([]domain.VolunteerShift, error) {
+	var shifts []domain.VolunteerShift
+	err := r.db.WithContext(ctx).Where("club_id = ? AND start_time >= ? AND start_time <= ?", clubID, from, to).
+		Order("start_time ASC, role ASC").
+		Find(&shifts).Error
+	return shifts, err
+}
+
+// GetAssignment obtiene una asignación de voluntario; devuelve nil si no existe
+func (r *PostgresVolunteerShiftRepository) GetAssignment(ctx context.Context, clubID string, id uuid.UUID) (*domain.VolunteerAssignment, error) {
+	var assignment domain.VolunteerAssignment
+	err := r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).First(&assignment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+// ListAssignmentsByShift obtiene los voluntarios anotados en un turno
+func (r *PostgresVolunteerShiftRepository) ListAssignmentsByShift(ctx context.Context, clubID string, shiftID uuid.UUID) ([]domain.VolunteerAssignment, error) {
+	var assignments []domain.VolunteerAssignment
+	err := r.db.WithContext(ctx).Where("club_id = ? AND shift_id = ?", clubID, shiftID).
+		Order("assigned_at ASC").
+		Find(&assignments).Error
+	return assignments, err
+}
+
+// ListAssignmentsByHoursStatus obtiene las asignaciones con horas en el estado indicado
+func (r *PostgresVolunteerShiftRepository) ListAssignmentsByHoursStatus(ctx context.Context, clubID, userID string, status domain.VolunteerHoursStatus) ([]domain.VolunteerAssignment, error) {
+	var assignments []domain.VolunteerAssignment
+	query := r.db.WithContext(ctx).Where("club_id = ?", clubID)
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+	if status != "" {
+		query = query.Where("hours_status = ?", status)
+	} else {
+		query = query.Where("hours_status <> ''")
+	}
+	err := query.Order("check_out_at ASC").Find(&assignments).Error
+	return assignments, err
+}
+
+// SignUp bloquea el turno (FOR UPDATE) para que el conteo de inscriptos y el alta sean atómicos
+func (r *PostgresVolunteerShiftRepository) SignUp(ctx context.Context, assignment *domain.VolunteerAssignment) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var shift domain.VolunteerShift
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("club_id = ? AND id = ?", assignment.ClubID, assignment.ShiftID).
+			First(&shift).Error; err != nil {
+			return err
+		}
+
+		var existing int64
+		if err := tx.Model(&domain.VolunteerAssignment{}).
+			Where("club_id = ? AND match_id = ? AND user_id = ?", assignment.ClubID, assignment.MatchID, assignment.UserID).
+			Count(&existing).Error; err != nil {
+			return err
+		}
+		if existing > 0 {
+			return domain.ErrAlreadyVolunteer
+		}
+
+		var signedUp int64
+		if err := tx.Model(&domain.VolunteerAssignment{}).
+			Where("club_id = ? AND shift_id = ?", assignment.ClubID, shift.ID).
+			Count(&signedUp).Error; err != nil {
+			return err
+		}
+		if signedUp >= int64(shift.Capacity) {
+			return domain.ErrShiftFull
+		}
+		return tx.Create(assignment).Error
+	})
+}
+
+// ClaimApprovedHours reserva las horas aprobadas del socio en una transacción con las filas bloqueadas
+func (r *PostgresVolunteerShiftRepository) ClaimApprovedHours(ctx context.Context, clubID, userID string) ([]domain.VolunteerAssignment, error) {
+	var assignments []domain.VolunteerAssignment
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("club_id = ? AND user_id = ? AND hours_status = ?", clubID, userID, domain.VolunteerHoursApproved).
+			Order("check_out_at ASC").
+			Find(&assignments).Error; err != nil {
+			return err
+		}
+		if len(assignments) == 0 {
+			return nil
+		}
+		ids := make([]uuid.UUID, len(assignments))
+		for i := range assignments {
+			ids[i] = assignments[i].ID
+			assignments[i].HoursStatus = domain.VolunteerHoursCrediting
+		}
+		return tx.Model(&domain.VolunteerAssignment{}).
+			Where("club_id = ? AND id IN ?", clubID, ids).
+			Update("hours_status", domain.VolunteerHoursCrediting).Error
+	})
+	return assignments, err
+}
+
+// CompleteHoursCredit solo actualiza las horas que siguen reservadas, así un reintento no las vuelve a imputar
+func (r *PostgresVolunteerShiftRepository) CompleteHoursCredit(ctx context.Context, clubID string, ids []uuid.UUID, paymentID uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Model(&domain.VolunteerAssignment{}).
+		Where("club_id = ? AND id IN ? AND hours_status = ?", clubID, ids, domain.VolunteerHoursCrediting).
+		Updates(map[string]interface{}{
+			"hours_status":      domain.VolunteerHoursCredited,
+			"credit_payment_id": paymentID,
+		}).Error
+}
+
+// ReleaseHoursClaim devuelve las horas reservadas a APPROVED
+func (r *PostgresVolunteerShiftRepository) ReleaseHoursClaim(ctx context.Context, clubID string, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Model(&domain.VolunteerAssignment{}).
+		Where("club_id = ? AND id IN ? AND hours_status = ?", clubID, ids, domain.VolunteerHoursCrediting).
+		Update("hours_status", domain.VolunteerHoursApproved).Error
+}
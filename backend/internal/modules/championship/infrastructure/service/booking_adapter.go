@@ -39,3 +39,8 @@ func (a *ChampionshipBookingAdapter) CreateSystemBooking(clubID, courtID string,
 
 	return &booking.ID, nil
 }
+
+// CancelSystemBooking releases the court booked for a match (used when it is rescheduled or postponed).
+func (a *ChampionshipBookingAdapter) CancelSystemBooking(clubID string, bookingID uuid.UUID) error {
+	return a.bookingUC.CancelSystemBooking(context.Background(), clubID, bookingID)
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	disciplineDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	teamApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/application"
+	userApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// DisciplineLister lista las disciplinas del club, para resolver la del torneo
+type DisciplineLister interface {
+	ListDisciplines(ctx context.Context, clubID string) ([]disciplineDomain.Discipline, error)
+}
+
+// ChampionshipEligibilityAdapter combina la documentación obligatoria (User) con el
+// semáforo del jugador (Team) para decidir si puede ser alineado.
+type ChampionshipEligibilityAdapter struct {
+	eligibilityService  *userApp.EligibilityService
+	playerStatusService *teamApp.PlayerStatusService
+	disciplines         DisciplineLister
+}
+
+func NewChampionshipEligibilityAdapter(eligibilityService *userApp.EligibilityService, playerStatusService *teamApp.PlayerStatusService, disciplines DisciplineLister) *ChampionshipEligibilityAdapter {
+	return &ChampionshipEligibilityAdapter{
+		eligibilityService:  eligibilityService,
+		playerStatusService: playerStatusService,
+		disciplines:         disciplines,
+	}
+}
+
+// GetPlayerIssues evalúa al jugador con la regla de elegibilidad de la disciplina y categoría del torneo
+func (a *ChampionshipEligibilityAdapter) GetPlayerIssues(ctx context.Context, clubID, userID string, tournament *domain.Tournament) ([]string, error) {
+	issues := []string{}
+
+	scope, err := a.tournamentScope(ctx, clubID, tournament)
+	if err != nil {
+		return nil, err
+	}
+	eligibility, err := a.eligibilityService.CheckEligibilityFor(ctx, clubID, userID, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !eligibility.IsEligible {
+		issues = append(issues, eligibility.Issues...)
+	}
+
+	status, err := a.playerStatusService.GetPlayerStatus(ctx, clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if status.IsInhabilitado {
+		// The medical certificate is already covered by the document check; debt is not.
+		if status.FinancialStatus == "DEBTOR" {
+			issues = append(issues, "Tiene deuda pendiente")
+		}
+		if status.MedicalStatus != "VALID" && eligibility.HasEMMAC {
+			issues = append(issues, "Apto físico vencido")
+		}
+	}
+
+	return issues, nil
+}
+
+// tournamentScope traduce el torneo al alcance de elegibilidad. El torneo guarda el nombre de la
+// disciplina en Sport (ver disciplines ChampionshipTournamentAdapter); si no coincide con ninguna
+// disciplina del club se aplican las reglas por categoría o la general.
+func (a *ChampionshipEligibilityAdapter) tournamentScope(ctx context.Context, clubID string, tournament *domain.Tournament) (userDomain.EligibilityScope, error) {
+	scope := userDomain.EligibilityScope{}
+	if tournament == nil {
+		return scope, nil
+	}
+	scope.Category = tournament.Category
+	if a.disciplines == nil || tournament.Sport == "" {
+		return scope, nil
+	}
+	disciplines, err := a.disciplines.ListDisciplines(ctx, clubID)
+	if err != nil {
+		return scope, err
+	}
+	for _, d := range disciplines {
+		if strings.EqualFold(strings.TrimSpace(d.Name), strings.TrimSpace(tournament.Sport)) {
+			id := d.ID
+			scope.DisciplineID = &id
+			break
+		}
+	}
+	return scope, nil
+}
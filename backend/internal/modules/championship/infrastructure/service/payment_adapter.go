@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	paymentApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/payment/application"
+	paymentDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/payment/domain"
+	"github.com/shopspring/decimal"
+)
+
+// ChampionshipPaymentAdapter cobra el arancel de inscripción de equipos externos con el módulo Payment.
+// El pago se registra con ReferenceType TEAM_REGISTRATION para que el webhook notifique al torneo.
+// También registra las horas de voluntariado acreditadas como pago en especie (LABOR_EXCHANGE).
+type ChampionshipPaymentAdapter struct {
+	paymentUC *paymentApp.PaymentUseCases
+}
+
+func NewChampionshipPaymentAdapter(paymentUC *paymentApp.PaymentUseCases) *ChampionshipPaymentAdapter {
+	return &ChampionshipPaymentAdapter{paymentUC: paymentUC}
+}
+
+func (a *ChampionshipPaymentAdapter) CreateEntryFeeCheckout(ctx context.Context, registration *domain.TeamRegistration, description string) (uuid.UUID, string, error) {
+	payment, url, err := a.paymentUC.Checkout(ctx, paymentApp.CheckoutRequest{
+		Amount:        registration.EntryFee.StringFixed(2),
+		Description:   description,
+		PayerEmail:    registration.ContactEmail,
+		ReferenceID:   registration.ID,
+		ReferenceType: domain.RegistrationReferenceType,
+		UserID:        uuid.Nil, // External payer: identified by PayerEmail, payer_id stays NULL
+		ClubID:        registration.ClubID,
+	})
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	return payment.ID, url, nil
+}
+
+func (a *ChampionshipPaymentAdapter) RefundEntryFee(ctx context.Context, clubID string, registrationID uuid.UUID) error {
+	return a.paymentUC.Refund(ctx, clubID, registrationID, domain.RegistrationReferenceType)
+}
+
+func (a *ChampionshipPaymentAdapter) CreditVolunteerHours(ctx context.Context, clubID, userID string, amount decimal.Decimal, referenceID uuid.UUID, referenceType, notes string) (uuid.UUID, error) {
+	payerID, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, errors.New("invalid payer ID")
+	}
+	payment, err := a.paymentUC.CreateOfflinePayment(ctx, paymentApp.CreateOfflinePaymentRequest{
+		Amount:        amount.StringFixed(2),
+		Method:        paymentDomain.PaymentMethodLaborExchange,
+		PayerID:       payerID,
+		ReferenceID:   referenceID,
+		ReferenceType: referenceType,
+		Notes:         notes,
+		ClubID:        clubID,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return payment.ID, nil
+}
@@ -79,6 +79,16 @@ func (m *MockRepo) GetTeamMembers(ctx context.Context, teamID string) ([]string,
 	return args.Get(0).([]string), args.Error(1)
 }
 
+func (m *MockRepo) GetTeamsMembers(ctx context.Context, teamIDs []string) (map[string][]string, error) {
+	args := m.Called(ctx, teamIDs)
+	return args.Get(0).(map[string][]string), args.Error(1)
+}
+
+func (m *MockRepo) GetTeamStandings(ctx context.Context, clubID, teamID string) ([]domain.Standing, error) {
+	args := m.Called(ctx, clubID, teamID)
+	return args.Get(0).([]domain.Standing), args.Error(1)
+}
+
 // Mock Notification Provider
 type MockEmailProvider struct{}
 
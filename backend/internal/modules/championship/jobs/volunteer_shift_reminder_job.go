@@ -0,0 +1,79 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+)
+
+// VolunteerShiftReminderJob reminds volunteers about the shifts they signed up for.
+// Each assignment is reminded once (ReminderSentAt), so the job can run as often as needed.
+type VolunteerShiftReminderJob struct {
+	shiftRepo           domain.VolunteerShiftRepository
+	volunteerRepo       domain.VolunteerRepository
+	notificationService *notificationSvc.NotificationService
+	reminderHours       int // How many hours before the shift to send the reminder
+}
+
+func NewVolunteerShiftReminderJob(
+	shiftRepo domain.VolunteerShiftRepository,
+	volunteerRepo domain.VolunteerRepository,
+	notificationService *notificationSvc.NotificationService,
+	reminderHours int,
+) *VolunteerShiftReminderJob {
+	if reminderHours <= 0 {
+		reminderHours = 24 // Default 24 hours before
+	}
+	return &VolunteerShiftReminderJob{
+		shiftRepo:           shiftRepo,
+		volunteerRepo:       volunteerRepo,
+		notificationService: notificationService,
+		reminderHours:       reminderHours,
+	}
+}
+
+// Run executes the job - should be called by a scheduler (e.g., cron)
+func (j *VolunteerShiftReminderJob) Run(ctx context.Context, clubID string) error {
+	now := time.Now()
+	shifts, err := j.shiftRepo.ListShifts(ctx, clubID, now, now.Add(time.Duration(j.reminderHours)*time.Hour))
+	if err != nil {
+		return err
+	}
+
+	for _, shift := range shifts {
+		assignments, err := j.shiftRepo.ListAssignmentsByShift(ctx, clubID, shift.ID)
+		if err != nil {
+			return err
+		}
+
+		for i := range assignments {
+			assignment := &assignments[i]
+			if assignment.ReminderSentAt != nil {
+				continue
+			}
+
+			// If sending fails the assignment stays unmarked and the next run retries it
+			if err := j.notificationService.Send(ctx, notificationSvc.Notification{
+				RecipientID: assignment.UserID,
+				Type:        notificationSvc.NotificationTypePush,
+				Title:       "🙌 Recordatorio de Voluntariado",
+				Body:        fmt.Sprintf("Tu turno de %s empieza el %s", shift.Role, shift.StartTime.Format("02/01 15:04")),
+			}); err != nil {
+				log.Printf("Volunteer shift reminder failed for assignment %s: %v", assignment.ID, err)
+				continue
+			}
+
+			sentAt := time.Now()
+			assignment.ReminderSentAt = &sentAt
+			if err := j.volunteerRepo.Update(ctx, assignment); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
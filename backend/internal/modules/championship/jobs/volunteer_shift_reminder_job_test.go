@@ -0,0 +1,106 @@
+package jobs_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/jobs"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockShiftRepo struct {
+	mock.Mock
+}
+
+func (m *MockShiftRepo) CreateShift(ctx context.Context, shift *domain.VolunteerShift) error {
+	return nil
+}
+func (m *MockShiftRepo) GetShift(ctx context.Context, clubID string, id uuid.UUID) (*domain.VolunteerShift, error) {
+	return nil, nil
+}
+func (m *MockShiftRepo) DeleteShift(ctx context.Context, clubID string, id uuid.UUID) error {
+	return nil
+}
+func (m *MockShiftRepo) ListShiftsByMatch(ctx context.Context, clubID string, matchID uuid.UUID) ([]domain.VolunteerShift, error) {
+	return nil, nil
+}
+func (m *MockShiftRepo) ListShifts(ctx context.Context, clubID string, from, to time.Time) ([]domain.VolunteerShift, error) {
+	args := m.Called(ctx, clubID, from, to)
+	return args.Get(0).([]domain.VolunteerShift), args.Error(1)
+}
+func (m *MockShiftRepo) GetAssignment(ctx context.Context, clubID string, id uuid.UUID) (*domain.VolunteerAssignment, error) {
+	return nil, nil
+}
+func (m *MockShiftRepo) ListAssignmentsByShift(ctx context.Context, clubID string, shiftID uuid.UUID) ([]domain.VolunteerAssignment, error) {
+	args := m.Called(ctx, clubID, shiftID)
+	return args.Get(0).([]domain.VolunteerAssignment), args.Error(1)
+}
+func (m *MockShiftRepo) ListAssignmentsByHoursStatus(ctx context.Context, clubID, userID string, status domain.VolunteerHoursStatus) ([]domain.VolunteerAssignment, error) {
+	return nil, nil
+}
+func (m *MockShiftRepo) SignUp(ctx context.Context, assignment *domain.VolunteerAssignment) error {
+	return nil
+}
+func (m *MockShiftRepo) ClaimApprovedHours(ctx context.Context, clubID, userID string) ([]domain.VolunteerAssignment, error) {
+	return nil, nil
+}
+func (m *MockShiftRepo) CompleteHoursCredit(ctx context.Context, clubID string, ids []uuid.UUID, paymentID uuid.UUID) error {
+	return nil
+}
+func (m *MockShiftRepo) ReleaseHoursClaim(ctx context.Context, clubID string, ids []uuid.UUID) error {
+	return nil
+}
+
+type MockVolunteerRepo struct {
+	mock.Mock
+}
+
+func (m *MockVolunteerRepo) Create(ctx context.Context, assignment *domain.VolunteerAssignment) error {
+	return nil
+}
+func (m *MockVolunteerRepo) GetByMatchID(ctx context.Context, clubID string, matchID uuid.UUID) ([]domain.VolunteerAssignment, error) {
+	return nil, nil
+}
+func (m *MockVolunteerRepo) GetByUserID(ctx context.Context, clubID, userID string) ([]domain.VolunteerAssignment, error) {
+	return nil, nil
+}
+func (m *MockVolunteerRepo) GetByRoleAndMatch(ctx context.Context, clubID string, matchID uuid.UUID, role domain.VolunteerRole) ([]domain.VolunteerAssignment, error) {
+	return nil, nil
+}
+func (m *MockVolunteerRepo) Update(ctx context.Context, assignment *domain.VolunteerAssignment) error {
+	return m.Called(ctx, assignment).Error(0)
+}
+func (m *MockVolunteerRepo) Delete(ctx context.Context, clubID string, id uuid.UUID) error {
+	return nil
+}
+
+func TestVolunteerShiftReminderJob(t *testing.T) {
+	shiftRepo := new(MockShiftRepo)
+	volunteerRepo := new(MockVolunteerRepo)
+	notifService := notificationSvc.NewNotificationService(&MockEmailProvider{}, &MockSMSProvider{})
+
+	job := jobs.NewVolunteerShiftReminderJob(shiftRepo, volunteerRepo, notifService, 24)
+
+	clubID := "test-club"
+	shift := domain.VolunteerShift{ID: uuid.New(), Role: domain.VolunteerRoleBuffet, StartTime: time.Now().Add(5 * time.Hour)}
+	alreadySent := time.Now().Add(-time.Hour)
+	pending := domain.VolunteerAssignment{ID: uuid.New(), UserID: "user1", ShiftID: &shift.ID}
+	reminded := domain.VolunteerAssignment{ID: uuid.New(), UserID: "user2", ShiftID: &shift.ID, ReminderSentAt: &alreadySent}
+
+	shiftRepo.On("ListShifts", mock.Anything, clubID, mock.Anything, mock.Anything).Return([]domain.VolunteerShift{shift}, nil)
+	shiftRepo.On("ListAssignmentsByShift", mock.Anything, clubID, shift.ID).Return([]domain.VolunteerAssignment{pending, reminded}, nil)
+	volunteerRepo.On("Update", mock.Anything, mock.MatchedBy(func(a *domain.VolunteerAssignment) bool {
+		return a.ID == pending.ID && a.ReminderSentAt != nil
+	})).Return(nil)
+
+	err := job.Run(context.Background(), clubID)
+
+	assert.NoError(t, err)
+	volunteerRepo.AssertNumberOfCalls(t, "Update", 1)
+	shiftRepo.AssertExpectations(t)
+}
@@ -11,11 +11,17 @@ import (
 	notification "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
 )
 
+// MarketingAudience returns the emails of the members that accepted commercial communications
+type MarketingAudience interface {
+	MarketingRecipients(ctx context.Context, clubID string) ([]string, error)
+}
+
 type ClubUseCases struct {
 	sponsorRepo domain.SponsorRepository
 	clubRepo    domain.ClubRepository
 	newsRepo    domain.NewsRepository
 	notifier    *notification.NotificationService
+	audience    MarketingAudience
 }
 
 func NewClubUseCases(
@@ -32,6 +38,12 @@ func NewClubUseCases(
 	}
 }
 
+// SetMarketingAudience limits the news broadcast to members with marketing consent.
+// Without it the news are emailed to every member of the club.
+func (uc *ClubUseCases) SetMarketingAudience(audience MarketingAudience) {
+	uc.audience = audience
+}
+
 // ... (Club methods remain)
 
 // --- News Management ---
@@ -55,7 +67,7 @@ func (uc *ClubUseCases) PublishNews(ctx context.Context, clubID, title, content,
 		// Broadcast Notification Async with restricted concurrency
 		go func() {
 			bgCtx := context.Background()
-			emails, err := uc.clubRepo.GetMemberEmails(bgCtx, clubID)
+			emails, err := uc.newsRecipients(bgCtx, clubID)
 			if err != nil {
 				// In production, use a proper logger
 				return
@@ -93,6 +105,13 @@ func (uc *ClubUseCases) PublishNews(ctx context.Context, clubID, title, content,
 	return news, nil
 }
 
+func (uc *ClubUseCases) newsRecipients(ctx context.Context, clubID string) ([]string, error) {
+	if uc.audience != nil {
+		return uc.audience.MarketingRecipients(ctx, clubID)
+	}
+	return uc.clubRepo.GetMemberEmails(ctx, clubID)
+}
+
 func (uc *ClubUseCases) GetPublicNews(ctx context.Context, slug string) ([]domain.News, error) {
 	club, err := uc.clubRepo.GetBySlug(ctx, slug)
 	if err != nil {
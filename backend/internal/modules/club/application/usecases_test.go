@@ -99,6 +99,13 @@ func (m *MockEmailProvider) SendEmail(ctx context.Context, to, subject, body str
 	return &notificationSvc.DeliveryResult{Success: true}, args.Error(1)
 }
 
+type MockMarketingAudience struct{ mock.Mock }
+
+func (m *MockMarketingAudience) MarketingRecipients(ctx context.Context, clubID string) ([]string, error) {
+	args := m.Called(ctx, clubID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 type MockSMSProvider struct{ mock.Mock }
 
 func (m *MockSMSProvider) SendSMS(ctx context.Context, to, body string) (*notificationSvc.DeliveryResult, error) {
@@ -177,4 +184,24 @@ func TestClubUseCases_PublishNews(t *testing.T) {
 		assert.NoError(t, err)
 		// No GetMemberEmails call
 	})
+
+	t.Run("Success: Notify Only Members With Marketing Consent", func(t *testing.T) {
+		consentEmail := new(MockEmailProvider)
+		audience := new(MockMarketingAudience)
+		memberRepo := new(MockClubRepo)
+		uc := application.NewClubUseCases(nil, memberRepo, newsRepo, notificationSvc.NewNotificationService(consentEmail, nil))
+		uc.SetMarketingAudience(audience)
+
+		newsRepo.On("CreateNews", mock.Anything, mock.Anything).Return(nil).Once()
+		audience.On("MarketingRecipients", mock.Anything, clubID).Return([]string{"acepto@example.com"}, nil).Once()
+		consentEmail.On("SendEmail", mock.Anything, "acepto@example.com", "Nueva noticia: Title 3", mock.Anything).Return(nil, nil).Once()
+
+		_, err := uc.PublishNews(context.TODO(), clubID, "Title 3", "Content", "", true)
+		assert.NoError(t, err)
+
+		time.Sleep(50 * time.Millisecond)
+		audience.AssertExpectations(t)
+		consentEmail.AssertExpectations(t)
+		memberRepo.AssertNotCalled(t, "GetMemberEmails", mock.Anything, clubID)
+	})
 }
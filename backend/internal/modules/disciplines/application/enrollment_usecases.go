@@ -0,0 +1,280 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrGroupNotFound      = errors.New("training group not found")
+	ErrEnrollmentNotFound = errors.New("enrollment not found")
+	ErrAlreadyEnrolled    = domain.ErrAlreadyEnrolled
+)
+
+// EnrollmentUseCases manages the explicit roster of each training group: enrollments,
+// waitlist, coaching staff and the group's capacity and monthly fee.
+type EnrollmentUseCases struct {
+	repo           domain.DisciplineRepository
+	enrollmentRepo domain.EnrollmentRepository
+	userRepo       userDomain.UserRepository
+}
+
+func NewEnrollmentUseCases(repo domain.DisciplineRepository, enrollmentRepo domain.EnrollmentRepository, userRepo userDomain.UserRepository) *EnrollmentUseCases {
+	return &EnrollmentUseCases{
+		repo:           repo,
+		enrollmentRepo: enrollmentRepo,
+		userRepo:       userRepo,
+	}
+}
+
+type EnrollMemberDTO struct {
+	UserID    string     `json:"user_id" binding:"required"`
+	StartDate *time.Time `json:"start_date"` // Defaults to today
+	EndDate   *time.Time `json:"end_date"`   // Optional, e.g. end of season
+	Notes     string     `json:"notes"`
+}
+
+// Enroll adds a member to the group. When the group is full the member is waitlisted
+// and gets the first free place in arrival order.
+func (uc *EnrollmentUseCases) Enroll(ctx context.Context, clubID string, groupID uuid.UUID, dto EnrollMemberDTO, createdBy string) (*domain.GroupEnrollment, error) {
+	if _, err := uc.getGroup(ctx, clubID, groupID); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, clubID, dto.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	start := today()
+	if dto.StartDate != nil {
+		start = truncateDay(*dto.StartDate)
+	}
+	var end *time.Time
+	if dto.EndDate != nil {
+		e := truncateDay(*dto.EndDate)
+		if e.Before(start) {
+			return nil, errors.New("end date must be on or after start date")
+		}
+		end = &e
+	}
+
+	enrollment := &domain.GroupEnrollment{
+		ID:        uuid.New(),
+		ClubID:    clubID,
+		GroupID:   groupID,
+		UserID:    dto.UserID,
+		Status:    domain.EnrollmentActive, // The repository waitlists it if the group is full
+		StartDate: start,
+		EndDate:   end,
+		Notes:     dto.Notes,
+		CreatedBy: createdBy,
+	}
+	if err := uc.enrollmentRepo.Enroll(ctx, enrollment); err != nil {
+		return nil, err
+	}
+	return enrollment, nil
+}
+
+// Withdraw ends an enrollment on the given date (today by default). The member stays on
+// the roster until then, and the freed place goes to the first member on the waitlist.
+func (uc *EnrollmentUseCases) Withdraw(ctx context.Context, clubID string, groupID, enrollmentID uuid.UUID, endDate *time.Time) (*domain.GroupEnrollment, error) {
+	enrollment, err := uc.enrollmentRepo.GetEnrollmentByID(ctx, clubID, enrollmentID)
+	if err != nil {
+		return nil, err
+	}
+	if enrollment == nil || enrollment.GroupID != groupID {
+		return nil, ErrEnrollmentNotFound
+	}
+	if !enrollment.IsOpen() {
+		return nil, errors.New("enrollment is already withdrawn")
+	}
+
+	wasActive := enrollment.Status == domain.EnrollmentActive
+	end := today()
+	if endDate != nil {
+		end = truncateDay(*endDate)
+	}
+	if !wasActive || end.Before(enrollment.StartDate) {
+		// Waitlisted or withdrawn before starting: keep it off every roster
+		end = enrollment.StartDate.AddDate(0, 0, -1)
+	}
+	enrollment.Status = domain.EnrollmentWithdrawn
+	enrollment.EndDate = &end
+	if err := uc.enrollmentRepo.UpdateEnrollment(ctx, enrollment); err != nil {
+		return nil, err
+	}
+
+	if wasActive {
+		group, err := uc.getGroup(ctx, clubID, enrollment.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		startFrom := end.AddDate(0, 0, 1)
+		if startFrom.Before(today()) {
+			startFrom = today()
+		}
+		if err := uc.promoteWaitlist(ctx, group, startFrom); err != nil {
+			return nil, err
+		}
+	}
+	return enrollment, nil
+}
+
+func (uc *EnrollmentUseCases) ListEnrollments(ctx context.Context, clubID string, groupID uuid.UUID, status string) ([]domain.GroupEnrollment, error) {
+	return uc.enrollmentRepo.ListEnrollments(ctx, clubID, groupID, domain.EnrollmentStatus(status))
+}
+
+// ListRoster returns the members enrolled in the group on the given date.
+func (uc *EnrollmentUseCases) ListRoster(ctx context.Context, clubID string, groupID uuid.UUID, date time.Time) ([]userDomain.User, error) {
+	return listRoster(ctx, uc.enrollmentRepo, uc.userRepo, clubID, groupID, date)
+}
+
+type GroupSettingsDTO struct {
+	Capacity   int             `json:"capacity"`
+	MonthlyFee decimal.Decimal `json:"monthly_fee"`
+}
+
+// UpdateGroupSettings changes capacity and monthly fee. Raising the capacity promotes
+// waitlisted members right away.
+func (uc *EnrollmentUseCases) UpdateGroupSettings(ctx context.Context, clubID string, groupID uuid.UUID, dto GroupSettingsDTO) (*domain.TrainingGroup, error) {
+	if dto.Capacity < 0 {
+		return nil, errors.New("capacity cannot be negative")
+	}
+	if dto.MonthlyFee.IsNegative() {
+		return nil, errors.New("monthly fee cannot be negative")
+	}
+	group, err := uc.getGroup(ctx, clubID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.enrollmentRepo.UpdateGroupSettings(ctx, clubID, groupID, dto.Capacity, dto.MonthlyFee); err != nil {
+		return nil, err
+	}
+	group.Capacity = dto.Capacity
+	group.MonthlyFee = dto.MonthlyFee
+
+	if err := uc.promoteWaitlist(ctx, group, today()); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// AddStaff assigns a coach or assistant to the group.
+func (uc *EnrollmentUseCases) AddStaff(ctx context.Context, clubID string, groupID uuid.UUID, userID string, role domain.StaffRole) (*domain.TrainingGroupStaff, error) {
+	if role == "" {
+		role = domain.StaffCoach
+	}
+	if role != domain.StaffHeadCoach && role != domain.StaffCoach && role != domain.StaffAssistant {
+		return nil, errors.New("invalid staff role")
+	}
+	if _, err := uc.getGroup(ctx, clubID, groupID); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	staff, err := uc.enrollmentRepo.ListStaff(ctx, clubID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range staff {
+		if s.UserID == userID {
+			return nil, errors.New("user is already part of the group staff")
+		}
+	}
+
+	member := &domain.TrainingGroupStaff{
+		ID:      uuid.New(),
+		ClubID:  clubID,
+		GroupID: groupID,
+		UserID:  userID,
+		Role:    role,
+	}
+	if err := uc.enrollmentRepo.AddStaff(ctx, member); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+func (uc *EnrollmentUseCases) RemoveStaff(ctx context.Context, clubID string, groupID uuid.UUID, userID string) error {
+	return uc.enrollmentRepo.RemoveStaff(ctx, clubID, groupID, userID)
+}
+
+func (uc *EnrollmentUseCases) ListStaff(ctx context.Context, clubID string, groupID uuid.UUID) ([]domain.TrainingGroupStaff, error) {
+	return uc.enrollmentRepo.ListStaff(ctx, clubID, groupID)
+}
+
+// promoteWaitlist fills the free places of the group with waitlisted members, oldest first.
+func (uc *EnrollmentUseCases) promoteWaitlist(ctx context.Context, group *domain.TrainingGroup, startFrom time.Time) error {
+	waitlist, err := uc.enrollmentRepo.ListEnrollments(ctx, group.ClubID, group.ID, domain.EnrollmentWaitlisted)
+	if err != nil || len(waitlist) == 0 {
+		return err
+	}
+
+	free := len(waitlist)
+	if group.Capacity > 0 {
+		active, err := uc.enrollmentRepo.CountActiveEnrollments(ctx, group.ClubID, group.ID)
+		if err != nil {
+			return err
+		}
+		free = group.Capacity - int(active)
+	}
+
+	for i := 0; i < free && i < len(waitlist); i++ {
+		e := waitlist[i]
+		e.Status = domain.EnrollmentActive
+		if e.StartDate.Before(startFrom) {
+			e.StartDate = startFrom
+		}
+		if err := uc.enrollmentRepo.UpdateEnrollment(ctx, &e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *EnrollmentUseCases) getGroup(ctx context.Context, clubID string, groupID uuid.UUID) (*domain.TrainingGroup, error) {
+	group, err := uc.repo.GetGroupByID(ctx, clubID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, ErrGroupNotFound
+	}
+	return group, nil
+}
+
+func listRoster(ctx context.Context, enrollmentRepo domain.EnrollmentRepository, userRepo userDomain.UserRepository, clubID string, groupID uuid.UUID, date time.Time) ([]userDomain.User, error) {
+	userIDs, err := enrollmentRepo.ListRosterUserIDs(ctx, clubID, groupID, date)
+	if err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return []userDomain.User{}, nil
+	}
+	return userRepo.ListByIDs(ctx, clubID, userIDs)
+}
+
+func today() time.Time {
+	return truncateDay(time.Now())
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
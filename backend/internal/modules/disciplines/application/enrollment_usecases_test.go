@@ -0,0 +1,235 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockEnrollmentRepo struct{ mock.Mock }
+
+func (m *MockEnrollmentRepo) CreateEnrollment(ctx context.Context, e *domain.GroupEnrollment) error {
+	return m.Called(ctx, e).Error(0)
+}
+func (m *MockEnrollmentRepo) Enroll(ctx context.Context, e *domain.GroupEnrollment) error {
+	args := m.Called(ctx, e)
+	if status, ok := args.Get(1).(domain.EnrollmentStatus); ok {
+		e.Status = status
+	}
+	return args.Error(0)
+}
+func (m *MockEnrollmentRepo) UpdateEnrollment(ctx context.Context, e *domain.GroupEnrollment) error {
+	return m.Called(ctx, e).Error(0)
+}
+func (m *MockEnrollmentRepo) GetEnrollmentByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.GroupEnrollment, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GroupEnrollment), args.Error(1)
+}
+func (m *MockEnrollmentRepo) GetOpenEnrollment(ctx context.Context, clubID string, groupID uuid.UUID, userID string) (*domain.GroupEnrollment, error) {
+	args := m.Called(ctx, clubID, groupID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GroupEnrollment), args.Error(1)
+}
+func (m *MockEnrollmentRepo) ListEnrollments(ctx context.Context, clubID string, groupID uuid.UUID, status domain.EnrollmentStatus) ([]domain.GroupEnrollment, error) {
+	args := m.Called(ctx, clubID, groupID, status)
+	return args.Get(0).([]domain.GroupEnrollment), args.Error(1)
+}
+func (m *MockEnrollmentRepo) CountActiveEnrollments(ctx context.Context, clubID string, groupID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, clubID, groupID)
+	return args.Get(0).(int64), args.Error(1)
+}
+func (m *MockEnrollmentRepo) ListRosterUserIDs(ctx context.Context, clubID string, groupID uuid.UUID, date time.Time) ([]string, error) {
+	args := m.Called(ctx, clubID, groupID, date)
+	return args.Get(0).([]string), args.Error(1)
+}
+func (m *MockEnrollmentRepo) UpdateGroupSettings(ctx context.Context, clubID string, groupID uuid.UUID, capacity int, monthlyFee decimal.Decimal) error {
+	return m.Called(ctx, clubID, groupID, capacity, monthlyFee).Error(0)
+}
+func (m *MockEnrollmentRepo) AddStaff(ctx context.Context, staff *domain.TrainingGroupStaff) error {
+	return m.Called(ctx, staff).Error(0)
+}
+func (m *MockEnrollmentRepo) RemoveStaff(ctx context.Context, clubID string, groupID uuid.UUID, userID string) error {
+	return m.Called(ctx, clubID, groupID, userID).Error(0)
+}
+func (m *MockEnrollmentRepo) ListStaff(ctx context.Context, clubID string, groupID uuid.UUID) ([]domain.TrainingGroupStaff, error) {
+	args := m.Called(ctx, clubID, groupID)
+	return args.Get(0).([]domain.TrainingGroupStaff), args.Error(1)
+}
+func (m *MockEnrollmentRepo) MonthlyFees(ctx context.Context, clubID string, userIDs []uuid.UUID, date time.Time) (map[uuid.UUID]decimal.Decimal, error) {
+	args := m.Called(ctx, clubID, userIDs, date)
+	return args.Get(0).(map[uuid.UUID]decimal.Decimal), args.Error(1)
+}
+
+func TestEnrollmentUseCases_Enroll(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "c1"
+	group := &domain.TrainingGroup{ID: uuid.New(), ClubID: clubID, Capacity: 2}
+
+	setup := func() (*application.EnrollmentUseCases, *MockDisciplineRepo, *MockEnrollmentRepo) {
+		dRepo := new(MockDisciplineRepo)
+		eRepo := new(MockEnrollmentRepo)
+		dRepo.On("GetGroupByID", ctx, clubID, group.ID).Return(group, nil)
+		return application.NewEnrollmentUseCases(dRepo, eRepo, new(MockUserRepo)), dRepo, eRepo
+	}
+
+	t.Run("Active when there is room", func(t *testing.T) {
+		uc, _, eRepo := setup()
+		eRepo.On("Enroll", ctx, mock.Anything).Return(nil, domain.EnrollmentActive).Once()
+
+		e, err := uc.Enroll(ctx, clubID, group.ID, application.EnrollMemberDTO{UserID: "u1"}, "coach-1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.EnrollmentActive, e.Status)
+		assert.Nil(t, e.EndDate)
+		eRepo.AssertExpectations(t)
+	})
+
+	t.Run("Waitlisted when group is full", func(t *testing.T) {
+		uc, _, eRepo := setup()
+		eRepo.On("Enroll", ctx, mock.Anything).Return(nil, domain.EnrollmentWaitlisted).Once()
+
+		e, err := uc.Enroll(ctx, clubID, group.ID, application.EnrollMemberDTO{UserID: "u2"}, "coach-1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.EnrollmentWaitlisted, e.Status)
+	})
+
+	t.Run("Fail: already enrolled", func(t *testing.T) {
+		uc, _, eRepo := setup()
+		eRepo.On("Enroll", ctx, mock.Anything).Return(domain.ErrAlreadyEnrolled, nil).Once()
+
+		_, err := uc.Enroll(ctx, clubID, group.ID, application.EnrollMemberDTO{UserID: "u1"}, "coach-1")
+		assert.ErrorIs(t, err, application.ErrAlreadyEnrolled)
+	})
+
+	t.Run("Fail: end date before start", func(t *testing.T) {
+		uc, _, eRepo := setup()
+		start := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+		end := start.AddDate(0, 0, -1)
+
+		_, err := uc.Enroll(ctx, clubID, group.ID, application.EnrollMemberDTO{UserID: "u3", StartDate: &start, EndDate: &end}, "coach-1")
+		assert.Error(t, err)
+		eRepo.AssertNotCalled(t, "Enroll", mock.Anything, mock.Anything)
+	})
+}
+
+func TestEnrollmentUseCases_Withdraw(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "c1"
+	group := &domain.TrainingGroup{ID: uuid.New(), ClubID: clubID, Capacity: 1}
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Promotes first waitlisted member", func(t *testing.T) {
+		dRepo := new(MockDisciplineRepo)
+		eRepo := new(MockEnrollmentRepo)
+		uc := application.NewEnrollmentUseCases(dRepo, eRepo, new(MockUserRepo))
+
+		active := &domain.GroupEnrollment{ID: uuid.New(), ClubID: clubID, GroupID: group.ID, UserID: "u1", Status: domain.EnrollmentActive, StartDate: start}
+		first := domain.GroupEnrollment{ID: uuid.New(), ClubID: clubID, GroupID: group.ID, UserID: "u2", Status: domain.EnrollmentWaitlisted, StartDate: start}
+		second := domain.GroupEnrollment{ID: uuid.New(), ClubID: clubID, GroupID: group.ID, UserID: "u3", Status: domain.EnrollmentWaitlisted, StartDate: start}
+
+		dRepo.On("GetGroupByID", ctx, clubID, group.ID).Return(group, nil)
+		eRepo.On("GetEnrollmentByID", ctx, clubID, active.ID).Return(active, nil).Once()
+		eRepo.On("UpdateEnrollment", ctx, mock.MatchedBy(func(e *domain.GroupEnrollment) bool {
+			return e.ID == active.ID && e.Status == domain.EnrollmentWithdrawn && e.EndDate != nil
+		})).Return(nil).Once()
+		eRepo.On("ListEnrollments", ctx, clubID, group.ID, domain.EnrollmentWaitlisted).Return([]domain.GroupEnrollment{first, second}, nil).Once()
+		eRepo.On("CountActiveEnrollments", ctx, clubID, group.ID).Return(int64(0), nil).Once()
+		eRepo.On("UpdateEnrollment", ctx, mock.MatchedBy(func(e *domain.GroupEnrollment) bool {
+			return e.ID == first.ID && e.Status == domain.EnrollmentActive && !e.StartDate.Before(start)
+		})).Return(nil).Once()
+
+		res, err := uc.Withdraw(ctx, clubID, group.ID, active.ID, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.EnrollmentWithdrawn, res.Status)
+		eRepo.AssertExpectations(t)
+		eRepo.AssertNumberOfCalls(t, "UpdateEnrollment", 2)
+	})
+
+	t.Run("Waitlisted withdrawal never reaches the roster", func(t *testing.T) {
+		dRepo := new(MockDisciplineRepo)
+		eRepo := new(MockEnrollmentRepo)
+		uc := application.NewEnrollmentUseCases(dRepo, eRepo, new(MockUserRepo))
+
+		waiting := &domain.GroupEnrollment{ID: uuid.New(), ClubID: clubID, GroupID: group.ID, UserID: "u2", Status: domain.EnrollmentWaitlisted, StartDate: start}
+		eRepo.On("GetEnrollmentByID", ctx, clubID, waiting.ID).Return(waiting, nil).Once()
+		eRepo.On("UpdateEnrollment", ctx, mock.Anything).Return(nil).Once()
+
+		res, err := uc.Withdraw(ctx, clubID, group.ID, waiting.ID, nil)
+		assert.NoError(t, err)
+		assert.True(t, res.EndDate.Before(res.StartDate))
+		eRepo.AssertNotCalled(t, "ListEnrollments", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Fail: enrollment from another group", func(t *testing.T) {
+		eRepo := new(MockEnrollmentRepo)
+		uc := application.NewEnrollmentUseCases(new(MockDisciplineRepo), eRepo, new(MockUserRepo))
+
+		other := &domain.GroupEnrollment{ID: uuid.New(), GroupID: uuid.New(), Status: domain.EnrollmentActive}
+		eRepo.On("GetEnrollmentByID", ctx, clubID, other.ID).Return(other, nil).Once()
+
+		_, err := uc.Withdraw(ctx, clubID, group.ID, other.ID, nil)
+		assert.ErrorIs(t, err, application.ErrEnrollmentNotFound)
+	})
+}
+
+func TestEnrollmentUseCases_Staff(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "c1"
+	group := &domain.TrainingGroup{ID: uuid.New(), ClubID: clubID}
+
+	dRepo := new(MockDisciplineRepo)
+	eRepo := new(MockEnrollmentRepo)
+	uc := application.NewEnrollmentUseCases(dRepo, eRepo, new(MockUserRepo))
+	dRepo.On("GetGroupByID", ctx, clubID, group.ID).Return(group, nil)
+
+	t.Run("Adds assistant", func(t *testing.T) {
+		eRepo.On("ListStaff", ctx, clubID, group.ID).Return([]domain.TrainingGroupStaff{}, nil).Once()
+		eRepo.On("AddStaff", ctx, mock.MatchedBy(func(s *domain.TrainingGroupStaff) bool {
+			return s.UserID == "a1" && s.Role == domain.StaffAssistant
+		})).Return(nil).Once()
+
+		_, err := uc.AddStaff(ctx, clubID, group.ID, "a1", domain.StaffAssistant)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail: duplicate", func(t *testing.T) {
+		eRepo.On("ListStaff", ctx, clubID, group.ID).Return([]domain.TrainingGroupStaff{{UserID: "a1"}}, nil).Once()
+
+		_, err := uc.AddStaff(ctx, clubID, group.ID, "a1", domain.StaffCoach)
+		assert.Error(t, err)
+	})
+
+	t.Run("Fail: invalid role", func(t *testing.T) {
+		_, err := uc.AddStaff(ctx, clubID, group.ID, "a2", domain.StaffRole("PARENT"))
+		assert.Error(t, err)
+	})
+}
+
+func TestDisciplineUseCases_ListStudentsInGroup(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "c1"
+	group := &domain.TrainingGroup{ID: uuid.New(), ClubID: clubID, Category: "2012"}
+
+	dRepo := new(MockDisciplineRepo)
+	eRepo := new(MockEnrollmentRepo)
+	uc := application.NewDisciplineUseCases(dRepo, new(MockTournamentRepo), new(MockUserRepo), eRepo)
+
+	dRepo.On("GetGroupByID", ctx, clubID, group.ID).Return(group, nil).Once()
+	eRepo.On("ListRosterUserIDs", ctx, clubID, group.ID, mock.Anything).Return([]string{"u1", "u2"}, nil).Once()
+
+	students, err := uc.ListStudentsInGroup(ctx, clubID, group.ID)
+	assert.NoError(t, err)
+	assert.Len(t, students, 2)
+	assert.Equal(t, "u1", students[0].ID)
+}
@@ -0,0 +1,163 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+)
+
+// ScheduleUseCases manages the structured weekly sessions of training groups and the
+// coach agenda built from them.
+type ScheduleUseCases struct {
+	repo         domain.DisciplineRepository
+	scheduleRepo domain.ScheduleRepository
+	bookings     domain.SessionBookingService
+}
+
+func NewScheduleUseCases(repo domain.DisciplineRepository, scheduleRepo domain.ScheduleRepository, bookings domain.SessionBookingService) *ScheduleUseCases {
+	return &ScheduleUseCases{
+		repo:         repo,
+		scheduleRepo: scheduleRepo,
+		bookings:     bookings,
+	}
+}
+
+type AddSessionDTO struct {
+	DayOfWeek  int        `json:"day_of_week" binding:"gte=0,lte=6"` // 0 = Sunday
+	StartTime  string     `json:"start_time" binding:"required"`     // HH:MM
+	EndTime    string     `json:"end_time" binding:"required"`       // HH:MM
+	FacilityID *string    `json:"facility_id"`                       // Optional, holds the court with a CLASS rule
+	ValidFrom  *time.Time `json:"valid_from"`                        // Defaults to today
+	ValidUntil *time.Time `json:"valid_until"`                       // Defaults to December 31st
+}
+
+// AddSession adds a weekly session to the group. If a facility is given, the court is
+// reserved through the booking module first; an overlap with another rule rejects the session.
+func (uc *ScheduleUseCases) AddSession(ctx context.Context, clubID string, groupID uuid.UUID, dto AddSessionDTO) (*domain.TrainingSession, error) {
+	group, err := uc.repo.GetGroupByID(ctx, clubID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, ErrGroupNotFound
+	}
+
+	from := today()
+	if dto.ValidFrom != nil {
+		from = truncateDay(*dto.ValidFrom)
+	}
+	until := time.Date(from.Year(), time.December, 31, 0, 0, 0, 0, time.UTC)
+	if dto.ValidUntil != nil {
+		until = truncateDay(*dto.ValidUntil)
+	}
+
+	session := &domain.TrainingSession{
+		ID:         uuid.New(),
+		ClubID:     clubID,
+		GroupID:    groupID,
+		DayOfWeek:  dto.DayOfWeek,
+		StartTime:  dto.StartTime,
+		EndTime:    dto.EndTime,
+		ValidFrom:  from,
+		ValidUntil: until,
+	}
+	if dto.FacilityID != nil && *dto.FacilityID != "" {
+		facilityID, err := uuid.Parse(*dto.FacilityID)
+		if err != nil {
+			return nil, errors.New("invalid facility id")
+		}
+		session.FacilityID = &facilityID
+	}
+	if err := session.Validate(); err != nil {
+		return nil, err
+	}
+
+	if session.FacilityID != nil {
+		ruleID, err := uc.bookings.ReserveSession(ctx, session, group.CoachID)
+		if err != nil {
+			return nil, err
+		}
+		session.RecurringRuleID = &ruleID
+	}
+
+	if err := uc.scheduleRepo.CreateSession(ctx, session); err != nil {
+		if session.RecurringRuleID != nil {
+			_ = uc.bookings.ReleaseSession(ctx, clubID, *session.RecurringRuleID)
+		}
+		return nil, err
+	}
+
+	if err := uc.refreshSummary(ctx, clubID, groupID); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// RemoveSession deletes a session and releases its facility, cancelling the class bookings
+// already generated for upcoming weeks.
+func (uc *ScheduleUseCases) RemoveSession(ctx context.Context, clubID string, groupID, sessionID uuid.UUID) error {
+	session, err := uc.scheduleRepo.GetSession(ctx, clubID, sessionID)
+	if err != nil {
+		return err
+	}
+	if session == nil || session.GroupID != groupID {
+		return errors.New("session not found")
+	}
+
+	if session.RecurringRuleID != nil {
+		if err := uc.bookings.ReleaseSession(ctx, clubID, *session.RecurringRuleID); err != nil {
+			return err
+		}
+	}
+	if err := uc.scheduleRepo.DeleteSession(ctx, clubID, sessionID); err != nil {
+		return err
+	}
+	return uc.refreshSummary(ctx, clubID, groupID)
+}
+
+func (uc *ScheduleUseCases) ListSessions(ctx context.Context, clubID string, groupID uuid.UUID) ([]domain.TrainingSession, error) {
+	return uc.scheduleRepo.ListSessions(ctx, clubID, []uuid.UUID{groupID})
+}
+
+// WeeklyAgenda returns the sessions of every group the coach leads or assists during the
+// week that contains the given day (Monday to Sunday).
+func (uc *ScheduleUseCases) WeeklyAgenda(ctx context.Context, clubID, coachID string, day time.Time) ([]domain.SessionOccurrence, error) {
+	groups, err := uc.repo.ListGroups(ctx, clubID, map[string]interface{}{"coach_id": coachID})
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return []domain.SessionOccurrence{}, nil
+	}
+
+	byID := make(map[uuid.UUID]domain.TrainingGroup, len(groups))
+	groupIDs := make([]uuid.UUID, 0, len(groups))
+	for _, g := range groups {
+		byID[g.ID] = g
+		groupIDs = append(groupIDs, g.ID)
+	}
+
+	sessions, err := uc.scheduleRepo.ListSessions(ctx, clubID, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	monday := truncateDay(day)
+	monday = monday.AddDate(0, 0, -((int(monday.Weekday()) + 6) % 7))
+	occurrences := domain.ExpandSessions(sessions, byID, monday, monday.AddDate(0, 0, 6))
+	if occurrences == nil {
+		occurrences = []domain.SessionOccurrence{}
+	}
+	return occurrences, nil
+}
+
+func (uc *ScheduleUseCases) refreshSummary(ctx context.Context, clubID string, groupID uuid.UUID) error {
+	sessions, err := uc.scheduleRepo.ListSessions(ctx, clubID, []uuid.UUID{groupID})
+	if err != nil {
+		return err
+	}
+	return uc.scheduleRepo.UpdateGroupSchedule(ctx, clubID, groupID, domain.ScheduleSummary(sessions))
+}
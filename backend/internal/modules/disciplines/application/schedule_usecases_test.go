@@ -0,0 +1,170 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockScheduleRepo struct{ mock.Mock }
+
+func (m *MockScheduleRepo) CreateSession(ctx context.Context, s *domain.TrainingSession) error {
+	return m.Called(ctx, s).Error(0)
+}
+func (m *MockScheduleRepo) GetSession(ctx context.Context, clubID string, id uuid.UUID) (*domain.TrainingSession, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TrainingSession), args.Error(1)
+}
+func (m *MockScheduleRepo) DeleteSession(ctx context.Context, clubID string, id uuid.UUID) error {
+	return m.Called(ctx, clubID, id).Error(0)
+}
+func (m *MockScheduleRepo) ListSessions(ctx context.Context, clubID string, groupIDs []uuid.UUID) ([]domain.TrainingSession, error) {
+	args := m.Called(ctx, clubID, groupIDs)
+	return args.Get(0).([]domain.TrainingSession), args.Error(1)
+}
+func (m *MockScheduleRepo) UpdateGroupSchedule(ctx context.Context, clubID string, groupID uuid.UUID, schedule string) error {
+	return m.Called(ctx, clubID, groupID, schedule).Error(0)
+}
+
+type MockSessionBooking struct{ mock.Mock }
+
+func (m *MockSessionBooking) ReserveSession(ctx context.Context, s *domain.TrainingSession, coachID string) (uuid.UUID, error) {
+	args := m.Called(ctx, s, coachID)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+func (m *MockSessionBooking) ReleaseSession(ctx context.Context, clubID string, ruleID uuid.UUID) error {
+	return m.Called(ctx, clubID, ruleID).Error(0)
+}
+
+func TestScheduleUseCases_AddSession(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "c1"
+	group := &domain.TrainingGroup{ID: uuid.New(), ClubID: clubID, CoachID: "coach-1"}
+	facilityID := uuid.New().String()
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	setup := func() (*application.ScheduleUseCases, *MockScheduleRepo, *MockSessionBooking) {
+		dRepo := new(MockDisciplineRepo)
+		sRepo := new(MockScheduleRepo)
+		bookings := new(MockSessionBooking)
+		dRepo.On("GetGroupByID", ctx, clubID, group.ID).Return(group, nil)
+		return application.NewScheduleUseCases(dRepo, sRepo, bookings), sRepo, bookings
+	}
+
+	t.Run("Reserves facility and refreshes summary", func(t *testing.T) {
+		uc, sRepo, bookings := setup()
+		ruleID := uuid.New()
+		bookings.On("ReserveSession", ctx, mock.Anything, "coach-1").Return(ruleID, nil).Once()
+		sRepo.On("CreateSession", ctx, mock.Anything).Return(nil).Once()
+		sRepo.On("ListSessions", ctx, clubID, []uuid.UUID{group.ID}).Return([]domain.TrainingSession{
+			{DayOfWeek: 1, StartTime: "18:00", EndTime: "19:30"},
+		}, nil).Once()
+		sRepo.On("UpdateGroupSchedule", ctx, clubID, group.ID, "Mon 18:00-19:30").Return(nil).Once()
+
+		s, err := uc.AddSession(ctx, clubID, group.ID, application.AddSessionDTO{
+			DayOfWeek: 1, StartTime: "18:00", EndTime: "19:30", FacilityID: &facilityID, ValidFrom: &from,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, ruleID, *s.RecurringRuleID)
+		assert.Equal(t, time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), s.ValidUntil)
+		sRepo.AssertExpectations(t)
+		bookings.AssertExpectations(t)
+	})
+
+	t.Run("Fail: facility already taken", func(t *testing.T) {
+		uc, sRepo, bookings := setup()
+		bookings.On("ReserveSession", ctx, mock.Anything, "coach-1").Return(uuid.Nil, errors.New("overlaps")).Once()
+
+		_, err := uc.AddSession(ctx, clubID, group.ID, application.AddSessionDTO{
+			DayOfWeek: 1, StartTime: "18:00", EndTime: "19:30", FacilityID: &facilityID,
+		})
+		assert.Error(t, err)
+		sRepo.AssertNotCalled(t, "CreateSession", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Releases facility when save fails", func(t *testing.T) {
+		uc, sRepo, bookings := setup()
+		ruleID := uuid.New()
+		bookings.On("ReserveSession", ctx, mock.Anything, "coach-1").Return(ruleID, nil).Once()
+		bookings.On("ReleaseSession", ctx, clubID, ruleID).Return(nil).Once()
+		sRepo.On("CreateSession", ctx, mock.Anything).Return(errors.New("db down")).Once()
+
+		_, err := uc.AddSession(ctx, clubID, group.ID, application.AddSessionDTO{
+			DayOfWeek: 1, StartTime: "18:00", EndTime: "19:30", FacilityID: &facilityID,
+		})
+		assert.Error(t, err)
+		bookings.AssertExpectations(t)
+	})
+
+	t.Run("Fail: end before start", func(t *testing.T) {
+		uc, _, bookings := setup()
+
+		_, err := uc.AddSession(ctx, clubID, group.ID, application.AddSessionDTO{
+			DayOfWeek: 1, StartTime: "19:30", EndTime: "18:00",
+		})
+		assert.Error(t, err)
+		bookings.AssertNotCalled(t, "ReserveSession", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestScheduleUseCases_RemoveSession(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "c1"
+	groupID := uuid.New()
+	ruleID := uuid.New()
+	session := &domain.TrainingSession{ID: uuid.New(), ClubID: clubID, GroupID: groupID, RecurringRuleID: &ruleID}
+
+	sRepo := new(MockScheduleRepo)
+	bookings := new(MockSessionBooking)
+	uc := application.NewScheduleUseCases(new(MockDisciplineRepo), sRepo, bookings)
+
+	sRepo.On("GetSession", ctx, clubID, session.ID).Return(session, nil)
+	bookings.On("ReleaseSession", ctx, clubID, ruleID).Return(nil).Once()
+	sRepo.On("DeleteSession", ctx, clubID, session.ID).Return(nil).Once()
+	sRepo.On("ListSessions", ctx, clubID, []uuid.UUID{groupID}).Return([]domain.TrainingSession{}, nil).Once()
+	sRepo.On("UpdateGroupSchedule", ctx, clubID, groupID, "").Return(nil).Once()
+
+	assert.NoError(t, uc.RemoveSession(ctx, clubID, groupID, session.ID))
+	sRepo.AssertExpectations(t)
+	bookings.AssertExpectations(t)
+
+	// A session of another group is not found
+	assert.Error(t, uc.RemoveSession(ctx, clubID, uuid.New(), session.ID))
+}
+
+func TestScheduleUseCases_WeeklyAgenda(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "c1"
+	group := domain.TrainingGroup{ID: uuid.New(), ClubID: clubID, Name: "Sub 12", CoachID: "coach-1"}
+	validFrom := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	validUntil := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	dRepo := new(MockDisciplineRepo)
+	sRepo := new(MockScheduleRepo)
+	uc := application.NewScheduleUseCases(dRepo, sRepo, new(MockSessionBooking))
+
+	dRepo.On("ListGroups", ctx, clubID, map[string]interface{}{"coach_id": "coach-1"}).Return([]domain.TrainingGroup{group}, nil)
+	sRepo.On("ListSessions", ctx, clubID, []uuid.UUID{group.ID}).Return([]domain.TrainingSession{
+		{ID: uuid.New(), GroupID: group.ID, DayOfWeek: 3, StartTime: "18:00", EndTime: "19:00", ValidFrom: validFrom, ValidUntil: validUntil},
+		{ID: uuid.New(), GroupID: group.ID, DayOfWeek: 1, StartTime: "18:00", EndTime: "19:00", ValidFrom: validFrom, ValidUntil: validUntil},
+	}, nil)
+
+	// Thursday 2026-03-12: the week runs from Monday 9th to Sunday 15th
+	agenda, err := uc.WeeklyAgenda(ctx, clubID, "coach-1", time.Date(2026, 3, 12, 10, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	if assert.Len(t, agenda, 2) {
+		assert.Equal(t, time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC), agenda[0].StartsAt)
+		assert.Equal(t, time.Date(2026, 3, 11, 18, 0, 0, 0, time.UTC), agenda[1].StartsAt)
+		assert.Equal(t, "Sub 12", agenda[0].GroupName)
+	}
+}
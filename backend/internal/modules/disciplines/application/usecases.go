@@ -14,13 +14,15 @@ type DisciplineUseCases struct {
 	repo           domain.DisciplineRepository
 	tournamentRepo domain.TournamentRepository
 	userRepo       userDomain.UserRepository
+	enrollmentRepo domain.EnrollmentRepository
 }
 
-func NewDisciplineUseCases(repo domain.DisciplineRepository, tournamentRepo domain.TournamentRepository, userRepo userDomain.UserRepository) *DisciplineUseCases {
+func NewDisciplineUseCases(repo domain.DisciplineRepository, tournamentRepo domain.TournamentRepository, userRepo userDomain.UserRepository, enrollmentRepo domain.EnrollmentRepository) *DisciplineUseCases {
 	return &DisciplineUseCases{
 		repo:           repo,
 		tournamentRepo: tournamentRepo,
 		userRepo:       userRepo,
+		enrollmentRepo: enrollmentRepo,
 	}
 }
 
@@ -81,9 +83,8 @@ func (uc *DisciplineUseCases) ListStudentsInGroup(ctx context.Context, clubID st
 		return nil, nil
 	}
 
-	// Filter users by the group's category (year of birth)
-	// We use the User module's List method with the "category" filter.
-	return uc.userRepo.List(ctx, clubID, 100, 0, map[string]interface{}{"category": group.Category})
+	// Students come from the group's enrollment roster (see EnrollmentUseCases)
+	return listRoster(ctx, uc.enrollmentRepo, uc.userRepo, clubID, groupID, time.Now())
 }
 
 // --- Championships ---
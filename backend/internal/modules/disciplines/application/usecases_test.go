@@ -33,10 +33,15 @@ func (m *MockDisciplineRepo) CreateGroup(ctx context.Context, g *domain.Training
 	return nil
 }
 func (m *MockDisciplineRepo) ListGroups(ctx context.Context, clubID string, filter map[string]interface{}) ([]domain.TrainingGroup, error) {
-	return nil, nil
+	args := m.Called(ctx, clubID, filter)
+	return args.Get(0).([]domain.TrainingGroup), args.Error(1)
 }
 func (m *MockDisciplineRepo) GetGroupByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.TrainingGroup, error) {
-	return nil, nil
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TrainingGroup), args.Error(1)
 }
 
 type MockTournamentRepo struct {
@@ -92,7 +97,7 @@ func (m *MockUserRepo) List(ctx context.Context, clubID string, limit, offset in
 // Checking userRepo usage in usecases.go -> List.
 func (m *MockUserRepo) Create(ctx context.Context, user *userDomain.User) error { return nil }
 func (m *MockUserRepo) GetByID(ctx context.Context, clubID, id string) (*userDomain.User, error) {
-	return nil, nil
+	return &userDomain.User{ID: id}, nil
 }
 func (m *MockUserRepo) GetByEmail(ctx context.Context, clubID, email string) (*userDomain.User, error) {
 	return nil, nil
@@ -100,7 +105,11 @@ func (m *MockUserRepo) GetByEmail(ctx context.Context, clubID, email string) (*u
 func (m *MockUserRepo) Update(ctx context.Context, user *userDomain.User) error { return nil }
 func (m *MockUserRepo) Delete(ctx context.Context, clubID, id string) error     { return nil }
 func (m *MockUserRepo) ListByIDs(ctx context.Context, clubID string, ids []string) ([]userDomain.User, error) {
-	return nil, nil
+	users := make([]userDomain.User, len(ids))
+	for i, id := range ids {
+		users[i] = userDomain.User{ID: id}
+	}
+	return users, nil
 }
 func (m *MockUserRepo) FindChildren(ctx context.Context, clubID, parentID string) ([]userDomain.User, error) {
 	return nil, nil
@@ -119,7 +128,7 @@ func TestDisciplineUseCases_CreateDiscipline(t *testing.T) {
 	tRepo := new(MockTournamentRepo)
 	uRepo := new(MockUserRepo)
 
-	uc := application.NewDisciplineUseCases(dRepo, tRepo, uRepo)
+	uc := application.NewDisciplineUseCases(dRepo, tRepo, uRepo, nil)
 	clubID := "c1"
 
 	t.Run("Success", func(t *testing.T) {
@@ -139,7 +148,7 @@ func TestDisciplineUseCases_CreateTournament(t *testing.T) {
 	tRepo := new(MockTournamentRepo)
 	uRepo := new(MockUserRepo)
 
-	uc := application.NewDisciplineUseCases(dRepo, tRepo, uRepo)
+	uc := application.NewDisciplineUseCases(dRepo, tRepo, uRepo, nil)
 	clubID := "c1"
 	dID := uuid.New()
 
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ErrAlreadyEnrolled is returned when the member already holds an open enrollment in the group
+var ErrAlreadyEnrolled = errors.New("member is already enrolled or waitlisted in this group")
+
+type EnrollmentStatus string
+
+const (
+	EnrollmentActive     EnrollmentStatus = "ACTIVE"
+	EnrollmentWaitlisted EnrollmentStatus = "WAITLISTED"
+	EnrollmentWithdrawn  EnrollmentStatus = "WITHDRAWN"
+)
+
+// GroupEnrollment links a member to a training group for a period of time.
+// Withdrawn enrollments are kept (with their EndDate) so past rosters can be rebuilt.
+type GroupEnrollment struct {
+	ID        uuid.UUID        `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID    string           `json:"club_id" gorm:"index;not null"`
+	GroupID   uuid.UUID        `json:"group_id" gorm:"type:uuid;index;not null"`
+	UserID    string           `json:"user_id" gorm:"index;not null"`
+	Status    EnrollmentStatus `json:"status" gorm:"not null;default:'ACTIVE'"`
+	StartDate time.Time        `json:"start_date" gorm:"type:date;not null"`
+	EndDate   *time.Time       `json:"end_date,omitempty" gorm:"type:date"`
+	Notes     string           `json:"notes,omitempty"`
+	CreatedBy string           `json:"created_by,omitempty"`
+	CreatedAt time.Time        `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time        `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (GroupEnrollment) TableName() string {
+	return "training_group_enrollments"
+}
+
+// IsOpen reports whether the enrollment still holds (or waits for) a place in the group.
+func (e *GroupEnrollment) IsOpen() bool {
+	return e.Status == EnrollmentActive || e.Status == EnrollmentWaitlisted
+}
+
+type StaffRole string
+
+const (
+	StaffHeadCoach StaffRole = "HEAD_COACH"
+	StaffCoach     StaffRole = "COACH"
+	StaffAssistant StaffRole = "ASSISTANT"
+)
+
+// TrainingGroupStaff lists the coaches and assistants of a group besides TrainingGroup.CoachID.
+type TrainingGroupStaff struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID    string    `json:"club_id" gorm:"index;not null"`
+	GroupID   uuid.UUID `json:"group_id" gorm:"type:uuid;index;not null"`
+	UserID    string    `json:"user_id" gorm:"not null"`
+	Role      StaffRole `json:"role" gorm:"not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (TrainingGroupStaff) TableName() string {
+	return "training_group_staff"
+}
+
+type EnrollmentRepository interface {
+	CreateEnrollment(ctx context.Context, enrollment *GroupEnrollment) error
+	// Enroll inserts the enrollment with the group row locked, so concurrent sign-ups cannot exceed
+	// the capacity: it is stored ACTIVE, or WAITLISTED when the group is full. Returns ErrAlreadyEnrolled
+	// if the member already has an open enrollment in the group.
+	Enroll(ctx context.Context, enrollment *GroupEnrollment) error
+	UpdateEnrollment(ctx context.Context, enrollment *GroupEnrollment) error
+	GetEnrollmentByID(ctx context.Context, clubID string, id uuid.UUID) (*GroupEnrollment, error)
+	// GetOpenEnrollment returns the active or waitlisted enrollment of a user in a group, nil if none
+	GetOpenEnrollment(ctx context.Context, clubID string, groupID uuid.UUID, userID string) (*GroupEnrollment, error)
+	// ListEnrollments lists a group's enrollments, optionally filtered by status; the waitlist comes out in arrival order
+	ListEnrollments(ctx context.Context, clubID string, groupID uuid.UUID, status EnrollmentStatus) ([]GroupEnrollment, error)
+	CountActiveEnrollments(ctx context.Context, clubID string, groupID uuid.UUID) (int64, error)
+	// ListRosterUserIDs returns the members enrolled in the group on the given date
+	ListRosterUserIDs(ctx context.Context, clubID string, groupID uuid.UUID, date time.Time) ([]string, error)
+
+	UpdateGroupSettings(ctx context.Context, clubID string, groupID uuid.UUID, capacity int, monthlyFee decimal.Decimal) error
+
+	AddStaff(ctx context.Context, staff *TrainingGroupStaff) error
+	RemoveStaff(ctx context.Context, clubID string, groupID uuid.UUID, userID string) error
+	ListStaff(ctx context.Context, clubID string, groupID uuid.UUID) ([]TrainingGroupStaff, error)
+
+	// MonthlyFees sums, per user, the monthly fee of every group the user is actively enrolled in on the given date
+	MonthlyFees(ctx context.Context, clubID string, userIDs []uuid.UUID, date time.Time) (map[uuid.UUID]decimal.Decimal, error)
+}
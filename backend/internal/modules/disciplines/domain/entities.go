@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
@@ -20,18 +21,20 @@ type Discipline struct {
 }
 
 type TrainingGroup struct {
-	ID           uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	ClubID       string         `json:"club_id" gorm:"index;not null"`
-	Name         string         `json:"name" gorm:"not null;size:100"`
-	DisciplineID uuid.UUID      `json:"discipline_id" gorm:"type:uuid;not null"`
-	Discipline   Discipline     `json:"discipline" gorm:"foreignKey:DisciplineID"`
-	Category     string         `json:"category" gorm:"not null;size:20"` // e.g. "2012"
-	CategoryYear int            `json:"category_year"`                    // Normalized year (e.g. 2010)
-	CoachID      string         `json:"coach_id"`                         // User ID of the coach
-	Schedule     string         `json:"schedule"`                         // e.g. "Mon/Wed 18:00"
-	CreatedAt    time.Time      `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt    time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
-	DeletedAt    gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index"`
+	ID           uuid.UUID       `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ClubID       string          `json:"club_id" gorm:"index;not null"`
+	Name         string          `json:"name" gorm:"not null;size:100"`
+	DisciplineID uuid.UUID       `json:"discipline_id" gorm:"type:uuid;not null"`
+	Discipline   Discipline      `json:"discipline" gorm:"foreignKey:DisciplineID"`
+	Category     string          `json:"category" gorm:"not null;size:20"` // e.g. "2012"
+	CategoryYear int             `json:"category_year"`                    // Normalized year (e.g. 2010)
+	CoachID      string          `json:"coach_id"`                         // User ID of the coach
+	Schedule     string          `json:"schedule"`                         // e.g. "Mon/Wed 18:00"
+	Capacity     int             `json:"capacity"`                         // Max active enrollments, 0 = unlimited
+	MonthlyFee   decimal.Decimal `json:"monthly_fee" gorm:"type:decimal(10,2);default:0"`
+	CreatedAt    time.Time       `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time       `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt    gorm.DeletedAt  `json:"deleted_at,omitempty" gorm:"index"`
 }
 
 type DisciplineRepository interface {
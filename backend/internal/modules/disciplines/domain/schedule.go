@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrainingSession is a weekly slot of a training group (e.g. every Monday 18:00-19:30 on court 2).
+// When it has a facility, the court is held with a CLASS recurring rule in the booking module.
+type TrainingSession struct {
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID          string     `json:"club_id" gorm:"index;not null"`
+	GroupID         uuid.UUID  `json:"group_id" gorm:"type:uuid;index;not null"`
+	DayOfWeek       int        `json:"day_of_week" gorm:"not null"`       // 0 = Sunday ... 6 = Saturday, as in booking.RecurringRule
+	StartTime       string     `json:"start_time" gorm:"size:5;not null"` // "18:00"
+	EndTime         string     `json:"end_time" gorm:"size:5;not null"`   // "19:30"
+	FacilityID      *uuid.UUID `json:"facility_id,omitempty" gorm:"type:uuid"`
+	ValidFrom       time.Time  `json:"valid_from" gorm:"type:date;not null"`
+	ValidUntil      time.Time  `json:"valid_until" gorm:"type:date;not null"`
+	RecurringRuleID *uuid.UUID `json:"recurring_rule_id,omitempty" gorm:"type:uuid"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (TrainingSession) TableName() string {
+	return "training_group_sessions"
+}
+
+// ParseClock parses an "HH:MM" time of day.
+func ParseClock(value string) (time.Time, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	return t, nil
+}
+
+// Validate checks the weekday, times and validity range of the session.
+func (s *TrainingSession) Validate() error {
+	if s.DayOfWeek < 0 || s.DayOfWeek > 6 {
+		return errors.New("day_of_week must be between 0 (Sunday) and 6 (Saturday)")
+	}
+	start, err := ParseClock(s.StartTime)
+	if err != nil {
+		return err
+	}
+	end, err := ParseClock(s.EndTime)
+	if err != nil {
+		return err
+	}
+	if !end.After(start) {
+		return errors.New("end time must be after start time")
+	}
+	if s.ValidUntil.Before(s.ValidFrom) {
+		return errors.New("valid_until must be on or after valid_from")
+	}
+	return nil
+}
+
+// OccursOn reports whether the session takes place on the given day.
+func (s *TrainingSession) OccursOn(day time.Time) bool {
+	d := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	return int(d.Weekday()) == s.DayOfWeek && !d.Before(s.ValidFrom) && !d.After(s.ValidUntil)
+}
+
+// SessionOccurrence is one concrete session on a given date, as shown in a coach's agenda.
+type SessionOccurrence struct {
+	SessionID  uuid.UUID  `json:"session_id"`
+	GroupID    uuid.UUID  `json:"group_id"`
+	GroupName  string     `json:"group_name"`
+	CoachID    string     `json:"coach_id"`
+	Date       time.Time  `json:"date"`
+	StartsAt   time.Time  `json:"starts_at"`
+	EndsAt     time.Time  `json:"ends_at"`
+	FacilityID *uuid.UUID `json:"facility_id,omitempty"`
+}
+
+// ExpandSessions lists the occurrences of the sessions between from and to (inclusive days),
+// sorted by start time. Sessions whose group is not in groups are skipped.
+func ExpandSessions(sessions []TrainingSession, groups map[uuid.UUID]TrainingGroup, from, to time.Time) []SessionOccurrence {
+	var occurrences []SessionOccurrence
+	first := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	for _, s := range sessions {
+		group, ok := groups[s.GroupID]
+		if !ok {
+			continue
+		}
+		start, errStart := ParseClock(s.StartTime)
+		end, errEnd := ParseClock(s.EndTime)
+		if errStart != nil || errEnd != nil {
+			continue
+		}
+		for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+			if !s.OccursOn(day) {
+				continue
+			}
+			occurrences = append(occurrences, SessionOccurrence{
+				SessionID:  s.ID,
+				GroupID:    s.GroupID,
+				GroupName:  group.Name,
+				CoachID:    group.CoachID,
+				Date:       day,
+				StartsAt:   day.Add(time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute),
+				EndsAt:     day.Add(time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute),
+				FacilityID: s.FacilityID,
+			})
+		}
+	}
+
+	sort.Slice(occurrences, func(i, j int) bool {
+		return occurrences[i].StartsAt.Before(occurrences[j].StartsAt)
+	})
+	return occurrences
+}
+
+var weekdayNames = [...]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// ScheduleSummary renders the sessions as the legacy TrainingGroup.Schedule text,
+// e.g. "Mon 18:00-19:30, Wed 18:00-19:30".
+func ScheduleSummary(sessions []TrainingSession) string {
+	sorted := make([]TrainingSession, len(sessions))
+	copy(sorted, sessions)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].DayOfWeek != sorted[j].DayOfWeek {
+			return sorted[i].DayOfWeek < sorted[j].DayOfWeek
+		}
+		return sorted[i].StartTime < sorted[j].StartTime
+	})
+
+	parts := make([]string, 0, len(sorted))
+	for _, s := range sorted {
+		parts = append(parts, fmt.Sprintf("%s %s-%s", weekdayNames[s.DayOfWeek], s.StartTime, s.EndTime))
+	}
+	return strings.Join(parts, ", ")
+}
+
+type ScheduleRepository interface {
+	CreateSession(ctx context.Context, session *TrainingSession) error
+	GetSession(ctx context.Context, clubID string, id uuid.UUID) (*TrainingSession, error)
+	DeleteSession(ctx context.Context, clubID string, id uuid.UUID) error
+	// ListSessions lists the sessions of the given groups, or of every group in the club when groupIDs is empty
+	ListSessions(ctx context.Context, clubID string, groupIDs []uuid.UUID) ([]TrainingSession, error)
+	// UpdateGroupSchedule keeps TrainingGroup.Schedule in sync with the structured sessions
+	UpdateGroupSchedule(ctx context.Context, clubID string, groupID uuid.UUID, schedule string) error
+}
+
+// SessionBookingService holds the facility of a session through the booking module.
+type SessionBookingService interface {
+	// ReserveSession creates a weekly CLASS rule for the session and returns its ID
+	ReserveSession(ctx context.Context, session *TrainingSession, coachID string) (uuid.UUID, error)
+	// ReleaseSession deletes the session's rule and cancels the future bookings it generated
+	ReleaseSession(ctx context.Context, clubID string, ruleID uuid.UUID) error
+}
@@ -0,0 +1,243 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+type EnrollmentHandler struct {
+	useCases *application.EnrollmentUseCases
+}
+
+func NewEnrollmentHandler(useCases *application.EnrollmentUseCases) *EnrollmentHandler {
+	return &EnrollmentHandler{useCases: useCases}
+}
+
+// canManageGroups: COACH, ADMIN or SUPER_ADMIN manage rosters and staff
+func canManageGroups(c *gin.Context) bool {
+	role, exists := c.Get("userRole")
+	return exists && (role == userDomain.RoleCoach || role == userDomain.RoleAdmin || role == userDomain.RoleSuperAdmin)
+}
+
+func parseGroupID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid group id"})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func enrollmentErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, application.ErrGroupNotFound), errors.Is(err, application.ErrEnrollmentNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, application.ErrAlreadyEnrolled):
+		return http.StatusConflict
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// POST /groups/:id/enrollments
+func (h *EnrollmentHandler) Enroll(c *gin.Context) {
+	if !canManageGroups(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires COACH or ADMIN role"})
+		return
+	}
+	groupID, ok := parseGroupID(c)
+	if !ok {
+		return
+	}
+
+	var dto application.EnrollMemberDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	enrollment, err := h.useCases.Enroll(c.Request.Context(), c.GetString("clubID"), groupID, dto, c.GetString("userID"))
+	if err != nil {
+		c.JSON(enrollmentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, enrollment)
+}
+
+// GET /groups/:id/enrollments?status=WAITLISTED
+func (h *EnrollmentHandler) ListEnrollments(c *gin.Context) {
+	groupID, ok := parseGroupID(c)
+	if !ok {
+		return
+	}
+	enrollments, err := h.useCases.ListEnrollments(c.Request.Context(), c.GetString("clubID"), groupID, c.Query("status"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, enrollments)
+}
+
+// POST /groups/:id/enrollments/:enrollmentId/withdraw
+func (h *EnrollmentHandler) Withdraw(c *gin.Context) {
+	if !canManageGroups(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires COACH or ADMIN role"})
+		return
+	}
+	groupID, ok := parseGroupID(c)
+	if !ok {
+		return
+	}
+	enrollmentID, err := uuid.Parse(c.Param("enrollmentId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid enrollment id"})
+		return
+	}
+
+	var req struct {
+		EndDate *time.Time `json:"end_date"`
+	}
+	_ = c.ShouldBindJSON(&req)
+
+	enrollment, err := h.useCases.Withdraw(c.Request.Context(), c.GetString("clubID"), groupID, enrollmentID, req.EndDate)
+	if err != nil {
+		c.JSON(enrollmentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, enrollment)
+}
+
+// GET /groups/:id/roster?date=YYYY-MM-DD
+func (h *EnrollmentHandler) GetRoster(c *gin.Context) {
+	groupID, ok := parseGroupID(c)
+	if !ok {
+		return
+	}
+	date := time.Now()
+	if dateStr := c.Query("date"); dateStr != "" {
+		parsed, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid date"})
+			return
+		}
+		date = parsed
+	}
+
+	users, err := h.useCases.ListRoster(c.Request.Context(), c.GetString("clubID"), groupID, date)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, users)
+}
+
+// PUT /groups/:id/settings
+func (h *EnrollmentHandler) UpdateSettings(c *gin.Context) {
+	// RBAC: fees affect billing, only ADMIN or SUPER_ADMIN
+	role, exists := c.Get("userRole")
+	if !exists || (role != userDomain.RoleAdmin && role != userDomain.RoleSuperAdmin) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+	groupID, ok := parseGroupID(c)
+	if !ok {
+		return
+	}
+
+	var dto application.GroupSettingsDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	group, err := h.useCases.UpdateGroupSettings(c.Request.Context(), c.GetString("clubID"), groupID, dto)
+	if err != nil {
+		c.JSON(enrollmentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, group)
+}
+
+// GET /groups/:id/staff
+func (h *EnrollmentHandler) ListStaff(c *gin.Context) {
+	groupID, ok := parseGroupID(c)
+	if !ok {
+		return
+	}
+	staff, err := h.useCases.ListStaff(c.Request.Context(), c.GetString("clubID"), groupID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, staff)
+}
+
+type AddStaffRequest struct {
+	UserID string           `json:"user_id" binding:"required"`
+	Role   domain.StaffRole `json:"role"` // HEAD_COACH, COACH (default) or ASSISTANT
+}
+
+// POST /groups/:id/staff
+func (h *EnrollmentHandler) AddStaff(c *gin.Context) {
+	if !canManageGroups(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires COACH or ADMIN role"})
+		return
+	}
+	groupID, ok := parseGroupID(c)
+	if !ok {
+		return
+	}
+
+	var req AddStaffRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	staff, err := h.useCases.AddStaff(c.Request.Context(), c.GetString("clubID"), groupID, req.UserID, req.Role)
+	if err != nil {
+		c.JSON(enrollmentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, staff)
+}
+
+// DELETE /groups/:id/staff/:userId
+func (h *EnrollmentHandler) RemoveStaff(c *gin.Context) {
+	if !canManageGroups(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires COACH or ADMIN role"})
+		return
+	}
+	groupID, ok := parseGroupID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.useCases.RemoveStaff(c.Request.Context(), c.GetString("clubID"), groupID, c.Param("userId")); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func RegisterEnrollmentRoutes(r *gin.RouterGroup, handler *EnrollmentHandler, authMiddleware, tenantMiddleware gin.HandlerFunc) {
+	groups := r.Group("/groups")
+	groups.Use(authMiddleware, tenantMiddleware)
+	{
+		groups.GET("/:id/enrollments", handler.ListEnrollments)
+		groups.POST("/:id/enrollments", handler.Enroll)
+		groups.POST("/:id/enrollments/:enrollmentId/withdraw", handler.Withdraw)
+		groups.GET("/:id/roster", handler.GetRoster)
+		groups.PUT("/:id/settings", handler.UpdateSettings)
+		groups.GET("/:id/staff", handler.ListStaff)
+		groups.POST("/:id/staff", handler.AddStaff)
+		groups.DELETE("/:id/staff/:userId", handler.RemoveStaff)
+	}
+}
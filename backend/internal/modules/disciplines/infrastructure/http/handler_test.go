@@ -131,7 +131,7 @@ func setupRouter(h *handler.DisciplineHandler, clubID, role string) *gin.Engine
 
 func TestDisciplineHandler_DisciplinesAndGroups(t *testing.T) {
 	mockRepo := new(MockDisciplineRepo)
-	uc := application.NewDisciplineUseCases(mockRepo, nil, nil)
+	uc := application.NewDisciplineUseCases(mockRepo, nil, nil, nil)
 	h := handler.NewDisciplineHandler(uc)
 	clubID := uuid.New().String()
 	r := setupRouter(h, clubID, userDomain.RoleAdmin)
@@ -157,7 +157,7 @@ func TestDisciplineHandler_DisciplinesAndGroups(t *testing.T) {
 
 func TestDisciplineHandler_Tournaments(t *testing.T) {
 	mockTourneyRepo := new(MockTournamentRepo)
-	uc := application.NewDisciplineUseCases(nil, mockTourneyRepo, nil)
+	uc := application.NewDisciplineUseCases(nil, mockTourneyRepo, nil, nil)
 	h := handler.NewDisciplineHandler(uc)
 	clubID := uuid.New().String()
 	r := setupRouter(h, clubID, userDomain.RoleAdmin)
@@ -249,7 +249,7 @@ func TestDisciplineHandler_Tournaments(t *testing.T) {
 func TestDisciplinesHandler_DetailedErrors(t *testing.T) {
 	mockDisciplineRepo := new(MockDisciplineRepo)
 	mockTourneyRepo := new(MockTournamentRepo)
-	uc := application.NewDisciplineUseCases(mockDisciplineRepo, mockTourneyRepo, nil)
+	uc := application.NewDisciplineUseCases(mockDisciplineRepo, mockTourneyRepo, nil, nil)
 	h := handler.NewDisciplineHandler(uc)
 	clubID := uuid.New().String()
 	r := setupRouter(h, clubID, userDomain.RoleAdmin)
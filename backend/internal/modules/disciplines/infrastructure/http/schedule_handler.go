@@ -0,0 +1,137 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/application"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+type ScheduleHandler struct {
+	useCases *application.ScheduleUseCases
+}
+
+func NewScheduleHandler(useCases *application.ScheduleUseCases) *ScheduleHandler {
+	return &ScheduleHandler{useCases: useCases}
+}
+
+// GET /groups/:id/sessions
+func (h *ScheduleHandler) ListSessions(c *gin.Context) {
+	groupID, ok := parseGroupID(c)
+	if !ok {
+		return
+	}
+	sessions, err := h.useCases.ListSessions(c.Request.Context(), c.GetString("clubID"), groupID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, sessions)
+}
+
+// POST /groups/:id/sessions
+func (h *ScheduleHandler) AddSession(c *gin.Context) {
+	// RBAC: sessions reserve facilities, only ADMIN or SUPER_ADMIN
+	role, exists := c.Get("userRole")
+	if !exists || (role != userDomain.RoleAdmin && role != userDomain.RoleSuperAdmin) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+	groupID, ok := parseGroupID(c)
+	if !ok {
+		return
+	}
+
+	var dto application.AddSessionDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	session, err := h.useCases.AddSession(c.Request.Context(), c.GetString("clubID"), groupID, dto)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, application.ErrGroupNotFound) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, session)
+}
+
+// DELETE /groups/:id/sessions/:sessionId
+func (h *ScheduleHandler) RemoveSession(c *gin.Context) {
+	role, exists := c.Get("userRole")
+	if !exists || (role != userDomain.RoleAdmin && role != userDomain.RoleSuperAdmin) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+		return
+	}
+	groupID, ok := parseGroupID(c)
+	if !ok {
+		return
+	}
+	sessionID, err := uuid.Parse(c.Param("sessionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid session id"})
+		return
+	}
+
+	if err := h.useCases.RemoveSession(c.Request.Context(), c.GetString("clubID"), groupID, sessionID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// GET /groups/agenda?date=YYYY-MM-DD&coach_id=
+// Returns the week (Monday to Sunday) containing date. Coaches see their own agenda;
+// admins may ask for another coach's.
+func (h *ScheduleHandler) GetWeeklyAgenda(c *gin.Context) {
+	if !canManageGroups(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires COACH or ADMIN role"})
+		return
+	}
+
+	coachID := c.GetString("userID")
+	if requested := c.Query("coach_id"); requested != "" && requested != coachID {
+		role, _ := c.Get("userRole")
+		if role != userDomain.RoleAdmin && role != userDomain.RoleSuperAdmin {
+			c.JSON(http.StatusForbidden, gin.H{"error": "requires ADMIN role"})
+			return
+		}
+		coachID = requested
+	}
+
+	day := time.Now()
+	if dateStr := c.Query("date"); dateStr != "" {
+		parsed, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid date"})
+			return
+		}
+		day = parsed
+	}
+
+	agenda, err := h.useCases.WeeklyAgenda(c.Request.Context(), c.GetString("clubID"), coachID, day)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, agenda)
+}
+
+func RegisterScheduleRoutes(r *gin.RouterGroup, handler *ScheduleHandler, authMiddleware, tenantMiddleware gin.HandlerFunc) {
+	groups := r.Group("/groups")
+	groups.Use(authMiddleware, tenantMiddleware)
+	{
+		groups.GET("/agenda", handler.GetWeeklyAgenda)
+		groups.GET("/:id/sessions", handler.ListSessions)
+		groups.POST("/:id/sessions", handler.AddSession)
+		groups.DELETE("/:id/sessions/:sessionId", handler.RemoveSession)
+	}
+}
@@ -52,7 +52,9 @@ func (r *PostgresDisciplineRepository) ListGroups(ctx context.Context, clubID st
 		query = query.Where("category = ?", category)
 	}
 	if coachID, ok := filter["coach_id"]; ok {
-		query = query.Where("coach_id = ?", coachID)
+		// Groups where the user is the main coach or part of the coaching staff
+		query = query.Where("coach_id = ? OR id IN (?)", coachID,
+			r.db.Model(&domain.TrainingGroupStaff{}).Select("group_id").Where("user_id = ?", coachID))
 	}
 	if year, ok := filter["category_year"]; ok {
 		query = query.Where("category_year = ?", year)
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PostgresEnrollmentRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresEnrollmentRepository(db *gorm.DB) *PostgresEnrollmentRepository {
+	return &PostgresEnrollmentRepository{db: db}
+}
+
+func (r *PostgresEnrollmentRepository) CreateEnrollment(ctx context.Context, enrollment *domain.GroupEnrollment) error {
+	return r.db.WithContext(ctx).Create(enrollment).Error
+}
+
+func (r *PostgresEnrollmentRepository) Enroll(ctx context.Context, enrollment *domain.GroupEnrollment) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Locking the group serializes sign-ups (and capacity changes) for the same group
+		var group domain.TrainingGroup
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("club_id = ? AND id = ?", enrollment.ClubID, enrollment.GroupID).
+			First(&group).Error; err != nil {
+			return err
+		}
+
+		var open int64
+		if err := tx.Model(&domain.GroupEnrollment{}).
+			Where("group_id = ? AND user_id = ?", enrollment.GroupID, enrollment.UserID).
+			Where("status IN ?", []domain.EnrollmentStatus{domain.EnrollmentActive, domain.EnrollmentWaitlisted}).
+			Count(&open).Error; err != nil {
+			return err
+		}
+		if open > 0 {
+			return domain.ErrAlreadyEnrolled
+		}
+
+		enrollment.Status = domain.EnrollmentActive
+		if group.Capacity > 0 {
+			var active int64
+			if err := tx.Model(&domain.GroupEnrollment{}).
+				Where("group_id = ? AND status = ?", enrollment.GroupID, domain.EnrollmentActive).
+				Count(&active).Error; err != nil {
+				return err
+			}
+			if active >= int64(group.Capacity) {
+				enrollment.Status = domain.EnrollmentWaitlisted
+			}
+		}
+		return tx.Create(enrollment).Error
+	})
+}
+
+func (r *PostgresEnrollmentRepository) UpdateEnrollment(ctx context.Context, enrollment *domain.GroupEnrollment) error {
+	return r.db.WithContext(ctx).Save(enrollment).Error
+}
+
+func (r *PostgresEnrollmentRepository) GetEnrollmentByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.GroupEnrollment, error) {
+	return r.first(r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id))
+}
+
+func (r *PostgresEnrollmentRepository) GetOpenEnrollment(ctx context.Context, clubID string, groupID uuid.UUID, userID string) (*domain.GroupEnrollment, error) {
+	return r.first(r.db.WithContext(ctx).
+		Where("club_id = ? AND group_id = ? AND user_id = ?", clubID, groupID, userID).
+		Where("status IN ?", []domain.EnrollmentStatus{domain.EnrollmentActive, domain.EnrollmentWaitlisted}))
+}
+
+func (r *PostgresEnrollmentRepository) ListEnrollments(ctx context.Context, clubID string, groupID uuid.UUID, status domain.EnrollmentStatus) ([]domain.GroupEnrollment, error) {
+	var enrollments []domain.GroupEnrollment
+	query := r.db.WithContext(ctx).Where("club_id = ? AND group_id = ?", clubID, groupID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("created_at ASC").Find(&enrollments).Error
+	return enrollments, err
+}
+
+func (r *PostgresEnrollmentRepository) CountActiveEnrollments(ctx context.Context, clubID string, groupID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.GroupEnrollment{}).
+		Where("club_id = ? AND group_id = ? AND status = ?", clubID, groupID, domain.EnrollmentActive).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *PostgresEnrollmentRepository) ListRosterUserIDs(ctx context.Context, clubID string, groupID uuid.UUID, date time.Time) ([]string, error) {
+	var userIDs []string
+	err := r.onRoster(r.db.WithContext(ctx).Model(&domain.GroupEnrollment{}), date).
+		Where("club_id = ? AND group_id = ?", clubID, groupID).
+		Distinct().
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
+func (r *PostgresEnrollmentRepository) UpdateGroupSettings(ctx context.Context, clubID string, groupID uuid.UUID, capacity int, monthlyFee decimal.Decimal) error {
+	return r.db.WithContext(ctx).Model(&domain.TrainingGroup{}).
+		Where("club_id = ? AND id = ?", clubID, groupID).
+		Updates(map[string]interface{}{
+			"capacity":    capacity,
+			"monthly_fee": monthlyFee,
+		}).Error
+}
+
+func (r *PostgresEnrollmentRepository) AddStaff(ctx context.Context, staff *domain.TrainingGroupStaff) error {
+	return r.db.WithContext(ctx).Create(staff).Error
+}
+
+func (r *PostgresEnrollmentRepository) RemoveStaff(ctx context.Context, clubID string, groupID uuid.UUID, userID string) error {
+	return r.db.WithContext(ctx).
+		Where("club_id = ? AND group_id = ? AND user_id = ?", clubID, groupID, userID).
+		Delete(&domain.TrainingGroupStaff{}).Error
+}
+
+func (r *PostgresEnrollmentRepository) ListStaff(ctx context.Context, clubID string, groupID uuid.UUID) ([]domain.TrainingGroupStaff, error) {
+	var staff []domain.TrainingGroupStaff
+	err := r.db.WithContext(ctx).
+		Where("club_id = ? AND group_id = ?", clubID, groupID).
+		Order("created_at ASC").
+		Find(&staff).Error
+	return staff, err
+}
+
+func (r *PostgresEnrollmentRepository) MonthlyFees(ctx context.Context, clubID string, userIDs []uuid.UUID, date time.Time) (map[uuid.UUID]decimal.Decimal, error) {
+	fees := make(map[uuid.UUID]decimal.Decimal)
+	if len(userIDs) == 0 {
+		return fees, nil
+	}
+	ids := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = id.String()
+	}
+
+	var rows []struct {
+		UserID     string
+		MonthlyFee decimal.Decimal
+	}
+	query := r.db.WithContext(ctx).Model(&domain.GroupEnrollment{}).
+		Select("training_group_enrollments.user_id, training_groups.monthly_fee").
+		Joins("JOIN training_groups ON training_groups.id = training_group_enrollments.group_id AND training_groups.deleted_at IS NULL").
+		Where("training_group_enrollments.club_id = ? AND training_group_enrollments.user_id IN ?", clubID, ids).
+		Where("training_groups.monthly_fee > 0")
+	if err := r.onRoster(query, date).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		uid, err := uuid.Parse(row.UserID)
+		if err != nil {
+			continue
+		}
+		fees[uid] = fees[uid].Add(row.MonthlyFee)
+	}
+	return fees, nil
+}
+
+// onRoster keeps the enrollments covering the given date. Withdrawn enrollments still count
+// until their end date so that lists for past sessions can be rebuilt.
+func (r *PostgresEnrollmentRepository) onRoster(query *gorm.DB, date time.Time) *gorm.DB {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return query.
+		Where("training_group_enrollments.status IN ?", []domain.EnrollmentStatus{domain.EnrollmentActive, domain.EnrollmentWithdrawn}).
+		Where("training_group_enrollments.start_date <= ?", day).
+		Where("training_group_enrollments.end_date IS NULL OR training_group_enrollments.end_date >= ?", day)
+}
+
+func (r *PostgresEnrollmentRepository) first(query *gorm.DB) (*domain.GroupEnrollment, error) {
+	var enrollment domain.GroupEnrollment
+	if err := query.First(&enrollment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &enrollment, nil
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	"gorm.io/gorm"
+)
+
+type PostgresScheduleRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresScheduleRepository(db *gorm.DB) *PostgresScheduleRepository {
+	return &PostgresScheduleRepository{db: db}
+}
+
+func (r *PostgresScheduleRepository) CreateSession(ctx context.Context, session *domain.TrainingSession) error {
+	return r.db.WithContext(ctx).Create(session).Error
+}
+
+func (r *PostgresScheduleRepository) GetSession(ctx context.Context, clubID string, id uuid.UUID) (*domain.TrainingSession, error) {
+	var session domain.TrainingSession
+	if err := r.db.WithContext(ctx).First(&session, "id = ? AND club_id = ?", id, clubID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (r *PostgresScheduleRepository) DeleteSession(ctx context.Context, clubID string, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&domain.TrainingSession{}, "id = ? AND club_id = ?", id, clubID).Error
+}
+
+func (r *PostgresScheduleRepository) ListSessions(ctx context.Context, clubID string, groupIDs []uuid.UUID) ([]domain.TrainingSession, error) {
+	var sessions []domain.TrainingSession
+	query := r.db.WithContext(ctx).Where("club_id = ?", clubID)
+	if len(groupIDs) > 0 {
+		query = query.Where("group_id IN ?", groupIDs)
+	}
+	err := query.Order("day_of_week ASC, start_time ASC").Find(&sessions).Error
+	return sessions, err
+}
+
+func (r *PostgresScheduleRepository) UpdateGroupSchedule(ctx context.Context, clubID string, groupID uuid.UUID, schedule string) error {
+	return r.db.WithContext(ctx).Model(&domain.TrainingGroup{}).
+		Where("club_id = ? AND id = ?", clubID, groupID).
+		Update("schedule", schedule).Error
+}
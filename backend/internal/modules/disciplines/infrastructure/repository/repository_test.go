@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/infrastructure/repository"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -37,6 +38,8 @@ type TestGroup struct {
 	CategoryYear int
 	CoachID      string
 	Schedule     string
+	Capacity     int
+	MonthlyFee   decimal.Decimal `gorm:"type:decimal(10,2);default:0"`
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	DeletedAt    gorm.DeletedAt `gorm:"index"`
@@ -44,6 +47,33 @@ type TestGroup struct {
 
 func (TestGroup) TableName() string { return "training_groups" }
 
+type TestEnrollment struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key"`
+	ClubID    string    `gorm:"index;not null"`
+	GroupID   uuid.UUID `gorm:"type:uuid;not null"`
+	UserID    string    `gorm:"not null"`
+	Status    string    `gorm:"not null"`
+	StartDate time.Time `gorm:"not null"`
+	EndDate   *time.Time
+	Notes     string
+	CreatedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (TestEnrollment) TableName() string { return "training_group_enrollments" }
+
+type TestStaff struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key"`
+	ClubID    string    `gorm:"index;not null"`
+	GroupID   uuid.UUID `gorm:"type:uuid;not null"`
+	UserID    string    `gorm:"not null"`
+	Role      string    `gorm:"not null"`
+	CreatedAt time.Time
+}
+
+func (TestStaff) TableName() string { return "training_group_staff" }
+
 type TestTournament struct {
 	ID           uuid.UUID `gorm:"type:uuid;primary_key"`
 	ClubID       string    `gorm:"index;not null"`
@@ -103,6 +133,8 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	err = db.AutoMigrate(
 		&TestDiscipline{},
 		&TestGroup{},
+		&TestEnrollment{},
+		&TestStaff{},
 		&TestTournament{},
 		&TestTeam{},
 		&TestMatch{},
@@ -191,6 +223,106 @@ func TestPostgresDisciplineRepository(t *testing.T) {
 	})
 }
 
+func TestPostgresEnrollmentRepository(t *testing.T) {
+	db := setupTestDB(t)
+	groups := repository.NewPostgresDisciplineRepository(db)
+	repo := repository.NewPostgresEnrollmentRepository(db)
+	ctx := context.Background()
+	clubID := "club-rep-3"
+
+	group := &domain.TrainingGroup{ID: uuid.New(), ClubID: clubID, Name: "Sub-15", DisciplineID: uuid.New(), Category: "2011", CoachID: "coach-1"}
+	assert.NoError(t, groups.CreateGroup(ctx, group))
+	assert.NoError(t, repo.UpdateGroupSettings(ctx, clubID, group.ID, 2, decimal.NewFromInt(40)))
+
+	day := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	end := day("2026-03-31")
+	userA, userB, userC := uuid.New(), uuid.New(), uuid.New()
+	enrollments := []*domain.GroupEnrollment{
+		{ID: uuid.New(), ClubID: clubID, GroupID: group.ID, UserID: userA.String(), Status: domain.EnrollmentActive, StartDate: day("2026-03-01")},
+		{ID: uuid.New(), ClubID: clubID, GroupID: group.ID, UserID: userB.String(), Status: domain.EnrollmentWithdrawn, StartDate: day("2026-03-01"), EndDate: &end},
+		{ID: uuid.New(), ClubID: clubID, GroupID: group.ID, UserID: userC.String(), Status: domain.EnrollmentWaitlisted, StartDate: day("2026-03-01")},
+	}
+	for _, e := range enrollments {
+		assert.NoError(t, repo.CreateEnrollment(ctx, e))
+	}
+
+	t.Run("Roster By Date", func(t *testing.T) {
+		roster, err := repo.ListRosterUserIDs(ctx, clubID, group.ID, day("2026-03-31"))
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{userA.String(), userB.String()}, roster)
+
+		roster, err = repo.ListRosterUserIDs(ctx, clubID, group.ID, day("2026-04-01"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{userA.String()}, roster)
+
+		roster, err = repo.ListRosterUserIDs(ctx, clubID, group.ID, day("2026-02-28"))
+		assert.NoError(t, err)
+		assert.Empty(t, roster)
+	})
+
+	t.Run("Open Enrollment And Counts", func(t *testing.T) {
+		open, err := repo.GetOpenEnrollment(ctx, clubID, group.ID, userC.String())
+		assert.NoError(t, err)
+		assert.Equal(t, domain.EnrollmentWaitlisted, open.Status)
+
+		open, err = repo.GetOpenEnrollment(ctx, clubID, group.ID, userB.String())
+		assert.NoError(t, err)
+		assert.Nil(t, open)
+
+		count, err := repo.CountActiveEnrollments(ctx, clubID, group.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		waitlist, err := repo.ListEnrollments(ctx, clubID, group.ID, domain.EnrollmentWaitlisted)
+		assert.NoError(t, err)
+		assert.Len(t, waitlist, 1)
+	})
+
+	t.Run("Monthly Fees", func(t *testing.T) {
+		fees, err := repo.MonthlyFees(ctx, clubID, []uuid.UUID{userA, userB, userC}, day("2026-04-01"))
+		assert.NoError(t, err)
+		assert.Len(t, fees, 1)
+		assert.True(t, fees[userA].Equal(decimal.NewFromInt(40)))
+	})
+
+	t.Run("Enroll Respects Capacity", func(t *testing.T) {
+		userD, userE := uuid.New().String(), uuid.New().String()
+		newEnrollment := func(userID string) *domain.GroupEnrollment {
+			return &domain.GroupEnrollment{ID: uuid.New(), ClubID: clubID, GroupID: group.ID, UserID: userID, StartDate: day("2026-04-01")}
+		}
+
+		first := newEnrollment(userD)
+		assert.NoError(t, repo.Enroll(ctx, first))
+		assert.Equal(t, domain.EnrollmentActive, first.Status)
+
+		second := newEnrollment(userE)
+		assert.NoError(t, repo.Enroll(ctx, second))
+		assert.Equal(t, domain.EnrollmentWaitlisted, second.Status)
+
+		assert.ErrorIs(t, repo.Enroll(ctx, newEnrollment(userD)), domain.ErrAlreadyEnrolled)
+	})
+
+	t.Run("Staff", func(t *testing.T) {
+		assert.NoError(t, repo.AddStaff(ctx, &domain.TrainingGroupStaff{ID: uuid.New(), ClubID: clubID, GroupID: group.ID, UserID: "assistant-1", Role: domain.StaffAssistant}))
+
+		staff, err := repo.ListStaff(ctx, clubID, group.ID)
+		assert.NoError(t, err)
+		assert.Len(t, staff, 1)
+
+		// Staff members see the group when filtering by coach
+		list, err := groups.ListGroups(ctx, clubID, map[string]interface{}{"coach_id": "assistant-1"})
+		assert.NoError(t, err)
+		assert.Len(t, list, 1)
+
+		assert.NoError(t, repo.RemoveStaff(ctx, clubID, group.ID, "assistant-1"))
+		staff, _ = repo.ListStaff(ctx, clubID, group.ID)
+		assert.Empty(t, staff)
+	})
+}
+
 func TestPostgresTournamentRepository(t *testing.T) {
 	db := setupTestDB(t)
 	repo := repository.NewPostgresTournamentRepository(db)
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	attendanceDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+)
+
+// AttendanceGroupDirectory implementa attendanceDomain.TrainingGroupDirectory sobre los grupos
+// de entrenamiento, para que las analíticas de asistencia no dependan de este módulo.
+type AttendanceGroupDirectory struct {
+	repo domain.DisciplineRepository
+}
+
+func NewAttendanceGroupDirectory(repo domain.DisciplineRepository) *AttendanceGroupDirectory {
+	return &AttendanceGroupDirectory{repo: repo}
+}
+
+func (d *AttendanceGroupDirectory) GetTrainingGroup(ctx context.Context, clubID string, groupID uuid.UUID) (*attendanceDomain.TrainingGroupRef, error) {
+	group, err := d.repo.GetGroupByID(ctx, clubID, groupID)
+	if err != nil || group == nil {
+		return nil, err
+	}
+	ref := toGroupRef(*group)
+	return &ref, nil
+}
+
+func (d *AttendanceGroupDirectory) ListDisciplineGroups(ctx context.Context, clubID string, disciplineID uuid.UUID) ([]attendanceDomain.TrainingGroupRef, error) {
+	groups, err := d.repo.ListGroups(ctx, clubID, map[string]interface{}{"discipline_id": disciplineID})
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]attendanceDomain.TrainingGroupRef, len(groups))
+	for i, g := range groups {
+		refs[i] = toGroupRef(g)
+	}
+	return refs, nil
+}
+
+func toGroupRef(g domain.TrainingGroup) attendanceDomain.TrainingGroupRef {
+	return attendanceDomain.TrainingGroupRef{ID: g.ID, Name: g.Name, CoachID: g.CoachID}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	bookingApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/booking/application"
+	bookingDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/booking/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+)
+
+// ClassRuleService es el subconjunto de los casos de uso de reservas que usan los horarios de entrenamiento
+type ClassRuleService interface {
+	CreateClassRule(ctx context.Context, clubID string, dto bookingApp.ClassRuleDTO) (*bookingDomain.RecurringRule, error)
+	DeleteClassRule(ctx context.Context, clubID string, id uuid.UUID) error
+}
+
+// ClassBookingAdapter implementa domain.SessionBookingService: cada sesión con cancha
+// se convierte en una regla recurrente semanal de tipo CLASS.
+type ClassBookingAdapter struct {
+	bookings ClassRuleService
+}
+
+func NewClassBookingAdapter(bookings ClassRuleService) *ClassBookingAdapter {
+	return &ClassBookingAdapter{bookings: bookings}
+}
+
+// ReserveSession crea la regla CLASS de la sesión y devuelve su ID
+func (a *ClassBookingAdapter) ReserveSession(ctx context.Context, session *domain.TrainingSession, coachID string) (uuid.UUID, error) {
+	start, err := domain.ParseClock(session.StartTime)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	end, err := domain.ParseClock(session.EndTime)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	dto := bookingApp.ClassRuleDTO{
+		FacilityID: *session.FacilityID,
+		GroupID:    session.GroupID,
+		DayOfWeek:  session.DayOfWeek,
+		StartTime:  start,
+		EndTime:    end,
+		StartDate:  session.ValidFrom,
+		EndDate:    session.ValidUntil,
+	}
+	if ownerID, err := uuid.Parse(coachID); err == nil {
+		dto.OwnerID = &ownerID
+	}
+
+	rule, err := a.bookings.CreateClassRule(ctx, session.ClubID, dto)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return rule.ID, nil
+}
+
+// ReleaseSession elimina la regla de la sesión y cancela las reservas futuras que ya había generado
+func (a *ClassBookingAdapter) ReleaseSession(ctx context.Context, clubID string, ruleID uuid.UUID) error {
+	return a.bookings.DeleteClassRule(ctx, clubID, ruleID)
+}
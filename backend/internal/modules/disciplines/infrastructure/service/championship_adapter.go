@@ -0,0 +1,351 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/google/uuid"
+	championshipApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	championshipDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	"gorm.io/gorm"
+)
+
+// ChampionshipService es el subconjunto de los casos de uso de campeonatos que usa la capa de compatibilidad
+type ChampionshipService interface {
+	CreateTournament(ctx context.Context, input championshipApp.CreateTournamentInput) (*championshipDomain.Tournament, error)
+	GetTournament(ctx context.Context, clubID, id string) (*championshipDomain.Tournament, error)
+	ListTournaments(ctx context.Context, clubID string) ([]championshipDomain.Tournament, error)
+	CreateTeam(ctx context.Context, input championshipApp.CreateTeamInput) (*championshipDomain.Team, error)
+	AddMember(ctx context.Context, clubID, teamID, userID string) error
+	GetTeamMembers(ctx context.Context, teamID string) ([]string, error)
+	GetTeamsMembers(ctx context.Context, teamIDs []string) (map[string][]string, error)
+	GetTeamStandings(ctx context.Context, clubID, teamID string) ([]championshipDomain.Standing, error)
+	RegisterTeam(ctx context.Context, clubID, groupID string, input championshipApp.RegisterTeamInput) (*championshipDomain.Standing, error)
+	AddMatch(ctx context.Context, input championshipApp.AddMatchInput) (*championshipDomain.TournamentMatch, error)
+	GetMatch(ctx context.Context, clubID, id string) (*championshipDomain.TournamentMatch, error)
+	GetMatchesByGroup(ctx context.Context, clubID, groupID string) ([]championshipDomain.TournamentMatch, error)
+	UpdateMatchResult(ctx context.Context, input championshipApp.UpdateMatchResultInput) error
+	GetStandings(ctx context.Context, clubID, groupID string) ([]championshipDomain.Standing, error)
+}
+
+// ChampionshipTournamentAdapter implementa domain.TournamentRepository sobre el módulo de campeonatos,
+// de modo que los endpoints /tournaments de disciplinas sigan funcionando con un único modelo de torneo.
+// Cada torneo de disciplinas es un campeonato con una sola fase (Liga o Llave) y un solo grupo.
+type ChampionshipTournamentAdapter struct {
+	championships  ChampionshipService
+	disciplineRepo domain.DisciplineRepository
+}
+
+func NewChampionshipTournamentAdapter(championships ChampionshipService, disciplineRepo domain.DisciplineRepository) *ChampionshipTournamentAdapter {
+	return &ChampionshipTournamentAdapter{
+		championships:  championships,
+		disciplineRepo: disciplineRepo,
+	}
+}
+
+func (a *ChampionshipTournamentAdapter) CreateTournament(ctx context.Context, tournament *domain.Tournament) error {
+	if _, err := uuid.Parse(tournament.ClubID); err != nil {
+		return errors.New("invalid club ID")
+	}
+
+	sport := "GENERAL"
+	if discipline, err := a.disciplineRepo.GetDisciplineByID(ctx, tournament.ClubID, tournament.DisciplineID); err != nil {
+		return err
+	} else if discipline != nil {
+		sport = discipline.Name
+	}
+
+	// La fase y el grupo únicos se crean en la misma transacción que el torneo
+	stageName, stageType := StageForFormat(tournament.Format)
+	input := championshipApp.CreateTournamentInput{
+		ClubID:    tournament.ClubID,
+		Name:      tournament.Name,
+		Sport:     sport,
+		StartDate: tournament.StartDate,
+		Settings:  BuildLegacySettings(tournament.DisciplineID, tournament.Format),
+		Stages: []championshipApp.InitialStageInput{
+			{Name: stageName, Type: string(stageType), Groups: []string{PrimaryGroupName}},
+		},
+	}
+	if !tournament.EndDate.IsZero() {
+		endDate := tournament.EndDate
+		input.EndDate = &endDate
+	}
+
+	created, err := a.championships.CreateTournament(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	tournament.ID = created.ID
+	tournament.Status = FromChampionshipTournamentStatus(created.Status)
+	return nil
+}
+
+func (a *ChampionshipTournamentAdapter) GetTournamentByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.Tournament, error) {
+	champ, err := a.getChampionship(ctx, clubID, id)
+	if err != nil || champ == nil {
+		return nil, err
+	}
+
+	tournament := FromChampionshipTournament(champ)
+	if tournament.Teams, err = a.ListTeams(ctx, clubID, id); err != nil {
+		return nil, err
+	}
+	if tournament.Matches, err = a.ListMatches(ctx, clubID, id); err != nil {
+		return nil, err
+	}
+	return &tournament, nil
+}
+
+func (a *ChampionshipTournamentAdapter) ListTournaments(ctx context.Context, clubID string) ([]domain.Tournament, error) {
+	champs, err := a.championships.ListTournaments(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	tournaments := make([]domain.Tournament, 0, len(champs))
+	for i := range champs {
+		tournaments = append(tournaments, FromChampionshipTournament(&champs[i]))
+	}
+	return tournaments, nil
+}
+
+func (a *ChampionshipTournamentAdapter) UpdateTournament(ctx context.Context, tournament *domain.Tournament) error {
+	return errors.New("la edición de torneos se realiza desde el módulo de campeonatos")
+}
+
+func (a *ChampionshipTournamentAdapter) CreateTeam(ctx context.Context, team *domain.Team) error {
+	members := team.Members
+	if team.CaptainID != nil && *team.CaptainID != "" && !containsString(members, *team.CaptainID) {
+		members = append(members, *team.CaptainID)
+	}
+	if len(members) == 0 {
+		return errors.New("el equipo debe tener al menos un jugador")
+	}
+
+	_, group, err := a.primaryGroup(ctx, team.ClubID, team.TournamentID)
+	if err != nil {
+		return err
+	}
+
+	created, err := a.championships.CreateTeam(ctx, championshipApp.CreateTeamInput{
+		ClubID:    team.ClubID,
+		Name:      team.Name,
+		CaptainID: team.CaptainID,
+	})
+	if err != nil {
+		return err
+	}
+	for _, userID := range members {
+		if err := a.championships.AddMember(ctx, team.ClubID, created.ID.String(), userID); err != nil {
+			return err
+		}
+	}
+	if _, err := a.championships.RegisterTeam(ctx, team.ClubID, group.ID.String(), championshipApp.RegisterTeamInput{
+		TeamID: created.ID.String(),
+	}); err != nil {
+		return err
+	}
+
+	team.ID = created.ID
+	team.Members = members
+	return nil
+}
+
+// GetTeamByID busca la inscripción del equipo y resuelve su torneo por el grupo único, sin recorrer todos los torneos
+func (a *ChampionshipTournamentAdapter) GetTeamByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.Team, error) {
+	standings, err := a.championships.GetTeamStandings(ctx, clubID, id.String())
+	if err != nil || len(standings) == 0 {
+		return nil, err
+	}
+	tournaments, err := a.championships.ListTournaments(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tournaments {
+		_, group := PrimaryGroup(&t)
+		if group == nil {
+			continue
+		}
+		for _, s := range standings {
+			if s.GroupID != group.ID {
+				continue
+			}
+			members, err := a.championships.GetTeamMembers(ctx, id.String())
+			if err != nil {
+				return nil, err
+			}
+			return &domain.Team{
+				ID:           id,
+				ClubID:       clubID,
+				Name:         s.TeamName,
+				TournamentID: t.ID,
+				Members:      members,
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (a *ChampionshipTournamentAdapter) ListTeams(ctx context.Context, clubID string, tournamentID uuid.UUID) ([]domain.Team, error) {
+	_, group, err := a.primaryGroup(ctx, clubID, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	standings, err := a.championships.GetStandings(ctx, clubID, group.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	teamIDs := make([]string, 0, len(standings))
+	for _, s := range standings {
+		teamIDs = append(teamIDs, s.TeamID.String())
+	}
+	members, err := a.championships.GetTeamsMembers(ctx, teamIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]domain.Team, 0, len(standings))
+	for _, s := range standings {
+		teams = append(teams, domain.Team{
+			ID:           s.TeamID,
+			ClubID:       clubID,
+			Name:         s.TeamName,
+			TournamentID: tournamentID,
+			Members:      members[s.TeamID.String()],
+		})
+	}
+	return teams, nil
+}
+
+func (a *ChampionshipTournamentAdapter) CreateMatch(ctx context.Context, match *domain.Match) error {
+	stage, group, err := a.primaryGroup(ctx, match.ClubID, match.TournamentID)
+	if err != nil {
+		return err
+	}
+	groupID := group.ID.String()
+
+	created, err := a.championships.AddMatch(ctx, championshipApp.AddMatchInput{
+		ClubID:       match.ClubID,
+		TournamentID: match.TournamentID.String(),
+		StageID:      stage.ID.String(),
+		GroupID:      &groupID,
+		HomeTeamID:   match.HomeTeamID.String(),
+		AwayTeamID:   match.AwayTeamID.String(),
+		Date:         match.StartTime,
+		Round:        match.Round,
+		Location:     match.Location,
+	})
+	if err != nil {
+		return err
+	}
+
+	match.ID = created.ID
+	return nil
+}
+
+// UpdateMatch solo admite la carga de resultados: la tabla se recalcula en el módulo de campeonatos
+func (a *ChampionshipTournamentAdapter) UpdateMatch(ctx context.Context, match *domain.Match) error {
+	switch match.Status {
+	case domain.MatchStatusPlayed:
+		return a.championships.UpdateMatchResult(ctx, championshipApp.UpdateMatchResultInput{
+			ClubID:    match.ClubID,
+			MatchID:   match.ID.String(),
+			HomeScore: float64(match.ScoreHome),
+			AwayScore: float64(match.ScoreAway),
+		})
+	case domain.MatchStatusScheduled:
+		return nil
+	default:
+		return errors.New("estado de partido no soportado")
+	}
+}
+
+func (a *ChampionshipTournamentAdapter) GetMatchByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.Match, error) {
+	champMatch, err := a.championships.GetMatch(ctx, clubID, id.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	match := FromChampionshipMatch(clubID, champMatch)
+	return &match, nil
+}
+
+func (a *ChampionshipTournamentAdapter) ListMatches(ctx context.Context, clubID string, tournamentID uuid.UUID) ([]domain.Match, error) {
+	_, group, err := a.primaryGroup(ctx, clubID, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	champMatches, err := a.championships.GetMatchesByGroup(ctx, clubID, group.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]domain.Match, 0, len(champMatches))
+	for i := range champMatches {
+		matches = append(matches, FromChampionshipMatch(clubID, &champMatches[i]))
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].StartTime.Before(matches[j].StartTime)
+	})
+	return matches, nil
+}
+
+func (a *ChampionshipTournamentAdapter) GetStandings(ctx context.Context, clubID string, tournamentID uuid.UUID) ([]domain.Standing, error) {
+	_, group, err := a.primaryGroup(ctx, clubID, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	champStandings, err := a.championships.GetStandings(ctx, clubID, group.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]domain.Standing, 0, len(champStandings))
+	for i := range champStandings {
+		standings = append(standings, FromChampionshipStanding(tournamentID, &champStandings[i]))
+	}
+	return standings, nil
+}
+
+func (a *ChampionshipTournamentAdapter) getChampionship(ctx context.Context, clubID string, id uuid.UUID) (*championshipDomain.Tournament, error) {
+	champ, err := a.championships.GetTournament(ctx, clubID, id.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return champ, nil
+}
+
+// primaryGroup resuelve la fase y el grupo únicos donde viven los equipos y partidos del torneo
+func (a *ChampionshipTournamentAdapter) primaryGroup(ctx context.Context, clubID string, tournamentID uuid.UUID) (*championshipDomain.TournamentStage, *championshipDomain.Group, error) {
+	champ, err := a.getChampionship(ctx, clubID, tournamentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if champ == nil {
+		return nil, nil, errors.New("tournament not found")
+	}
+	stage, group := PrimaryGroup(champ)
+	if group == nil {
+		return nil, nil, errors.New("el torneo no tiene fases configuradas")
+	}
+	return stage, group, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
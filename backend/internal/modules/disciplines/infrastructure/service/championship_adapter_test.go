@@ -0,0 +1,269 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	championshipApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/application"
+	championshipDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/infrastructure/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockChampionshipService struct {
+	mock.Mock
+}
+
+func (m *MockChampionshipService) CreateTournament(ctx context.Context, input championshipApp.CreateTournamentInput) (*championshipDomain.Tournament, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*championshipDomain.Tournament), args.Error(1)
+}
+func (m *MockChampionshipService) GetTournament(ctx context.Context, clubID, id string) (*championshipDomain.Tournament, error) {
+	args := m.Called(ctx, clubID, id)
+	return args.Get(0).(*championshipDomain.Tournament), args.Error(1)
+}
+func (m *MockChampionshipService) ListTournaments(ctx context.Context, clubID string) ([]championshipDomain.Tournament, error) {
+	args := m.Called(ctx, clubID)
+	return args.Get(0).([]championshipDomain.Tournament), args.Error(1)
+}
+func (m *MockChampionshipService) CreateTeam(ctx context.Context, input championshipApp.CreateTeamInput) (*championshipDomain.Team, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*championshipDomain.Team), args.Error(1)
+}
+func (m *MockChampionshipService) AddMember(ctx context.Context, clubID, teamID, userID string) error {
+	return m.Called(ctx, clubID, teamID, userID).Error(0)
+}
+func (m *MockChampionshipService) GetTeamMembers(ctx context.Context, teamID string) ([]string, error) {
+	args := m.Called(ctx, teamID)
+	return args.Get(0).([]string), args.Error(1)
+}
+func (m *MockChampionshipService) GetTeamsMembers(ctx context.Context, teamIDs []string) (map[string][]string, error) {
+	args := m.Called(ctx, teamIDs)
+	return args.Get(0).(map[string][]string), args.Error(1)
+}
+func (m *MockChampionshipService) GetTeamStandings(ctx context.Context, clubID, teamID string) ([]championshipDomain.Standing, error) {
+	args := m.Called(ctx, clubID, teamID)
+	return args.Get(0).([]championshipDomain.Standing), args.Error(1)
+}
+func (m *MockChampionshipService) RegisterTeam(ctx context.Context, clubID, groupID string, input championshipApp.RegisterTeamInput) (*championshipDomain.Standing, error) {
+	args := m.Called(ctx, clubID, groupID, input)
+	return args.Get(0).(*championshipDomain.Standing), args.Error(1)
+}
+func (m *MockChampionshipService) AddMatch(ctx context.Context, input championshipApp.AddMatchInput) (*championshipDomain.TournamentMatch, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*championshipDomain.TournamentMatch), args.Error(1)
+}
+func (m *MockChampionshipService) GetMatch(ctx context.Context, clubID, id string) (*championshipDomain.TournamentMatch, error) {
+	args := m.Called(ctx, clubID, id)
+	return args.Get(0).(*championshipDomain.TournamentMatch), args.Error(1)
+}
+func (m *MockChampionshipService) GetMatchesByGroup(ctx context.Context, clubID, groupID string) ([]championshipDomain.TournamentMatch, error) {
+	args := m.Called(ctx, clubID, groupID)
+	return args.Get(0).([]championshipDomain.TournamentMatch), args.Error(1)
+}
+func (m *MockChampionshipService) UpdateMatchResult(ctx context.Context, input championshipApp.UpdateMatchResultInput) error {
+	return m.Called(ctx, input).Error(0)
+}
+func (m *MockChampionshipService) GetStandings(ctx context.Context, clubID, groupID string) ([]championshipDomain.Standing, error) {
+	args := m.Called(ctx, clubID, groupID)
+	return args.Get(0).([]championshipDomain.Standing), args.Error(1)
+}
+
+type MockDisciplineRepo struct {
+	mock.Mock
+}
+
+func (m *MockDisciplineRepo) CreateDiscipline(ctx context.Context, d *domain.Discipline) error {
+	return nil
+}
+func (m *MockDisciplineRepo) ListDisciplines(ctx context.Context, clubID string) ([]domain.Discipline, error) {
+	return nil, nil
+}
+func (m *MockDisciplineRepo) GetDisciplineByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.Discipline, error) {
+	args := m.Called(ctx, clubID, id)
+	return args.Get(0).(*domain.Discipline), args.Error(1)
+}
+func (m *MockDisciplineRepo) CreateGroup(ctx context.Context, g *domain.TrainingGroup) error {
+	return nil
+}
+func (m *MockDisciplineRepo) ListGroups(ctx context.Context, clubID string, filter map[string]interface{}) ([]domain.TrainingGroup, error) {
+	return nil, nil
+}
+func (m *MockDisciplineRepo) GetGroupByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.TrainingGroup, error) {
+	return nil, nil
+}
+
+// legacyChampionship arma un campeonato con la fase y el grupo únicos que crea el adapter
+func legacyChampionship(clubID uuid.UUID, format string) (*championshipDomain.Tournament, uuid.UUID, uuid.UUID) {
+	stageID, groupID := uuid.New(), uuid.New()
+	return &championshipDomain.Tournament{
+		ID:       uuid.New(),
+		ClubID:   clubID,
+		Name:     "Apertura",
+		Status:   championshipDomain.TournamentDraft,
+		Settings: service.BuildLegacySettings(uuid.New(), format),
+		Stages: []championshipDomain.TournamentStage{
+			{ID: stageID, Order: 1, Groups: []championshipDomain.Group{{ID: groupID, StageID: stageID}}},
+		},
+	}, stageID, groupID
+}
+
+func TestChampionshipTournamentAdapter_CreateTournament(t *testing.T) {
+	ctx := context.TODO()
+	clubID := uuid.New()
+	disciplineID := uuid.New()
+	champs := new(MockChampionshipService)
+	dRepo := new(MockDisciplineRepo)
+	adapter := service.NewChampionshipTournamentAdapter(champs, dRepo)
+
+	created := &championshipDomain.Tournament{ID: uuid.New(), ClubID: clubID, Status: championshipDomain.TournamentDraft}
+
+	dRepo.On("GetDisciplineByID", ctx, clubID.String(), disciplineID).Return(&domain.Discipline{Name: "Fútbol"}, nil)
+	champs.On("CreateTournament", ctx, mock.MatchedBy(func(in championshipApp.CreateTournamentInput) bool {
+		ls := service.ParseLegacySettings(in.Settings)
+		return in.Sport == "Fútbol" && ls.DisciplineID == disciplineID && ls.Format == service.FormatBracket && in.EndDate != nil &&
+			len(in.Stages) == 1 && in.Stages[0].Type == string(championshipDomain.StageKnockout) &&
+			len(in.Stages[0].Groups) == 1 && in.Stages[0].Groups[0] == service.PrimaryGroupName
+	})).Return(created, nil)
+
+	tournament := &domain.Tournament{
+		ID:           uuid.New(),
+		ClubID:       clubID.String(),
+		Name:         "Copa",
+		DisciplineID: disciplineID,
+		StartDate:    time.Now(),
+		EndDate:      time.Now().AddDate(0, 1, 0),
+		Format:       service.FormatBracket,
+	}
+	err := adapter.CreateTournament(ctx, tournament)
+
+	assert.NoError(t, err)
+	assert.Equal(t, created.ID, tournament.ID)
+	assert.Equal(t, domain.TournamentStatusOpen, tournament.Status)
+	champs.AssertExpectations(t)
+}
+
+func TestChampionshipTournamentAdapter_CreateTeam(t *testing.T) {
+	ctx := context.TODO()
+	clubID := uuid.New()
+	champ, _, groupID := legacyChampionship(clubID, service.FormatLeague)
+
+	t.Run("Registers captain and members in the primary group", func(t *testing.T) {
+		champs := new(MockChampionshipService)
+		adapter := service.NewChampionshipTournamentAdapter(champs, nil)
+		captain := "captain"
+		teamID := uuid.New()
+
+		champs.On("GetTournament", ctx, clubID.String(), champ.ID.String()).Return(champ, nil)
+		champs.On("CreateTeam", ctx, mock.Anything).Return(&championshipDomain.Team{ID: teamID}, nil)
+		champs.On("AddMember", ctx, clubID.String(), teamID.String(), "player").Return(nil).Once()
+		champs.On("AddMember", ctx, clubID.String(), teamID.String(), captain).Return(nil).Once()
+		champs.On("RegisterTeam", ctx, clubID.String(), groupID.String(), championshipApp.RegisterTeamInput{TeamID: teamID.String()}).
+			Return(&championshipDomain.Standing{}, nil)
+
+		team := &domain.Team{ClubID: clubID.String(), TournamentID: champ.ID, Name: "Los Pumas", CaptainID: &captain, Members: []string{"player"}}
+		err := adapter.CreateTeam(ctx, team)
+
+		assert.NoError(t, err)
+		assert.Equal(t, teamID, team.ID)
+		champs.AssertExpectations(t)
+	})
+
+	t.Run("Rejects team without players", func(t *testing.T) {
+		adapter := service.NewChampionshipTournamentAdapter(new(MockChampionshipService), nil)
+		err := adapter.CreateTeam(ctx, &domain.Team{ClubID: clubID.String(), TournamentID: champ.ID, Name: "Vacío"})
+		assert.Error(t, err)
+	})
+}
+
+func TestChampionshipTournamentAdapter_Teams(t *testing.T) {
+	ctx := context.TODO()
+	clubID := uuid.New()
+	champ, _, groupID := legacyChampionship(clubID, service.FormatLeague)
+	other, _, _ := legacyChampionship(clubID, service.FormatLeague)
+	teamA, teamB := uuid.New(), uuid.New()
+	champs := new(MockChampionshipService)
+	adapter := service.NewChampionshipTournamentAdapter(champs, nil)
+
+	champs.On("GetTournament", ctx, clubID.String(), champ.ID.String()).Return(champ, nil)
+	champs.On("ListTournaments", ctx, clubID.String()).Return([]championshipDomain.Tournament{*other, *champ}, nil)
+	champs.On("GetStandings", ctx, clubID.String(), groupID.String()).Return([]championshipDomain.Standing{
+		{TeamID: teamA, GroupID: groupID, TeamName: "Los Pumas"}, {TeamID: teamB, GroupID: groupID, TeamName: "Halcones"},
+	}, nil)
+	champs.On("GetTeamsMembers", ctx, []string{teamA.String(), teamB.String()}).Return(map[string][]string{
+		teamA.String(): {"p1", "p2"}, teamB.String(): {"p3"},
+	}, nil).Once()
+	champs.On("GetTeamStandings", ctx, clubID.String(), teamB.String()).Return([]championshipDomain.Standing{
+		{TeamID: teamB, GroupID: groupID, TeamName: "Halcones"},
+	}, nil)
+	champs.On("GetTeamMembers", ctx, teamB.String()).Return([]string{"p3"}, nil).Once()
+
+	t.Run("Lists teams with one members query", func(t *testing.T) {
+		teams, err := adapter.ListTeams(ctx, clubID.String(), champ.ID)
+		assert.NoError(t, err)
+		assert.Len(t, teams, 2)
+		assert.Equal(t, []string{"p1", "p2"}, teams[0].Members)
+		assert.Equal(t, []string{"p3"}, teams[1].Members)
+	})
+
+	t.Run("Finds team by its registration", func(t *testing.T) {
+		team, err := adapter.GetTeamByID(ctx, clubID.String(), teamB)
+		assert.NoError(t, err)
+		assert.Equal(t, champ.ID, team.TournamentID)
+		assert.Equal(t, "Halcones", team.Name)
+		assert.Equal(t, []string{"p3"}, team.Members)
+		champs.AssertExpectations(t)
+	})
+}
+
+func TestChampionshipTournamentAdapter_Matches(t *testing.T) {
+	ctx := context.TODO()
+	clubID := uuid.New()
+	champ, stageID, groupID := legacyChampionship(clubID, service.FormatLeague)
+	champs := new(MockChampionshipService)
+	adapter := service.NewChampionshipTournamentAdapter(champs, nil)
+	champs.On("GetTournament", ctx, clubID.String(), champ.ID.String()).Return(champ, nil)
+
+	t.Run("Schedules match in the primary group", func(t *testing.T) {
+		matchID := uuid.New()
+		champs.On("AddMatch", ctx, mock.MatchedBy(func(in championshipApp.AddMatchInput) bool {
+			return in.StageID == stageID.String() && *in.GroupID == groupID.String() && in.Round == "1" && in.Location == "Cancha 2"
+		})).Return(&championshipDomain.TournamentMatch{ID: matchID}, nil)
+
+		match := &domain.Match{ClubID: clubID.String(), TournamentID: champ.ID, HomeTeamID: uuid.New(), AwayTeamID: uuid.New(), Round: "1", Location: "Cancha 2"}
+		assert.NoError(t, adapter.CreateMatch(ctx, match))
+		assert.Equal(t, matchID, match.ID)
+	})
+
+	t.Run("Played match updates championship result", func(t *testing.T) {
+		match := &domain.Match{ID: uuid.New(), ClubID: clubID.String(), ScoreHome: 3, ScoreAway: 1, Status: domain.MatchStatusPlayed}
+		champs.On("UpdateMatchResult", ctx, championshipApp.UpdateMatchResultInput{
+			ClubID: clubID.String(), MatchID: match.ID.String(), HomeScore: 3, AwayScore: 1,
+		}).Return(nil).Once()
+
+		assert.NoError(t, adapter.UpdateMatch(ctx, match))
+		champs.AssertExpectations(t)
+	})
+
+	t.Run("Lists matches with integer scores", func(t *testing.T) {
+		two, zero := 2.0, 0.0
+		champs.On("GetMatchesByGroup", ctx, clubID.String(), groupID.String()).Return([]championshipDomain.TournamentMatch{
+			{ID: uuid.New(), TournamentID: champ.ID, HomeScore: &two, AwayScore: &zero, Status: championshipDomain.MatchCompleted, Round: "2"},
+			{ID: uuid.New(), TournamentID: champ.ID, Status: championshipDomain.MatchInProgress},
+		}, nil)
+
+		matches, err := adapter.ListMatches(ctx, clubID.String(), champ.ID)
+		assert.NoError(t, err)
+		assert.Len(t, matches, 2)
+		assert.Equal(t, domain.MatchStatusPlayed, matches[0].Status)
+		assert.Equal(t, 2, matches[0].ScoreHome)
+		assert.Equal(t, "2", matches[0].Round)
+		assert.Equal(t, domain.MatchStatusScheduled, matches[1].Status)
+	})
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	championshipDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	"gorm.io/datatypes"
+)
+
+// Formatos de torneo del módulo de disciplinas
+const (
+	FormatLeague  = "LEAGUE"
+	FormatBracket = "BRACKET"
+)
+
+// LegacySettings son los datos del torneo de disciplinas que no tienen columna propia en
+// el modelo de campeonatos y se guardan en championships.settings
+type LegacySettings struct {
+	DisciplineID uuid.UUID `json:"discipline_id"`
+	Format       string    `json:"format"`
+}
+
+// BuildLegacySettings serializa la disciplina y el formato para championships.settings
+func BuildLegacySettings(disciplineID uuid.UUID, format string) datatypes.JSON {
+	raw, _ := json.Marshal(LegacySettings{DisciplineID: disciplineID, Format: format})
+	return datatypes.JSON(raw)
+}
+
+// ParseLegacySettings lee la disciplina y el formato guardados en championships.settings.
+// Un torneo creado desde el módulo de campeonatos se informa como LEAGUE.
+func ParseLegacySettings(settings datatypes.JSON) LegacySettings {
+	var ls LegacySettings
+	if len(settings) > 0 {
+		_ = json.Unmarshal(settings, &ls)
+	}
+	if ls.Format == "" {
+		ls.Format = FormatLeague
+	}
+	return ls
+}
+
+// StageForFormat devuelve la fase única que aloja un torneo LEAGUE (todos contra todos) o BRACKET (llave)
+func StageForFormat(format string) (string, championshipDomain.StageType) {
+	if format == FormatBracket {
+		return "Llave", championshipDomain.StageKnockout
+	}
+	return "Liga", championshipDomain.StageGroup
+}
+
+// PrimaryGroupName es el nombre del grupo único donde se inscriben los equipos migrados
+const PrimaryGroupName = "Zona Única"
+
+func ToChampionshipTournamentStatus(status domain.TournamentStatus) championshipDomain.TournamentStatus {
+	switch status {
+	case domain.TournamentStatusActive:
+		return championshipDomain.TournamentActive
+	case domain.TournamentStatusCompleted:
+		return championshipDomain.TournamentCompleted
+	default:
+		return championshipDomain.TournamentDraft
+	}
+}
+
+func FromChampionshipTournamentStatus(status championshipDomain.TournamentStatus) domain.TournamentStatus {
+	switch status {
+	case championshipDomain.TournamentActive:
+		return domain.TournamentStatusActive
+	case championshipDomain.TournamentCompleted:
+		return domain.TournamentStatusCompleted
+	default:
+		return domain.TournamentStatusOpen
+	}
+}
+
+func ToChampionshipMatchStatus(status domain.MatchStatus) championshipDomain.MatchStatus {
+	switch status {
+	case domain.MatchStatusPlayed:
+		return championshipDomain.MatchCompleted
+	case domain.MatchStatusCancelled:
+		return championshipDomain.MatchCancelled
+	default:
+		return championshipDomain.MatchScheduled
+	}
+}
+
+// FromChampionshipMatchStatus mapea el estado del partido; un partido en juego sigue siendo SCHEDULED para disciplinas
+func FromChampionshipMatchStatus(status championshipDomain.MatchStatus) domain.MatchStatus {
+	switch status {
+	case championshipDomain.MatchCompleted:
+		return domain.MatchStatusPlayed
+	case championshipDomain.MatchCancelled:
+		return domain.MatchStatusCancelled
+	default:
+		return domain.MatchStatusScheduled
+	}
+}
+
+// FromChampionshipTournament convierte un campeonato al modelo de disciplinas (sin equipos ni partidos)
+func FromChampionshipTournament(t *championshipDomain.Tournament) domain.Tournament {
+	ls := ParseLegacySettings(t.Settings)
+	tournament := domain.Tournament{
+		ID:           t.ID,
+		ClubID:       t.ClubID.String(),
+		Name:         t.Name,
+		DisciplineID: ls.DisciplineID,
+		StartDate:    t.StartDate,
+		Status:       FromChampionshipTournamentStatus(t.Status),
+		Format:       ls.Format,
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+	}
+	if t.EndDate != nil {
+		tournament.EndDate = *t.EndDate
+	}
+	return tournament
+}
+
+// ToChampionshipMatch convierte un partido de disciplinas al modelo de campeonatos dentro de la fase y grupo indicados
+func ToChampionshipMatch(m *domain.Match, stageID, groupID uuid.UUID) championshipDomain.TournamentMatch {
+	match := championshipDomain.TournamentMatch{
+		ID:           m.ID,
+		TournamentID: m.TournamentID,
+		StageID:      stageID,
+		GroupID:      &groupID,
+		HomeTeamID:   m.HomeTeamID,
+		AwayTeamID:   m.AwayTeamID,
+		Status:       ToChampionshipMatchStatus(m.Status),
+		Date:         m.StartTime,
+		Round:        m.Round,
+		Location:     m.Location,
+	}
+	if m.Status == domain.MatchStatusPlayed {
+		home, away := float64(m.ScoreHome), float64(m.ScoreAway)
+		match.HomeScore = &home
+		match.AwayScore = &away
+	}
+	return match
+}
+
+// FromChampionshipMatch convierte un partido de campeonato al modelo de disciplinas (marcador entero)
+func FromChampionshipMatch(clubID string, m *championshipDomain.TournamentMatch) domain.Match {
+	match := domain.Match{
+		ID:           m.ID,
+		ClubID:       clubID,
+		TournamentID: m.TournamentID,
+		HomeTeamID:   m.HomeTeamID,
+		AwayTeamID:   m.AwayTeamID,
+		StartTime:    m.Date,
+		Status:       FromChampionshipMatchStatus(m.Status),
+		Round:        m.Round,
+		Location:     m.Location,
+	}
+	if m.HomeScore != nil {
+		match.ScoreHome = int(*m.HomeScore)
+	}
+	if m.AwayScore != nil {
+		match.ScoreAway = int(*m.AwayScore)
+	}
+	return match
+}
+
+// FromChampionshipStanding convierte una fila de la tabla de posiciones al modelo de disciplinas
+func FromChampionshipStanding(tournamentID uuid.UUID, s *championshipDomain.Standing) domain.Standing {
+	return domain.Standing{
+		TournamentID: tournamentID,
+		TeamID:       s.TeamID,
+		TeamName:     s.TeamName,
+		Played:       s.Played,
+		Won:          s.Won,
+		Drawn:        s.Drawn,
+		Lost:         s.Lost,
+		Points:       int(s.Points),
+		GoalsFor:     int(s.GoalsFor),
+		GoalsAgainst: int(s.GoalsAgainst),
+	}
+}
+
+// PrimaryGroup devuelve la primera fase y su primer grupo, donde vive un torneo de disciplinas
+func PrimaryGroup(t *championshipDomain.Tournament) (*championshipDomain.TournamentStage, *championshipDomain.Group) {
+	var stage *championshipDomain.TournamentStage
+	for i := range t.Stages {
+		if stage == nil || t.Stages[i].Order < stage.Order {
+			stage = &t.Stages[i]
+		}
+	}
+	if stage == nil || len(stage.Groups) == 0 {
+		return stage, nil
+	}
+	return stage, &stage.Groups[0]
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	championshipDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/championship/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// MigrationReport resume la conversión de torneos de disciplinas al modelo de campeonatos
+type MigrationReport struct {
+	Tournaments int      `json:"tournaments"`
+	Teams       int      `json:"teams"`
+	Matches     int      `json:"matches"`
+	Skipped     int      `json:"skipped"` // Ya convertidos en una ejecución anterior
+	Errors      []string `json:"errors,omitempty"`
+}
+
+// legacyTeam lee los equipos de disciplinas con los integrantes como JSON crudo
+type legacyTeam struct {
+	ID           uuid.UUID
+	Name         string
+	TournamentID uuid.UUID
+	CaptainID    *string
+	Members      datatypes.JSON
+}
+
+var errDryRun = errors.New("dry run")
+
+// ChampionshipMigrator convierte los torneos, equipos y partidos de disciplinas en campeonatos.
+// Conserva los IDs originales, por lo que es idempotente y las URLs existentes siguen siendo válidas.
+// Las tablas originales (tournaments, matches) no se modifican.
+type ChampionshipMigrator struct {
+	db *gorm.DB
+}
+
+func NewChampionshipMigrator(db *gorm.DB) *ChampionshipMigrator {
+	return &ChampionshipMigrator{db: db}
+}
+
+// Migrate convierte cada torneo en su propia transacción. Con dryRun se ejecuta todo y se revierte al final.
+func (m *ChampionshipMigrator) Migrate(ctx context.Context, dryRun bool) (*MigrationReport, error) {
+	var tournaments []domain.Tournament
+	if err := m.db.WithContext(ctx).Order("created_at").Find(&tournaments).Error; err != nil {
+		return nil, err
+	}
+
+	report := &MigrationReport{}
+	for i := range tournaments {
+		t := &tournaments[i]
+
+		var existing int64
+		if err := m.db.WithContext(ctx).Table("championships").Where("id = ?", t.ID).Count(&existing).Error; err != nil {
+			return report, err
+		}
+		if existing > 0 {
+			report.Skipped++
+			continue
+		}
+
+		var teams, matches int
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var err error
+			teams, matches, err = m.migrateTournament(tx, t)
+			if err != nil {
+				return err
+			}
+			if dryRun {
+				return errDryRun
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errDryRun) {
+			report.Errors = append(report.Errors, fmt.Sprintf("torneo %s (%s): %v", t.ID, t.Name, err))
+			continue
+		}
+
+		report.Tournaments++
+		report.Teams += teams
+		report.Matches += matches
+	}
+	return report, nil
+}
+
+func (m *ChampionshipMigrator) migrateTournament(tx *gorm.DB, t *domain.Tournament) (int, int, error) {
+	clubID, err := uuid.Parse(t.ClubID)
+	if err != nil {
+		return 0, 0, errors.New("club_id no es un UUID válido")
+	}
+
+	sport := "GENERAL"
+	var discipline domain.Discipline
+	if err := tx.Where("id = ?", t.DisciplineID).Limit(1).Find(&discipline).Error; err != nil {
+		return 0, 0, err
+	}
+	if discipline.Name != "" {
+		sport = discipline.Name
+	}
+
+	champ := &championshipDomain.Tournament{
+		ID:        t.ID,
+		ClubID:    clubID,
+		Name:      t.Name,
+		Sport:     sport,
+		Status:    ToChampionshipTournamentStatus(t.Status),
+		StartDate: t.StartDate,
+		Settings:  BuildLegacySettings(t.DisciplineID, t.Format),
+		CreatedAt: t.CreatedAt,
+	}
+	if !t.EndDate.IsZero() {
+		endDate := t.EndDate
+		champ.EndDate = &endDate
+	}
+	if err := tx.Create(champ).Error; err != nil {
+		return 0, 0, err
+	}
+
+	stageName, stageType := StageForFormat(t.Format)
+	stage := &championshipDomain.TournamentStage{
+		ID:           uuid.New(),
+		TournamentID: champ.ID,
+		Order:        1,
+		Name:         stageName,
+		Type:         stageType,
+		Status:       championshipDomain.StagePending,
+	}
+	if t.Status != domain.TournamentStatusOpen {
+		stage.Status = championshipDomain.StageActive
+		if t.Status == domain.TournamentStatusCompleted {
+			stage.Status = championshipDomain.StageCompleted
+		}
+	}
+	if err := tx.Create(stage).Error; err != nil {
+		return 0, 0, err
+	}
+	group := &championshipDomain.Group{ID: uuid.New(), StageID: stage.ID, Name: PrimaryGroupName}
+	if err := tx.Create(group).Error; err != nil {
+		return 0, 0, err
+	}
+
+	// Los equipos viven en la tabla compartida "teams": se reutiliza la fila y se cargan sus integrantes
+	var teams []legacyTeam
+	if err := tx.Table("teams").
+		Select("id, name, tournament_id, captain_id, members").
+		Where("tournament_id = ? AND deleted_at IS NULL", t.ID).
+		Scan(&teams).Error; err != nil {
+		return 0, 0, err
+	}
+
+	standings := make([]championshipDomain.Standing, 0, len(teams))
+	for _, team := range teams {
+		var members []string
+		if len(team.Members) > 0 {
+			if err := json.Unmarshal(team.Members, &members); err != nil {
+				return 0, 0, fmt.Errorf("integrantes inválidos en equipo %s: %w", team.ID, err)
+			}
+		}
+		if team.CaptainID != nil && *team.CaptainID != "" && !containsString(members, *team.CaptainID) {
+			members = append(members, *team.CaptainID)
+		}
+		for _, userID := range members {
+			if err := tx.Exec("INSERT INTO team_members (team_id, user_id) VALUES (?, ?) ON CONFLICT DO NOTHING", team.ID, userID).Error; err != nil {
+				return 0, 0, err
+			}
+		}
+		standings = append(standings, championshipDomain.Standing{ID: uuid.New(), GroupID: group.ID, TeamID: team.ID})
+	}
+
+	var legacyMatches []domain.Match
+	if err := tx.Where("tournament_id = ?", t.ID).Order("start_time").Find(&legacyMatches).Error; err != nil {
+		return 0, 0, err
+	}
+	matches := make([]championshipDomain.TournamentMatch, 0, len(legacyMatches))
+	for i := range legacyMatches {
+		matches = append(matches, ToChampionshipMatch(&legacyMatches[i], stage.ID, group.ID))
+	}
+
+	if len(standings) > 0 {
+		championshipDomain.CalculateStandings(standings, matches, false)
+		championshipDomain.RankStandings(standings)
+		if err := tx.Create(&standings).Error; err != nil {
+			return 0, 0, err
+		}
+	}
+	if len(matches) > 0 {
+		if err := tx.Create(&matches).Error; err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return len(teams), len(matches), nil
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// LeagueRosterDirectory implementa userDomain.TeamRosterDirectory: el equipo de la carpeta de liga
+// es un grupo de entrenamiento y su plantel, los inscriptos vigentes.
+type LeagueRosterDirectory struct {
+	repo        domain.DisciplineRepository
+	enrollments domain.EnrollmentRepository
+}
+
+func NewLeagueRosterDirectory(repo domain.DisciplineRepository, enrollments domain.EnrollmentRepository) *LeagueRosterDirectory {
+	return &LeagueRosterDirectory{repo: repo, enrollments: enrollments}
+}
+
+func (d *LeagueRosterDirectory) GetTeamRoster(ctx context.Context, clubID string, teamID uuid.UUID) (*userDomain.TeamRoster, error) {
+	group, err := d.repo.GetGroupByID(ctx, clubID, teamID)
+	if err != nil || group == nil {
+		return nil, err
+	}
+	userIDs, err := d.enrollments.ListRosterUserIDs(ctx, clubID, teamID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	disciplineID := group.DisciplineID
+	return &userDomain.TeamRoster{
+		TeamID:  group.ID,
+		Name:    group.Name,
+		Scope:   userDomain.EligibilityScope{DisciplineID: &disciplineID, Category: group.Category},
+		UserIDs: userIDs,
+	}, nil
+}
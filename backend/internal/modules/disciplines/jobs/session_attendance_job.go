@@ -0,0 +1,69 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	attendanceDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+)
+
+// AttendanceListPreparer creates (or refreshes) the attendance list of a training group session.
+// Implemented by the attendance use cases.
+type AttendanceListPreparer interface {
+	GetOrCreateListByTrainingGroup(ctx context.Context, clubID string, groupID uuid.UUID, groupName string, date time.Time, coachID string) (*attendanceDomain.AttendanceList, error)
+}
+
+// SessionAttendanceJob prepares the attendance lists of upcoming training sessions so coaches
+// find them ready (with the enrollment roster) when the session starts. Lists are created
+// once; running it again only adds members enrolled in the meantime.
+type SessionAttendanceJob struct {
+	repo         domain.DisciplineRepository
+	scheduleRepo domain.ScheduleRepository
+	attendance   AttendanceListPreparer
+	daysAhead    int
+}
+
+func NewSessionAttendanceJob(repo domain.DisciplineRepository, scheduleRepo domain.ScheduleRepository, attendance AttendanceListPreparer, daysAhead int) *SessionAttendanceJob {
+	if daysAhead <= 0 {
+		daysAhead = 1 // Today and tomorrow
+	}
+	return &SessionAttendanceJob{
+		repo:         repo,
+		scheduleRepo: scheduleRepo,
+		attendance:   attendance,
+		daysAhead:    daysAhead,
+	}
+}
+
+// Run prepares the lists of the club's sessions from today to daysAhead and returns how many
+// lists were prepared, along with the last error if some of them failed.
+func (j *SessionAttendanceJob) Run(ctx context.Context, clubID string) (int, error) {
+	sessions, err := j.scheduleRepo.ListSessions(ctx, clubID, nil)
+	if err != nil || len(sessions) == 0 {
+		return 0, err
+	}
+
+	groups, err := j.repo.ListGroups(ctx, clubID, map[string]interface{}{})
+	if err != nil {
+		return 0, err
+	}
+	byID := make(map[uuid.UUID]domain.TrainingGroup, len(groups))
+	for _, g := range groups {
+		byID[g.ID] = g
+	}
+
+	now := time.Now().UTC()
+	prepared := 0
+	var lastErr error
+	for _, occ := range domain.ExpandSessions(sessions, byID, now, now.AddDate(0, 0, j.daysAhead)) {
+		// One failing group must not block the lists of the others
+		if _, err := j.attendance.GetOrCreateListByTrainingGroup(ctx, clubID, occ.GroupID, occ.GroupName, occ.Date, occ.CoachID); err != nil {
+			lastErr = err
+			continue
+		}
+		prepared++
+	}
+	return prepared, lastErr
+}
@@ -0,0 +1,115 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	attendanceDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDisciplineRepo struct {
+	mock.Mock
+}
+
+func (m *MockDisciplineRepo) CreateDiscipline(ctx context.Context, d *domain.Discipline) error {
+	return nil
+}
+func (m *MockDisciplineRepo) ListDisciplines(ctx context.Context, clubID string) ([]domain.Discipline, error) {
+	return nil, nil
+}
+func (m *MockDisciplineRepo) GetDisciplineByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.Discipline, error) {
+	return nil, nil
+}
+func (m *MockDisciplineRepo) CreateGroup(ctx context.Context, g *domain.TrainingGroup) error {
+	return nil
+}
+func (m *MockDisciplineRepo) ListGroups(ctx context.Context, clubID string, filter map[string]interface{}) ([]domain.TrainingGroup, error) {
+	args := m.Called(ctx, clubID, filter)
+	return args.Get(0).([]domain.TrainingGroup), args.Error(1)
+}
+func (m *MockDisciplineRepo) GetGroupByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.TrainingGroup, error) {
+	return nil, nil
+}
+
+type MockScheduleRepo struct {
+	mock.Mock
+}
+
+func (m *MockScheduleRepo) CreateSession(ctx context.Context, s *domain.TrainingSession) error {
+	return nil
+}
+func (m *MockScheduleRepo) GetSession(ctx context.Context, clubID string, id uuid.UUID) (*domain.TrainingSession, error) {
+	return nil, nil
+}
+func (m *MockScheduleRepo) DeleteSession(ctx context.Context, clubID string, id uuid.UUID) error {
+	return nil
+}
+func (m *MockScheduleRepo) ListSessions(ctx context.Context, clubID string, groupIDs []uuid.UUID) ([]domain.TrainingSession, error) {
+	args := m.Called(ctx, clubID, groupIDs)
+	return args.Get(0).([]domain.TrainingSession), args.Error(1)
+}
+func (m *MockScheduleRepo) UpdateGroupSchedule(ctx context.Context, clubID string, groupID uuid.UUID, schedule string) error {
+	return nil
+}
+
+type MockAttendancePreparer struct {
+	mock.Mock
+}
+
+func (m *MockAttendancePreparer) GetOrCreateListByTrainingGroup(ctx context.Context, clubID string, groupID uuid.UUID, groupName string, date time.Time, coachID string) (*attendanceDomain.AttendanceList, error) {
+	args := m.Called(ctx, clubID, groupID, groupName, date, coachID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*attendanceDomain.AttendanceList), args.Error(1)
+}
+
+func TestSessionAttendanceJob_Run(t *testing.T) {
+	ctx := context.Background()
+	clubID := "c1"
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	tomorrow := today.AddDate(0, 0, 1)
+
+	okGroup := domain.TrainingGroup{ID: uuid.New(), ClubID: clubID, Name: "Sub 12", CoachID: "coach-1"}
+	failingGroup := domain.TrainingGroup{ID: uuid.New(), ClubID: clubID, Name: "Sub 14", CoachID: "coach-2"}
+
+	session := func(groupID uuid.UUID, day time.Time) domain.TrainingSession {
+		return domain.TrainingSession{
+			ID: uuid.New(), ClubID: clubID, GroupID: groupID,
+			DayOfWeek: int(day.Weekday()), StartTime: "18:00", EndTime: "19:00",
+			ValidFrom: today.AddDate(0, 0, -30), ValidUntil: today.AddDate(0, 0, 30),
+		}
+	}
+
+	dRepo := new(MockDisciplineRepo)
+	sRepo := new(MockScheduleRepo)
+	preparer := new(MockAttendancePreparer)
+
+	sRepo.On("ListSessions", ctx, clubID, []uuid.UUID(nil)).Return([]domain.TrainingSession{
+		session(okGroup.ID, today),
+		session(okGroup.ID, tomorrow),
+		session(failingGroup.ID, today),
+	}, nil)
+	dRepo.On("ListGroups", ctx, clubID, map[string]interface{}{}).Return([]domain.TrainingGroup{okGroup, failingGroup}, nil)
+
+	preparer.On("GetOrCreateListByTrainingGroup", ctx, clubID, okGroup.ID, "Sub 12", today, "coach-1").Return(&attendanceDomain.AttendanceList{}, nil).Once()
+	preparer.On("GetOrCreateListByTrainingGroup", ctx, clubID, okGroup.ID, "Sub 12", tomorrow, "coach-1").Return(&attendanceDomain.AttendanceList{}, nil).Once()
+	preparer.On("GetOrCreateListByTrainingGroup", ctx, clubID, failingGroup.ID, "Sub 14", today, "coach-2").Return(nil, errors.New("db error")).Once()
+
+	job := jobs.NewSessionAttendanceJob(dRepo, sRepo, preparer, 1)
+	prepared, err := job.Run(ctx, clubID)
+
+	// The failing group does not stop the others
+	assert.Error(t, err)
+	assert.Equal(t, 2, prepared)
+	preparer.AssertExpectations(t)
+}
@@ -22,6 +22,7 @@ type MembershipUseCases struct {
 	repo             domain.MembershipRepository
 	scholarshipRepo  domain.ScholarshipRepository
 	subscriptionRepo domain.SubscriptionRepository
+	feeSources       []domain.FeeSource
 }
 
 func NewMembershipUseCases(repo domain.MembershipRepository, scholarshipRepo domain.ScholarshipRepository, subscriptionRepo domain.SubscriptionRepository) *MembershipUseCases {
@@ -32,6 +33,11 @@ func NewMembershipUseCases(repo domain.MembershipRepository, scholarshipRepo dom
 	}
 }
 
+// RegisterFeeSource adds extra monthly charges (e.g. training group fees) to ProcessMonthlyBilling.
+func (uc *MembershipUseCases) RegisterFeeSource(source domain.FeeSource) {
+	uc.feeSources = append(uc.feeSources, source)
+}
+
 // addMonthsRobust adds months to a date while handling end-of-month edge cases.
 // For example: Jan 31 + 1 month = Feb 28 (or 29 in leap year), not March 3.
 func addMonthsRobust(t time.Time, months int) time.Time {
@@ -200,8 +206,14 @@ func (uc *MembershipUseCases) ProcessMonthlyBilling(ctx context.Context, clubID
 
 	// 1. Batch Fetch Scholarships
 	var userIDs []string
+	var userUUIDs []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
 	for _, m := range billable {
 		userIDs = append(userIDs, m.UserID.String())
+		if !seen[m.UserID] {
+			seen[m.UserID] = true
+			userUUIDs = append(userUUIDs, m.UserID)
+		}
 	}
 
 	scholarships, err := uc.scholarshipRepo.ListActiveByUserIDs(ctx, userIDs)
@@ -209,6 +221,18 @@ func (uc *MembershipUseCases) ProcessMonthlyBilling(ctx context.Context, clubID
 		return 0, err // Fail entire batch? Or log and proceed? For consistency, fail.
 	}
 
+	// Extra charges from other modules (training group fees), billed once per user
+	extraFees := make(map[uuid.UUID]decimal.Decimal)
+	for _, source := range uc.feeSources {
+		fees, err := source.MonthlyFees(ctx, clubID, userUUIDs, now)
+		if err != nil {
+			return 0, err
+		}
+		for userID, amount := range fees {
+			extraFees[userID] = extraFees[userID].Add(amount)
+		}
+	}
+
 	// 2. Calculate Updates in Memory
 	updates := make(map[uuid.UUID]struct {
 		Balance     decimal.Decimal
@@ -226,6 +250,10 @@ func (uc *MembershipUseCases) ProcessMonthlyBilling(ctx context.Context, clubID
 
 		// Calculate fee with potential scholarship
 		fee := m.MembershipTier.MonthlyFee
+		if extra, ok := extraFees[m.UserID]; ok {
+			fee = fee.Add(extra)
+			delete(extraFees, m.UserID)
+		}
 
 		// Memory Lookup
 		if s, ok := scholarships[m.UserID.String()]; ok {
@@ -173,4 +173,50 @@ func TestProcessMonthlyBilling(t *testing.T) {
 		assert.Equal(t, 1, count)
 		repo.AssertExpectations(t)
 	})
+	t.Run("Adds Group Fees Once Per User", func(t *testing.T) {
+		secondMemID := uuid.New()
+		memberships := []domain.Membership{
+			{
+				ID: memID, UserID: userID, AutoRenew: true,
+				OutstandingBalance: decimal.Zero,
+				NextBillingDate:    time.Now().AddDate(0, -1, 0),
+				MembershipTier:     domain.MembershipTier{MonthlyFee: decimal.NewFromFloat(100)},
+			},
+			{
+				ID: secondMemID, UserID: userID, AutoRenew: true,
+				OutstandingBalance: decimal.Zero,
+				NextBillingDate:    time.Now().AddDate(0, -1, 0),
+				MembershipTier:     domain.MembershipTier{MonthlyFee: decimal.NewFromFloat(10)},
+			},
+		}
+		repo.On("ListBillable", ctx, clubID, mock.Anything).Return(memberships, nil).Once()
+		sRepo.On("ListActiveByUserIDs", ctx, mock.Anything).Return(map[string]*domain.Scholarship{}, nil).Once()
+
+		feeSource := new(MockFeeSource)
+		feeSource.On("MonthlyFees", ctx, clubID, []uuid.UUID{userID}, mock.Anything).
+			Return(map[uuid.UUID]decimal.Decimal{userID: decimal.NewFromFloat(40)}, nil).Once()
+		billing := application.NewMembershipUseCases(repo, sRepo, subRepo)
+		billing.RegisterFeeSource(feeSource)
+
+		repo.On("UpdateBalancesBatch", ctx, mock.MatchedBy(func(updates map[uuid.UUID]struct {
+			Balance     decimal.Decimal
+			NextBilling time.Time
+		}) bool {
+			return updates[memID].Balance.Equal(decimal.NewFromFloat(140)) &&
+				updates[secondMemID].Balance.Equal(decimal.NewFromFloat(10))
+		})).Return(nil).Once()
+
+		count, err := billing.ProcessMonthlyBilling(ctx, clubID)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+		repo.AssertExpectations(t)
+		feeSource.AssertExpectations(t)
+	})
+}
+
+type MockFeeSource struct{ mock.Mock }
+
+func (m *MockFeeSource) MonthlyFees(ctx context.Context, clubID string, userIDs []uuid.UUID, date time.Time) (map[uuid.UUID]decimal.Decimal, error) {
+	args := m.Called(ctx, clubID, userIDs, date)
+	return args.Get(0).(map[uuid.UUID]decimal.Decimal), args.Error(1)
 }
@@ -99,3 +99,9 @@ type MembershipRepository interface {
 	}) error
 	ListAll(ctx context.Context, clubID string) ([]Membership, error)
 }
+
+// FeeSource adds recurring charges owned by other modules (e.g. training group fees)
+// to the monthly billing run. It returns the amount to charge per user.
+type FeeSource interface {
+	MonthlyFees(ctx context.Context, clubID string, userIDs []uuid.UUID, date time.Time) (map[uuid.UUID]decimal.Decimal, error)
+}
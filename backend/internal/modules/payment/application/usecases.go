@@ -41,7 +41,7 @@ type CheckoutRequest struct {
 	PayerEmail    string
 	ReferenceID   uuid.UUID
 	ReferenceType string
-	UserID        uuid.UUID
+	UserID        uuid.UUID // uuid.Nil for external payers, identified by PayerEmail
 	ClubID        string
 }
 
@@ -58,11 +58,19 @@ func (uc *PaymentUseCases) Checkout(ctx context.Context, req CheckoutRequest) (*
 		Currency:      "ARS",
 		Status:        domain.PaymentStatusPending,
 		Method:        domain.PaymentMethodMercadoPago,
-		PayerID:       req.UserID,
 		ClubID:        req.ClubID,
 		ReferenceID:   req.ReferenceID,
 		ReferenceType: req.ReferenceType,
 	}
+	if req.UserID != uuid.Nil {
+		payerID := req.UserID
+		payment.PayerID = &payerID
+	} else {
+		if req.PayerEmail == "" {
+			return nil, "", errors.New("external payer requires an email")
+		}
+		payment.PayerEmail = req.PayerEmail
+	}
 
 	if err := uc.repo.Create(ctx, payment); err != nil {
 		log.Printf("Failed to create payment: %v", err)
@@ -253,7 +261,7 @@ func (uc *PaymentUseCases) CreateOfflinePayment(ctx context.Context, req CreateO
 		Currency:      "ARS",
 		Status:        domain.PaymentStatusCompleted, // Offline payments are recorded when completed
 		Method:        req.Method,
-		PayerID:       req.PayerID,
+		PayerID:       &req.PayerID,
 		ClubID:        req.ClubID,
 		ReferenceID:   req.ReferenceID,
 		ReferenceType: req.ReferenceType,
@@ -276,6 +284,11 @@ func (uc *PaymentUseCases) CreateOfflinePayment(ctx context.Context, req CreateO
 	return payment, nil
 }
 
+// GetPayment returns a payment of the club, or nil if it does not exist.
+func (uc *PaymentUseCases) GetPayment(ctx context.Context, clubID string, id uuid.UUID) (*domain.Payment, error) {
+	return uc.repo.GetByID(ctx, clubID, id)
+}
+
 // ListPayments retrieves filtered payments for a club.
 func (uc *PaymentUseCases) ListPayments(ctx context.Context, clubID string, filter domain.PaymentFilter) ([]*domain.Payment, int64, error) {
 	return uc.repo.List(ctx, clubID, filter)
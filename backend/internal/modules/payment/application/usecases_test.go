@@ -124,6 +124,22 @@ func TestPaymentUseCases_Checkout(t *testing.T) {
 		assert.NotNil(t, payment)
 	})
 
+	t.Run("External Payer", func(t *testing.T) {
+		reqExternal := req
+		reqExternal.UserID = uuid.Nil
+		repo.On("Create", ctx, mock.MatchedBy(func(p *domain.Payment) bool {
+			return p.PayerID == nil && p.PayerEmail == "test@user.com"
+		})).Return(nil).Once()
+		gateway.On("CreatePreference", ctx, mock.Anything, "test@user.com", "Booking 123").Return("http://checkout.url", nil).Once()
+
+		_, _, err := uc.Checkout(ctx, reqExternal)
+		assert.NoError(t, err)
+
+		reqExternal.PayerEmail = ""
+		_, _, err = uc.Checkout(ctx, reqExternal)
+		assert.Error(t, err)
+	})
+
 	t.Run("Invalid Amount", func(t *testing.T) {
 		reqInvalid := req
 		reqInvalid.Amount = "invalid"
@@ -34,12 +34,13 @@ type Payment struct {
 	Currency      string          `json:"currency" gorm:"not null;default:'ARS'"`
 	Status        PaymentStatus   `json:"status" gorm:"not null;default:'PENDING'"`
 	Method        PaymentMethod   `json:"method" gorm:"not null"`
-	ExternalID    string          `json:"external_id"`          // ID from Payment Provider
-	ClubID        string          `json:"club_id" gorm:"index"` // Tenant Isolation
-	PayerID       uuid.UUID       `json:"payer_id" gorm:"type:uuid;not null;index"`
-	ReferenceID   uuid.UUID       `json:"reference_id" gorm:"type:uuid;index"` // Could be Membership ID or Booking ID
-	ReferenceType string          `json:"reference_type"`                      // "MEMBERSHIP", "BOOKING"
-	Notes         string          `json:"notes" gorm:"type:text"`              // Details for Offline/Labor payments
+	ExternalID    string          `json:"external_id"`                               // ID from Payment Provider
+	ClubID        string          `json:"club_id" gorm:"index"`                      // Tenant Isolation
+	PayerID       *uuid.UUID      `json:"payer_id,omitempty" gorm:"type:uuid;index"` // Nil for external payers (not club members)
+	PayerEmail    string          `json:"payer_email,omitempty"`                     // Contact of an external payer
+	ReferenceID   uuid.UUID       `json:"reference_id" gorm:"type:uuid;index"`       // Could be Membership ID or Booking ID
+	ReferenceType string          `json:"reference_type"`                            // "MEMBERSHIP", "BOOKING"
+	Notes         string          `json:"notes" gorm:"type:text"`                    // Details for Offline/Labor payments
 
 	PaidAt    *time.Time     `json:"paid_at,omitempty"`
 	CreatedAt time.Time      `json:"created_at" gorm:"autoCreateTime"`
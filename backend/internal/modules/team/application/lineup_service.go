@@ -0,0 +1,278 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+)
+
+// PlayerStatusProvider obtiene el semáforo de un jugador (lo implementa PlayerStatusService)
+type PlayerStatusProvider interface {
+	GetPlayerStatus(ctx context.Context, clubID, userID string) (PlayerStatusFlags, error)
+}
+
+// LineupService arma la convocatoria y la alineación de los partidos de un grupo de entrenamiento
+// a partir de la disponibilidad informada por los jugadores y su semáforo (deuda, apto médico)
+type LineupService struct {
+	teamRepo domain.TeamRepository
+	lineups  domain.LineupRepository
+	roster   domain.SquadRosterProvider
+	status   PlayerStatusProvider
+	notifier notificationSvc.NotificationSender
+}
+
+// NewLineupService crea una nueva instancia del servicio
+func NewLineupService(
+	teamRepo domain.TeamRepository,
+	lineups domain.LineupRepository,
+	roster domain.SquadRosterProvider,
+	status PlayerStatusProvider,
+	notifier notificationSvc.NotificationSender,
+) *LineupService {
+	return &LineupService{
+		teamRepo: teamRepo,
+		lineups:  lineups,
+		roster:   roster,
+		status:   status,
+		notifier: notifier,
+	}
+}
+
+// SquadProposal es la propuesta de convocados de un partido
+type SquadProposal struct {
+	Event      *domain.MatchEvent      `json:"event"`
+	Candidates []domain.SquadCandidate `json:"candidates"`
+	Confirmed  int                     `json:"confirmed"`
+	Declined   int                     `json:"declined"`
+	Maybe      int                     `json:"maybe"`
+	Pending    int                     `json:"pending"`
+	Lineup     *domain.Lineup          `json:"lineup,omitempty"`
+}
+
+// LineupPlayerInput es un convocado elegido por el entrenador
+type LineupPlayerInput struct {
+	UserID      string            `json:"user_id" binding:"required"`
+	Role        domain.LineupRole `json:"role" binding:"required"`
+	Position    string            `json:"position"`
+	ShirtNumber *int              `json:"shirt_number"`
+}
+
+// SaveLineupInput contiene la alineación armada por el entrenador
+type SaveLineupInput struct {
+	ClubID    string              `json:"-"`
+	EventID   uuid.UUID           `json:"-"`
+	Formation string              `json:"formation"`
+	Players   []LineupPlayerInput `json:"players" binding:"dive"`
+}
+
+// GetSquadProposal evalúa el plantel del grupo y sugiere hasta size convocados
+func (s *LineupService) GetSquadProposal(ctx context.Context, clubID string, eventID uuid.UUID, size int) (*SquadProposal, error) {
+	event, err := s.getEvent(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := s.candidates(ctx, clubID, event)
+	if err != nil {
+		return nil, err
+	}
+	lineup, err := s.lineups.GetLineup(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := &SquadProposal{Event: event, Lineup: lineup}
+	for _, c := range candidates {
+		switch c.Availability {
+		case domain.AvailabilityConfirmed:
+			proposal.Confirmed++
+		case domain.AvailabilityDeclined:
+			proposal.Declined++
+		case domain.AvailabilityMaybe:
+			proposal.Maybe++
+		default:
+			proposal.Pending++
+		}
+	}
+	proposal.Candidates = domain.ProposeSquad(candidates, size)
+	return proposal, nil
+}
+
+// GetLineup obtiene la alineación de un partido (nil si todavía no se armó)
+func (s *LineupService) GetLineup(ctx context.Context, clubID string, eventID uuid.UUID) (*domain.Lineup, error) {
+	if _, err := s.getEvent(ctx, clubID, eventID); err != nil {
+		return nil, err
+	}
+	return s.lineups.GetLineup(ctx, clubID, eventID)
+}
+
+// SaveLineup guarda titulares, suplentes y posiciones mientras la alineación esté abierta
+func (s *LineupService) SaveLineup(ctx context.Context, input SaveLineupInput) (*domain.Lineup, error) {
+	event, err := s.getEvent(ctx, input.ClubID, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	lineup, err := s.lineups.GetLineup(ctx, input.ClubID, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if lineup == nil {
+		lineup = &domain.Lineup{
+			ID:           uuid.New(),
+			ClubID:       input.ClubID,
+			MatchEventID: input.EventID,
+			Status:       domain.LineupDraft,
+		}
+	}
+
+	players := make([]domain.LineupPlayer, len(input.Players))
+	for i, p := range input.Players {
+		players[i] = domain.LineupPlayer{UserID: p.UserID, Role: p.Role, Position: p.Position, ShirtNumber: p.ShirtNumber}
+	}
+	if err := lineup.SetPlayers(players); err != nil {
+		return nil, err
+	}
+	if err := s.validateSelection(ctx, input.ClubID, event, lineup.Players); err != nil {
+		return nil, err
+	}
+	lineup.Formation = input.Formation
+
+	if err := s.lineups.SaveLineup(ctx, lineup); err != nil {
+		return nil, err
+	}
+	return lineup, nil
+}
+
+// LockLineup cierra la alineación y avisa a cada convocado su rol.
+// Se vuelve a validar la selección por si algún jugador se bajó o quedó inhabilitado desde que se armó.
+func (s *LineupService) LockLineup(ctx context.Context, clubID string, eventID uuid.UUID, coachID string) (*domain.Lineup, error) {
+	event, err := s.getEvent(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	lineup, err := s.lineups.GetLineup(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if lineup == nil {
+		return nil, domain.ErrEmptyLineup
+	}
+	if lineup.IsLocked() {
+		return nil, domain.ErrLineupLocked
+	}
+	if err := s.validateSelection(ctx, clubID, event, lineup.Players); err != nil {
+		return nil, err
+	}
+	if err := lineup.Lock(coachID, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.lineups.SaveLineup(ctx, lineup); err != nil {
+		return nil, err
+	}
+
+	s.notifySelected(ctx, event, lineup)
+	return lineup, nil
+}
+
+// candidates reúne el plantel del grupo a la fecha del partido con la respuesta de cada uno a la convocatoria
+func (s *LineupService) candidates(ctx context.Context, clubID string, event *domain.MatchEvent) ([]domain.SquadCandidate, error) {
+	roster, err := s.roster.ListRosterUserIDs(ctx, clubID, event.TrainingGroupID, event.MeetupTime)
+	if err != nil {
+		return nil, err
+	}
+	availabilities, err := s.teamRepo.GetEventAvailabilities(ctx, clubID, event.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// Solo cuenta la respuesta de quien integra el plantel: quien dejó el grupo (o nunca estuvo)
+	// no puede ser convocado aunque haya contestado
+	responses := make(map[string]domain.PlayerAvailabilityStatus, len(availabilities))
+	for _, a := range availabilities {
+		responses[a.UserID] = a.Status
+	}
+
+	candidates := make([]domain.SquadCandidate, 0, len(roster))
+	for _, userID := range roster {
+		availability, ok := responses[userID]
+		if !ok {
+			availability = domain.AvailabilityPending
+		}
+		candidate := domain.SquadCandidate{UserID: userID, Availability: availability}
+		flags, err := s.status.GetPlayerStatus(ctx, clubID, userID)
+		if err != nil {
+			// Sin semáforo no se puede asegurar que esté habilitado
+			candidate.Issues = []string{"No se pudo verificar el estado del jugador"}
+		} else {
+			candidate.Eligible = !flags.IsInhabilitado
+			candidate.Issues = flags.Issues()
+			candidate.AttendanceRate = flags.AttendanceRate
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
+
+func (s *LineupService) validateSelection(ctx context.Context, clubID string, event *domain.MatchEvent, players []domain.LineupPlayer) error {
+	if len(players) == 0 {
+		return nil
+	}
+	candidates, err := s.candidates(ctx, clubID, event)
+	if err != nil {
+		return err
+	}
+	byUser := make(map[string]*domain.SquadCandidate, len(candidates))
+	for i := range candidates {
+		byUser[candidates[i].UserID] = &candidates[i]
+	}
+	for _, p := range players {
+		candidate, ok := byUser[p.UserID]
+		if !ok {
+			return fmt.Errorf("%w: %s", domain.ErrPlayerNotInSquad, p.UserID)
+		}
+		if err := candidate.CanBeSelected(); err != nil {
+			return fmt.Errorf("%w: %s", err, p.UserID)
+		}
+	}
+	return nil
+}
+
+func (s *LineupService) notifySelected(ctx context.Context, event *domain.MatchEvent, lineup *domain.Lineup) {
+	if s.notifier == nil {
+		return
+	}
+	for _, p := range lineup.Players {
+		role := "titular"
+		if p.Role == domain.LineupSubstitute {
+			role = "suplente"
+		}
+		if p.Position != "" {
+			role += fmt.Sprintf(" (%s)", p.Position)
+		}
+		body := fmt.Sprintf("Fuiste convocado como %s vs %s. Encuentro: %s", role, event.OpponentName, event.MeetupTime.Format("02/01 15:04"))
+		if event.Location != "" {
+			body += " en " + event.Location
+		}
+		err := s.notifier.Send(ctx, notificationSvc.Notification{
+			RecipientID: p.UserID,
+			Type:        notificationSvc.NotificationTypePush,
+			Title:       "📋 Convocatoria confirmada",
+			Body:        body,
+		})
+		if err != nil {
+			log.Printf("[LineupService] error notificando a %s: %v", p.UserID, err)
+		}
+	}
+}
+
+func (s *LineupService) getEvent(ctx context.Context, clubID string, eventID uuid.UUID) (*domain.MatchEvent, error) {
+	event, err := s.teamRepo.GetMatchEvent(ctx, clubID, eventID.String())
+	if err != nil || event == nil {
+		return nil, domain.ErrMatchEventNotFound
+	}
+	return event, nil
+}
@@ -0,0 +1,179 @@
+package application_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLineupRepo struct {
+	mock.Mock
+}
+
+func (m *MockLineupRepo) GetLineup(ctx context.Context, clubID string, matchEventID uuid.UUID) (*domain.Lineup, error) {
+	args := m.Called(ctx, clubID, matchEventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Lineup), args.Error(1)
+}
+func (m *MockLineupRepo) SaveLineup(ctx context.Context, lineup *domain.Lineup) error {
+	return m.Called(ctx, lineup).Error(0)
+}
+func (m *MockLineupRepo) ListUpcomingMatchEvents(ctx context.Context, clubID string, from, to time.Time) ([]domain.MatchEvent, error) {
+	args := m.Called(ctx, clubID, from, to)
+	return args.Get(0).([]domain.MatchEvent), args.Error(1)
+}
+func (m *MockLineupRepo) ListRemindedUserIDs(ctx context.Context, matchEventID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, matchEventID)
+	return args.Get(0).([]string), args.Error(1)
+}
+func (m *MockLineupRepo) CreateReminder(ctx context.Context, reminder *domain.AvailabilityReminder) error {
+	return m.Called(ctx, reminder).Error(0)
+}
+
+type MockSquadRoster struct {
+	mock.Mock
+}
+
+func (m *MockSquadRoster) ListRosterUserIDs(ctx context.Context, clubID string, groupID uuid.UUID, date time.Time) ([]string, error) {
+	args := m.Called(ctx, clubID, groupID, date)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+type MockPlayerStatus struct {
+	mock.Mock
+}
+
+func (m *MockPlayerStatus) GetPlayerStatus(ctx context.Context, clubID, userID string) (application.PlayerStatusFlags, error) {
+	args := m.Called(ctx, clubID, userID)
+	return args.Get(0).(application.PlayerStatusFlags), args.Error(1)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) Send(ctx context.Context, n notificationSvc.Notification) error {
+	return m.Called(ctx, n).Error(0)
+}
+
+func TestLineupService(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	event := &domain.MatchEvent{ID: uuid.New(), TrainingGroupID: uuid.New(), OpponentName: "Rivals", MeetupTime: time.Now().Add(48 * time.Hour)}
+
+	ok := application.PlayerStatusFlags{FinancialStatus: "ACTIVE", MedicalStatus: "VALID", AttendanceRate: 0.9}
+	debtor := application.PlayerStatusFlags{FinancialStatus: "DEBTOR", MedicalStatus: "VALID", AttendanceRate: 0.9, IsInhabilitado: true}
+
+	setup := func() (*application.LineupService, *MockTeamRepo, *MockLineupRepo, *MockNotifier) {
+		teamRepo := new(MockTeamRepo)
+		lineups := new(MockLineupRepo)
+		roster := new(MockSquadRoster)
+		status := new(MockPlayerStatus)
+		notifier := new(MockNotifier)
+
+		teamRepo.On("GetMatchEvent", ctx, clubID, event.ID.String()).Return(event, nil)
+		roster.On("ListRosterUserIDs", ctx, clubID, event.TrainingGroupID, event.MeetupTime).Return([]string{"u1", "u2", "u3", "u4"}, nil)
+		teamRepo.On("GetEventAvailabilities", ctx, clubID, event.ID.String()).Return([]domain.PlayerAvailability{
+			{UserID: "u1", Status: domain.AvailabilityConfirmed},
+			{UserID: "u2", Status: domain.AvailabilityDeclined},
+			{UserID: "u3", Status: domain.AvailabilityConfirmed},
+			{UserID: "ex-1", Status: domain.AvailabilityConfirmed}, // Dejó el grupo después de responder
+		}, nil)
+		status.On("GetPlayerStatus", ctx, clubID, "u3").Return(debtor, nil)
+		status.On("GetPlayerStatus", ctx, clubID, mock.Anything).Return(ok, nil)
+
+		return application.NewLineupService(teamRepo, lineups, roster, status, notifier), teamRepo, lineups, notifier
+	}
+
+	t.Run("Propuesta con confirmados habilitados", func(t *testing.T) {
+		svc, _, lineups, _ := setup()
+		lineups.On("GetLineup", ctx, clubID, event.ID).Return(nil, nil)
+
+		proposal, err := svc.GetSquadProposal(ctx, clubID, event.ID, 18)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, proposal.Confirmed)
+		assert.Equal(t, 1, proposal.Declined)
+		assert.Equal(t, 1, proposal.Pending)
+		assert.Len(t, proposal.Candidates, 4, "solo el plantel del grupo")
+		assert.Equal(t, "u1", proposal.Candidates[0].UserID)
+		assert.True(t, proposal.Candidates[0].Suggested)
+		for _, c := range proposal.Candidates[1:] {
+			assert.False(t, c.Suggested, c.UserID)
+		}
+	})
+
+	t.Run("Rechaza jugadores que no pueden ser convocados", func(t *testing.T) {
+		svc, _, lineups, _ := setup()
+		lineups.On("GetLineup", ctx, clubID, event.ID).Return(nil, nil)
+
+		for user, expected := range map[string]error{
+			"u2":      domain.ErrPlayerDeclined,
+			"u3":      domain.ErrPlayerNotEligible,
+			"ajeno-1": domain.ErrPlayerNotInSquad,
+			"ex-1":    domain.ErrPlayerNotInSquad,
+		} {
+			_, err := svc.SaveLineup(ctx, application.SaveLineupInput{
+				ClubID:  clubID,
+				EventID: event.ID,
+				Players: []application.LineupPlayerInput{{UserID: user, Role: domain.LineupStarter}},
+			})
+			assert.ErrorIs(t, err, expected, user)
+		}
+		lineups.AssertNotCalled(t, "SaveLineup", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Guarda titulares y suplentes; el pendiente puede ser convocado", func(t *testing.T) {
+		svc, _, lineups, _ := setup()
+		lineups.On("GetLineup", ctx, clubID, event.ID).Return(nil, nil)
+		lineups.On("SaveLineup", ctx, mock.MatchedBy(func(l *domain.Lineup) bool {
+			return len(l.Players) == 2 && l.Status == domain.LineupDraft && l.Formation == "4-3-3"
+		})).Return(nil).Once()
+
+		lineup, err := svc.SaveLineup(ctx, application.SaveLineupInput{
+			ClubID:    clubID,
+			EventID:   event.ID,
+			Formation: "4-3-3",
+			Players: []application.LineupPlayerInput{
+				{UserID: "u1", Role: domain.LineupStarter, Position: "ARQ"},
+				{UserID: "u4", Role: domain.LineupSubstitute},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, event.ID, lineup.MatchEventID)
+		lineups.AssertExpectations(t)
+	})
+
+	t.Run("Cerrar notifica a cada convocado", func(t *testing.T) {
+		svc, _, lineups, notifier := setup()
+		draft := &domain.Lineup{ID: uuid.New(), MatchEventID: event.ID, Status: domain.LineupDraft, Players: []domain.LineupPlayer{
+			{UserID: "u1", Role: domain.LineupStarter, Position: "ARQ"},
+			{UserID: "u4", Role: domain.LineupSubstitute},
+		}}
+		lineups.On("GetLineup", ctx, clubID, event.ID).Return(draft, nil)
+		lineups.On("SaveLineup", ctx, mock.Anything).Return(nil).Once()
+		notifier.On("Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool {
+			return n.RecipientID == "u1" && strings.Contains(n.Body, "titular (ARQ)")
+		})).Return(nil).Once()
+		notifier.On("Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool {
+			return n.RecipientID == "u4"
+		})).Return(nil).Once()
+
+		lineup, err := svc.LockLineup(ctx, clubID, event.ID, "coach-1")
+		assert.NoError(t, err)
+		assert.True(t, lineup.IsLocked())
+		notifier.AssertExpectations(t)
+
+		_, err = svc.LockLineup(ctx, clubID, event.ID, "coach-1")
+		assert.ErrorIs(t, err, domain.ErrLineupLocked)
+	})
+}
@@ -10,12 +10,19 @@ import (
 	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
 )
 
+// DocumentEligibilityChecker evalúa la documentación exigida por las reglas del club
+// (lo implementa EligibilityService del módulo User)
+type DocumentEligibilityChecker interface {
+	ListIssues(ctx context.Context, clubID, userID string, scope userDomain.EligibilityScope) ([]userDomain.EligibilityIssue, error)
+}
+
 // PlayerStatusService maneja la lógica de negocio para obtener el estado unificado de jugadores
 type PlayerStatusService struct {
 	membershipRepo membershipDomain.MembershipRepository
 	documentRepo   userDomain.UserDocumentRepository
 	attendanceRepo attendanceDomain.AttendanceRepository
 	userRepo       userDomain.UserRepository
+	eligibility    DocumentEligibilityChecker
 }
 
 // NewPlayerStatusService crea una nueva instancia del servicio
@@ -33,12 +40,20 @@ func NewPlayerStatusService(
 	}
 }
 
+// SetEligibilityChecker hace que la documentación se evalúe con los requisitos configurables
+// (DNI, apto, seguro, ficha de liga, autorización parental) en lugar de solo el apto médico
+func (s *PlayerStatusService) SetEligibilityChecker(checker DocumentEligibilityChecker) {
+	s.eligibility = checker
+}
+
 // PlayerStatusFlags contiene los indicadores de estado de un jugador
 type PlayerStatusFlags struct {
-	FinancialStatus string  `json:"financial_status"` // "ACTIVE" | "DEBTOR"
-	MedicalStatus   string  `json:"medical_status"`   // "VALID" | "EXPIRED" | "MISSING"
-	AttendanceRate  float64 `json:"attendance_rate"`  // 0.0 - 1.0
-	IsInhabilitado  bool    `json:"is_inhabilitado"`  // true si tiene deuda O apto vencido
+	FinancialStatus     string                        `json:"financial_status"`               // "ACTIVE" | "DEBTOR"
+	MedicalStatus       string                        `json:"medical_status"`                 // "VALID" | "EXPIRED" | "MISSING"
+	DocumentationStatus string                        `json:"documentation_status,omitempty"` // "COMPLETE" | "INCOMPLETE" (con reglas de elegibilidad)
+	DocumentIssues      []userDomain.EligibilityIssue `json:"document_issues,omitempty"`
+	AttendanceRate      float64                       `json:"attendance_rate"` // 0.0 - 1.0
+	IsInhabilitado      bool                          `json:"is_inhabilitado"` // true si tiene deuda O apto vencido (o documentación incompleta)
 }
 
 // PlayerWithStatus representa un jugador con su estado unificado
@@ -47,8 +62,14 @@ type PlayerWithStatus struct {
 	StatusFlags PlayerStatusFlags `json:"status_flags"`
 }
 
-// GetPlayerStatus obtiene el estado unificado de un jugador
+// GetPlayerStatus obtiene el estado unificado de un jugador con la regla general del club
 func (s *PlayerStatusService) GetPlayerStatus(ctx context.Context, clubID, userID string) (PlayerStatusFlags, error) {
+	return s.GetPlayerStatusFor(ctx, clubID, userID, userDomain.EligibilityScope{})
+}
+
+// GetPlayerStatusFor obtiene el estado unificado de un jugador evaluando la documentación
+// con la regla de la disciplina y categoría indicadas
+func (s *PlayerStatusService) GetPlayerStatusFor(ctx context.Context, clubID, userID string, scope userDomain.EligibilityScope) (PlayerStatusFlags, error) {
 	var flags PlayerStatusFlags
 
 	// 1. Estado Financiero (desde Membership)
@@ -105,9 +126,27 @@ func (s *PlayerStatusService) GetPlayerStatus(ctx context.Context, clubID, userI
 	// 3. Tasa de Asistencia (último mes)
 	flags.AttendanceRate = s.calculateAttendanceRate(ctx, clubID, userID)
 
-	// 4. Regla de Inhabilitación
-	flags.IsInhabilitado = flags.FinancialStatus == "DEBTOR" ||
-		flags.MedicalStatus != "VALID"
+	// 4. Requisitos documentales configurados (reemplazan al control de apto en la inhabilitación)
+	if s.eligibility != nil {
+		issues, err := s.eligibility.ListIssues(ctx, clubID, userID, scope)
+		if err != nil {
+			return flags, err
+		}
+		flags.DocumentIssues = issues
+		flags.DocumentationStatus = "COMPLETE"
+		if len(issues) > 0 {
+			flags.DocumentationStatus = "INCOMPLETE"
+		}
+	}
+
+	// 5. Regla de Inhabilitación
+	if flags.DocumentationStatus != "" {
+		flags.IsInhabilitado = flags.FinancialStatus == "DEBTOR" ||
+			flags.DocumentationStatus != "COMPLETE"
+	} else {
+		flags.IsInhabilitado = flags.FinancialStatus == "DEBTOR" ||
+			flags.MedicalStatus != "VALID"
+	}
 
 	return flags, nil
 }
@@ -189,24 +228,35 @@ func (s *PlayerStatusService) GetPlayerIssues(ctx context.Context, clubID, userI
 		return nil, err
 	}
 
+	return status.Issues(), nil
+}
+
+// Issues describe los problemas que reflejan los indicadores
+func (f PlayerStatusFlags) Issues() []string {
 	issues := []string{}
 
-	if status.FinancialStatus == "DEBTOR" {
+	if f.FinancialStatus == "DEBTOR" {
 		issues = append(issues, "Tiene deuda pendiente")
 	}
 
-	switch status.MedicalStatus {
-	case "EXPIRED":
-		issues = append(issues, "Apto médico vencido")
-	case "MISSING":
-		issues = append(issues, "Apto médico faltante")
+	if f.DocumentationStatus != "" {
+		for _, issue := range f.DocumentIssues {
+			issues = append(issues, issue.Message)
+		}
+	} else {
+		switch f.MedicalStatus {
+		case "EXPIRED":
+			issues = append(issues, "Apto médico vencido")
+		case "MISSING":
+			issues = append(issues, "Apto médico faltante")
+		}
 	}
 
-	if status.AttendanceRate < 0.5 {
+	if f.AttendanceRate < 0.5 {
 		issues = append(issues, "Baja asistencia (menos del 50%)")
-	} else if status.AttendanceRate < 0.7 {
+	} else if f.AttendanceRate < 0.7 {
 		issues = append(issues, "Asistencia regular (menos del 70%)")
 	}
 
-	return issues, nil
+	return issues
 }
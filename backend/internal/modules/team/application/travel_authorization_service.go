@@ -0,0 +1,446 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jung-kurt/gofpdf"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// TravelAuthorizationService gestiona el permiso firmado por el padre/madre para que un menor viaje.
+// Sin autorización firmada el menor no puede confirmar su asistencia (ver TravelEventService.RespondToEvent).
+type TravelAuthorizationService struct {
+	eventRepo domain.TravelEventRepository
+	repo      domain.TravelAuthorizationRepository
+	userRepo  userDomain.UserRepository
+	notifier  notificationSvc.NotificationSender
+}
+
+// NewTravelAuthorizationService crea una nueva instancia del servicio
+func NewTravelAuthorizationService(
+	eventRepo domain.TravelEventRepository,
+	repo domain.TravelAuthorizationRepository,
+	userRepo userDomain.UserRepository,
+	notifier notificationSvc.NotificationSender,
+) *TravelAuthorizationService {
+	return &TravelAuthorizationService{
+		eventRepo: eventRepo,
+		repo:      repo,
+		userRepo:  userRepo,
+		notifier:  notifier,
+	}
+}
+
+// AuthorizationRequestResult resume el envío de pedidos de autorización
+type AuthorizationRequestResult struct {
+	Requested     []domain.TravelAuthorization `json:"requested"`
+	MissingParent []string                     `json:"missing_parent"` // Menores sin padre/madre vinculado
+}
+
+// RequestAuthorizations envía el pedido de autorización al padre/madre de cada menor.
+// Sin lista explícita se toman los invitados al evento que no rechazaron. Los mayores se ignoran.
+func (s *TravelAuthorizationService) RequestAuthorizations(ctx context.Context, clubID string, eventID uuid.UUID, userIDs []string, requestedBy string) (*AuthorizationRequestResult, error) {
+	event, err := s.getOpenEvent(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		rsvps, err := s.eventRepo.GetRSVPsByEventID(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		for _, rsvp := range rsvps {
+			if rsvp.Status != domain.RSVPStatusDeclined {
+				userIDs = append(userIDs, rsvp.UserID)
+			}
+		}
+	}
+
+	result := &AuthorizationRequestResult{
+		Requested:     []domain.TravelAuthorization{},
+		MissingParent: []string{},
+	}
+	for _, userID := range userIDs {
+		user, err := s.userRepo.GetByID(ctx, clubID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil || !user.IsMinor(event.DepartureDate) {
+			continue
+		}
+		if user.ParentID == nil {
+			result.MissingParent = append(result.MissingParent, userID)
+			continue
+		}
+		authorization, err := s.request(ctx, event, user, requestedBy)
+		if err != nil {
+			return nil, err
+		}
+		result.Requested = append(result.Requested, *authorization)
+	}
+	return result, nil
+}
+
+// AuthorizationDocument es la autorización junto con el texto que se firma
+type AuthorizationDocument struct {
+	Authorization *domain.TravelAuthorization `json:"authorization"`
+	Terms         string                      `json:"terms"`
+}
+
+// GetAuthorizationDocument devuelve el texto a firmar; solo lo ve el padre/madre del menor
+func (s *TravelAuthorizationService) GetAuthorizationDocument(ctx context.Context, clubID string, eventID uuid.UUID, minorID, parentID string) (*AuthorizationDocument, error) {
+	event, err := s.eventRepo.GetByID(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	authorization, err := s.getParentAuthorization(ctx, clubID, eventID, minorID, parentID)
+	if err != nil {
+		return nil, err
+	}
+	terms, err := s.terms(ctx, clubID, event, authorization)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthorizationDocument{Authorization: authorization, Terms: terms}, nil
+}
+
+// SignAuthorizationInput contiene la firma electrónica del padre/madre
+type SignAuthorizationInput struct {
+	ClubID      string    `json:"-"`
+	EventID     uuid.UUID `json:"-"`
+	MinorID     string    `json:"-"`
+	ParentID    string    `json:"-"`
+	SignedName  string    `json:"signed_name" binding:"required"`
+	AcceptTerms bool      `json:"accept_terms"`
+	IPAddress   string    `json:"-"`
+	UserAgent   string    `json:"-"`
+}
+
+// SignAuthorization registra la firma del padre/madre con IP y user agent, y avisa al organizador
+func (s *TravelAuthorizationService) SignAuthorization(ctx context.Context, input SignAuthorizationInput) (*domain.TravelAuthorization, error) {
+	if !input.AcceptTerms {
+		return nil, domain.ErrSignatureTermsNotAccepted
+	}
+	event, err := s.getOpenEvent(ctx, input.ClubID, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	authorization, err := s.getParentAuthorization(ctx, input.ClubID, input.EventID, input.MinorID, input.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	terms, err := s.terms(ctx, input.ClubID, event, authorization)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorization.Sign(input.SignedName, terms, input.IPAddress, input.UserAgent, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(ctx, authorization); err != nil {
+		return nil, err
+	}
+
+	s.notify(ctx, event.CreatedBy, "✍️ Autorización firmada",
+		fmt.Sprintf("%s firmó la autorización de viaje para \"%s\"", authorization.SignedName, event.Title))
+	return authorization, nil
+}
+
+// RevokeAuthorization deja sin efecto la firma. Si el menor había confirmado, su respuesta vuelve a pendiente.
+func (s *TravelAuthorizationService) RevokeAuthorization(ctx context.Context, clubID string, eventID uuid.UUID, minorID, parentID string) (*domain.TravelAuthorization, error) {
+	event, err := s.getOpenEvent(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	authorization, err := s.getParentAuthorization(ctx, clubID, eventID, minorID, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorization.Revoke(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(ctx, authorization); err != nil {
+		return nil, err
+	}
+
+	rsvp, err := s.eventRepo.GetRSVPByUserAndEvent(ctx, eventID, minorID)
+	if err == nil && rsvp != nil && rsvp.Status == domain.RSVPStatusConfirmed {
+		rsvp.Status = domain.RSVPStatusPending
+		rsvp.Notes = "Autorización de viaje revocada"
+		if err := s.eventRepo.UpdateRSVP(ctx, rsvp); err != nil {
+			return nil, err
+		}
+	}
+
+	s.notify(ctx, event.CreatedBy, "⚠️ Autorización revocada",
+		fmt.Sprintf("Se revocó la autorización de viaje de un participante para \"%s\"", event.Title))
+	return authorization, nil
+}
+
+// ListEventAuthorizations devuelve el estado de las autorizaciones de un evento (entrenador)
+func (s *TravelAuthorizationService) ListEventAuthorizations(ctx context.Context, clubID string, eventID uuid.UUID) ([]domain.TravelAuthorization, error) {
+	authorizations, err := s.repo.ListByEvent(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if authorizations == nil {
+		authorizations = []domain.TravelAuthorization{}
+	}
+	return authorizations, nil
+}
+
+// ListParentAuthorizations devuelve las autorizaciones pedidas a un padre/madre
+func (s *TravelAuthorizationService) ListParentAuthorizations(ctx context.Context, clubID, parentID string) ([]domain.TravelAuthorization, error) {
+	authorizations, err := s.repo.ListByParent(ctx, clubID, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if authorizations == nil {
+		authorizations = []domain.TravelAuthorization{}
+	}
+	return authorizations, nil
+}
+
+// CheckCanConfirm bloquea la confirmación de un menor sin autorización firmada.
+// Si todavía no se le pidió al padre/madre, el pedido se envía en ese momento.
+func (s *TravelAuthorizationService) CheckCanConfirm(ctx context.Context, clubID string, event *domain.TravelEvent, userID string) error {
+	user, err := s.userRepo.GetByID(ctx, clubID, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil || !user.IsMinor(event.DepartureDate) {
+		return nil
+	}
+	authorization, err := s.repo.Get(ctx, clubID, event.ID, userID)
+	if err != nil {
+		return err
+	}
+	if authorization != nil && authorization.IsSigned() {
+		return nil
+	}
+	if authorization == nil {
+		if user.ParentID == nil {
+			return fmt.Errorf("%w: %v", domain.ErrAuthorizationRequired, domain.ErrNoParentLinked)
+		}
+		if _, err := s.request(ctx, event, user, ""); err != nil {
+			return err
+		}
+	}
+	return domain.ErrAuthorizationRequired
+}
+
+// GetRoster arma el listado de confirmados con contactos de emergencia y cobertura médica
+func (s *TravelAuthorizationService) GetRoster(ctx context.Context, clubID string, eventID uuid.UUID) (*domain.TravelRoster, error) {
+	event, err := s.eventRepo.GetByID(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	rsvps, err := s.eventRepo.GetRSVPsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	var confirmed []string
+	for _, rsvp := range rsvps {
+		if rsvp.Status == domain.RSVPStatusConfirmed {
+			confirmed = append(confirmed, rsvp.UserID)
+		}
+	}
+
+	roster := &domain.TravelRoster{Event: event, Entries: []domain.TravelRosterEntry{}}
+	if len(confirmed) == 0 {
+		return roster, nil
+	}
+	users, err := s.userRepo.ListByIDs(ctx, clubID, confirmed)
+	if err != nil {
+		return nil, err
+	}
+	authorizations, err := s.repo.ListByEvent(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	signed := make(map[string]bool, len(authorizations))
+	for i := range authorizations {
+		signed[authorizations[i].MinorID] = authorizations[i].IsSigned()
+	}
+
+	for i := range users {
+		u := &users[i]
+		minor := u.IsMinor(event.DepartureDate)
+		roster.Entries = append(roster.Entries, domain.TravelRosterEntry{
+			UserID:                u.ID,
+			Name:                  u.Name,
+			DateOfBirth:           u.DateOfBirth,
+			IsMinor:               minor,
+			Authorized:            !minor || signed[u.ID],
+			EmergencyContactName:  u.EmergencyContactName,
+			EmergencyContactPhone: u.EmergencyContactPhone,
+			InsuranceProvider:     u.InsuranceProvider,
+			InsuranceNumber:       u.InsuranceNumber,
+		})
+	}
+	sort.Slice(roster.Entries, func(i, j int) bool { return roster.Entries[i].Name < roster.Entries[j].Name })
+	return roster, nil
+}
+
+// WriteRosterPDF escribe el listado imprimible de viajeros para el entrenador
+func (s *TravelAuthorizationService) WriteRosterPDF(roster *domain.TravelRoster, w io.Writer) error {
+	pdf := gofpdf.New("L", "mm", "A4", "")
+	pdf.SetMargins(10, 12, 10)
+	tr := pdf.UnicodeTranslatorFromDescriptor("") // Las fuentes core no soportan UTF-8
+	pdf.AddPage()
+
+	// Título
+	event := roster.Event
+	pdf.SetFont("Arial", "B", 16)
+	pdf.CellFormat(0, 10, tr("LISTADO DE VIAJEROS - "+event.Title), "", 1, "C", false, 0, "")
+	pdf.SetFont("Arial", "", 10)
+	subtitle := fmt.Sprintf("Destino: %s - Salida: %s", event.Destination, event.DepartureDate.Format("02/01/2006 15:04"))
+	if event.MeetingPoint != "" {
+		subtitle += " - Encuentro: " + event.MeetingPoint
+	}
+	pdf.CellFormat(0, 6, tr(subtitle), "", 1, "C", false, 0, "")
+	pdf.Ln(6)
+
+	pdf.SetFont("Arial", "B", 9)
+	pdf.SetFillColor(200, 200, 200)
+	pdf.CellFormat(10, 8, tr("N°"), "1", 0, "C", true, 0, "")
+	pdf.CellFormat(60, 8, "Apellido y Nombre", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(24, 8, "Fecha Nac.", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(55, 8, "Contacto de Emergencia", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(32, 8, tr("Teléfono"), "1", 0, "C", true, 0, "")
+	pdf.CellFormat(66, 8, tr("Obra Social / N° Afiliado"), "1", 0, "C", true, 0, "")
+	pdf.CellFormat(30, 8, tr("Autorización"), "1", 1, "C", true, 0, "")
+
+	pdf.SetFont("Arial", "", 9)
+	pending := 0
+	for i, entry := range roster.Entries {
+		birthDate := "-"
+		if entry.DateOfBirth != nil {
+			birthDate = entry.DateOfBirth.Format("02/01/2006")
+		}
+		insurance := entry.InsuranceProvider
+		if entry.InsuranceNumber != "" {
+			insurance += " / " + entry.InsuranceNumber
+		}
+		authorization := "Mayor"
+		if entry.IsMinor {
+			authorization = "Firmada"
+			if !entry.Authorized {
+				authorization = "PENDIENTE"
+				pending++
+			}
+		}
+
+		pdf.CellFormat(10, 7, fmt.Sprintf("%d", i+1), "1", 0, "C", false, 0, "")
+		pdf.CellFormat(60, 7, tr(entry.Name), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(24, 7, birthDate, "1", 0, "C", false, 0, "")
+		pdf.CellFormat(55, 7, tr(valueOrDash(entry.EmergencyContactName)), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(32, 7, tr(valueOrDash(entry.EmergencyContactPhone)), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(66, 7, tr(valueOrDash(insurance)), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(30, 7, tr(authorization), "1", 1, "C", false, 0, "")
+	}
+
+	pdf.Ln(6)
+	pdf.SetFont("Arial", "I", 8)
+	pdf.CellFormat(0, 5, tr(fmt.Sprintf("Total de viajeros: %d - Autorizaciones pendientes: %d - Generado el %s",
+		len(roster.Entries), pending, time.Now().Format("02/01/2006 15:04"))), "", 1, "L", false, 0, "")
+
+	return pdf.Output(w)
+}
+
+func (s *TravelAuthorizationService) request(ctx context.Context, event *domain.TravelEvent, minor *userDomain.User, requestedBy string) (*domain.TravelAuthorization, error) {
+	existing, err := s.repo.Get(ctx, event.ClubID, event.ID, minor.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	authorization := &domain.TravelAuthorization{
+		ID:          uuid.New(),
+		ClubID:      event.ClubID,
+		EventID:     event.ID,
+		MinorID:     minor.ID,
+		ParentID:    *minor.ParentID,
+		Status:      domain.TravelAuthorizationPending,
+		RequestedBy: requestedBy,
+		RequestedAt: time.Now(),
+	}
+	if err := s.repo.Save(ctx, authorization); err != nil {
+		return nil, err
+	}
+	s.notify(ctx, authorization.ParentID, "🧳 Autorización de viaje",
+		fmt.Sprintf("%s necesita tu autorización firmada para viajar a \"%s\" (%s)", minor.Name, event.Title, event.DepartureDate.Format("02/01")))
+	return authorization, nil
+}
+
+func (s *TravelAuthorizationService) getParentAuthorization(ctx context.Context, clubID string, eventID uuid.UUID, minorID, parentID string) (*domain.TravelAuthorization, error) {
+	authorization, err := s.repo.Get(ctx, clubID, eventID, minorID)
+	if err != nil {
+		return nil, err
+	}
+	if authorization == nil {
+		return nil, domain.ErrAuthorizationNotFound
+	}
+	if authorization.ParentID != parentID {
+		return nil, domain.ErrNotParentOfParticipant
+	}
+	return authorization, nil
+}
+
+func (s *TravelAuthorizationService) terms(ctx context.Context, clubID string, event *domain.TravelEvent, authorization *domain.TravelAuthorization) (string, error) {
+	minor, err := s.userRepo.GetByID(ctx, clubID, authorization.MinorID)
+	if err != nil {
+		return "", err
+	}
+	parent, err := s.userRepo.GetByID(ctx, clubID, authorization.ParentID)
+	if err != nil {
+		return "", err
+	}
+	if minor == nil || parent == nil {
+		return "", errors.New("usuario no encontrado")
+	}
+	return domain.AuthorizationTerms(event, minor.Name, parent.Name), nil
+}
+
+func (s *TravelAuthorizationService) getOpenEvent(ctx context.Context, clubID string, eventID uuid.UUID) (*domain.TravelEvent, error) {
+	event, err := s.eventRepo.GetByID(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !event.IsOpen() {
+		return nil, domain.ErrEventDeparted
+	}
+	return event, nil
+}
+
+func (s *TravelAuthorizationService) notify(ctx context.Context, recipientID, title, body string) {
+	if s.notifier == nil || recipientID == "" {
+		return
+	}
+	err := s.notifier.Send(ctx, notificationSvc.Notification{
+		RecipientID: recipientID,
+		Type:        notificationSvc.NotificationTypePush,
+		Title:       title,
+		Body:        body,
+	})
+	if err != nil {
+		log.Printf("[TravelAuthorizationService] error notificando a %s: %v", recipientID, err)
+	}
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
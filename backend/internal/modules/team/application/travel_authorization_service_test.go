@@ -0,0 +1,171 @@
+package application_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTravelAuthorizationRepo struct {
+	mock.Mock
+}
+
+func (m *MockTravelAuthorizationRepo) Save(ctx context.Context, authorization *domain.TravelAuthorization) error {
+	return m.Called(ctx, authorization).Error(0)
+}
+func (m *MockTravelAuthorizationRepo) Get(ctx context.Context, clubID string, eventID uuid.UUID, minorID string) (*domain.TravelAuthorization, error) {
+	args := m.Called(ctx, clubID, eventID, minorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TravelAuthorization), args.Error(1)
+}
+func (m *MockTravelAuthorizationRepo) ListByEvent(ctx context.Context, clubID string, eventID uuid.UUID) ([]domain.TravelAuthorization, error) {
+	args := m.Called(ctx, clubID, eventID)
+	return args.Get(0).([]domain.TravelAuthorization), args.Error(1)
+}
+func (m *MockTravelAuthorizationRepo) ListByParent(ctx context.Context, clubID, parentID string) ([]domain.TravelAuthorization, error) {
+	args := m.Called(ctx, clubID, parentID)
+	return args.Get(0).([]domain.TravelAuthorization), args.Error(1)
+}
+
+func TestTravelAuthorizationService(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	event := &domain.TravelEvent{ID: uuid.New(), ClubID: clubID, Title: "Viaje a Rosario", Destination: "Rosario", CreatedBy: "coach-1", DepartureDate: time.Now().Add(72 * time.Hour)}
+	birth := time.Now().AddDate(-12, 0, 0)
+	adultBirth := time.Now().AddDate(-30, 0, 0)
+	parentID := "parent-1"
+	minor := &userDomain.User{ID: "kid-1", Name: "Juan Pérez", DateOfBirth: &birth, ParentID: &parentID, EmergencyContactName: "Ana Pérez", EmergencyContactPhone: "11-5555-0000", InsuranceProvider: "OSDE"}
+	parent := &userDomain.User{ID: parentID, Name: "Ana Pérez", DateOfBirth: &adultBirth}
+	adult := &userDomain.User{ID: "adult-1", Name: "Carlos Gómez", DateOfBirth: &adultBirth}
+
+	setup := func() (*application.TravelAuthorizationService, *MockTravelEventRepo, *MockTravelAuthorizationRepo, *MockUserRepo, *MockNotifier) {
+		events := new(MockTravelEventRepo)
+		repo := new(MockTravelAuthorizationRepo)
+		users := new(MockUserRepo)
+		notifier := new(MockNotifier)
+		events.On("GetByID", ctx, clubID, event.ID).Return(event, nil)
+		users.On("GetByID", ctx, clubID, minor.ID).Return(minor, nil)
+		users.On("GetByID", ctx, clubID, parentID).Return(parent, nil)
+		users.On("GetByID", ctx, clubID, adult.ID).Return(adult, nil)
+		return application.NewTravelAuthorizationService(events, repo, users, notifier), events, repo, users, notifier
+	}
+
+	t.Run("El menor sin autorización no puede confirmar y se le pide al padre", func(t *testing.T) {
+		svc, events, repo, _, notifier := setup()
+		repo.On("Get", ctx, clubID, event.ID, minor.ID).Return(nil, nil)
+		repo.On("Save", ctx, mock.MatchedBy(func(a *domain.TravelAuthorization) bool {
+			return a.MinorID == minor.ID && a.ParentID == parentID && a.Status == domain.TravelAuthorizationPending
+		})).Return(nil).Once()
+		notifier.On("Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool {
+			return n.RecipientID == parentID
+		})).Return(nil).Once()
+
+		eventService := application.NewTravelEventService(events, svc)
+		err := eventService.RespondToEvent(ctx, clubID, event.ID, minor.ID, domain.RSVPStatusConfirmed, "")
+		assert.ErrorIs(t, err, domain.ErrAuthorizationRequired)
+		events.AssertNotCalled(t, "CreateRSVP", mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("Los mayores y las respuestas que no confirman no se bloquean", func(t *testing.T) {
+		svc, events, repo, _, _ := setup()
+		events.On("GetRSVPByUserAndEvent", ctx, event.ID, mock.Anything).Return(nil, nil)
+		events.On("CreateRSVP", ctx, mock.Anything).Return(nil).Twice()
+
+		eventService := application.NewTravelEventService(events, svc)
+		assert.NoError(t, eventService.RespondToEvent(ctx, clubID, event.ID, adult.ID, domain.RSVPStatusConfirmed, ""))
+		assert.NoError(t, eventService.RespondToEvent(ctx, clubID, event.ID, minor.ID, domain.RSVPStatusDeclined, ""))
+		repo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Firma del padre con IP y user agent; luego el menor confirma", func(t *testing.T) {
+		svc, events, repo, _, notifier := setup()
+		pending := &domain.TravelAuthorization{ID: uuid.New(), ClubID: clubID, EventID: event.ID, MinorID: minor.ID, ParentID: parentID, Status: domain.TravelAuthorizationPending}
+		repo.On("Get", ctx, clubID, event.ID, minor.ID).Return(pending, nil)
+		repo.On("Save", ctx, pending).Return(nil).Once()
+		notifier.On("Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool {
+			return n.RecipientID == event.CreatedBy
+		})).Return(nil).Once()
+
+		input := application.SignAuthorizationInput{
+			ClubID: clubID, EventID: event.ID, MinorID: minor.ID, ParentID: "otro-adulto",
+			SignedName: "Ana Pérez", AcceptTerms: true, IPAddress: "10.0.0.1", UserAgent: "Mozilla/5.0",
+		}
+		_, err := svc.SignAuthorization(ctx, input)
+		assert.ErrorIs(t, err, domain.ErrNotParentOfParticipant)
+
+		input.ParentID = parentID
+		input.AcceptTerms = false
+		_, err = svc.SignAuthorization(ctx, input)
+		assert.ErrorIs(t, err, domain.ErrSignatureTermsNotAccepted)
+
+		input.AcceptTerms = true
+		signed, err := svc.SignAuthorization(ctx, input)
+		assert.NoError(t, err)
+		assert.True(t, signed.IsSigned())
+		assert.Equal(t, "10.0.0.1", signed.IPAddress)
+		assert.Equal(t, "Mozilla/5.0", signed.UserAgent)
+		terms := domain.AuthorizationTerms(event, minor.Name, parent.Name)
+		assert.Equal(t, domain.HashAuthorizationTerms(terms), signed.TermsHash)
+		notifier.AssertExpectations(t)
+
+		events.On("GetRSVPByUserAndEvent", ctx, event.ID, minor.ID).Return(nil, nil)
+		events.On("CreateRSVP", ctx, mock.Anything).Return(nil).Once()
+		eventService := application.NewTravelEventService(events, svc)
+		assert.NoError(t, eventService.RespondToEvent(ctx, clubID, event.ID, minor.ID, domain.RSVPStatusConfirmed, ""))
+	})
+
+	t.Run("Revocar vuelve a pendiente la confirmación del menor", func(t *testing.T) {
+		svc, events, repo, _, notifier := setup()
+		now := time.Now()
+		signed := &domain.TravelAuthorization{ID: uuid.New(), ClubID: clubID, EventID: event.ID, MinorID: minor.ID, ParentID: parentID, Status: domain.TravelAuthorizationSigned, SignedAt: &now}
+		repo.On("Get", ctx, clubID, event.ID, minor.ID).Return(signed, nil)
+		repo.On("Save", ctx, signed).Return(nil).Once()
+		rsvp := &domain.EventRSVP{EventID: event.ID, UserID: minor.ID, Status: domain.RSVPStatusConfirmed}
+		events.On("GetRSVPByUserAndEvent", ctx, event.ID, minor.ID).Return(rsvp, nil)
+		events.On("UpdateRSVP", ctx, rsvp).Return(nil).Once()
+		notifier.On("Send", ctx, mock.Anything).Return(nil)
+
+		revoked, err := svc.RevokeAuthorization(ctx, clubID, event.ID, minor.ID, parentID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.TravelAuthorizationRevoked, revoked.Status)
+		assert.Equal(t, domain.RSVPStatusPending, rsvp.Status)
+		events.AssertExpectations(t)
+	})
+
+	t.Run("Listado de viajeros con contactos de emergencia en PDF", func(t *testing.T) {
+		svc, events, repo, users, _ := setup()
+		events.On("GetRSVPsByEventID", ctx, event.ID).Return([]domain.EventRSVP{
+			{UserID: minor.ID, Status: domain.RSVPStatusConfirmed},
+			{UserID: adult.ID, Status: domain.RSVPStatusConfirmed},
+			{UserID: "u9", Status: domain.RSVPStatusDeclined},
+		}, nil)
+		users.On("ListByIDs", ctx, clubID, []string{minor.ID, adult.ID}).Return([]userDomain.User{*minor, *adult}, nil)
+		repo.On("ListByEvent", ctx, clubID, event.ID).Return([]domain.TravelAuthorization{}, nil)
+
+		roster, err := svc.GetRoster(ctx, clubID, event.ID)
+		assert.NoError(t, err)
+		assert.Len(t, roster.Entries, 2)
+		assert.Equal(t, adult.ID, roster.Entries[0].UserID)
+		assert.True(t, roster.Entries[0].Authorized)
+		assert.True(t, roster.Entries[1].IsMinor)
+		assert.False(t, roster.Entries[1].Authorized)
+		assert.Equal(t, "11-5555-0000", roster.Entries[1].EmergencyContactPhone)
+
+		var buf bytes.Buffer
+		assert.NoError(t, svc.WriteRosterPDF(roster, &buf))
+		assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF")))
+	})
+}
@@ -10,15 +10,23 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// TravelParticipationGuard valida si un usuario puede confirmar su asistencia a un viaje
+// (lo implementa TravelAuthorizationService para los menores sin autorización firmada)
+type TravelParticipationGuard interface {
+	CheckCanConfirm(ctx context.Context, clubID string, event *domain.TravelEvent, userID string) error
+}
+
 // TravelEventService maneja la lógica de negocio para eventos de viaje
 type TravelEventService struct {
 	eventRepo domain.TravelEventRepository
+	guard     TravelParticipationGuard
 }
 
 // NewTravelEventService crea una nueva instancia del servicio
-func NewTravelEventService(eventRepo domain.TravelEventRepository) *TravelEventService {
+func NewTravelEventService(eventRepo domain.TravelEventRepository, guard TravelParticipationGuard) *TravelEventService {
 	return &TravelEventService{
 		eventRepo: eventRepo,
+		guard:     guard,
 	}
 }
 
@@ -46,8 +54,19 @@ func (s *TravelEventService) GetUpcomingEvents(ctx context.Context, clubID strin
 	return s.eventRepo.GetUpcoming(ctx, clubID, teamID)
 }
 
-// RespondToEvent registra la respuesta de un usuario a un evento
-func (s *TravelEventService) RespondToEvent(ctx context.Context, eventID uuid.UUID, userID string, status domain.RSVPStatus, notes string) error {
+// RespondToEvent registra la respuesta de un usuario a un evento.
+// Un menor solo puede confirmar con la autorización de viaje firmada por su padre/madre.
+func (s *TravelEventService) RespondToEvent(ctx context.Context, clubID string, eventID uuid.UUID, userID string, status domain.RSVPStatus, notes string) error {
+	if status == domain.RSVPStatusConfirmed && s.guard != nil {
+		event, err := s.eventRepo.GetByID(ctx, clubID, eventID)
+		if err != nil {
+			return err
+		}
+		if err := s.guard.CheckCanConfirm(ctx, clubID, event, userID); err != nil {
+			return err
+		}
+	}
+
 	// Verificar si ya existe una respuesta
 	existingRSVP, err := s.eventRepo.GetRSVPByUserAndEvent(ctx, eventID, userID)
 
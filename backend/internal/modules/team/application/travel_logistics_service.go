@@ -0,0 +1,488 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	paymentDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/payment/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// maxVehicleSeats limita los lugares que puede ofrecer un conductor
+const maxVehicleSeats = 8
+
+// TravelPaymentGateway abstrae el cobro de los cargos de viaje (módulo Payment)
+type TravelPaymentGateway interface {
+	CreateChargeCheckout(ctx context.Context, charge *domain.TravelCharge, payerID, payerEmail, description string) (paymentID uuid.UUID, checkoutURL string, err error)
+	RecordOfflineChargePayment(ctx context.Context, charge *domain.TravelCharge, method paymentDomain.PaymentMethod, notes string) (uuid.UUID, error)
+	GetChargePayment(ctx context.Context, clubID string, paymentID uuid.UUID) (*paymentDomain.Payment, error)
+}
+
+// TravelLogisticsService gestiona el cobro de los viajes y el carpooling.
+// Cada confirmado recibe un cargo por el costo per cápita; los pagos se acreditan vía
+// OnPaymentStatusChanged, tanto los online como los registrados en secretaría.
+type TravelLogisticsService struct {
+	eventRepo domain.TravelEventRepository
+	repo      domain.TravelLogisticsRepository
+	payments  TravelPaymentGateway
+	userRepo  userDomain.UserRepository
+	notifier  notificationSvc.NotificationSender
+}
+
+// NewTravelLogisticsService crea una nueva instancia del servicio
+func NewTravelLogisticsService(
+	eventRepo domain.TravelEventRepository,
+	repo domain.TravelLogisticsRepository,
+	payments TravelPaymentGateway,
+	userRepo userDomain.UserRepository,
+	notifier notificationSvc.NotificationSender,
+) *TravelLogisticsService {
+	return &TravelLogisticsService{
+		eventRepo: eventRepo,
+		repo:      repo,
+		payments:  payments,
+		userRepo:  userRepo,
+		notifier:  notifier,
+	}
+}
+
+// GenerateCharges crea o actualiza el cargo de cada confirmado con el costo per cápita vigente.
+// Los cargos pagados no se tocan y los de quienes dejaron de estar confirmados se anulan.
+func (s *TravelLogisticsService) GenerateCharges(ctx context.Context, clubID string, eventID uuid.UUID) (*domain.ChargeTracker, error) {
+	event, err := s.eventRepo.GetByID(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	confirmed, err := s.confirmedUsers(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	cost := event.CalculateCostPerPerson(len(confirmed))
+	if !cost.IsPositive() {
+		return nil, domain.ErrNoCostDefined
+	}
+	event.CostPerPerson = cost
+	if err := s.eventRepo.Update(ctx, event); err != nil {
+		return nil, err
+	}
+
+	charges, err := s.repo.ListCharges(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	byUser := make(map[string]*domain.TravelCharge, len(charges))
+	for i := range charges {
+		byUser[charges[i].UserID] = &charges[i]
+	}
+
+	isConfirmed := make(map[string]bool, len(confirmed))
+	for _, userID := range confirmed {
+		isConfirmed[userID] = true
+		charge, ok := byUser[userID]
+		if !ok {
+			charge = &domain.TravelCharge{
+				ID:      uuid.New(),
+				ClubID:  clubID,
+				EventID: eventID,
+				UserID:  userID,
+				Amount:  cost,
+				Status:  domain.TravelChargePending,
+			}
+			if err := s.repo.CreateCharge(ctx, charge); err != nil {
+				return nil, err
+			}
+			s.notify(ctx, userID, "💳 Costo del viaje", fmt.Sprintf("Tu parte del viaje \"%s\" es $%s", event.Title, cost.StringFixed(2)))
+			continue
+		}
+		if charge.Status == domain.TravelChargePaid {
+			continue
+		}
+		if charge.Status == domain.TravelChargeCancelled || !charge.Amount.Equal(cost) {
+			charge.Status = domain.TravelChargePending
+			charge.Amount = cost
+			charge.PaymentID = nil // El checkout anterior era por otro monto, hay que generar uno nuevo
+			if err := s.repo.UpdateCharge(ctx, charge); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for i := range charges {
+		charge := &charges[i]
+		if !isConfirmed[charge.UserID] && charge.Status == domain.TravelChargePending {
+			charge.Status = domain.TravelChargeCancelled
+			if err := s.repo.UpdateCharge(ctx, charge); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return s.GetChargeTracker(ctx, clubID, eventID)
+}
+
+// GetChargeTracker muestra quién pagó y cuánto se recaudó
+func (s *TravelLogisticsService) GetChargeTracker(ctx context.Context, clubID string, eventID uuid.UUID) (*domain.ChargeTracker, error) {
+	event, err := s.eventRepo.GetByID(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	charges, err := s.repo.ListCharges(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if charges == nil {
+		charges = []domain.TravelCharge{}
+	}
+	return domain.BuildChargeTracker(event, charges), nil
+}
+
+// CheckoutCharge genera el link de pago online; puede pagarlo el participante o su padre/madre
+func (s *TravelLogisticsService) CheckoutCharge(ctx context.Context, clubID string, eventID, chargeID uuid.UUID, payerID string) (string, error) {
+	charge, err := s.getPayableCharge(ctx, clubID, eventID, chargeID)
+	if err != nil {
+		return "", err
+	}
+	payer, err := s.userRepo.GetByID(ctx, clubID, payerID)
+	if err != nil {
+		return "", err
+	}
+	if payer == nil {
+		return "", errors.New("usuario no encontrado")
+	}
+	if payerID != charge.UserID {
+		if err := s.checkParent(ctx, clubID, payerID, charge.UserID); err != nil {
+			return "", err
+		}
+	}
+
+	paymentID, url, err := s.payments.CreateChargeCheckout(ctx, charge, payerID, payer.Email, "Viaje - cuota por participante")
+	if err != nil {
+		return "", err
+	}
+	charge.PaymentID = &paymentID
+	if err := s.repo.UpdateCharge(ctx, charge); err != nil {
+		return "", err
+	}
+	return url, nil
+}
+
+// RecordOfflinePayment registra un pago en efectivo o transferencia por el monto del cargo
+func (s *TravelLogisticsService) RecordOfflinePayment(ctx context.Context, clubID string, eventID, chargeID uuid.UUID, method paymentDomain.PaymentMethod, notes string) (*domain.TravelCharge, error) {
+	charge, err := s.getPayableCharge(ctx, clubID, eventID, chargeID)
+	if err != nil {
+		return nil, err
+	}
+	if method == "" {
+		method = paymentDomain.PaymentMethodCash
+	}
+	paymentID, err := s.payments.RecordOfflineChargePayment(ctx, charge, method, notes)
+	if err != nil {
+		return nil, err
+	}
+	// El aviso sincrónico del módulo Payment llega antes de conocer el ID del pago y se ignora;
+	// el cargo se acredita acá contra el pago recién registrado
+	charge.PaymentID = &paymentID
+	if err := s.applyPayment(ctx, charge, paymentDomain.PaymentStatusCompleted); err != nil {
+		return nil, err
+	}
+	return s.repo.GetCharge(ctx, clubID, chargeID)
+}
+
+// OnPaymentStatusChanged acredita o revierte el cargo cuando cambia el estado del pago.
+// Solo cuenta el pago registrado en el cargo (PaymentID) y por su monto: el aviso de otro pago
+// de la misma referencia, uno por otro monto o sobre un cargo que no está pendiente no acredita nada.
+func (s *TravelLogisticsService) OnPaymentStatusChanged(ctx context.Context, clubID string, referenceID uuid.UUID, status paymentDomain.PaymentStatus) error {
+	charge, err := s.repo.GetCharge(ctx, clubID, referenceID)
+	if err != nil {
+		return err
+	}
+	if charge == nil {
+		return domain.ErrChargeNotFound
+	}
+	return s.applyPayment(ctx, charge, status)
+}
+
+func (s *TravelLogisticsService) applyPayment(ctx context.Context, charge *domain.TravelCharge, status paymentDomain.PaymentStatus) error {
+	if charge.PaymentID == nil {
+		return nil
+	}
+	payment, err := s.payments.GetChargePayment(ctx, charge.ClubID, *charge.PaymentID)
+	if err != nil {
+		return err
+	}
+	if payment == nil || payment.Status != status {
+		return nil // El aviso corresponde a otro pago de la misma referencia
+	}
+
+	switch status {
+	case paymentDomain.PaymentStatusCompleted:
+		if charge.Status != domain.TravelChargePending {
+			return nil
+		}
+		if !payment.Amount.Equal(charge.Amount) {
+			log.Printf("[TravelLogistics] Payment %s of %s does not match charge %s of %s, not credited",
+				payment.ID, payment.Amount.StringFixed(2), charge.ID, charge.Amount.StringFixed(2))
+			return nil
+		}
+		now := time.Now()
+		charge.Status = domain.TravelChargePaid
+		charge.PaidAt = &now
+	case paymentDomain.PaymentStatusRefunded:
+		if charge.Status != domain.TravelChargePaid {
+			return nil
+		}
+		charge.Status = domain.TravelChargePending
+		charge.PaidAt = nil
+	default:
+		return nil
+	}
+	return s.repo.UpdateCharge(ctx, charge)
+}
+
+// OfferVehicleInput contiene los datos del vehículo que ofrece un conductor
+type OfferVehicleInput struct {
+	Seats       int    `json:"seats" binding:"required,min=1"`
+	Description string `json:"description"`
+}
+
+// OfferVehicle registra los lugares que ofrece un conductor (uno por evento)
+func (s *TravelLogisticsService) OfferVehicle(ctx context.Context, clubID string, eventID uuid.UUID, driverID string, input OfferVehicleInput) (*domain.TravelVehicle, error) {
+	if input.Seats < 1 || input.Seats > maxVehicleSeats {
+		return nil, fmt.Errorf("se pueden ofrecer entre 1 y %d lugares", maxVehicleSeats)
+	}
+	if _, err := s.getOpenEvent(ctx, clubID, eventID); err != nil {
+		return nil, err
+	}
+	vehicles, err := s.repo.ListVehicles(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range vehicles {
+		if v.DriverID == driverID {
+			return nil, domain.ErrVehicleExists
+		}
+	}
+
+	vehicle := &domain.TravelVehicle{
+		ID:          uuid.New(),
+		ClubID:      clubID,
+		EventID:     eventID,
+		DriverID:    driverID,
+		Seats:       input.Seats,
+		Description: input.Description,
+		Passengers:  []domain.TravelPassenger{},
+	}
+	if err := s.repo.CreateVehicle(ctx, vehicle); err != nil {
+		return nil, err
+	}
+	return vehicle, nil
+}
+
+// RemoveVehicle retira un vehículo; solo el conductor o el staff. Sus pasajeros quedan sin asignar.
+func (s *TravelLogisticsService) RemoveVehicle(ctx context.Context, clubID string, eventID, vehicleID uuid.UUID, userID string, isStaff bool) error {
+	vehicle, err := s.getVehicle(ctx, clubID, eventID, vehicleID)
+	if err != nil {
+		return err
+	}
+	if !isStaff && vehicle.DriverID != userID {
+		return errors.New("solo el conductor puede retirar su vehículo")
+	}
+	return s.repo.DeleteVehicle(ctx, clubID, vehicleID)
+}
+
+// AssignPassenger sube a un confirmado a un vehículo (o lo cambia de vehículo).
+// Si es menor, queda pendiente de la autorización de su padre/madre, a quien se avisa.
+func (s *TravelLogisticsService) AssignPassenger(ctx context.Context, clubID string, eventID, vehicleID uuid.UUID, userID string) (*domain.TravelPassenger, error) {
+	event, err := s.getOpenEvent(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	vehicle, err := s.getVehicle(ctx, clubID, eventID, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	rsvp, err := s.eventRepo.GetRSVPByUserAndEvent(ctx, eventID, userID)
+	if err != nil || rsvp == nil || rsvp.Status != domain.RSVPStatusConfirmed {
+		return nil, domain.ErrNotConfirmed
+	}
+
+	existing, err := s.repo.GetPassenger(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.VehicleID == vehicleID {
+		return existing, nil
+	}
+	if vehicle.FreeSeats() <= 0 {
+		return nil, domain.ErrVehicleFull
+	}
+
+	user, err := s.userRepo.GetByID(ctx, clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("usuario no encontrado")
+	}
+
+	// La autorización es para viajar con un conductor en particular: al cambiar de vehículo se vuelve a pedir
+	passenger := &domain.TravelPassenger{
+		ID:                    uuid.New(),
+		EventID:               eventID,
+		VehicleID:             vehicleID,
+		UserID:                userID,
+		RequiresAuthorization: user.IsMinor(event.DepartureDate),
+	}
+	if err := s.repo.SavePassenger(ctx, passenger); err != nil {
+		return nil, err
+	}
+
+	if passenger.RequiresAuthorization && user.ParentID != nil {
+		s.notify(ctx, *user.ParentID, "🚗 Autorización de traslado",
+			fmt.Sprintf("%s fue asignado/a a un vehículo para el viaje \"%s\". Autorizá el traslado antes de la salida.", user.Name, event.Title))
+	}
+	return passenger, nil
+}
+
+// UnassignPassenger baja a un participante de su vehículo
+func (s *TravelLogisticsService) UnassignPassenger(ctx context.Context, clubID string, eventID uuid.UUID, userID string) error {
+	if _, err := s.getOpenEvent(ctx, clubID, eventID); err != nil {
+		return err
+	}
+	passenger, err := s.repo.GetPassenger(ctx, eventID, userID)
+	if err != nil {
+		return err
+	}
+	if passenger == nil {
+		return domain.ErrPassengerNotFound
+	}
+	return s.repo.DeletePassenger(ctx, eventID, userID)
+}
+
+// AuthorizePassenger registra la autorización del padre/madre para el traslado de un menor
+func (s *TravelLogisticsService) AuthorizePassenger(ctx context.Context, clubID string, eventID uuid.UUID, userID, parentID string) (*domain.TravelPassenger, error) {
+	if _, err := s.getOpenEvent(ctx, clubID, eventID); err != nil {
+		return nil, err
+	}
+	passenger, err := s.repo.GetPassenger(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if passenger == nil {
+		return nil, domain.ErrPassengerNotFound
+	}
+	if err := s.checkParent(ctx, clubID, parentID, userID); err != nil {
+		return nil, err
+	}
+	if passenger.AuthorizedAt != nil {
+		return passenger, nil
+	}
+
+	now := time.Now()
+	passenger.AuthorizedBy = parentID
+	passenger.AuthorizedAt = &now
+	if err := s.repo.SavePassenger(ctx, passenger); err != nil {
+		return nil, err
+	}
+	return passenger, nil
+}
+
+// GetCarpoolPlan muestra vehículos, pasajeros, confirmados sin lugar y autorizaciones pendientes
+func (s *TravelLogisticsService) GetCarpoolPlan(ctx context.Context, clubID string, eventID uuid.UUID) (*domain.CarpoolPlan, error) {
+	if _, err := s.eventRepo.GetByID(ctx, clubID, eventID); err != nil {
+		return nil, err
+	}
+	vehicles, err := s.repo.ListVehicles(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if vehicles == nil {
+		vehicles = []domain.TravelVehicle{}
+	}
+	confirmed, err := s.confirmedUsers(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.BuildCarpoolPlan(eventID, vehicles, confirmed), nil
+}
+
+func (s *TravelLogisticsService) confirmedUsers(ctx context.Context, eventID uuid.UUID) ([]string, error) {
+	rsvps, err := s.eventRepo.GetRSVPsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	confirmed := make([]string, 0, len(rsvps))
+	for _, rsvp := range rsvps {
+		if rsvp.Status == domain.RSVPStatusConfirmed {
+			confirmed = append(confirmed, rsvp.UserID)
+		}
+	}
+	return confirmed, nil
+}
+
+func (s *TravelLogisticsService) getPayableCharge(ctx context.Context, clubID string, eventID, chargeID uuid.UUID) (*domain.TravelCharge, error) {
+	charge, err := s.repo.GetCharge(ctx, clubID, chargeID)
+	if err != nil {
+		return nil, err
+	}
+	if charge == nil || charge.EventID != eventID {
+		return nil, domain.ErrChargeNotFound
+	}
+	if err := charge.CanBePaid(); err != nil {
+		return nil, err
+	}
+	return charge, nil
+}
+
+func (s *TravelLogisticsService) getOpenEvent(ctx context.Context, clubID string, eventID uuid.UUID) (*domain.TravelEvent, error) {
+	event, err := s.eventRepo.GetByID(ctx, clubID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !event.IsOpen() {
+		return nil, domain.ErrEventDeparted
+	}
+	return event, nil
+}
+
+func (s *TravelLogisticsService) getVehicle(ctx context.Context, clubID string, eventID, vehicleID uuid.UUID) (*domain.TravelVehicle, error) {
+	vehicle, err := s.repo.GetVehicle(ctx, clubID, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	if vehicle == nil || vehicle.EventID != eventID {
+		return nil, domain.ErrVehicleNotFound
+	}
+	return vehicle, nil
+}
+
+func (s *TravelLogisticsService) checkParent(ctx context.Context, clubID, parentID, childID string) error {
+	child, err := s.userRepo.GetByID(ctx, clubID, childID)
+	if err != nil {
+		return err
+	}
+	if child == nil || child.ParentID == nil || *child.ParentID != parentID {
+		return domain.ErrNotParentOfParticipant
+	}
+	return nil
+}
+
+func (s *TravelLogisticsService) notify(ctx context.Context, recipientID, title, body string) {
+	if s.notifier == nil {
+		return
+	}
+	err := s.notifier.Send(ctx, notificationSvc.Notification{
+		RecipientID: recipientID,
+		Type:        notificationSvc.NotificationTypePush,
+		Title:       title,
+		Body:        body,
+	})
+	if err != nil {
+		log.Printf("[TravelLogisticsService] error notificando a %s: %v", recipientID, err)
+	}
+}
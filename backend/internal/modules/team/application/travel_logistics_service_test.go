@@ -0,0 +1,332 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	paymentDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/payment/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTravelEventRepo struct {
+	mock.Mock
+}
+
+func (m *MockTravelEventRepo) Create(ctx context.Context, event *domain.TravelEvent) error {
+	return nil
+}
+func (m *MockTravelEventRepo) GetByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.TravelEvent, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TravelEvent), args.Error(1)
+}
+func (m *MockTravelEventRepo) GetByTeamID(ctx context.Context, clubID string, teamID uuid.UUID) ([]domain.TravelEvent, error) {
+	return nil, nil
+}
+func (m *MockTravelEventRepo) GetUpcoming(ctx context.Context, clubID string, teamID uuid.UUID) ([]domain.TravelEvent, error) {
+	return nil, nil
+}
+func (m *MockTravelEventRepo) Update(ctx context.Context, event *domain.TravelEvent) error {
+	return m.Called(ctx, event).Error(0)
+}
+func (m *MockTravelEventRepo) Delete(ctx context.Context, clubID string, id uuid.UUID) error {
+	return nil
+}
+func (m *MockTravelEventRepo) CreateRSVP(ctx context.Context, rsvp *domain.EventRSVP) error {
+	return m.Called(ctx, rsvp).Error(0)
+}
+func (m *MockTravelEventRepo) GetRSVPsByEventID(ctx context.Context, eventID uuid.UUID) ([]domain.EventRSVP, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).([]domain.EventRSVP), args.Error(1)
+}
+func (m *MockTravelEventRepo) GetRSVPByUserAndEvent(ctx context.Context, eventID uuid.UUID, userID string) (*domain.EventRSVP, error) {
+	args := m.Called(ctx, eventID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EventRSVP), args.Error(1)
+}
+func (m *MockTravelEventRepo) UpdateRSVP(ctx context.Context, rsvp *domain.EventRSVP) error {
+	return m.Called(ctx, rsvp).Error(0)
+}
+func (m *MockTravelEventRepo) DeleteRSVP(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+type MockTravelLogisticsRepo struct {
+	mock.Mock
+}
+
+func (m *MockTravelLogisticsRepo) CreateCharge(ctx context.Context, charge *domain.TravelCharge) error {
+	return m.Called(ctx, charge).Error(0)
+}
+func (m *MockTravelLogisticsRepo) UpdateCharge(ctx context.Context, charge *domain.TravelCharge) error {
+	return m.Called(ctx, charge).Error(0)
+}
+func (m *MockTravelLogisticsRepo) GetCharge(ctx context.Context, clubID string, id uuid.UUID) (*domain.TravelCharge, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TravelCharge), args.Error(1)
+}
+func (m *MockTravelLogisticsRepo) ListCharges(ctx context.Context, clubID string, eventID uuid.UUID) ([]domain.TravelCharge, error) {
+	args := m.Called(ctx, clubID, eventID)
+	return args.Get(0).([]domain.TravelCharge), args.Error(1)
+}
+func (m *MockTravelLogisticsRepo) CreateVehicle(ctx context.Context, vehicle *domain.TravelVehicle) error {
+	return m.Called(ctx, vehicle).Error(0)
+}
+func (m *MockTravelLogisticsRepo) GetVehicle(ctx context.Context, clubID string, id uuid.UUID) (*domain.TravelVehicle, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TravelVehicle), args.Error(1)
+}
+func (m *MockTravelLogisticsRepo) ListVehicles(ctx context.Context, clubID string, eventID uuid.UUID) ([]domain.TravelVehicle, error) {
+	args := m.Called(ctx, clubID, eventID)
+	return args.Get(0).([]domain.TravelVehicle), args.Error(1)
+}
+func (m *MockTravelLogisticsRepo) DeleteVehicle(ctx context.Context, clubID string, id uuid.UUID) error {
+	return m.Called(ctx, clubID, id).Error(0)
+}
+func (m *MockTravelLogisticsRepo) GetPassenger(ctx context.Context, eventID uuid.UUID, userID string) (*domain.TravelPassenger, error) {
+	args := m.Called(ctx, eventID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TravelPassenger), args.Error(1)
+}
+func (m *MockTravelLogisticsRepo) SavePassenger(ctx context.Context, passenger *domain.TravelPassenger) error {
+	return m.Called(ctx, passenger).Error(0)
+}
+func (m *MockTravelLogisticsRepo) DeletePassenger(ctx context.Context, eventID uuid.UUID, userID string) error {
+	return m.Called(ctx, eventID, userID).Error(0)
+}
+
+type MockTravelPayments struct {
+	mock.Mock
+}
+
+func (m *MockTravelPayments) CreateChargeCheckout(ctx context.Context, charge *domain.TravelCharge, payerID, payerEmail, description string) (uuid.UUID, string, error) {
+	args := m.Called(ctx, charge, payerID, payerEmail, description)
+	return args.Get(0).(uuid.UUID), args.String(1), args.Error(2)
+}
+func (m *MockTravelPayments) RecordOfflineChargePayment(ctx context.Context, charge *domain.TravelCharge, method paymentDomain.PaymentMethod, notes string) (uuid.UUID, error) {
+	args := m.Called(ctx, charge, method, notes)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+func (m *MockTravelPayments) GetChargePayment(ctx context.Context, clubID string, paymentID uuid.UUID) (*paymentDomain.Payment, error) {
+	args := m.Called(ctx, clubID, paymentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*paymentDomain.Payment), args.Error(1)
+}
+
+type MockUserRepo struct {
+	mock.Mock
+}
+
+func (m *MockUserRepo) GetByID(ctx context.Context, clubID, id string) (*userDomain.User, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*userDomain.User), args.Error(1)
+}
+func (m *MockUserRepo) Update(ctx context.Context, user *userDomain.User) error { return nil }
+func (m *MockUserRepo) Delete(ctx context.Context, clubID, id string) error     { return nil }
+func (m *MockUserRepo) List(ctx context.Context, clubID string, limit, offset int, filters map[string]interface{}) ([]userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepo) ListByIDs(ctx context.Context, clubID string, ids []string) ([]userDomain.User, error) {
+	args := m.Called(ctx, clubID, ids)
+	return args.Get(0).([]userDomain.User), args.Error(1)
+}
+func (m *MockUserRepo) FindChildren(ctx context.Context, clubID, parentID string) ([]userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepo) Create(ctx context.Context, user *userDomain.User) error { return nil }
+func (m *MockUserRepo) CreateIncident(ctx context.Context, incident *userDomain.IncidentLog) error {
+	return nil
+}
+func (m *MockUserRepo) GetByEmail(ctx context.Context, clubID, email string) (*userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepo) AnonymizeForGDPR(ctx context.Context, clubID, id string) error { return nil }
+
+func TestTravelLogisticsService_Charges(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	event := &domain.TravelEvent{ID: uuid.New(), Title: "Viaje a Rosario", EstimatedCost: decimal.NewFromInt(9000), DepartureDate: time.Now().Add(72 * time.Hour)}
+
+	setup := func() (*application.TravelLogisticsService, *MockTravelEventRepo, *MockTravelLogisticsRepo, *MockTravelPayments, *MockNotifier) {
+		events := new(MockTravelEventRepo)
+		repo := new(MockTravelLogisticsRepo)
+		payments := new(MockTravelPayments)
+		notifier := new(MockNotifier)
+		events.On("GetByID", ctx, clubID, event.ID).Return(event, nil)
+		return application.NewTravelLogisticsService(events, repo, payments, new(MockUserRepo), notifier), events, repo, payments, notifier
+	}
+
+	t.Run("Genera cargos, respeta los pagados y anula a los que bajaron", func(t *testing.T) {
+		svc, events, repo, _, notifier := setup()
+		events.On("GetRSVPsByEventID", ctx, event.ID).Return([]domain.EventRSVP{
+			{UserID: "u1", Status: domain.RSVPStatusConfirmed},
+			{UserID: "u2", Status: domain.RSVPStatusConfirmed},
+			{UserID: "u3", Status: domain.RSVPStatusConfirmed},
+			{UserID: "u4", Status: domain.RSVPStatusDeclined},
+		}, nil)
+		events.On("Update", ctx, event).Return(nil)
+		existing := []domain.TravelCharge{
+			{ID: uuid.New(), EventID: event.ID, UserID: "u1", Amount: decimal.NewFromInt(2250), Status: domain.TravelChargePaid},
+			{ID: uuid.New(), EventID: event.ID, UserID: "u4", Amount: decimal.NewFromInt(2250), Status: domain.TravelChargePending},
+		}
+		repo.On("ListCharges", ctx, clubID, event.ID).Return(existing, nil)
+		repo.On("CreateCharge", ctx, mock.MatchedBy(func(c *domain.TravelCharge) bool {
+			return (c.UserID == "u2" || c.UserID == "u3") && c.Amount.Equal(decimal.NewFromInt(3000)) && c.Status == domain.TravelChargePending
+		})).Return(nil).Twice()
+		repo.On("UpdateCharge", ctx, mock.MatchedBy(func(c *domain.TravelCharge) bool {
+			return c.UserID == "u4" && c.Status == domain.TravelChargeCancelled
+		})).Return(nil).Once()
+		notifier.On("Send", ctx, mock.Anything).Return(nil).Twice()
+
+		tracker, err := svc.GenerateCharges(ctx, clubID, event.ID)
+		assert.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(3000).Equal(tracker.CostPerPerson))
+		assert.Equal(t, 1, tracker.PaidCount)
+		assert.Equal(t, domain.TravelChargePaid, existing[0].Status)
+		assert.True(t, decimal.NewFromInt(2250).Equal(existing[0].Amount))
+		repo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("Sin confirmados no hay costo", func(t *testing.T) {
+		svc, events, _, _, _ := setup()
+		events.On("GetRSVPsByEventID", ctx, event.ID).Return([]domain.EventRSVP{}, nil)
+
+		_, err := svc.GenerateCharges(ctx, clubID, event.ID)
+		assert.ErrorIs(t, err, domain.ErrNoCostDefined)
+	})
+
+	t.Run("El pago en secretaría acredita el cargo", func(t *testing.T) {
+		svc, _, repo, payments, _ := setup()
+		charge := &domain.TravelCharge{ID: uuid.New(), ClubID: clubID, EventID: event.ID, UserID: uuid.NewString(), Amount: decimal.NewFromInt(3000), Status: domain.TravelChargePending}
+		paymentID := uuid.New()
+		repo.On("GetCharge", ctx, clubID, charge.ID).Return(charge, nil)
+		repo.On("UpdateCharge", ctx, charge).Return(nil).Once()
+		payments.On("GetChargePayment", ctx, clubID, paymentID).
+			Return(&paymentDomain.Payment{ID: paymentID, Amount: decimal.NewFromInt(3000), Status: paymentDomain.PaymentStatusCompleted}, nil)
+		// El módulo Payment invoca al responder de forma sincrónica, antes de que el cargo conozca el pago
+		payments.On("RecordOfflineChargePayment", ctx, charge, paymentDomain.PaymentMethodCash, "").
+			Run(func(args mock.Arguments) {
+				assert.NoError(t, svc.OnPaymentStatusChanged(ctx, clubID, charge.ID, paymentDomain.PaymentStatusCompleted))
+			}).
+			Return(paymentID, nil)
+
+		paid, err := svc.RecordOfflinePayment(ctx, clubID, event.ID, charge.ID, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.TravelChargePaid, paid.Status)
+		assert.NotNil(t, paid.PaidAt)
+
+		_, err = svc.RecordOfflinePayment(ctx, clubID, event.ID, charge.ID, "", "")
+		assert.ErrorIs(t, err, domain.ErrChargeAlreadyPaid)
+		payments.AssertNumberOfCalls(t, "RecordOfflineChargePayment", 1)
+		assert.Equal(t, paymentID, *paid.PaymentID)
+	})
+
+	t.Run("Solo acredita el pago del cargo, por su monto y si está pendiente", func(t *testing.T) {
+		checkoutID := uuid.New()
+		for name, tc := range map[string]struct {
+			status  domain.TravelChargeStatus
+			payment *paymentDomain.Payment
+		}{
+			"otro monto":       {domain.TravelChargePending, &paymentDomain.Payment{ID: checkoutID, Amount: decimal.NewFromInt(1), Status: paymentDomain.PaymentStatusCompleted}},
+			"otro pago":        {domain.TravelChargePending, &paymentDomain.Payment{ID: checkoutID, Amount: decimal.NewFromInt(3000), Status: paymentDomain.PaymentStatusPending}},
+			"cargo anulado":    {domain.TravelChargeCancelled, &paymentDomain.Payment{ID: checkoutID, Amount: decimal.NewFromInt(3000), Status: paymentDomain.PaymentStatusCompleted}},
+			"pago inexistente": {domain.TravelChargePending, nil},
+		} {
+			svc, _, repo, payments, _ := setup()
+			charge := &domain.TravelCharge{ID: uuid.New(), ClubID: clubID, EventID: event.ID, UserID: "u1", Amount: decimal.NewFromInt(3000), Status: tc.status, PaymentID: &checkoutID}
+			repo.On("GetCharge", ctx, clubID, charge.ID).Return(charge, nil)
+			if tc.payment == nil {
+				payments.On("GetChargePayment", ctx, clubID, checkoutID).Return(nil, nil)
+			} else {
+				payments.On("GetChargePayment", ctx, clubID, checkoutID).Return(tc.payment, nil)
+			}
+
+			assert.NoError(t, svc.OnPaymentStatusChanged(ctx, clubID, charge.ID, paymentDomain.PaymentStatusCompleted), name)
+			assert.Equal(t, tc.status, charge.Status, name)
+			repo.AssertNotCalled(t, "UpdateCharge", mock.Anything, mock.Anything)
+		}
+	})
+}
+
+func TestTravelLogisticsService_Carpool(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	event := &domain.TravelEvent{ID: uuid.New(), Title: "Viaje a Rosario", DepartureDate: time.Now().Add(72 * time.Hour)}
+	birth := time.Now().AddDate(-12, 0, 0)
+	parentID := "parent-1"
+	minor := &userDomain.User{ID: "kid-1", Name: "Juan", DateOfBirth: &birth, ParentID: &parentID}
+
+	setup := func() (*application.TravelLogisticsService, *MockTravelEventRepo, *MockTravelLogisticsRepo, *MockNotifier) {
+		events := new(MockTravelEventRepo)
+		repo := new(MockTravelLogisticsRepo)
+		users := new(MockUserRepo)
+		notifier := new(MockNotifier)
+		events.On("GetByID", ctx, clubID, event.ID).Return(event, nil)
+		events.On("GetRSVPByUserAndEvent", ctx, event.ID, minor.ID).Return(&domain.EventRSVP{UserID: minor.ID, Status: domain.RSVPStatusConfirmed}, nil)
+		users.On("GetByID", ctx, clubID, minor.ID).Return(minor, nil)
+		return application.NewTravelLogisticsService(events, repo, new(MockTravelPayments), users, notifier), events, repo, notifier
+	}
+
+	t.Run("El menor queda pendiente de autorización y se avisa al padre", func(t *testing.T) {
+		svc, _, repo, notifier := setup()
+		vehicle := &domain.TravelVehicle{ID: uuid.New(), EventID: event.ID, DriverID: "driver-1", Seats: 2}
+		repo.On("GetVehicle", ctx, clubID, vehicle.ID).Return(vehicle, nil)
+		repo.On("GetPassenger", ctx, event.ID, minor.ID).Return(nil, nil).Once()
+		repo.On("SavePassenger", ctx, mock.Anything).Return(nil)
+		notifier.On("Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool {
+			return n.RecipientID == parentID
+		})).Return(nil).Once()
+
+		passenger, err := svc.AssignPassenger(ctx, clubID, event.ID, vehicle.ID, minor.ID)
+		assert.NoError(t, err)
+		assert.True(t, passenger.RequiresAuthorization)
+		assert.False(t, passenger.IsAuthorized())
+		notifier.AssertExpectations(t)
+
+		repo.On("GetPassenger", ctx, event.ID, minor.ID).Return(passenger, nil)
+		_, err = svc.AuthorizePassenger(ctx, clubID, event.ID, minor.ID, "otro-adulto")
+		assert.ErrorIs(t, err, domain.ErrNotParentOfParticipant)
+
+		authorized, err := svc.AuthorizePassenger(ctx, clubID, event.ID, minor.ID, parentID)
+		assert.NoError(t, err)
+		assert.True(t, authorized.IsAuthorized())
+		assert.Equal(t, parentID, authorized.AuthorizedBy)
+	})
+
+	t.Run("No se puede subir a un vehículo completo", func(t *testing.T) {
+		svc, _, repo, _ := setup()
+		vehicle := &domain.TravelVehicle{ID: uuid.New(), EventID: event.ID, Seats: 1, Passengers: []domain.TravelPassenger{{UserID: "u9"}}}
+		repo.On("GetVehicle", ctx, clubID, vehicle.ID).Return(vehicle, nil)
+		repo.On("GetPassenger", ctx, event.ID, minor.ID).Return(nil, nil)
+
+		_, err := svc.AssignPassenger(ctx, clubID, event.ID, vehicle.ID, minor.ID)
+		assert.ErrorIs(t, err, domain.ErrVehicleFull)
+		repo.AssertNotCalled(t, "SavePassenger", mock.Anything, mock.Anything)
+	})
+}
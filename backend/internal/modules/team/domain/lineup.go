@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultSquadSize es la cantidad de convocados que se propone por defecto
+const DefaultSquadSize = 18
+
+// AvailabilityPending identifica a los jugadores que todavía no respondieron (no se persiste)
+const AvailabilityPending PlayerAvailabilityStatus = "PENDING"
+
+var (
+	ErrLineupLocked          = errors.New("la alineación está cerrada")
+	ErrPlayerNotInSquad      = errors.New("el jugador no pertenece al plantel del grupo")
+	ErrPlayerDeclined        = errors.New("el jugador indicó que no está disponible")
+	ErrPlayerNotEligible     = errors.New("el jugador está inhabilitado (deuda o apto médico)")
+	ErrDuplicateLineupPlayer = errors.New("el jugador figura más de una vez en la alineación")
+	ErrInvalidLineupRole     = errors.New("rol inválido: debe ser STARTER o SUBSTITUTE")
+	ErrEmptyLineup           = errors.New("la alineación no tiene titulares")
+	ErrMatchEventNotFound    = errors.New("partido no encontrado")
+)
+
+// LineupStatus define el estado de una alineación
+type LineupStatus string
+
+const (
+	LineupDraft  LineupStatus = "DRAFT"  // El entrenador la está armando
+	LineupLocked LineupStatus = "LOCKED" // Cerrada y comunicada a los convocados
+)
+
+// LineupRole define el rol de un convocado
+type LineupRole string
+
+const (
+	LineupStarter    LineupRole = "STARTER"    // Titular
+	LineupSubstitute LineupRole = "SUBSTITUTE" // Suplente
+)
+
+// Lineup es la alineación de un partido del grupo de entrenamiento (MatchEvent)
+type Lineup struct {
+	ID           uuid.UUID      `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID       string         `json:"club_id" gorm:"index;not null"`
+	MatchEventID uuid.UUID      `json:"match_event_id" gorm:"type:uuid;uniqueIndex;not null"`
+	Formation    string         `json:"formation,omitempty"` // Ej: "4-4-2"
+	Status       LineupStatus   `json:"status" gorm:"not null;default:'DRAFT'"`
+	LockedAt     *time.Time     `json:"locked_at,omitempty"`
+	LockedBy     string         `json:"locked_by,omitempty"`
+	CreatedAt    time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	Players      []LineupPlayer `json:"players" gorm:"foreignKey:LineupID"`
+}
+
+func (Lineup) TableName() string {
+	return "match_lineups"
+}
+
+// LineupPlayer es un convocado de la alineación
+type LineupPlayer struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key"`
+	LineupID    uuid.UUID  `json:"lineup_id" gorm:"type:uuid;not null;index"`
+	UserID      string     `json:"user_id" gorm:"not null"`
+	Role        LineupRole `json:"role" gorm:"not null"`
+	Position    string     `json:"position,omitempty"` // Ej: "ARQ", "DEF", "MED", "DEL"
+	ShirtNumber *int       `json:"shirt_number,omitempty"`
+}
+
+func (LineupPlayer) TableName() string {
+	return "match_lineup_players"
+}
+
+// IsLocked indica si la alineación ya fue cerrada
+func (l *Lineup) IsLocked() bool {
+	return l.Status == LineupLocked
+}
+
+// SetPlayers reemplaza los convocados validando roles y duplicados
+func (l *Lineup) SetPlayers(players []LineupPlayer) error {
+	if l.IsLocked() {
+		return ErrLineupLocked
+	}
+	seen := make(map[string]bool, len(players))
+	for i := range players {
+		p := &players[i]
+		if p.Role != LineupStarter && p.Role != LineupSubstitute {
+			return ErrInvalidLineupRole
+		}
+		if seen[p.UserID] {
+			return ErrDuplicateLineupPlayer
+		}
+		seen[p.UserID] = true
+		if p.ID == uuid.Nil {
+			p.ID = uuid.New()
+		}
+		p.LineupID = l.ID
+	}
+	l.Players = players
+	return nil
+}
+
+// Lock cierra la alineación; a partir de ahí no se puede modificar
+func (l *Lineup) Lock(by string, at time.Time) error {
+	if l.IsLocked() {
+		return ErrLineupLocked
+	}
+	hasStarter := false
+	for _, p := range l.Players {
+		if p.Role == LineupStarter {
+			hasStarter = true
+			break
+		}
+	}
+	if !hasStarter {
+		return ErrEmptyLineup
+	}
+	l.Status = LineupLocked
+	l.LockedAt = &at
+	l.LockedBy = by
+	return nil
+}
+
+// SquadCandidate es un jugador del plantel evaluado para la convocatoria
+type SquadCandidate struct {
+	UserID         string                   `json:"user_id"`
+	Availability   PlayerAvailabilityStatus `json:"availability"`
+	Eligible       bool                     `json:"eligible"`
+	Issues         []string                 `json:"issues,omitempty"`
+	AttendanceRate float64                  `json:"attendance_rate"`
+	Suggested      bool                     `json:"suggested"`
+}
+
+// CanBeSelected indica si el entrenador puede incluir al jugador en la alineación
+func (c *SquadCandidate) CanBeSelected() error {
+	if c.Availability == AvailabilityDeclined {
+		return ErrPlayerDeclined
+	}
+	if !c.Eligible {
+		return ErrPlayerNotEligible
+	}
+	return nil
+}
+
+// ProposeSquad marca como sugeridos hasta size jugadores habilitados: primero los confirmados y,
+// si sobra lugar, los que respondieron "tal vez". Dentro de cada grupo se prioriza la asistencia.
+// Devuelve los candidatos ordenados con los sugeridos primero.
+func ProposeSquad(candidates []SquadCandidate, size int) []SquadCandidate {
+	if size <= 0 {
+		size = DefaultSquadSize
+	}
+	rank := func(c SquadCandidate) int {
+		switch {
+		case !c.Eligible || c.Availability == AvailabilityDeclined:
+			return 3
+		case c.Availability == AvailabilityConfirmed:
+			return 0
+		case c.Availability == AvailabilityMaybe:
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ri, rj := rank(candidates[i]), rank(candidates[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return candidates[i].AttendanceRate > candidates[j].AttendanceRate
+	})
+
+	selected := 0
+	for i := range candidates {
+		candidates[i].Suggested = false
+		if selected < size && rank(candidates[i]) <= 1 {
+			candidates[i].Suggested = true
+			selected++
+		}
+	}
+	return candidates
+}
+
+// AvailabilityReminder registra el recordatorio enviado a un jugador que no respondió la convocatoria
+type AvailabilityReminder struct {
+	MatchEventID uuid.UUID `json:"match_event_id" gorm:"type:uuid;primary_key"`
+	UserID       string    `json:"user_id" gorm:"primary_key"`
+	SentAt       time.Time `json:"sent_at" gorm:"not null"`
+}
+
+func (AvailabilityReminder) TableName() string {
+	return "match_availability_reminders"
+}
+
+// SquadRosterProvider devuelve el plantel de un grupo de entrenamiento en una fecha
+// (lo implementa el repositorio de inscripciones de Disciplines)
+type SquadRosterProvider interface {
+	ListRosterUserIDs(ctx context.Context, clubID string, groupID uuid.UUID, date time.Time) ([]string, error)
+}
+
+// LineupRepository define las operaciones de persistencia de alineaciones y recordatorios
+type LineupRepository interface {
+	// GetLineup devuelve nil si el partido todavía no tiene alineación
+	GetLineup(ctx context.Context, clubID string, matchEventID uuid.UUID) (*Lineup, error)
+	// SaveLineup guarda la alineación reemplazando sus convocados
+	SaveLineup(ctx context.Context, lineup *Lineup) error
+	ListUpcomingMatchEvents(ctx context.Context, clubID string, from, to time.Time) ([]MatchEvent, error)
+	ListRemindedUserIDs(ctx context.Context, matchEventID uuid.UUID) ([]string, error)
+	CreateReminder(ctx context.Context, reminder *AvailabilityReminder) error
+}
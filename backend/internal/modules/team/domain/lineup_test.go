@@ -0,0 +1,75 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProposeSquad(t *testing.T) {
+	candidates := []domain.SquadCandidate{
+		{UserID: "maybe", Availability: domain.AvailabilityMaybe, Eligible: true, AttendanceRate: 0.9},
+		{UserID: "deudor", Availability: domain.AvailabilityConfirmed, Eligible: false, AttendanceRate: 1},
+		{UserID: "confirmado-bajo", Availability: domain.AvailabilityConfirmed, Eligible: true, AttendanceRate: 0.5},
+		{UserID: "pendiente", Availability: domain.AvailabilityPending, Eligible: true, AttendanceRate: 1},
+		{UserID: "confirmado-alto", Availability: domain.AvailabilityConfirmed, Eligible: true, AttendanceRate: 0.8},
+		{UserID: "no-viene", Availability: domain.AvailabilityDeclined, Eligible: true, AttendanceRate: 1},
+	}
+
+	t.Run("Confirmados primero, luego tal vez", func(t *testing.T) {
+		result := domain.ProposeSquad(candidates, 18)
+		assert.Equal(t, "confirmado-alto", result[0].UserID)
+		assert.Equal(t, "confirmado-bajo", result[1].UserID)
+		assert.Equal(t, "maybe", result[2].UserID)
+
+		suggested := map[string]bool{}
+		for _, c := range result {
+			suggested[c.UserID] = c.Suggested
+		}
+		assert.True(t, suggested["maybe"])
+		assert.False(t, suggested["pendiente"], "Sin respuesta no se sugiere")
+		assert.False(t, suggested["deudor"], "Inhabilitado no se sugiere")
+		assert.False(t, suggested["no-viene"])
+	})
+
+	t.Run("Respeta el tamaño", func(t *testing.T) {
+		result := domain.ProposeSquad(candidates, 1)
+		assert.True(t, result[0].Suggested)
+		assert.False(t, result[1].Suggested)
+	})
+}
+
+func TestLineup_SetPlayersAndLock(t *testing.T) {
+	t.Run("Valida roles y duplicados", func(t *testing.T) {
+		l := &domain.Lineup{ID: uuid.New(), Status: domain.LineupDraft}
+		err := l.SetPlayers([]domain.LineupPlayer{{UserID: "u1", Role: "CAPTAIN"}})
+		assert.ErrorIs(t, err, domain.ErrInvalidLineupRole)
+
+		err = l.SetPlayers([]domain.LineupPlayer{
+			{UserID: "u1", Role: domain.LineupStarter},
+			{UserID: "u1", Role: domain.LineupSubstitute},
+		})
+		assert.ErrorIs(t, err, domain.ErrDuplicateLineupPlayer)
+	})
+
+	t.Run("Requiere titulares para cerrar", func(t *testing.T) {
+		l := &domain.Lineup{ID: uuid.New(), Status: domain.LineupDraft}
+		assert.NoError(t, l.SetPlayers([]domain.LineupPlayer{{UserID: "u1", Role: domain.LineupSubstitute}}))
+		assert.ErrorIs(t, l.Lock("coach", time.Now()), domain.ErrEmptyLineup)
+	})
+
+	t.Run("Cerrada no se modifica", func(t *testing.T) {
+		l := &domain.Lineup{ID: uuid.New(), Status: domain.LineupDraft}
+		assert.NoError(t, l.SetPlayers([]domain.LineupPlayer{{UserID: "u1", Role: domain.LineupStarter, Position: "ARQ"}}))
+		assert.Equal(t, l.ID, l.Players[0].LineupID)
+
+		assert.NoError(t, l.Lock("coach", time.Now()))
+		assert.True(t, l.IsLocked())
+		assert.Equal(t, "coach", l.LockedBy)
+		assert.ErrorIs(t, l.SetPlayers(nil), domain.ErrLineupLocked)
+		assert.ErrorIs(t, l.Lock("coach", time.Now()), domain.ErrLineupLocked)
+	})
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAuthorizationRequired     = errors.New("el menor necesita la autorización firmada de su padre/madre para confirmar el viaje")
+	ErrAuthorizationNotFound     = errors.New("no hay una autorización de viaje para el participante")
+	ErrAuthorizationSigned       = errors.New("la autorización ya fue firmada")
+	ErrAuthorizationNotSigned    = errors.New("la autorización no está firmada")
+	ErrNoParentLinked            = errors.New("el menor no tiene un padre/madre vinculado")
+	ErrSignatureNameRequired     = errors.New("la firma requiere el nombre completo del padre/madre")
+	ErrSignatureTermsNotAccepted = errors.New("debe aceptar los términos de la autorización")
+)
+
+// TravelAuthorizationStatus define el estado de la autorización de viaje de un menor
+type TravelAuthorizationStatus string
+
+const (
+	TravelAuthorizationPending TravelAuthorizationStatus = "PENDING" // Enviada al padre/madre, sin firmar
+	TravelAuthorizationSigned  TravelAuthorizationStatus = "SIGNED"  // Firmada electrónicamente
+	TravelAuthorizationRevoked TravelAuthorizationStatus = "REVOKED" // Revocada por el padre/madre antes de la salida
+)
+
+// TravelAuthorization es el permiso firmado por el padre/madre para que un menor viaje con el equipo.
+// Como en ConsentRecord, la firma electrónica guarda IP, user agent y el hash del texto aceptado.
+type TravelAuthorization struct {
+	ID          uuid.UUID                 `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID      string                    `json:"club_id" gorm:"index;not null"`
+	EventID     uuid.UUID                 `json:"event_id" gorm:"type:uuid;not null;uniqueIndex:idx_travel_authorization_event_minor"`
+	MinorID     string                    `json:"minor_id" gorm:"not null;uniqueIndex:idx_travel_authorization_event_minor"`
+	ParentID    string                    `json:"parent_id" gorm:"not null;index"`
+	Status      TravelAuthorizationStatus `json:"status" gorm:"not null;default:'PENDING'"`
+	RequestedBy string                    `json:"requested_by,omitempty"`
+	RequestedAt time.Time                 `json:"requested_at" gorm:"not null"`
+
+	// Firma electrónica
+	SignedName string     `json:"signed_name,omitempty"` // Nombre completo tipeado por el firmante
+	TermsHash  string     `json:"terms_hash,omitempty"`  // SHA-256 del texto aceptado
+	SignedAt   *time.Time `json:"signed_at,omitempty"`
+	IPAddress  string     `json:"ip_address,omitempty"`
+	UserAgent  string     `json:"user_agent,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (TravelAuthorization) TableName() string {
+	return "travel_event_authorizations"
+}
+
+// IsSigned indica si la autorización está vigente
+func (a *TravelAuthorization) IsSigned() bool {
+	return a.Status == TravelAuthorizationSigned
+}
+
+// Sign registra la firma electrónica del padre/madre sobre el texto de la autorización
+func (a *TravelAuthorization) Sign(signedName, terms, ipAddress, userAgent string, at time.Time) error {
+	if a.IsSigned() {
+		return ErrAuthorizationSigned
+	}
+	signedName = strings.TrimSpace(signedName)
+	if signedName == "" {
+		return ErrSignatureNameRequired
+	}
+	a.Status = TravelAuthorizationSigned
+	a.SignedName = signedName
+	a.TermsHash = HashAuthorizationTerms(terms)
+	a.SignedAt = &at
+	a.IPAddress = ipAddress
+	a.UserAgent = userAgent
+	a.RevokedAt = nil
+	return nil
+}
+
+// Revoke deja sin efecto la autorización firmada
+func (a *TravelAuthorization) Revoke(at time.Time) error {
+	if !a.IsSigned() {
+		return ErrAuthorizationNotSigned
+	}
+	a.Status = TravelAuthorizationRevoked
+	a.RevokedAt = &at
+	return nil
+}
+
+// AuthorizationTerms arma el texto que firma el padre/madre para un viaje
+func AuthorizationTerms(event *TravelEvent, minorName, parentName string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Yo, %s, en mi carácter de padre/madre/tutor de %s, autorizo a que viaje con la delegación del club ", parentName, minorName)
+	fmt.Fprintf(&b, "al evento \"%s\" con destino %s, saliendo el %s", event.Title, event.Destination, event.DepartureDate.Format("02/01/2006 15:04"))
+	if event.ReturnDate != nil {
+		fmt.Fprintf(&b, " y regresando el %s", event.ReturnDate.Format("02/01/2006 15:04"))
+	}
+	if event.MeetingPoint != "" {
+		fmt.Fprintf(&b, ", con punto de encuentro en %s", event.MeetingPoint)
+	}
+	b.WriteString(". Declaro que los datos de contacto de emergencia y cobertura médica registrados en el club son correctos ")
+	b.WriteString("y autorizo al cuerpo técnico a gestionar la atención médica que resulte necesaria durante el viaje.")
+	return b.String()
+}
+
+// HashAuthorizationTerms devuelve el hash con el que se prueba qué texto se firmó
+func HashAuthorizationTerms(terms string) string {
+	sum := sha256.Sum256([]byte(terms))
+	return hex.EncodeToString(sum[:])
+}
+
+// TravelRosterEntry es una fila del listado de viajeros que lleva el entrenador
+type TravelRosterEntry struct {
+	UserID                string     `json:"user_id"`
+	Name                  string     `json:"name"`
+	DateOfBirth           *time.Time `json:"date_of_birth,omitempty"`
+	IsMinor               bool       `json:"is_minor"`
+	Authorized            bool       `json:"authorized"` // Siempre true para mayores
+	EmergencyContactName  string     `json:"emergency_contact_name,omitempty"`
+	EmergencyContactPhone string     `json:"emergency_contact_phone,omitempty"`
+	InsuranceProvider     string     `json:"insurance_provider,omitempty"`
+	InsuranceNumber       string     `json:"insurance_number,omitempty"`
+}
+
+// TravelRoster es el listado imprimible de los confirmados de un viaje
+type TravelRoster struct {
+	Event   *TravelEvent        `json:"event"`
+	Entries []TravelRosterEntry `json:"entries"`
+}
+
+// TravelAuthorizationRepository define la persistencia de las autorizaciones de viaje
+type TravelAuthorizationRepository interface {
+	// Save crea o actualiza la autorización (una por evento y menor)
+	Save(ctx context.Context, authorization *TravelAuthorization) error
+	// Get devuelve nil si no existe
+	Get(ctx context.Context, clubID string, eventID uuid.UUID, minorID string) (*TravelAuthorization, error)
+	ListByEvent(ctx context.Context, clubID string, eventID uuid.UUID) ([]TravelAuthorization, error)
+	ListByParent(ctx context.Context, clubID, parentID string) ([]TravelAuthorization, error)
+}
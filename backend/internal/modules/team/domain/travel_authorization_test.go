@@ -0,0 +1,48 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTravelAuthorization_SignAndRevoke(t *testing.T) {
+	now := time.Now()
+	event := &domain.TravelEvent{ID: uuid.New(), Title: "Torneo Nacional", Destination: "Córdoba", DepartureDate: now.Add(48 * time.Hour)}
+	terms := domain.AuthorizationTerms(event, "Juan Pérez", "Ana Pérez")
+	auth := &domain.TravelAuthorization{Status: domain.TravelAuthorizationPending}
+
+	assert.ErrorIs(t, auth.Sign("  ", terms, "10.0.0.1", "UA", now), domain.ErrSignatureNameRequired)
+	assert.ErrorIs(t, auth.Revoke(now), domain.ErrAuthorizationNotSigned)
+
+	assert.NoError(t, auth.Sign(" Ana Pérez ", terms, "10.0.0.1", "UA", now))
+	assert.True(t, auth.IsSigned())
+	assert.Equal(t, "Ana Pérez", auth.SignedName)
+	assert.Equal(t, domain.HashAuthorizationTerms(terms), auth.TermsHash)
+	assert.ErrorIs(t, auth.Sign("Ana Pérez", terms, "", "", now), domain.ErrAuthorizationSigned)
+
+	assert.NoError(t, auth.Revoke(now))
+	assert.False(t, auth.IsSigned())
+	assert.NotNil(t, auth.RevokedAt)
+
+	// Se puede volver a firmar después de revocar
+	assert.NoError(t, auth.Sign("Ana Pérez", terms, "10.0.0.2", "UA", now))
+	assert.Nil(t, auth.RevokedAt)
+}
+
+func TestAuthorizationTerms(t *testing.T) {
+	event := &domain.TravelEvent{Title: "Torneo Nacional", Destination: "Córdoba", MeetingPoint: "Sede", DepartureDate: time.Date(2026, 11, 20, 7, 0, 0, 0, time.UTC)}
+	terms := domain.AuthorizationTerms(event, "Juan Pérez", "Ana Pérez")
+
+	assert.Contains(t, terms, "Ana Pérez")
+	assert.Contains(t, terms, "Juan Pérez")
+	assert.Contains(t, terms, "Córdoba")
+	assert.Contains(t, terms, "20/11/2026 07:00")
+
+	// Cambiar el destino cambia el hash de lo firmado
+	event.Destination = "Mendoza"
+	assert.NotEqual(t, domain.HashAuthorizationTerms(terms), domain.HashAuthorizationTerms(domain.AuthorizationTerms(event, "Juan Pérez", "Ana Pérez")))
+}
@@ -0,0 +1,199 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TravelChargeReferenceType identifica los pagos de viajes en el módulo Payment
+const TravelChargeReferenceType = "TRAVEL_CHARGE"
+
+var (
+	ErrChargeNotFound         = errors.New("cargo no encontrado")
+	ErrChargeAlreadyPaid      = errors.New("el cargo ya está pagado")
+	ErrChargeCancelled        = errors.New("el cargo fue anulado")
+	ErrNoCostDefined          = errors.New("el evento no tiene costo definido")
+	ErrVehicleNotFound        = errors.New("vehículo no encontrado")
+	ErrVehicleFull            = errors.New("el vehículo no tiene lugares libres")
+	ErrVehicleExists          = errors.New("ya ofreciste un vehículo para este evento")
+	ErrNotConfirmed           = errors.New("el participante no confirmó su asistencia al evento")
+	ErrPassengerNotFound      = errors.New("el participante no tiene vehículo asignado")
+	ErrNotParentOfParticipant = errors.New("solo el padre/madre del participante puede autorizarlo")
+	ErrEventDeparted          = errors.New("el evento ya partió")
+)
+
+// TravelChargeStatus define el estado del cargo de un participante
+type TravelChargeStatus string
+
+const (
+	TravelChargePending   TravelChargeStatus = "PENDING"   // A cobrar
+	TravelChargePaid      TravelChargeStatus = "PAID"      // Pagado (online o registrado en secretaría)
+	TravelChargeCancelled TravelChargeStatus = "CANCELLED" // El participante dejó de estar confirmado
+)
+
+// TravelCharge es lo que cada participante confirmado debe pagar del viaje
+type TravelCharge struct {
+	ID        uuid.UUID          `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID    string             `json:"club_id" gorm:"index;not null"`
+	EventID   uuid.UUID          `json:"event_id" gorm:"type:uuid;not null;index"`
+	UserID    string             `json:"user_id" gorm:"not null"`
+	Amount    decimal.Decimal    `json:"amount" gorm:"type:decimal(10,2);not null"`
+	Status    TravelChargeStatus `json:"status" gorm:"not null;default:'PENDING'"`
+	PaymentID *uuid.UUID         `json:"payment_id,omitempty" gorm:"type:uuid"`
+	PaidAt    *time.Time         `json:"paid_at,omitempty"`
+	CreatedAt time.Time          `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time          `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (TravelCharge) TableName() string {
+	return "travel_event_charges"
+}
+
+// CanBePaid verifica que el cargo admita un pago
+func (c *TravelCharge) CanBePaid() error {
+	switch c.Status {
+	case TravelChargePaid:
+		return ErrChargeAlreadyPaid
+	case TravelChargeCancelled:
+		return ErrChargeCancelled
+	}
+	return nil
+}
+
+// ChargeTracker resume quién pagó el viaje
+type ChargeTracker struct {
+	EventID        uuid.UUID       `json:"event_id"`
+	CostPerPerson  decimal.Decimal `json:"cost_per_person"`
+	TotalCharged   decimal.Decimal `json:"total_charged"`
+	TotalCollected decimal.Decimal `json:"total_collected"`
+	PaidCount      int             `json:"paid_count"`
+	PendingCount   int             `json:"pending_count"`
+	Charges        []TravelCharge  `json:"charges"`
+}
+
+// BuildChargeTracker suma los cargos vigentes (los anulados se listan pero no suman)
+func BuildChargeTracker(event *TravelEvent, charges []TravelCharge) *ChargeTracker {
+	tracker := &ChargeTracker{
+		EventID:        event.ID,
+		CostPerPerson:  event.CostPerPerson,
+		TotalCharged:   decimal.Zero,
+		TotalCollected: decimal.Zero,
+		Charges:        charges,
+	}
+	for _, c := range charges {
+		switch c.Status {
+		case TravelChargePaid:
+			tracker.PaidCount++
+			tracker.TotalCharged = tracker.TotalCharged.Add(c.Amount)
+			tracker.TotalCollected = tracker.TotalCollected.Add(c.Amount)
+		case TravelChargePending:
+			tracker.PendingCount++
+			tracker.TotalCharged = tracker.TotalCharged.Add(c.Amount)
+		}
+	}
+	return tracker
+}
+
+// TravelVehicle es un auto ofrecido por un conductor (socio o familiar) para el viaje
+type TravelVehicle struct {
+	ID          uuid.UUID         `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID      string            `json:"club_id" gorm:"index;not null"`
+	EventID     uuid.UUID         `json:"event_id" gorm:"type:uuid;not null;index"`
+	DriverID    string            `json:"driver_id" gorm:"not null"`
+	Seats       int               `json:"seats" gorm:"not null"` // Lugares para pasajeros, sin contar al conductor
+	Description string            `json:"description,omitempty"` // Ej: "Gol gris, patente AB123CD"
+	CreatedAt   time.Time         `json:"created_at" gorm:"autoCreateTime"`
+	Passengers  []TravelPassenger `json:"passengers" gorm:"foreignKey:VehicleID"`
+}
+
+func (TravelVehicle) TableName() string {
+	return "travel_event_vehicles"
+}
+
+// FreeSeats devuelve los lugares que quedan libres
+func (v *TravelVehicle) FreeSeats() int {
+	return v.Seats - len(v.Passengers)
+}
+
+// TravelPassenger es la asignación de un participante a un vehículo.
+// Los menores necesitan la autorización de su padre/madre antes de la salida.
+type TravelPassenger struct {
+	ID                    uuid.UUID  `json:"id" gorm:"type:uuid;primary_key"`
+	EventID               uuid.UUID  `json:"event_id" gorm:"type:uuid;not null;uniqueIndex:idx_travel_passenger_event_user"`
+	VehicleID             uuid.UUID  `json:"vehicle_id" gorm:"type:uuid;not null;index"`
+	UserID                string     `json:"user_id" gorm:"not null;uniqueIndex:idx_travel_passenger_event_user"`
+	RequiresAuthorization bool       `json:"requires_authorization"`
+	AuthorizedBy          string     `json:"authorized_by,omitempty"`
+	AuthorizedAt          *time.Time `json:"authorized_at,omitempty"`
+	CreatedAt             time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (TravelPassenger) TableName() string {
+	return "travel_event_passengers"
+}
+
+// IsAuthorized indica si el pasajero puede viajar
+func (p *TravelPassenger) IsAuthorized() bool {
+	return !p.RequiresAuthorization || p.AuthorizedAt != nil
+}
+
+// CarpoolPlan es la distribución de participantes en vehículos
+type CarpoolPlan struct {
+	EventID               uuid.UUID       `json:"event_id"`
+	Vehicles              []TravelVehicle `json:"vehicles"`
+	Unassigned            []string        `json:"unassigned"`             // Confirmados sin vehículo
+	PendingAuthorizations []string        `json:"pending_authorizations"` // Menores sin autorización
+	FreeSeats             int             `json:"free_seats"`
+	ReadyToDepart         bool            `json:"ready_to_depart"`
+}
+
+// BuildCarpoolPlan arma el plan a partir de los vehículos y los confirmados del evento
+func BuildCarpoolPlan(eventID uuid.UUID, vehicles []TravelVehicle, confirmed []string) *CarpoolPlan {
+	plan := &CarpoolPlan{
+		EventID:               eventID,
+		Vehicles:              vehicles,
+		Unassigned:            []string{},
+		PendingAuthorizations: []string{},
+	}
+	assigned := make(map[string]bool)
+	for i := range vehicles {
+		plan.FreeSeats += vehicles[i].FreeSeats()
+		for _, p := range vehicles[i].Passengers {
+			assigned[p.UserID] = true
+			if !p.IsAuthorized() {
+				plan.PendingAuthorizations = append(plan.PendingAuthorizations, p.UserID)
+			}
+		}
+	}
+	for _, userID := range confirmed {
+		if !assigned[userID] {
+			plan.Unassigned = append(plan.Unassigned, userID)
+		}
+	}
+	plan.ReadyToDepart = len(plan.Unassigned) == 0 && len(plan.PendingAuthorizations) == 0
+	return plan
+}
+
+// TravelLogisticsRepository define la persistencia de cobros y carpooling de viajes
+type TravelLogisticsRepository interface {
+	CreateCharge(ctx context.Context, charge *TravelCharge) error
+	UpdateCharge(ctx context.Context, charge *TravelCharge) error
+	// GetCharge devuelve nil si no existe
+	GetCharge(ctx context.Context, clubID string, id uuid.UUID) (*TravelCharge, error)
+	ListCharges(ctx context.Context, clubID string, eventID uuid.UUID) ([]TravelCharge, error)
+
+	CreateVehicle(ctx context.Context, vehicle *TravelVehicle) error
+	// GetVehicle devuelve nil si no existe
+	GetVehicle(ctx context.Context, clubID string, id uuid.UUID) (*TravelVehicle, error)
+	ListVehicles(ctx context.Context, clubID string, eventID uuid.UUID) ([]TravelVehicle, error)
+	DeleteVehicle(ctx context.Context, clubID string, id uuid.UUID) error
+
+	// GetPassenger devuelve nil si el participante no tiene vehículo
+	GetPassenger(ctx context.Context, eventID uuid.UUID, userID string) (*TravelPassenger, error)
+	SavePassenger(ctx context.Context, passenger *TravelPassenger) error
+	DeletePassenger(ctx context.Context, eventID uuid.UUID, userID string) error
+}
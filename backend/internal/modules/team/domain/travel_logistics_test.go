@@ -0,0 +1,66 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildChargeTracker(t *testing.T) {
+	event := &domain.TravelEvent{ID: uuid.New(), CostPerPerson: decimal.NewFromInt(2500)}
+	charges := []domain.TravelCharge{
+		{UserID: "u1", Amount: decimal.NewFromInt(2500), Status: domain.TravelChargePaid},
+		{UserID: "u2", Amount: decimal.NewFromInt(2500), Status: domain.TravelChargePending},
+		{UserID: "u3", Amount: decimal.NewFromInt(2500), Status: domain.TravelChargeCancelled},
+	}
+
+	tracker := domain.BuildChargeTracker(event, charges)
+
+	assert.Equal(t, 1, tracker.PaidCount)
+	assert.Equal(t, 1, tracker.PendingCount)
+	assert.True(t, decimal.NewFromInt(5000).Equal(tracker.TotalCharged), tracker.TotalCharged.String())
+	assert.True(t, decimal.NewFromInt(2500).Equal(tracker.TotalCollected), tracker.TotalCollected.String())
+	assert.Len(t, tracker.Charges, 3)
+}
+
+func TestTravelCharge_CanBePaid(t *testing.T) {
+	assert.NoError(t, (&domain.TravelCharge{Status: domain.TravelChargePending}).CanBePaid())
+	assert.ErrorIs(t, (&domain.TravelCharge{Status: domain.TravelChargePaid}).CanBePaid(), domain.ErrChargeAlreadyPaid)
+	assert.ErrorIs(t, (&domain.TravelCharge{Status: domain.TravelChargeCancelled}).CanBePaid(), domain.ErrChargeCancelled)
+}
+
+func TestBuildCarpoolPlan(t *testing.T) {
+	now := time.Now()
+	eventID := uuid.New()
+	vehicles := []domain.TravelVehicle{
+		{ID: uuid.New(), Seats: 3, Passengers: []domain.TravelPassenger{
+			{UserID: "u1"},
+			{UserID: "u2", RequiresAuthorization: true},
+		}},
+		{ID: uuid.New(), Seats: 2, Passengers: []domain.TravelPassenger{
+			{UserID: "u3", RequiresAuthorization: true, AuthorizedBy: "p3", AuthorizedAt: &now},
+		}},
+	}
+
+	t.Run("Faltan lugares y autorizaciones", func(t *testing.T) {
+		plan := domain.BuildCarpoolPlan(eventID, vehicles, []string{"u1", "u2", "u3", "u4"})
+
+		assert.Equal(t, 2, plan.FreeSeats)
+		assert.Equal(t, []string{"u4"}, plan.Unassigned)
+		assert.Equal(t, []string{"u2"}, plan.PendingAuthorizations)
+		assert.False(t, plan.ReadyToDepart)
+	})
+
+	t.Run("Listo para salir", func(t *testing.T) {
+		vehicles[0].Passengers[1].AuthorizedAt = &now
+		plan := domain.BuildCarpoolPlan(eventID, vehicles, []string{"u1", "u2", "u3"})
+
+		assert.Empty(t, plan.Unassigned)
+		assert.Empty(t, plan.PendingAuthorizations)
+		assert.True(t, plan.ReadyToDepart)
+	})
+}
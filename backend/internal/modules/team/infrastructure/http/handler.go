@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 	"time"
@@ -116,13 +117,24 @@ func (h *TeamHandler) GetTeamPlayersWithStatus(c *gin.Context) {
 	c.JSON(http.StatusOK, players)
 }
 
-// GetPlayerStatus obtiene el estado de un jugador específico
-// GET /teams/players/:playerId/status
+// GetPlayerStatus obtiene el estado de un jugador específico. La documentación se evalúa con
+// la regla de la disciplina y categoría indicadas (o la general del club)
+// GET /teams/players/:playerId/status?discipline_id=&category=
 func (h *TeamHandler) GetPlayerStatus(c *gin.Context) {
 	clubID := c.GetString("clubID")
 	playerID := c.Param("playerId")
 
-	status, err := h.playerStatusService.GetPlayerStatus(c.Request.Context(), clubID, playerID)
+	scope := userDomain.EligibilityScope{Category: c.Query("category")}
+	if raw := c.Query("discipline_id"); raw != "" {
+		disciplineID, err := uuid.Parse(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "discipline_id inválido"})
+			return
+		}
+		scope.DisciplineID = &disciplineID
+	}
+
+	status, err := h.playerStatusService.GetPlayerStatusFor(c.Request.Context(), clubID, playerID, scope)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -185,7 +197,7 @@ func (h *TeamHandler) CreateTravelEvent(c *gin.Context) {
 	}
 
 	clubID := c.GetString("clubID")
-	createdBy := c.GetString("user_id")
+	createdBy := c.GetString("userID")
 
 	var event domain.TravelEvent
 	if err := c.ShouldBindJSON(&event); err != nil {
@@ -251,7 +263,8 @@ type RSVPRequest struct {
 // RespondToTravelEvent registra la respuesta de un usuario a un evento
 // POST /events/:eventId/rsvp
 func (h *TeamHandler) RespondToTravelEvent(c *gin.Context) {
-	userID := c.GetString("user_id")
+	clubID := c.GetString("clubID")
+	userID := c.GetString("userID")
 	eventID := c.Param("eventId")
 
 	eventUUID, err := uuid.Parse(eventID)
@@ -266,7 +279,11 @@ func (h *TeamHandler) RespondToTravelEvent(c *gin.Context) {
 		return
 	}
 
-	if err := h.travelEventService.RespondToEvent(c.Request.Context(), eventUUID, userID, req.Status, req.Notes); err != nil {
+	if err := h.travelEventService.RespondToEvent(c.Request.Context(), clubID, eventUUID, userID, req.Status, req.Notes); err != nil {
+		if errors.Is(err, domain.ErrAuthorizationRequired) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -0,0 +1,162 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+type LineupHandler struct {
+	service *application.LineupService
+}
+
+func NewLineupHandler(service *application.LineupService) *LineupHandler {
+	return &LineupHandler{service: service}
+}
+
+// GetSquadProposal propone los convocados según disponibilidad y semáforo
+// GET /team/events/:eventId/squad?size=18
+func (h *LineupHandler) GetSquadProposal(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+	size := domain.DefaultSquadSize
+	if s := c.Query("size"); s != "" {
+		parsed, err := strconv.Atoi(s)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "size inválido"})
+			return
+		}
+		size = parsed
+	}
+
+	proposal, err := h.service.GetSquadProposal(c.Request.Context(), c.GetString("clubID"), eventID, size)
+	if err != nil {
+		c.JSON(lineupErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, proposal)
+}
+
+// GetLineup obtiene la alineación del partido
+// GET /team/events/:eventId/lineup
+func (h *LineupHandler) GetLineup(c *gin.Context) {
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	lineup, err := h.service.GetLineup(c.Request.Context(), c.GetString("clubID"), eventID)
+	if err != nil {
+		c.JSON(lineupErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	if lineup == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "El partido todavía no tiene alineación"})
+		return
+	}
+	c.JSON(http.StatusOK, lineup)
+}
+
+// SaveLineup guarda titulares, suplentes y posiciones
+// PUT /team/events/:eventId/lineup
+func (h *LineupHandler) SaveLineup(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	var input application.SaveLineupInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.EventID = eventID
+
+	lineup, err := h.service.SaveLineup(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(lineupErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, lineup)
+}
+
+// LockLineup cierra la alineación y notifica a los convocados
+// POST /team/events/:eventId/lineup/lock
+func (h *LineupHandler) LockLineup(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	lineup, err := h.service.LockLineup(c.Request.Context(), c.GetString("clubID"), eventID, c.GetString("userID"))
+	if err != nil {
+		c.JSON(lineupErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, lineup)
+}
+
+func requireCoach(c *gin.Context) bool {
+	role, exists := c.Get("userRole")
+	if !exists || (role != userDomain.RoleCoach && role != userDomain.RoleAdmin && role != userDomain.RoleSuperAdmin) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "requires COACH or ADMIN role"})
+		return false
+	}
+	return true
+}
+
+func parseEventID(c *gin.Context) (uuid.UUID, bool) {
+	eventID, err := uuid.Parse(c.Param("eventId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de evento inválido"})
+		return uuid.Nil, false
+	}
+	return eventID, true
+}
+
+func lineupErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrMatchEventNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrLineupLocked):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrPlayerNotInSquad),
+		errors.Is(err, domain.ErrPlayerDeclined),
+		errors.Is(err, domain.ErrPlayerNotEligible),
+		errors.Is(err, domain.ErrDuplicateLineupPlayer),
+		errors.Is(err, domain.ErrInvalidLineupRole),
+		errors.Is(err, domain.ErrEmptyLineup):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func RegisterLineupRoutes(r *gin.RouterGroup, handler *LineupHandler, authMiddleware, tenantMiddleware gin.HandlerFunc) {
+	events := r.Group("/team/events/:eventId")
+	events.Use(authMiddleware, tenantMiddleware)
+	{
+		events.GET("/squad", handler.GetSquadProposal)
+		events.GET("/lineup", handler.GetLineup)
+		events.PUT("/lineup", handler.SaveLineup)
+		events.POST("/lineup/lock", handler.LockLineup)
+	}
+}
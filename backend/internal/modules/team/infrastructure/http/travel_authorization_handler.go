@@ -0,0 +1,225 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+)
+
+type TravelAuthorizationHandler struct {
+	service *application.TravelAuthorizationService
+}
+
+func NewTravelAuthorizationHandler(service *application.TravelAuthorizationService) *TravelAuthorizationHandler {
+	return &TravelAuthorizationHandler{service: service}
+}
+
+type requestAuthorizationsRequest struct {
+	UserIDs []string `json:"user_ids"`
+}
+
+// RequestAuthorizations envía el pedido de autorización a los padres de los menores
+// POST /events/:eventId/authorizations
+func (h *TravelAuthorizationHandler) RequestAuthorizations(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	var req requestAuthorizationsRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	result, err := h.service.RequestAuthorizations(c.Request.Context(), c.GetString("clubID"), eventID, req.UserIDs, c.GetString("userID"))
+	if err != nil {
+		c.JSON(authorizationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// ListEventAuthorizations muestra el estado de las autorizaciones del evento
+// GET /events/:eventId/authorizations
+func (h *TravelAuthorizationHandler) ListEventAuthorizations(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	authorizations, err := h.service.ListEventAuthorizations(c.Request.Context(), c.GetString("clubID"), eventID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, authorizations)
+}
+
+// GetAuthorizationDocument devuelve el texto que firma el padre/madre
+// GET /events/:eventId/authorizations/:minorId
+func (h *TravelAuthorizationHandler) GetAuthorizationDocument(c *gin.Context) {
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	document, err := h.service.GetAuthorizationDocument(c.Request.Context(), c.GetString("clubID"), eventID, c.Param("minorId"), c.GetString("userID"))
+	if err != nil {
+		c.JSON(authorizationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, document)
+}
+
+// SignAuthorization registra la firma electrónica del padre/madre
+// POST /events/:eventId/authorizations/:minorId/sign
+func (h *TravelAuthorizationHandler) SignAuthorization(c *gin.Context) {
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	var input application.SignAuthorizationInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	input.ClubID = c.GetString("clubID")
+	input.EventID = eventID
+	input.MinorID = c.Param("minorId")
+	input.ParentID = c.GetString("userID")
+	input.IPAddress = c.ClientIP()
+	input.UserAgent = c.Request.UserAgent()
+
+	authorization, err := h.service.SignAuthorization(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(authorizationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, authorization)
+}
+
+// RevokeAuthorization revoca la autorización firmada
+// POST /events/:eventId/authorizations/:minorId/revoke
+func (h *TravelAuthorizationHandler) RevokeAuthorization(c *gin.Context) {
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	authorization, err := h.service.RevokeAuthorization(c.Request.Context(), c.GetString("clubID"), eventID, c.Param("minorId"), c.GetString("userID"))
+	if err != nil {
+		c.JSON(authorizationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, authorization)
+}
+
+// ListMyAuthorizations lista las autorizaciones pedidas al padre/madre autenticado
+// GET /travel-authorizations/me
+func (h *TravelAuthorizationHandler) ListMyAuthorizations(c *gin.Context) {
+	authorizations, err := h.service.ListParentAuthorizations(c.Request.Context(), c.GetString("clubID"), c.GetString("userID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, authorizations)
+}
+
+// GetRoster devuelve el listado de viajeros con contactos de emergencia y cobertura
+// GET /events/:eventId/roster
+func (h *TravelAuthorizationHandler) GetRoster(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	roster, err := h.service.GetRoster(c.Request.Context(), c.GetString("clubID"), eventID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Evento no encontrado"})
+		return
+	}
+	c.JSON(http.StatusOK, roster)
+}
+
+// ExportRosterPDF descarga el listado de viajeros imprimible
+// GET /events/:eventId/roster.pdf
+func (h *TravelAuthorizationHandler) ExportRosterPDF(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	roster, err := h.service.GetRoster(c.Request.Context(), c.GetString("clubID"), eventID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Evento no encontrado"})
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := h.service.WriteRosterPDF(roster, &buf); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=viajeros_%s.pdf", eventID.String()[:8]))
+	c.Data(http.StatusOK, "application/pdf", buf.Bytes())
+}
+
+func authorizationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrAuthorizationNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrNotParentOfParticipant):
+		return http.StatusForbidden
+	case errors.Is(err, domain.ErrAuthorizationSigned),
+		errors.Is(err, domain.ErrAuthorizationNotSigned):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrEventDeparted),
+		errors.Is(err, domain.ErrSignatureNameRequired),
+		errors.Is(err, domain.ErrSignatureTermsNotAccepted):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func RegisterTravelAuthorizationRoutes(r *gin.RouterGroup, handler *TravelAuthorizationHandler, authMiddleware, tenantMiddleware gin.HandlerFunc) {
+	events := r.Group("/events/:eventId")
+	events.Use(authMiddleware, tenantMiddleware)
+	{
+		events.POST("/authorizations", handler.RequestAuthorizations)
+		events.GET("/authorizations", handler.ListEventAuthorizations)
+		events.GET("/authorizations/:minorId", handler.GetAuthorizationDocument)
+		events.POST("/authorizations/:minorId/sign", handler.SignAuthorization)
+		events.POST("/authorizations/:minorId/revoke", handler.RevokeAuthorization)
+
+		events.GET("/roster", handler.GetRoster)
+		events.GET("/roster.pdf", handler.ExportRosterPDF)
+	}
+
+	mine := r.Group("/travel-authorizations")
+	mine.Use(authMiddleware, tenantMiddleware)
+	{
+		mine.GET("/me", handler.ListMyAuthorizations)
+	}
+}
@@ -0,0 +1,290 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	paymentDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/payment/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+type TravelLogisticsHandler struct {
+	service *application.TravelLogisticsService
+}
+
+func NewTravelLogisticsHandler(service *application.TravelLogisticsService) *TravelLogisticsHandler {
+	return &TravelLogisticsHandler{service: service}
+}
+
+// GenerateCharges genera (o recalcula) el cargo de cada confirmado
+// POST /events/:eventId/charges
+func (h *TravelLogisticsHandler) GenerateCharges(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	tracker, err := h.service.GenerateCharges(c.Request.Context(), c.GetString("clubID"), eventID)
+	if err != nil {
+		c.JSON(travelErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, tracker)
+}
+
+// GetChargeTracker muestra quién pagó el viaje
+// GET /events/:eventId/charges
+func (h *TravelLogisticsHandler) GetChargeTracker(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	tracker, err := h.service.GetChargeTracker(c.Request.Context(), c.GetString("clubID"), eventID)
+	if err != nil {
+		c.JSON(travelErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, tracker)
+}
+
+// CheckoutCharge genera el link de pago del cargo (participante o padre/madre)
+// POST /events/:eventId/charges/:chargeId/checkout
+func (h *TravelLogisticsHandler) CheckoutCharge(c *gin.Context) {
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+	chargeID, ok := parseUUIDParam(c, "chargeId", "ID de cargo inválido")
+	if !ok {
+		return
+	}
+
+	url, err := h.service.CheckoutCharge(c.Request.Context(), c.GetString("clubID"), eventID, chargeID, c.GetString("userID"))
+	if err != nil {
+		c.JSON(travelErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"checkout_url": url})
+}
+
+type offlineChargePaymentRequest struct {
+	Method paymentDomain.PaymentMethod `json:"method"`
+	Notes  string                      `json:"notes"`
+}
+
+// RecordOfflinePayment registra un pago en efectivo o transferencia hecho en secretaría
+// POST /events/:eventId/charges/:chargeId/offline
+func (h *TravelLogisticsHandler) RecordOfflinePayment(c *gin.Context) {
+	role := c.GetString("userRole")
+	if role != userDomain.RoleAdmin && role != "STAFF" && role != userDomain.RoleSuperAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "insufficient permissions to create offline payments"})
+		return
+	}
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+	chargeID, ok := parseUUIDParam(c, "chargeId", "ID de cargo inválido")
+	if !ok {
+		return
+	}
+
+	var req offlineChargePaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	charge, err := h.service.RecordOfflinePayment(c.Request.Context(), c.GetString("clubID"), eventID, chargeID, req.Method, req.Notes)
+	if err != nil {
+		c.JSON(travelErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, charge)
+}
+
+// GetCarpoolPlan muestra vehículos, pasajeros y autorizaciones pendientes
+// GET /events/:eventId/carpool
+func (h *TravelLogisticsHandler) GetCarpoolPlan(c *gin.Context) {
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	plan, err := h.service.GetCarpoolPlan(c.Request.Context(), c.GetString("clubID"), eventID)
+	if err != nil {
+		c.JSON(travelErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, plan)
+}
+
+// OfferVehicle registra los lugares que ofrece el usuario como conductor
+// POST /events/:eventId/carpool/vehicles
+func (h *TravelLogisticsHandler) OfferVehicle(c *gin.Context) {
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	var input application.OfferVehicleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	vehicle, err := h.service.OfferVehicle(c.Request.Context(), c.GetString("clubID"), eventID, c.GetString("userID"), input)
+	if err != nil {
+		c.JSON(travelErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, vehicle)
+}
+
+// RemoveVehicle retira un vehículo (el conductor o el staff)
+// DELETE /events/:eventId/carpool/vehicles/:vehicleId
+func (h *TravelLogisticsHandler) RemoveVehicle(c *gin.Context) {
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+	vehicleID, ok := parseUUIDParam(c, "vehicleId", "ID de vehículo inválido")
+	if !ok {
+		return
+	}
+
+	role := c.GetString("userRole")
+	isStaff := role == userDomain.RoleCoach || role == userDomain.RoleAdmin || role == userDomain.RoleSuperAdmin
+	if err := h.service.RemoveVehicle(c.Request.Context(), c.GetString("clubID"), eventID, vehicleID, c.GetString("userID"), isStaff); err != nil {
+		c.JSON(travelErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+type assignPassengerRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+}
+
+// AssignPassenger sube a un confirmado al vehículo
+// PUT /events/:eventId/carpool/vehicles/:vehicleId/passengers
+func (h *TravelLogisticsHandler) AssignPassenger(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+	vehicleID, ok := parseUUIDParam(c, "vehicleId", "ID de vehículo inválido")
+	if !ok {
+		return
+	}
+
+	var req assignPassengerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	passenger, err := h.service.AssignPassenger(c.Request.Context(), c.GetString("clubID"), eventID, vehicleID, req.UserID)
+	if err != nil {
+		c.JSON(travelErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, passenger)
+}
+
+// UnassignPassenger baja a un participante de su vehículo
+// DELETE /events/:eventId/carpool/passengers/:userId
+func (h *TravelLogisticsHandler) UnassignPassenger(c *gin.Context) {
+	if !requireCoach(c) {
+		return
+	}
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.UnassignPassenger(c.Request.Context(), c.GetString("clubID"), eventID, c.Param("userId")); err != nil {
+		c.JSON(travelErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// AuthorizePassenger registra la autorización del padre/madre para el traslado del menor
+// POST /events/:eventId/carpool/passengers/:userId/authorize
+func (h *TravelLogisticsHandler) AuthorizePassenger(c *gin.Context) {
+	eventID, ok := parseEventID(c)
+	if !ok {
+		return
+	}
+
+	passenger, err := h.service.AuthorizePassenger(c.Request.Context(), c.GetString("clubID"), eventID, c.Param("userId"), c.GetString("userID"))
+	if err != nil {
+		c.JSON(travelErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, passenger)
+}
+
+func parseUUIDParam(c *gin.Context, name, message string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(name))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": message})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func travelErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrChargeNotFound),
+		errors.Is(err, domain.ErrVehicleNotFound),
+		errors.Is(err, domain.ErrPassengerNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrNotParentOfParticipant):
+		return http.StatusForbidden
+	case errors.Is(err, domain.ErrChargeAlreadyPaid),
+		errors.Is(err, domain.ErrChargeCancelled),
+		errors.Is(err, domain.ErrVehicleExists),
+		errors.Is(err, domain.ErrVehicleFull):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrNoCostDefined),
+		errors.Is(err, domain.ErrNotConfirmed),
+		errors.Is(err, domain.ErrEventDeparted):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func RegisterTravelLogisticsRoutes(r *gin.RouterGroup, handler *TravelLogisticsHandler, authMiddleware, tenantMiddleware gin.HandlerFunc) {
+	events := r.Group("/events/:eventId")
+	events.Use(authMiddleware, tenantMiddleware)
+	{
+		events.POST("/charges", handler.GenerateCharges)
+		events.GET("/charges", handler.GetChargeTracker)
+		events.POST("/charges/:chargeId/checkout", handler.CheckoutCharge)
+		events.POST("/charges/:chargeId/offline", handler.RecordOfflinePayment)
+
+		events.GET("/carpool", handler.GetCarpoolPlan)
+		events.POST("/carpool/vehicles", handler.OfferVehicle)
+		events.DELETE("/carpool/vehicles/:vehicleId", handler.RemoveVehicle)
+		events.PUT("/carpool/vehicles/:vehicleId/passengers", handler.AssignPassenger)
+		events.DELETE("/carpool/passengers/:userId", handler.UnassignPassenger)
+		events.POST("/carpool/passengers/:userId/authorize", handler.AuthorizePassenger)
+	}
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PostgresLineupRepository implementa el repositorio de alineaciones usando PostgreSQL
+type PostgresLineupRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresLineupRepository crea una nueva instancia del repositorio
+func NewPostgresLineupRepository(db *gorm.DB) *PostgresLineupRepository {
+	return &PostgresLineupRepository{db: db}
+}
+
+// GetLineup obtiene la alineación de un partido con sus convocados
+func (r *PostgresLineupRepository) GetLineup(ctx context.Context, clubID string, matchEventID uuid.UUID) (*domain.Lineup, error) {
+	var lineup domain.Lineup
+	err := r.db.WithContext(ctx).
+		Where("club_id = ? AND match_event_id = ?", clubID, matchEventID).
+		Preload("Players").
+		First(&lineup).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &lineup, nil
+}
+
+// SaveLineup guarda la alineación y reemplaza sus convocados en una transacción
+func (r *PostgresLineupRepository) SaveLineup(ctx context.Context, lineup *domain.Lineup) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Players").Save(lineup).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("lineup_id = ?", lineup.ID).Delete(&domain.LineupPlayer{}).Error; err != nil {
+			return err
+		}
+		if len(lineup.Players) == 0 {
+			return nil
+		}
+		return tx.Create(&lineup.Players).Error
+	})
+}
+
+// ListUpcomingMatchEvents obtiene los partidos del club con encuentro entre from y to
+func (r *PostgresLineupRepository) ListUpcomingMatchEvents(ctx context.Context, clubID string, from, to time.Time) ([]domain.MatchEvent, error) {
+	var events []domain.MatchEvent
+	err := r.db.WithContext(ctx).
+		Joins("JOIN training_groups ON training_groups.id = match_events.training_group_id").
+		Where("training_groups.club_id = ? AND match_events.meetup_time BETWEEN ? AND ?", clubID, from, to).
+		Order("match_events.meetup_time ASC").
+		Find(&events).Error
+	return events, err
+}
+
+// ListRemindedUserIDs obtiene los jugadores que ya recibieron el recordatorio del partido
+func (r *PostgresLineupRepository) ListRemindedUserIDs(ctx context.Context, matchEventID uuid.UUID) ([]string, error) {
+	var userIDs []string
+	err := r.db.WithContext(ctx).Model(&domain.AvailabilityReminder{}).
+		Where("match_event_id = ?", matchEventID).
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
+// CreateReminder registra un recordatorio enviado (ignora duplicados)
+func (r *PostgresLineupRepository) CreateReminder(ctx context.Context, reminder *domain.AvailabilityReminder) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(reminder).Error
+}
@@ -0,0 +1,59 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/infrastructure/repository"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestPostgresLineupRepository_SaveLineup(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&domain.Lineup{}, &domain.LineupPlayer{}, &domain.AvailabilityReminder{}))
+	repo := repository.NewPostgresLineupRepository(db)
+	ctx := context.Background()
+	eventID := uuid.New()
+
+	t.Run("Sin alineación devuelve nil", func(t *testing.T) {
+		lineup, err := repo.GetLineup(ctx, "club-1", eventID)
+		assert.NoError(t, err)
+		assert.Nil(t, lineup)
+	})
+
+	t.Run("Reemplaza los convocados al guardar", func(t *testing.T) {
+		lineup := &domain.Lineup{ID: uuid.New(), ClubID: "club-1", MatchEventID: eventID, Status: domain.LineupDraft}
+		assert.NoError(t, lineup.SetPlayers([]domain.LineupPlayer{
+			{UserID: "u1", Role: domain.LineupStarter},
+			{UserID: "u2", Role: domain.LineupSubstitute},
+		}))
+		assert.NoError(t, repo.SaveLineup(ctx, lineup))
+
+		assert.NoError(t, lineup.SetPlayers([]domain.LineupPlayer{{UserID: "u3", Role: domain.LineupStarter, Position: "DEL"}}))
+		assert.NoError(t, repo.SaveLineup(ctx, lineup))
+
+		stored, err := repo.GetLineup(ctx, "club-1", eventID)
+		assert.NoError(t, err)
+		assert.Len(t, stored.Players, 1)
+		assert.Equal(t, "u3", stored.Players[0].UserID)
+
+		other, err := repo.GetLineup(ctx, "club-2", eventID)
+		assert.NoError(t, err)
+		assert.Nil(t, other, "No se expone a otro club")
+	})
+
+	t.Run("Recordatorios sin duplicados", func(t *testing.T) {
+		reminder := &domain.AvailabilityReminder{MatchEventID: eventID, UserID: "u4"}
+		assert.NoError(t, repo.CreateReminder(ctx, reminder))
+		assert.NoError(t, repo.CreateReminder(ctx, reminder))
+
+		reminded, err := repo.ListRemindedUserIDs(ctx, eventID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"u4"}, reminded)
+	})
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	"gorm.io/gorm"
+)
+
+// PostgresTravelAuthorizationRepository implementa las autorizaciones de viaje usando PostgreSQL
+type PostgresTravelAuthorizationRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresTravelAuthorizationRepository crea una nueva instancia del repositorio
+func NewPostgresTravelAuthorizationRepository(db *gorm.DB) *PostgresTravelAuthorizationRepository {
+	return &PostgresTravelAuthorizationRepository{db: db}
+}
+
+// Save crea o actualiza una autorización
+func (r *PostgresTravelAuthorizationRepository) Save(ctx context.Context, authorization *domain.TravelAuthorization) error {
+	return r.db.WithContext(ctx).Save(authorization).Error
+}
+
+// Get obtiene la autorización de un menor para un evento
+func (r *PostgresTravelAuthorizationRepository) Get(ctx context.Context, clubID string, eventID uuid.UUID, minorID string) (*domain.TravelAuthorization, error) {
+	var authorization domain.TravelAuthorization
+	err := r.db.WithContext(ctx).
+		Where("club_id = ? AND event_id = ? AND minor_id = ?", clubID, eventID, minorID).
+		First(&authorization).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &authorization, nil
+}
+
+// ListByEvent obtiene las autorizaciones de un evento
+func (r *PostgresTravelAuthorizationRepository) ListByEvent(ctx context.Context, clubID string, eventID uuid.UUID) ([]domain.TravelAuthorization, error) {
+	var authorizations []domain.TravelAuthorization
+	err := r.db.WithContext(ctx).Where("club_id = ? AND event_id = ?", clubID, eventID).
+		Order("requested_at ASC").
+		Find(&authorizations).Error
+	return authorizations, err
+}
+
+// ListByParent obtiene las autorizaciones que debe firmar (o firmó) un padre/madre
+func (r *PostgresTravelAuthorizationRepository) ListByParent(ctx context.Context, clubID, parentID string) ([]domain.TravelAuthorization, error) {
+	var authorizations []domain.TravelAuthorization
+	err := r.db.WithContext(ctx).Where("club_id = ? AND parent_id = ?", clubID, parentID).
+		Order("requested_at DESC").
+		Find(&authorizations).Error
+	return authorizations, err
+}
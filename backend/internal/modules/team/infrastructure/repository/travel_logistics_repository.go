@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PostgresTravelLogisticsRepository implementa cobros y carpooling de viajes usando PostgreSQL
+type PostgresTravelLogisticsRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresTravelLogisticsRepository crea una nueva instancia del repositorio
+func NewPostgresTravelLogisticsRepository(db *gorm.DB) *PostgresTravelLogisticsRepository {
+	return &PostgresTravelLogisticsRepository{db: db}
+}
+
+// CreateCharge crea el cargo de un participante
+func (r *PostgresTravelLogisticsRepository) CreateCharge(ctx context.Context, charge *domain.TravelCharge) error {
+	return r.db.WithContext(ctx).Create(charge).Error
+}
+
+// UpdateCharge actualiza un cargo existente
+func (r *PostgresTravelLogisticsRepository) UpdateCharge(ctx context.Context, charge *domain.TravelCharge) error {
+	return r.db.WithContext(ctx).Save(charge).Error
+}
+
+// GetCharge obtiene un cargo por su ID
+func (r *PostgresTravelLogisticsRepository) GetCharge(ctx context.Context, clubID string, id uuid.UUID) (*domain.TravelCharge, error) {
+	var charge domain.TravelCharge
+	err := r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).First(&charge).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &charge, nil
+}
+
+// ListCharges obtiene los cargos de un evento
+func (r *PostgresTravelLogisticsRepository) ListCharges(ctx context.Context, clubID string, eventID uuid.UUID) ([]domain.TravelCharge, error) {
+	var charges []domain.TravelCharge
+	err := r.db.WithContext(ctx).Where("club_id = ? AND event_id = ?", clubID, eventID).
+		Order("created_at ASC").
+		Find(&charges).Error
+	return charges, err
+}
+
+// CreateVehicle registra un vehículo ofrecido
+func (r *PostgresTravelLogisticsRepository) CreateVehicle(ctx context.Context, vehicle *domain.TravelVehicle) error {
+	return r.db.WithContext(ctx).Omit("Passengers").Create(vehicle).Error
+}
+
+// GetVehicle obtiene un vehículo con sus pasajeros
+func (r *PostgresTravelLogisticsRepository) GetVehicle(ctx context.Context, clubID string, id uuid.UUID) (*domain.TravelVehicle, error) {
+	var vehicle domain.TravelVehicle
+	err := r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).
+		Preload("Passengers").
+		First(&vehicle).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &vehicle, nil
+}
+
+// ListVehicles obtiene los vehículos de un evento con sus pasajeros
+func (r *PostgresTravelLogisticsRepository) ListVehicles(ctx context.Context, clubID string, eventID uuid.UUID) ([]domain.TravelVehicle, error) {
+	var vehicles []domain.TravelVehicle
+	err := r.db.WithContext(ctx).Where("club_id = ? AND event_id = ?", clubID, eventID).
+		Order("created_at ASC").
+		Preload("Passengers").
+		Find(&vehicles).Error
+	return vehicles, err
+}
+
+// DeleteVehicle elimina un vehículo; sus pasajeros quedan sin asignar
+func (r *PostgresTravelLogisticsRepository) DeleteVehicle(ctx context.Context, clubID string, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("vehicle_id = ?", id).Delete(&domain.TravelPassenger{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("club_id = ? AND id = ?", clubID, id).Delete(&domain.TravelVehicle{}).Error
+	})
+}
+
+// GetPassenger obtiene la asignación de un participante
+func (r *PostgresTravelLogisticsRepository) GetPassenger(ctx context.Context, eventID uuid.UUID, userID string) (*domain.TravelPassenger, error) {
+	var passenger domain.TravelPassenger
+	err := r.db.WithContext(ctx).Where("event_id = ? AND user_id = ?", eventID, userID).First(&passenger).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &passenger, nil
+}
+
+// SavePassenger crea o mueve la asignación de un participante (una por evento)
+func (r *PostgresTravelLogisticsRepository) SavePassenger(ctx context.Context, passenger *domain.TravelPassenger) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"vehicle_id", "requires_authorization", "authorized_by", "authorized_at"}),
+	}).Create(passenger).Error
+}
+
+// DeletePassenger quita al participante de su vehículo
+func (r *PostgresTravelLogisticsRepository) DeletePassenger(ctx context.Context, eventID uuid.UUID, userID string) error {
+	return r.db.WithContext(ctx).Where("event_id = ? AND user_id = ?", eventID, userID).
+		Delete(&domain.TravelPassenger{}).Error
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	paymentApp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/payment/application"
+	paymentDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/payment/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+)
+
+// TravelPaymentAdapter cobra los cargos de viaje con el módulo Payment.
+// Los pagos se registran con ReferenceType TRAVEL_CHARGE para que el webhook (o el registro
+// en secretaría) acredite el cargo en el servicio de logística.
+type TravelPaymentAdapter struct {
+	paymentUC *paymentApp.PaymentUseCases
+}
+
+func NewTravelPaymentAdapter(paymentUC *paymentApp.PaymentUseCases) *TravelPaymentAdapter {
+	return &TravelPaymentAdapter{paymentUC: paymentUC}
+}
+
+func (a *TravelPaymentAdapter) CreateChargeCheckout(ctx context.Context, charge *domain.TravelCharge, payerID, payerEmail, description string) (uuid.UUID, string, error) {
+	userID, err := uuid.Parse(payerID)
+	if err != nil {
+		return uuid.Nil, "", errors.New("invalid payer ID")
+	}
+	payment, url, err := a.paymentUC.Checkout(ctx, paymentApp.CheckoutRequest{
+		Amount:        charge.Amount.StringFixed(2),
+		Description:   description,
+		PayerEmail:    payerEmail,
+		ReferenceID:   charge.ID,
+		ReferenceType: domain.TravelChargeReferenceType,
+		UserID:        userID,
+		ClubID:        charge.ClubID,
+	})
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	return payment.ID, url, nil
+}
+
+func (a *TravelPaymentAdapter) RecordOfflineChargePayment(ctx context.Context, charge *domain.TravelCharge, method paymentDomain.PaymentMethod, notes string) (uuid.UUID, error) {
+	payerID, err := uuid.Parse(charge.UserID)
+	if err != nil {
+		return uuid.Nil, errors.New("invalid payer ID")
+	}
+	payment, err := a.paymentUC.CreateOfflinePayment(ctx, paymentApp.CreateOfflinePaymentRequest{
+		Amount:        charge.Amount.StringFixed(2),
+		Method:        method,
+		PayerID:       payerID,
+		ReferenceID:   charge.ID,
+		ReferenceType: domain.TravelChargeReferenceType,
+		Notes:         notes,
+		ClubID:        charge.ClubID,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return payment.ID, nil
+}
+
+func (a *TravelPaymentAdapter) GetChargePayment(ctx context.Context, clubID string, paymentID uuid.UUID) (*paymentDomain.Payment, error) {
+	return a.paymentUC.GetPayment(ctx, clubID, paymentID)
+}
@@ -0,0 +1,115 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+)
+
+// AvailabilityReminderJob recuerda responder la convocatoria a los jugadores del plantel que todavía
+// no informaron su disponibilidad para un partido próximo. Cada jugador recibe un solo recordatorio
+// por partido y no se envían si la alineación ya está cerrada.
+type AvailabilityReminderJob struct {
+	teamRepo    domain.TeamRepository
+	lineups     domain.LineupRepository
+	roster      domain.SquadRosterProvider
+	notifier    notificationSvc.NotificationSender
+	hoursBefore int
+}
+
+// NewAvailabilityReminderJob crea el job; hoursBefore es la ventana de partidos a recordar
+func NewAvailabilityReminderJob(
+	teamRepo domain.TeamRepository,
+	lineups domain.LineupRepository,
+	roster domain.SquadRosterProvider,
+	notifier notificationSvc.NotificationSender,
+	hoursBefore int,
+) *AvailabilityReminderJob {
+	if hoursBefore <= 0 {
+		hoursBefore = 48 // Dos días antes del encuentro
+	}
+	return &AvailabilityReminderJob{
+		teamRepo:    teamRepo,
+		lineups:     lineups,
+		roster:      roster,
+		notifier:    notifier,
+		hoursBefore: hoursBefore,
+	}
+}
+
+// Run envía los recordatorios pendientes del club y devuelve cuántos se enviaron
+func (j *AvailabilityReminderJob) Run(ctx context.Context, clubID string) (int, error) {
+	now := time.Now()
+	events, err := j.lineups.ListUpcomingMatchEvents(ctx, clubID, now, now.Add(time.Duration(j.hoursBefore)*time.Hour))
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range events {
+		n, err := j.remindEvent(ctx, clubID, &events[i], now)
+		sent += n
+		if err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+func (j *AvailabilityReminderJob) remindEvent(ctx context.Context, clubID string, event *domain.MatchEvent, now time.Time) (int, error) {
+	lineup, err := j.lineups.GetLineup(ctx, clubID, event.ID)
+	if err != nil {
+		return 0, err
+	}
+	if lineup != nil && lineup.IsLocked() {
+		return 0, nil
+	}
+
+	roster, err := j.roster.ListRosterUserIDs(ctx, clubID, event.TrainingGroupID, event.MeetupTime)
+	if err != nil {
+		return 0, err
+	}
+	availabilities, err := j.teamRepo.GetEventAvailabilities(ctx, clubID, event.ID.String())
+	if err != nil {
+		return 0, err
+	}
+	reminded, err := j.lineups.ListRemindedUserIDs(ctx, event.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	skip := make(map[string]bool, len(availabilities)+len(reminded))
+	for _, a := range availabilities {
+		skip[a.UserID] = true
+	}
+	for _, userID := range reminded {
+		skip[userID] = true
+	}
+
+	sent := 0
+	for _, userID := range roster {
+		if skip[userID] {
+			continue
+		}
+		err := j.notifier.Send(ctx, notificationSvc.Notification{
+			RecipientID: userID,
+			Type:        notificationSvc.NotificationTypePush,
+			Title:       "⚽ ¿Jugás el partido?",
+			Body:        fmt.Sprintf("Confirmá tu disponibilidad para el partido vs %s del %s", event.OpponentName, event.MeetupTime.Format("02/01 15:04")),
+		})
+		if err != nil {
+			// Se reintenta en la próxima ejecución
+			log.Printf("[AvailabilityReminderJob] error notificando a %s: %v", userID, err)
+			continue
+		}
+		if err := j.lineups.CreateReminder(ctx, &domain.AvailabilityReminder{MatchEventID: event.ID, UserID: userID, SentAt: now}); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
@@ -0,0 +1,111 @@
+package jobs_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/team/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTeamRepo struct {
+	mock.Mock
+}
+
+func (m *MockTeamRepo) CreateMatchEvent(ctx context.Context, event *domain.MatchEvent) error {
+	return nil
+}
+func (m *MockTeamRepo) GetMatchEvent(ctx context.Context, clubID, id string) (*domain.MatchEvent, error) {
+	return nil, nil
+}
+func (m *MockTeamRepo) SetPlayerAvailability(ctx context.Context, clubID string, availability *domain.PlayerAvailability) error {
+	return nil
+}
+func (m *MockTeamRepo) GetEventAvailabilities(ctx context.Context, clubID, eventID string) ([]domain.PlayerAvailability, error) {
+	args := m.Called(ctx, clubID, eventID)
+	return args.Get(0).([]domain.PlayerAvailability), args.Error(1)
+}
+
+type MockLineupRepo struct {
+	mock.Mock
+}
+
+func (m *MockLineupRepo) GetLineup(ctx context.Context, clubID string, matchEventID uuid.UUID) (*domain.Lineup, error) {
+	args := m.Called(ctx, clubID, matchEventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Lineup), args.Error(1)
+}
+func (m *MockLineupRepo) SaveLineup(ctx context.Context, lineup *domain.Lineup) error {
+	return m.Called(ctx, lineup).Error(0)
+}
+func (m *MockLineupRepo) ListUpcomingMatchEvents(ctx context.Context, clubID string, from, to time.Time) ([]domain.MatchEvent, error) {
+	args := m.Called(ctx, clubID, from, to)
+	return args.Get(0).([]domain.MatchEvent), args.Error(1)
+}
+func (m *MockLineupRepo) ListRemindedUserIDs(ctx context.Context, matchEventID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, matchEventID)
+	return args.Get(0).([]string), args.Error(1)
+}
+func (m *MockLineupRepo) CreateReminder(ctx context.Context, reminder *domain.AvailabilityReminder) error {
+	return m.Called(ctx, reminder).Error(0)
+}
+
+type MockSquadRoster struct {
+	mock.Mock
+}
+
+func (m *MockSquadRoster) ListRosterUserIDs(ctx context.Context, clubID string, groupID uuid.UUID, date time.Time) ([]string, error) {
+	args := m.Called(ctx, clubID, groupID, date)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) Send(ctx context.Context, n notificationSvc.Notification) error {
+	return m.Called(ctx, n).Error(0)
+}
+
+func TestAvailabilityReminderJob_Run(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	open := domain.MatchEvent{ID: uuid.New(), TrainingGroupID: uuid.New(), OpponentName: "Rivals", MeetupTime: time.Now().Add(24 * time.Hour)}
+	closed := domain.MatchEvent{ID: uuid.New(), TrainingGroupID: uuid.New(), MeetupTime: time.Now().Add(30 * time.Hour)}
+
+	teamRepo := new(MockTeamRepo)
+	lineups := new(MockLineupRepo)
+	roster := new(MockSquadRoster)
+	notifier := new(MockNotifier)
+
+	lineups.On("ListUpcomingMatchEvents", ctx, clubID, mock.Anything, mock.Anything).Return([]domain.MatchEvent{open, closed}, nil)
+	lineups.On("GetLineup", ctx, clubID, open.ID).Return(nil, nil)
+	lineups.On("GetLineup", ctx, clubID, closed.ID).Return(&domain.Lineup{Status: domain.LineupLocked}, nil)
+
+	// u1 respondió, u2 ya fue recordado: solo u3 recibe el recordatorio
+	roster.On("ListRosterUserIDs", ctx, clubID, open.TrainingGroupID, open.MeetupTime).Return([]string{"u1", "u2", "u3"}, nil)
+	teamRepo.On("GetEventAvailabilities", ctx, clubID, open.ID.String()).Return([]domain.PlayerAvailability{{UserID: "u1", Status: domain.AvailabilityMaybe}}, nil)
+	lineups.On("ListRemindedUserIDs", ctx, open.ID).Return([]string{"u2"}, nil)
+	notifier.On("Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool {
+		return n.RecipientID == "u3"
+	})).Return(nil).Once()
+	lineups.On("CreateReminder", ctx, mock.MatchedBy(func(r *domain.AvailabilityReminder) bool {
+		return r.UserID == "u3" && r.MatchEventID == open.ID
+	})).Return(nil).Once()
+
+	job := jobs.NewAvailabilityReminderJob(teamRepo, lineups, roster, notifier, 48)
+	sent, err := job.Run(ctx, clubID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	notifier.AssertExpectations(t)
+	lineups.AssertExpectations(t)
+	roster.AssertNotCalled(t, "ListRosterUserIDs", ctx, clubID, closed.TrainingGroupID, mock.Anything)
+}
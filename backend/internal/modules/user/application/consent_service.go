@@ -0,0 +1,368 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// pendingConsentsTTL acota cuánto se reutiliza el cálculo de consentimientos pendientes de un socio.
+// El gate de re-consentimiento lo consulta en cada request; otra instancia que publique una versión
+// nueva se nota como máximo después de este tiempo.
+const pendingConsentsTTL = time.Minute
+
+var ErrConsentForbidden = errors.New("no tienes permiso para gestionar los consentimientos de este usuario")
+
+// ConsentService administra el registro de políticas versionadas (términos, privacidad, datos de salud,
+// marketing) y los consentimientos de los socios, con el consentimiento del padre/madre para los menores.
+type ConsentService struct {
+	repo     domain.ConsentRepository
+	userRepo domain.UserRepository
+	now      func() time.Time
+
+	mu       sync.Mutex
+	pending  map[string]cachedPendingConsents
+	prunedAt time.Time
+}
+
+type cachedPendingConsents struct {
+	result    *PendingConsents
+	fetchedAt time.Time
+}
+
+// NewConsentService crea una nueva instancia del servicio
+func NewConsentService(repo domain.ConsentRepository, userRepo domain.UserRepository) *ConsentService {
+	return &ConsentService{
+		repo:     repo,
+		userRepo: userRepo,
+		now:      time.Now,
+		pending:  map[string]cachedPendingConsents{},
+	}
+}
+
+// PendingConsents son las versiones obligatorias vigentes que el socio todavía no aceptó
+type PendingConsents struct {
+	Policies []domain.ConsentPolicy `json:"policies"`
+	// ParentConsentRequired indica que el socio es menor y las tiene que aceptar su padre/madre
+	ParentConsentRequired bool `json:"parent_consent_required"`
+}
+
+// PublishConsentPolicyInput contiene una nueva versión de política.
+// Sin PublishedAt entra en vigencia al publicarse; con una fecha futura queda programada.
+type PublishConsentPolicyInput struct {
+	ConsentType domain.ConsentType `json:"consent_type" binding:"required"`
+	Version     string             `json:"version" binding:"required"`
+	Title       string             `json:"title"`
+	Content     string             `json:"content"`
+	URL         string             `json:"url"`
+	Mandatory   bool               `json:"mandatory"`
+	PublishedAt *time.Time         `json:"published_at"`
+}
+
+// PublishPolicy registra una nueva versión. Si es obligatoria, al entrar en vigencia todos los socios
+// tienen que volver a aceptarla antes de seguir usando la API.
+func (s *ConsentService) PublishPolicy(ctx context.Context, access DocumentAccess, input PublishConsentPolicyInput) (*domain.ConsentPolicy, error) {
+	if !isPrivacyAdmin(access.Role) {
+		return nil, ErrConsentForbidden
+	}
+	publishedAt := s.now()
+	if input.PublishedAt != nil {
+		publishedAt = *input.PublishedAt
+	}
+	policy, err := domain.NewConsentPolicy(access.ClubID, input.ConsentType, input.Version, input.Title, input.Content, input.URL, input.Mandatory, publishedAt, access.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.ListPolicies(ctx, access.ClubID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range existing {
+		if p.ConsentType == policy.ConsentType && p.Version == policy.Version {
+			return nil, domain.ErrConsentPolicyExists
+		}
+	}
+	if err := s.repo.CreatePolicy(ctx, policy); err != nil {
+		return nil, fmt.Errorf("error guardando la política: %w", err)
+	}
+
+	s.mu.Lock()
+	s.pending = map[string]cachedPendingConsents{}
+	s.mu.Unlock()
+	return policy, nil
+}
+
+// ListPolicies devuelve las versiones vigentes; con history los administradores ven también las anteriores y las programadas
+func (s *ConsentService) ListPolicies(ctx context.Context, access DocumentAccess, history bool) ([]domain.ConsentPolicy, error) {
+	policies, err := s.repo.ListPolicies(ctx, access.ClubID)
+	if err != nil {
+		return nil, err
+	}
+	if history && isPrivacyAdmin(access.Role) {
+		return policies, nil
+	}
+	return domain.CurrentPolicies(policies, s.now()), nil
+}
+
+// History devuelve los consentimientos dados y revocados por el socio (o en su nombre)
+func (s *ConsentService) History(ctx context.Context, access DocumentAccess, userID string) ([]domain.ConsentRecord, error) {
+	if _, err := s.subject(ctx, access, userID, isPrivacyAdmin(access.Role)); err != nil {
+		return nil, err
+	}
+	if userID == "" {
+		userID = access.UserID
+	}
+	return s.repo.GetByUserID(ctx, access.ClubID, userID)
+}
+
+// Pending devuelve las versiones obligatorias que le faltan aceptar al socio (o a su hijo/a)
+func (s *ConsentService) Pending(ctx context.Context, access DocumentAccess, userID string) (*PendingConsents, error) {
+	user, err := s.subject(ctx, access, userID, false)
+	if err != nil {
+		return nil, err
+	}
+	return s.computePending(ctx, access.ClubID, user)
+}
+
+// PendingConsents es la consulta del gate de re-consentimiento: usa el cálculo cacheado del socio
+func (s *ConsentService) PendingConsents(ctx context.Context, clubID, userID string) (*PendingConsents, error) {
+	key := clubID + "/" + userID
+	s.mu.Lock()
+	cached, ok := s.pending[key]
+	s.mu.Unlock()
+	if ok && s.now().Sub(cached.fetchedAt) < pendingConsentsTTL {
+		return cached.result, nil
+	}
+
+	user, err := s.userRepo.GetByID(ctx, clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := &PendingConsents{}
+	if user != nil {
+		if result, err = s.computePending(ctx, clubID, user); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mu.Lock()
+	now := s.now()
+	s.pruneExpired(now)
+	s.pending[key] = cachedPendingConsents{result: result, fetchedAt: now}
+	s.mu.Unlock()
+	return result, nil
+}
+
+// pruneExpired descarta las entradas vencidas del cache, a lo sumo una vez por TTL, para que no crezca
+// con cada socio que pasó por el gate. Se llama con s.mu tomado.
+func (s *ConsentService) pruneExpired(now time.Time) {
+	if now.Sub(s.prunedAt) < pendingConsentsTTL {
+		return
+	}
+	for key, cached := range s.pending {
+		if now.Sub(cached.fetchedAt) >= pendingConsentsTTL {
+			delete(s.pending, key)
+		}
+	}
+	s.prunedAt = now
+}
+
+// Accept registra la aceptación de la versión vigente de una política. El consentimiento de un
+// menor lo da su padre/madre (userID es el hijo/a) y queda registrado quién lo dio.
+func (s *ConsentService) Accept(ctx context.Context, access DocumentAccess, userID string, policyID uuid.UUID) (*domain.ConsentRecord, error) {
+	user, err := s.subject(ctx, access, userID, false)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	var parentID *string
+	if user.IsMinor(now) {
+		if user.ID == access.UserID {
+			return nil, domain.ErrParentConsentRequired
+		}
+		parent := access.UserID
+		parentID = &parent
+	}
+
+	policy, err := s.repo.GetPolicy(ctx, access.ClubID, policyID)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return nil, domain.ErrConsentPolicyNotFound
+	}
+	if !s.isCurrent(ctx, policy, now) {
+		return nil, domain.ErrConsentPolicyNotCurrent
+	}
+
+	records, err := s.repo.GetByUserID(ctx, access.ClubID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range records {
+		if records[i].Covers(policy) {
+			return &records[i], nil
+		}
+	}
+
+	record := &domain.ConsentRecord{
+		ID:           uuid.New(),
+		ClubID:       access.ClubID,
+		UserID:       user.ID,
+		ConsentType:  policy.ConsentType,
+		Version:      policy.Version,
+		PolicyID:     &policy.ID,
+		Accepted:     true,
+		AcceptedAt:   now,
+		IPAddress:    access.IPAddress,
+		UserAgent:    access.UserAgent,
+		ParentUserID: parentID,
+	}
+	if err := s.repo.Create(ctx, record); err != nil {
+		return nil, fmt.Errorf("error guardando el consentimiento: %w", err)
+	}
+	s.forget(access.ClubID, user.ID)
+	return record, nil
+}
+
+// Revoke retira el consentimiento de un tipo (GDPR Art. 7.3). Los obligatorios no se revocan:
+// dejar de aceptarlos equivale a dejar de usar el servicio, y para eso está la supresión de datos.
+func (s *ConsentService) Revoke(ctx context.Context, access DocumentAccess, userID string, consentType domain.ConsentType) ([]domain.ConsentRecord, error) {
+	user, err := s.subject(ctx, access, userID, false)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := s.repo.ListPolicies(ctx, access.ClubID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	for _, p := range domain.CurrentPolicies(policies, now) {
+		if p.ConsentType == consentType && p.Mandatory {
+			return nil, domain.ErrMandatoryConsent
+		}
+	}
+
+	records, err := s.repo.GetByUserID(ctx, access.ClubID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	var revoked []domain.ConsentRecord
+	for i := range records {
+		if records[i].ConsentType != consentType || !records[i].IsActive() {
+			continue
+		}
+		if err := records[i].Revoke(now); err != nil {
+			return nil, err
+		}
+		if err := s.repo.Update(ctx, &records[i]); err != nil {
+			return nil, fmt.Errorf("error revocando el consentimiento: %w", err)
+		}
+		revoked = append(revoked, records[i])
+	}
+	if len(revoked) == 0 {
+		return nil, domain.ErrConsentNotFound
+	}
+	s.forget(access.ClubID, user.ID)
+	return revoked, nil
+}
+
+// MarketingRecipients devuelve los emails de los socios que aceptaron recibir comunicaciones comerciales
+func (s *ConsentService) MarketingRecipients(ctx context.Context, clubID string) ([]string, error) {
+	return s.repo.ListConsentingEmails(ctx, clubID, domain.ConsentTypeMarketing)
+}
+
+// subject resuelve el socio sobre el que se opera: el propio, un hijo/a del que accede,
+// o cualquiera del club si allowAdmin y quien accede es administrador
+func (s *ConsentService) subject(ctx context.Context, access DocumentAccess, userID string, allowAdmin bool) (*domain.User, error) {
+	if userID == "" {
+		userID = access.UserID
+	}
+	user, err := s.userRepo.GetByID(ctx, access.ClubID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("usuario no encontrado")
+	}
+	if user.ID == access.UserID || allowAdmin {
+		return user, nil
+	}
+	if user.ParentID != nil && *user.ParentID == access.UserID {
+		return user, nil
+	}
+	return nil, ErrConsentForbidden
+}
+
+// computePending cruza las versiones obligatorias vigentes con los consentimientos activos del socio
+func (s *ConsentService) computePending(ctx context.Context, clubID string, user *domain.User) (*PendingConsents, error) {
+	now := s.now()
+	policies, err := s.repo.ListPolicies(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	result := &PendingConsents{Policies: []domain.ConsentPolicy{}}
+	current := domain.CurrentPolicies(policies, now)
+	if len(current) == 0 {
+		return result, nil
+	}
+
+	records, err := s.repo.GetByUserID(ctx, clubID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range current {
+		policy := &current[i]
+		if !policy.Mandatory || acceptedAtSignup(user, policy) {
+			continue
+		}
+		covered := false
+		for j := range records {
+			if records[j].Covers(policy) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			result.Policies = append(result.Policies, *policy)
+		}
+	}
+	result.ParentConsentRequired = len(result.Policies) > 0 && user.IsMinor(now)
+	return result, nil
+}
+
+// acceptedAtSignup reconoce la política de privacidad aceptada al registrarse, que solo quedó en
+// User.TermsAcceptedAt y User.PrivacyPolicyVersion. Ese campo guarda la versión de privacidad, no la
+// de los términos: los términos necesitan un registro de consentimiento propio.
+func acceptedAtSignup(user *domain.User, policy *domain.ConsentPolicy) bool {
+	if policy.ConsentType != domain.ConsentTypePrivacy {
+		return false
+	}
+	return user.TermsAcceptedAt != nil && strings.TrimSpace(user.PrivacyPolicyVersion) == policy.Version
+}
+
+func (s *ConsentService) isCurrent(ctx context.Context, policy *domain.ConsentPolicy, at time.Time) bool {
+	policies, err := s.repo.ListPolicies(ctx, policy.ClubID)
+	if err != nil {
+		return false
+	}
+	for _, p := range domain.CurrentPolicies(policies, at) {
+		if p.ID == policy.ID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *ConsentService) forget(clubID, userID string) {
+	s.mu.Lock()
+	delete(s.pending, clubID+"/"+userID)
+	s.mu.Unlock()
+}
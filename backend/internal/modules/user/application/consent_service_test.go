@@ -0,0 +1,242 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryConsents guarda políticas y consentimientos en memoria, como copias
+type memoryConsents struct {
+	policies []domain.ConsentPolicy
+	records  []domain.ConsentRecord
+	emails   map[string]string
+}
+
+func (m *memoryConsents) CreatePolicy(ctx context.Context, policy *domain.ConsentPolicy) error {
+	m.policies = append(m.policies, *policy)
+	return nil
+}
+
+func (m *memoryConsents) GetPolicy(ctx context.Context, clubID string, id uuid.UUID) (*domain.ConsentPolicy, error) {
+	for _, p := range m.policies {
+		if p.ClubID == clubID && p.ID == id {
+			return &p, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *memoryConsents) ListPolicies(ctx context.Context, clubID string) ([]domain.ConsentPolicy, error) {
+	var result []domain.ConsentPolicy
+	for _, p := range m.policies {
+		if p.ClubID == clubID {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryConsents) Create(ctx context.Context, record *domain.ConsentRecord) error {
+	m.records = append(m.records, *record)
+	return nil
+}
+
+func (m *memoryConsents) Update(ctx context.Context, record *domain.ConsentRecord) error {
+	for i := range m.records {
+		if m.records[i].ID == record.ID {
+			m.records[i] = *record
+		}
+	}
+	return nil
+}
+
+func (m *memoryConsents) GetByUserID(ctx context.Context, clubID, userID string) ([]domain.ConsentRecord, error) {
+	var result []domain.ConsentRecord
+	for _, r := range m.records {
+		if r.ClubID == clubID && r.UserID == userID {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryConsents) ListConsentingEmails(ctx context.Context, clubID string, consentType domain.ConsentType) ([]string, error) {
+	var result []string
+	for _, r := range m.records {
+		if r.ClubID == clubID && r.ConsentType == consentType && r.IsActive() {
+			result = append(result, m.emails[r.UserID])
+		}
+	}
+	return result, nil
+}
+
+func TestConsentService(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	admin := application.DocumentAccess{ClubID: clubID, UserID: "admin-1", Role: domain.RoleAdmin}
+	member := application.DocumentAccess{ClubID: clubID, UserID: "user-1", Role: domain.RoleMember, IPAddress: "10.0.0.1"}
+	parent := application.DocumentAccess{ClubID: clubID, UserID: "parent-1", Role: domain.RoleMember}
+
+	adultBirth := time.Now().AddDate(-30, 0, 0)
+	minorBirth := time.Now().AddDate(-12, 0, 0)
+	parentID := "parent-1"
+
+	setup := func() (*application.ConsentService, *memoryConsents) {
+		repo := &memoryConsents{emails: map[string]string{"user-1": "socio@club.com", "child-1": "hijo@club.com"}}
+		userRepo := new(MockUserRepo)
+		userRepo.On("GetByID", mock.Anything, clubID, "user-1").Return(&domain.User{ID: "user-1", ClubID: clubID, DateOfBirth: &adultBirth}, nil)
+		userRepo.On("GetByID", mock.Anything, clubID, "child-1").Return(&domain.User{ID: "child-1", ClubID: clubID, DateOfBirth: &minorBirth, ParentID: &parentID}, nil)
+		userRepo.On("GetByID", mock.Anything, clubID, "parent-1").Return(&domain.User{ID: "parent-1", ClubID: clubID, DateOfBirth: &adultBirth}, nil)
+		return application.NewConsentService(repo, userRepo), repo
+	}
+	publish := func(t *testing.T, svc *application.ConsentService, consentType domain.ConsentType, version string, mandatory bool) *domain.ConsentPolicy {
+		past := time.Now().Add(-time.Minute)
+		policy, err := svc.PublishPolicy(ctx, admin, application.PublishConsentPolicyInput{
+			ConsentType: consentType, Version: version, Content: "Texto " + version, Mandatory: mandatory, PublishedAt: &past,
+		})
+		require.NoError(t, err)
+		return policy
+	}
+
+	t.Run("Una versión obligatoria nueva exige volver a aceptar", func(t *testing.T) {
+		svc, _ := setup()
+		v1 := publish(t, svc, domain.ConsentTypePrivacy, "2026-01", true)
+		_, err := svc.Accept(ctx, member, "", v1.ID)
+		require.NoError(t, err)
+
+		pending, err := svc.PendingConsents(ctx, clubID, "user-1")
+		require.NoError(t, err)
+		assert.Empty(t, pending.Policies)
+
+		v2 := publish(t, svc, domain.ConsentTypePrivacy, "2026-10", true)
+		pending, err = svc.PendingConsents(ctx, clubID, "user-1")
+		require.NoError(t, err)
+		require.Len(t, pending.Policies, 1)
+		assert.Equal(t, v2.ID, pending.Policies[0].ID)
+
+		_, err = svc.Accept(ctx, member, "", v1.ID)
+		assert.ErrorIs(t, err, domain.ErrConsentPolicyNotCurrent)
+
+		record, err := svc.Accept(ctx, member, "", v2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "10.0.0.1", record.IPAddress)
+		assert.Nil(t, record.ParentUserID)
+
+		pending, err = svc.PendingConsents(ctx, clubID, "user-1")
+		require.NoError(t, err)
+		assert.Empty(t, pending.Policies)
+	})
+
+	t.Run("Las versiones programadas no se exigen antes de tiempo", func(t *testing.T) {
+		svc, _ := setup()
+		future := time.Now().Add(24 * time.Hour)
+		policy, err := svc.PublishPolicy(ctx, admin, application.PublishConsentPolicyInput{
+			ConsentType: domain.ConsentTypeTerms, Version: "2027-01", URL: "https://club.com/terminos", Mandatory: true, PublishedAt: &future,
+		})
+		require.NoError(t, err)
+
+		pending, err := svc.Pending(ctx, member, "")
+		require.NoError(t, err)
+		assert.Empty(t, pending.Policies)
+		_, err = svc.Accept(ctx, member, "", policy.ID)
+		assert.ErrorIs(t, err, domain.ErrConsentPolicyNotCurrent)
+	})
+
+	t.Run("El consentimiento de un menor lo da su padre/madre", func(t *testing.T) {
+		svc, _ := setup()
+		terms := publish(t, svc, domain.ConsentTypeTerms, "2026-10", true)
+		child := application.DocumentAccess{ClubID: clubID, UserID: "child-1", Role: domain.RoleMember}
+
+		pending, err := svc.PendingConsents(ctx, clubID, "child-1")
+		require.NoError(t, err)
+		assert.True(t, pending.ParentConsentRequired)
+
+		_, err = svc.Accept(ctx, child, "", terms.ID)
+		assert.ErrorIs(t, err, domain.ErrParentConsentRequired)
+		_, err = svc.Accept(ctx, member, "child-1", terms.ID)
+		assert.ErrorIs(t, err, application.ErrConsentForbidden)
+
+		record, err := svc.Accept(ctx, parent, "child-1", terms.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "child-1", record.UserID)
+		require.NotNil(t, record.ParentUserID)
+		assert.Equal(t, "parent-1", *record.ParentUserID)
+
+		pending, err = svc.PendingConsents(ctx, clubID, "child-1")
+		require.NoError(t, err)
+		assert.Empty(t, pending.Policies)
+	})
+
+	t.Run("La privacidad aceptada al registrarse cuenta para la misma versión, los términos no", func(t *testing.T) {
+		repo := &memoryConsents{}
+		userRepo := new(MockUserRepo)
+		signedUp := time.Now().AddDate(0, -6, 0)
+		userRepo.On("GetByID", mock.Anything, clubID, "user-1").Return(&domain.User{
+			ID: "user-1", ClubID: clubID, DateOfBirth: &adultBirth, TermsAcceptedAt: &signedUp, PrivacyPolicyVersion: "2026-01",
+		}, nil)
+		svc := application.NewConsentService(repo, userRepo)
+		publish(t, svc, domain.ConsentTypePrivacy, "2026-01", true)
+
+		pending, err := svc.Pending(ctx, member, "")
+		require.NoError(t, err)
+		assert.Empty(t, pending.Policies)
+
+		// PrivacyPolicyVersion es la versión de privacidad: no prueba que aceptó los términos de igual versión
+		terms := publish(t, svc, domain.ConsentTypeTerms, "2026-01", true)
+		pending, err = svc.Pending(ctx, member, "")
+		require.NoError(t, err)
+		require.Len(t, pending.Policies, 1)
+		assert.Equal(t, terms.ID, pending.Policies[0].ID)
+		_, err = svc.Accept(ctx, member, "", terms.ID)
+		require.NoError(t, err)
+
+		publish(t, svc, domain.ConsentTypePrivacy, "2026-10", true)
+		pending, err = svc.Pending(ctx, member, "")
+		require.NoError(t, err)
+		assert.Len(t, pending.Policies, 1)
+	})
+
+	t.Run("Marketing es opcional, revocable y filtra los destinatarios", func(t *testing.T) {
+		svc, _ := setup()
+		past := time.Now().Add(-time.Minute)
+		_, err := svc.PublishPolicy(ctx, admin, application.PublishConsentPolicyInput{
+			ConsentType: domain.ConsentTypeMarketing, Version: "2026-10", Content: "Novedades", Mandatory: true, PublishedAt: &past,
+		})
+		assert.ErrorIs(t, err, domain.ErrMarketingNotMandatory)
+		_, err = svc.PublishPolicy(ctx, member, application.PublishConsentPolicyInput{ConsentType: domain.ConsentTypeMarketing, Version: "2026-10", Content: "Novedades"})
+		assert.ErrorIs(t, err, application.ErrConsentForbidden)
+
+		marketing := publish(t, svc, domain.ConsentTypeMarketing, "2026-10", false)
+		terms := publish(t, svc, domain.ConsentTypeTerms, "2026-10", true)
+		_, err = svc.Accept(ctx, member, "", marketing.ID)
+		require.NoError(t, err)
+		_, err = svc.Accept(ctx, member, "", terms.ID)
+		require.NoError(t, err)
+
+		emails, err := svc.MarketingRecipients(ctx, clubID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"socio@club.com"}, emails)
+
+		_, err = svc.Revoke(ctx, member, "", domain.ConsentTypeTerms)
+		assert.ErrorIs(t, err, domain.ErrMandatoryConsent)
+
+		revoked, err := svc.Revoke(ctx, member, "", domain.ConsentTypeMarketing)
+		require.NoError(t, err)
+		require.Len(t, revoked, 1)
+		assert.NotNil(t, revoked[0].RevokedAt)
+		_, err = svc.Revoke(ctx, member, "", domain.ConsentTypeMarketing)
+		assert.ErrorIs(t, err, domain.ErrConsentNotFound)
+
+		emails, err = svc.MarketingRecipients(ctx, clubID)
+		require.NoError(t, err)
+		assert.Empty(t, emails)
+	})
+}
@@ -0,0 +1,217 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// dataRetentionRunsLimit es cuántas ejecuciones devuelve el historial
+const dataRetentionRunsLimit = 50
+
+var ErrDataRetentionForbidden = errors.New("solo los administradores gestionan la retención de datos")
+
+// UserEraser borra los datos de una cuenta y la anonimiza (lo implementa DataSubjectRequestService.EraseUser)
+type UserEraser interface {
+	EraseUser(ctx context.Context, clubID, userID string) error
+}
+
+// DataRetentionService aplica los plazos de conservación de cada categoría de datos: anonimiza las cuentas
+// vencidas y borra los logs viejos. Cada ejecución, real o de prueba (dry run), queda registrada con el
+// detalle de lo que alcanzó.
+type DataRetentionService struct {
+	repo     domain.DataRetentionRepository
+	expired  domain.ExpiredDataRepository
+	userRepo domain.UserRepository
+	eraser   UserEraser
+	now      func() time.Time
+}
+
+// NewDataRetentionService crea una nueva instancia del servicio
+func NewDataRetentionService(repo domain.DataRetentionRepository, expired domain.ExpiredDataRepository, userRepo domain.UserRepository) *DataRetentionService {
+	return &DataRetentionService{
+		repo:     repo,
+		expired:  expired,
+		userRepo: userRepo,
+		now:      time.Now,
+	}
+}
+
+// SetUserEraser hace que las cuentas vencidas se borren como una solicitud de supresión (documentos,
+// actividad y exportaciones). Sin eraser solo se anonimiza la cuenta.
+func (s *DataRetentionService) SetUserEraser(eraser UserEraser) {
+	s.eraser = eraser
+}
+
+// DataRetentionPolicyView es el plazo vigente de una categoría junto con su descripción
+type DataRetentionPolicyView struct {
+	domain.RetentionCategoryInfo
+	RetentionDays int  `json:"retention_days"`
+	Enabled       bool `json:"enabled"`
+	Configured    bool `json:"configured"` // false: se usa el plazo por defecto
+}
+
+// Policies devuelve los plazos vigentes del club. Cualquier socio los puede consultar (GDPR Art. 13.2.a).
+func (s *DataRetentionService) Policies(ctx context.Context, clubID string) ([]DataRetentionPolicyView, error) {
+	configured, err := s.repo.ListPolicies(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	views := make([]DataRetentionPolicyView, 0, len(domain.RetentionCategories))
+	for _, policy := range domain.EffectiveRetentionPolicies(clubID, configured) {
+		info, _ := domain.RetentionCategoryByName(policy.Category)
+		views = append(views, DataRetentionPolicyView{
+			RetentionCategoryInfo: info,
+			RetentionDays:         policy.RetentionDays,
+			Enabled:               policy.Enabled,
+			Configured:            policy.ID != uuid.Nil,
+		})
+	}
+	return views, nil
+}
+
+// UpdatePolicy configura el plazo del club para una categoría; no puede bajar del mínimo legal
+func (s *DataRetentionService) UpdatePolicy(ctx context.Context, access DocumentAccess, category domain.RetentionCategory, days int, enabled bool) (*domain.DataRetentionPolicy, error) {
+	if !isPrivacyAdmin(access.Role) {
+		return nil, ErrDataRetentionForbidden
+	}
+	policy, err := domain.NewDataRetentionPolicy(access.ClubID, category, days, enabled, access.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	configured, err := s.repo.ListPolicies(ctx, access.ClubID)
+	if err != nil {
+		return nil, err
+	}
+	for _, existing := range configured {
+		if existing.Category == category {
+			policy.ID = existing.ID
+			policy.CreatedAt = existing.CreatedAt
+		}
+	}
+	policy.UpdatedAt = s.now()
+	if err := s.repo.SavePolicy(ctx, policy); err != nil {
+		return nil, fmt.Errorf("error guardando el plazo: %w", err)
+	}
+	return policy, nil
+}
+
+// Trigger ejecuta la retención a pedido de un administrador; con dryRun solo informa lo que se borraría
+func (s *DataRetentionService) Trigger(ctx context.Context, access DocumentAccess, dryRun bool) (*domain.DataRetentionRun, error) {
+	if !isPrivacyAdmin(access.Role) {
+		return nil, ErrDataRetentionForbidden
+	}
+	return s.Run(ctx, access.ClubID, dryRun, access.UserID)
+}
+
+// Run aplica los plazos del club. Un error en una categoría queda en el reporte y no frena a las demás;
+// la ejecución termina FAILED. Solo devuelve error si no se pudo registrar la ejecución.
+func (s *DataRetentionService) Run(ctx context.Context, clubID string, dryRun bool, triggeredBy string) (*domain.DataRetentionRun, error) {
+	configured, err := s.repo.ListPolicies(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	run := domain.NewDataRetentionRun(clubID, dryRun, triggeredBy, now)
+	if err := s.repo.CreateRun(ctx, run); err != nil {
+		return nil, fmt.Errorf("error registrando la ejecución: %w", err)
+	}
+
+	for _, policy := range domain.EffectiveRetentionPolicies(clubID, configured) {
+		if !policy.Enabled {
+			continue
+		}
+		info, _ := domain.RetentionCategoryByName(policy.Category)
+		item := domain.DataRetentionItem{
+			Category:      policy.Category,
+			Action:        info.Action,
+			RetentionDays: policy.RetentionDays,
+			Cutoff:        policy.Cutoff(now),
+		}
+		var err error
+		if policy.Category == domain.RetentionUserAccounts {
+			err = s.applyUserAccounts(ctx, clubID, dryRun, now, &item)
+		} else if dryRun {
+			item.Count, err = s.expired.CountExpiredLogs(ctx, clubID, policy.Category, item.Cutoff)
+		} else {
+			item.Count, err = s.expired.PurgeExpiredLogs(ctx, clubID, policy.Category, item.Cutoff)
+		}
+		if err != nil {
+			log.Printf("[DataRetentionService] error aplicando %s en el club %s: %v", policy.Category, clubID, err)
+			item.Error = err.Error()
+		}
+		run.Items = append(run.Items, item)
+	}
+
+	run.Finish(s.now())
+	if err := s.repo.UpdateRun(ctx, run); err != nil {
+		return nil, fmt.Errorf("error registrando el resultado: %w", err)
+	}
+	return run, nil
+}
+
+// applyUserAccounts anonimiza las cuentas vencidas. Se sigue con las demás si una falla;
+// SubjectIDs lista solo las anonimizadas (o las que se anonimizarían en un dry run).
+func (s *DataRetentionService) applyUserAccounts(ctx context.Context, clubID string, dryRun bool, now time.Time, item *domain.DataRetentionItem) error {
+	ids, err := s.expired.ExpiredUserIDs(ctx, clubID, item.Cutoff, now)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		item.SubjectIDs = ids
+		item.Count = int64(len(ids))
+		return nil
+	}
+
+	var failed []string
+	var lastErr error
+	for _, id := range ids {
+		if err := s.eraseUser(ctx, clubID, id); err != nil {
+			failed = append(failed, id)
+			lastErr = err
+			continue
+		}
+		item.SubjectIDs = append(item.SubjectIDs, id)
+	}
+	item.Count = int64(len(item.SubjectIDs))
+	if len(failed) > 0 {
+		return fmt.Errorf("no se pudieron anonimizar %d cuentas (%v): %w", len(failed), failed, lastErr)
+	}
+	return nil
+}
+
+func (s *DataRetentionService) eraseUser(ctx context.Context, clubID, userID string) error {
+	if s.eraser != nil {
+		return s.eraser.EraseUser(ctx, clubID, userID)
+	}
+	return s.userRepo.AnonymizeForGDPR(ctx, clubID, userID)
+}
+
+// Runs devuelve el historial de ejecuciones del club
+func (s *DataRetentionService) Runs(ctx context.Context, access DocumentAccess) ([]domain.DataRetentionRun, error) {
+	if !isPrivacyAdmin(access.Role) {
+		return nil, ErrDataRetentionForbidden
+	}
+	return s.repo.ListRuns(ctx, access.ClubID, dataRetentionRunsLimit)
+}
+
+// GetRun devuelve el reporte de una ejecución
+func (s *DataRetentionService) GetRun(ctx context.Context, access DocumentAccess, id uuid.UUID) (*domain.DataRetentionRun, error) {
+	if !isPrivacyAdmin(access.Role) {
+		return nil, ErrDataRetentionForbidden
+	}
+	run, err := s.repo.GetRun(ctx, access.ClubID, id)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, domain.ErrDataRetentionRunNotFound
+	}
+	return run, nil
+}
@@ -0,0 +1,193 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryDataRetention guarda plazos y ejecuciones en memoria
+type memoryDataRetention struct {
+	policies []domain.DataRetentionPolicy
+	runs     map[uuid.UUID]domain.DataRetentionRun
+}
+
+func (m *memoryDataRetention) ListPolicies(ctx context.Context, clubID string) ([]domain.DataRetentionPolicy, error) {
+	return m.policies, nil
+}
+
+func (m *memoryDataRetention) SavePolicy(ctx context.Context, policy *domain.DataRetentionPolicy) error {
+	for i := range m.policies {
+		if m.policies[i].Category == policy.Category {
+			m.policies[i] = *policy
+			return nil
+		}
+	}
+	m.policies = append(m.policies, *policy)
+	return nil
+}
+
+func (m *memoryDataRetention) CreateRun(ctx context.Context, run *domain.DataRetentionRun) error {
+	m.runs[run.ID] = *run
+	return nil
+}
+
+func (m *memoryDataRetention) UpdateRun(ctx context.Context, run *domain.DataRetentionRun) error {
+	m.runs[run.ID] = *run
+	return nil
+}
+
+func (m *memoryDataRetention) GetRun(ctx context.Context, clubID string, id uuid.UUID) (*domain.DataRetentionRun, error) {
+	run, ok := m.runs[id]
+	if !ok || run.ClubID != clubID {
+		return nil, nil
+	}
+	return &run, nil
+}
+
+func (m *memoryDataRetention) ListRuns(ctx context.Context, clubID string, limit int) ([]domain.DataRetentionRun, error) {
+	var runs []domain.DataRetentionRun
+	for _, run := range m.runs {
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+type MockExpiredDataRepository struct {
+	mock.Mock
+}
+
+func (m *MockExpiredDataRepository) ExpiredUserIDs(ctx context.Context, clubID string, deletedBefore, now time.Time) ([]string, error) {
+	args := m.Called(ctx, clubID, deletedBefore, now)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockExpiredDataRepository) CountExpiredLogs(ctx context.Context, clubID string, category domain.RetentionCategory, before time.Time) (int64, error) {
+	args := m.Called(ctx, clubID, category, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockExpiredDataRepository) PurgeExpiredLogs(ctx context.Context, clubID string, category domain.RetentionCategory, before time.Time) (int64, error) {
+	args := m.Called(ctx, clubID, category, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type MockUserEraser struct {
+	mock.Mock
+}
+
+func (m *MockUserEraser) EraseUser(ctx context.Context, clubID, userID string) error {
+	return m.Called(ctx, clubID, userID).Error(0)
+}
+
+func TestDataRetentionService(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	admin := application.DocumentAccess{ClubID: clubID, UserID: "admin-1", Role: domain.RoleAdmin}
+	member := application.DocumentAccess{ClubID: clubID, UserID: "user-1", Role: domain.RoleMember}
+
+	setup := func() (*application.DataRetentionService, *memoryDataRetention, *MockExpiredDataRepository, *MockUserEraser) {
+		repo := &memoryDataRetention{runs: map[uuid.UUID]domain.DataRetentionRun{}}
+		expired := new(MockExpiredDataRepository)
+		eraser := new(MockUserEraser)
+		svc := application.NewDataRetentionService(repo, expired, new(MockUserRepo))
+		svc.SetUserEraser(eraser)
+		return svc, repo, expired, eraser
+	}
+
+	t.Run("El dry run informa lo vencido sin borrar nada y queda registrado", func(t *testing.T) {
+		svc, repo, expired, eraser := setup()
+		_, err := svc.UpdatePolicy(ctx, admin, domain.RetentionAuthLogs, 90, false)
+		require.NoError(t, err)
+		_, err = svc.UpdatePolicy(ctx, admin, domain.RetentionUserAccounts, 90, true)
+		require.NoError(t, err)
+
+		expired.On("ExpiredUserIDs", ctx, clubID, mock.Anything, mock.Anything).Return([]string{"user-9"}, nil).Once()
+		expired.On("CountExpiredLogs", ctx, clubID, domain.RetentionAccessLogs, mock.Anything).Return(int64(340), nil).Once()
+		expired.On("CountExpiredLogs", ctx, clubID, domain.RetentionHealthAccessLogs, mock.Anything).Return(int64(0), nil).Once()
+
+		run, err := svc.Trigger(ctx, admin, true)
+		require.NoError(t, err)
+		assert.True(t, run.DryRun)
+		assert.Equal(t, domain.DataRetentionCompleted, run.Status)
+		assert.Equal(t, "admin-1", run.TriggeredBy)
+		require.Len(t, run.Items, 3) // AUTH_LOGS deshabilitada
+		assert.Equal(t, []string{"user-9"}, run.Items[0].SubjectIDs)
+		assert.Equal(t, int64(341), run.TotalAffected())
+
+		stored, err := svc.GetRun(ctx, admin, run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, run.Items, stored.Items)
+		assert.Len(t, repo.runs, 1)
+
+		expired.AssertExpectations(t)
+		expired.AssertNotCalled(t, "PurgeExpiredLogs", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		eraser.AssertNotCalled(t, "EraseUser", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("La ejecución anonimiza las cuentas vencidas y purga los logs con el plazo configurado", func(t *testing.T) {
+		svc, _, expired, eraser := setup()
+		_, err := svc.UpdatePolicy(ctx, admin, domain.RetentionAccessLogs, 30, true)
+		require.NoError(t, err)
+		_, err = svc.UpdatePolicy(ctx, admin, domain.RetentionUserAccounts, 90, true)
+		require.NoError(t, err)
+
+		expired.On("ExpiredUserIDs", ctx, clubID, mock.Anything, mock.Anything).Return([]string{"user-8", "user-9"}, nil).Once()
+		eraser.On("EraseUser", ctx, clubID, "user-8").Return(nil).Once()
+		eraser.On("EraseUser", ctx, clubID, "user-9").Return(errors.New("storage caído")).Once()
+		expired.On("PurgeExpiredLogs", ctx, clubID, domain.RetentionAccessLogs, mock.MatchedBy(func(before time.Time) bool {
+			return time.Since(before) > 29*24*time.Hour && time.Since(before) < 31*24*time.Hour
+		})).Return(int64(1200), nil).Once()
+		expired.On("PurgeExpiredLogs", ctx, clubID, domain.RetentionAuthLogs, mock.Anything).Return(int64(15), nil).Once()
+		expired.On("PurgeExpiredLogs", ctx, clubID, domain.RetentionHealthAccessLogs, mock.Anything).Return(int64(0), nil).Once()
+
+		run, err := svc.Run(ctx, clubID, false, domain.RoleSystem)
+		require.NoError(t, err)
+
+		// La cuenta que falló no frena al resto y deja la ejecución en FAILED
+		assert.Equal(t, domain.DataRetentionFailed, run.Status)
+		assert.Equal(t, []string{"user-8"}, run.Items[0].SubjectIDs)
+		assert.Contains(t, run.Items[0].Error, "user-9")
+		assert.Equal(t, int64(1200), run.Items[1].Count)
+		assert.Equal(t, 30, run.Items[1].RetentionDays)
+		expired.AssertExpectations(t)
+		eraser.AssertExpectations(t)
+	})
+
+	t.Run("Sin configurar no se anonimiza ninguna cuenta", func(t *testing.T) {
+		svc, _, expired, eraser := setup()
+		expired.On("PurgeExpiredLogs", ctx, clubID, mock.Anything, mock.Anything).Return(int64(0), nil)
+
+		run, err := svc.Run(ctx, clubID, false, domain.RoleSystem)
+		require.NoError(t, err)
+		assert.Equal(t, domain.DataRetentionCompleted, run.Status)
+		for _, item := range run.Items {
+			assert.NotEqual(t, domain.RetentionUserAccounts, item.Category)
+		}
+		expired.AssertNotCalled(t, "ExpiredUserIDs", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		eraser.AssertNotCalled(t, "EraseUser", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Solo los administradores configuran y ejecutan", func(t *testing.T) {
+		svc, _, _, _ := setup()
+		_, err := svc.UpdatePolicy(ctx, member, domain.RetentionAccessLogs, 60, true)
+		assert.ErrorIs(t, err, application.ErrDataRetentionForbidden)
+		_, err = svc.Trigger(ctx, member, true)
+		assert.ErrorIs(t, err, application.ErrDataRetentionForbidden)
+		_, err = svc.UpdatePolicy(ctx, admin, domain.RetentionHealthAccessLogs, 30, true)
+		assert.ErrorIs(t, err, domain.ErrRetentionBelowMinimum)
+
+		policies, err := svc.Policies(ctx, clubID)
+		require.NoError(t, err)
+		require.Len(t, policies, len(domain.RetentionCategories))
+		assert.False(t, policies[0].Configured)
+	})
+}
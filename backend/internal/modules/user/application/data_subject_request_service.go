@@ -0,0 +1,688 @@
+package application
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/storage"
+)
+
+// dataRequestProcessingTimeout es cuánto puede estar una solicitud en PROCESSING antes de considerarla
+// abandonada (p. ej. el scheduler se reinició a mitad de camino) y volver a procesarla
+const dataRequestProcessingTimeout = time.Hour
+
+var (
+	ErrDataSubjectRequestForbidden = errors.New("no tienes permiso para gestionar esta solicitud")
+	ErrDataArchiveStorageMissing   = errors.New("el almacenamiento de exportaciones no está configurado")
+)
+
+// DataSubjectRequestService gestiona las solicitudes de derechos GDPR (acceso, portabilidad y supresión):
+// verificación de identidad, cola con plazo legal de 30 días, exportación completa en ZIP y supresión
+// que conserva los registros financieros exigidos por ley.
+type DataSubjectRequestService struct {
+	repo         domain.DataSubjectRequestRepository
+	personalData domain.PersonalDataRepository
+	users        *UserUseCases
+	userRepo     domain.UserRepository
+	docRepo      domain.UserDocumentRepository
+	notifier     notificationSvc.NotificationSender
+	files        *DocumentStorageService
+	archives     storage.FileStorage
+	encryptor    DocumentEncryptor
+}
+
+// NewDataSubjectRequestService crea una nueva instancia del servicio
+func NewDataSubjectRequestService(
+	repo domain.DataSubjectRequestRepository,
+	personalData domain.PersonalDataRepository,
+	users *UserUseCases,
+	userRepo domain.UserRepository,
+	docRepo domain.UserDocumentRepository,
+	notifier notificationSvc.NotificationSender,
+) *DataSubjectRequestService {
+	return &DataSubjectRequestService{
+		repo:         repo,
+		personalData: personalData,
+		users:        users,
+		userRepo:     userRepo,
+		docRepo:      docRepo,
+		notifier:     notifier,
+	}
+}
+
+// SetArchiveStorage configura de dónde se leen los documentos del socio y dónde se guardan las exportaciones
+func (s *DataSubjectRequestService) SetArchiveStorage(files *DocumentStorageService, archives storage.FileStorage) {
+	s.files = files
+	s.archives = archives
+}
+
+// SetEncryptor habilita el cifrado en reposo de los archivos exportados
+func (s *DataSubjectRequestService) SetEncryptor(encryptor DocumentEncryptor) {
+	s.encryptor = encryptor
+}
+
+func isPrivacyAdmin(role string) bool {
+	return role == domain.RoleAdmin || role == domain.RoleSuperAdmin
+}
+
+// Create registra la solicitud y envía el código de verificación al titular. Un administrador puede
+// cargarla en nombre de un socio (userID); si userID está vacío la solicitud es de quien accede.
+func (s *DataSubjectRequestService) Create(ctx context.Context, access DocumentAccess, userID string, requestType domain.DataSubjectRequestType) (*domain.DataSubjectRequest, error) {
+	if userID == "" {
+		userID = access.UserID
+	}
+	if userID != access.UserID && !isPrivacyAdmin(access.Role) {
+		return nil, ErrDataSubjectRequestForbidden
+	}
+	if !requestType.IsValid() {
+		return nil, domain.ErrInvalidDataSubjectRequest
+	}
+
+	user, err := s.userRepo.GetByID(ctx, access.ClubID, userID)
+	if err != nil || user == nil {
+		return nil, errors.New("usuario no encontrado")
+	}
+
+	open, err := s.repo.List(ctx, access.ClubID, domain.DataSubjectRequestFilter{UserID: userID, Type: requestType})
+	if err != nil {
+		return nil, err
+	}
+	for _, existing := range open {
+		if existing.IsOpen() {
+			return nil, domain.ErrDataSubjectRequestOpen
+		}
+	}
+
+	now := time.Now()
+	req, err := domain.NewDataSubjectRequest(access.ClubID, userID, access.UserID, requestType, now)
+	if err != nil {
+		return nil, err
+	}
+	code, err := newVerificationCode()
+	if err != nil {
+		return nil, err
+	}
+	if err := req.SetVerificationCode(code, now); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, req); err != nil {
+		return nil, fmt.Errorf("error guardando la solicitud: %w", err)
+	}
+	s.sendVerificationCode(ctx, req, code)
+	return req, nil
+}
+
+// ResendCode genera un código nuevo e invalida el anterior
+func (s *DataSubjectRequestService) ResendCode(ctx context.Context, access DocumentAccess, id uuid.UUID) (*domain.DataSubjectRequest, error) {
+	req, err := s.ownRequest(ctx, access, id)
+	if err != nil {
+		return nil, err
+	}
+	code, err := newVerificationCode()
+	if err != nil {
+		return nil, err
+	}
+	if err := req.SetVerificationCode(code, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, req); err != nil {
+		return nil, err
+	}
+	s.sendVerificationCode(ctx, req, code)
+	return req, nil
+}
+
+// Verify confirma la identidad del titular con el código recibido por email y encola la solicitud.
+// Los intentos fallidos también se guardan, para que el límite no se pueda evitar.
+func (s *DataSubjectRequestService) Verify(ctx context.Context, access DocumentAccess, id uuid.UUID, code string) (*domain.DataSubjectRequest, error) {
+	req, err := s.ownRequest(ctx, access, id)
+	if err != nil {
+		return nil, err
+	}
+	verifyErr := req.Verify(strings.TrimSpace(code), time.Now())
+	if verifyErr != nil && !errors.Is(verifyErr, domain.ErrInvalidVerificationCode) {
+		return nil, verifyErr
+	}
+	if err := s.repo.Update(ctx, req); err != nil {
+		return nil, err
+	}
+	return req, verifyErr
+}
+
+// VerifyIdentity registra que un administrador verificó la identidad del titular por otro medio
+func (s *DataSubjectRequestService) VerifyIdentity(ctx context.Context, access DocumentAccess, id uuid.UUID) (*domain.DataSubjectRequest, error) {
+	req, err := s.adminRequest(ctx, access, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.VerifyByAdmin(access.UserID, time.Now()); err != nil {
+		return nil, err
+	}
+	return req, s.repo.Update(ctx, req)
+}
+
+// Reject cierra la solicitud sin procesarla y avisa al titular el motivo
+func (s *DataSubjectRequestService) Reject(ctx context.Context, access DocumentAccess, id uuid.UUID, reason string) (*domain.DataSubjectRequest, error) {
+	req, err := s.adminRequest(ctx, access, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.Reject(reason, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, req); err != nil {
+		return nil, err
+	}
+	s.send(ctx, notificationSvc.Notification{
+		RecipientID: req.UserID,
+		Type:        notificationSvc.NotificationTypeEmail,
+		Title:       "Solicitud de datos personales rechazada",
+		Body:        fmt.Sprintf("Tu solicitud de %s fue rechazada: %s", requestTypeLabel(req.Type), reason),
+	})
+	return req, nil
+}
+
+// Retry vuelve a encolar una solicitud que agotó los reintentos automáticos
+func (s *DataSubjectRequestService) Retry(ctx context.Context, access DocumentAccess, id uuid.UUID) (*domain.DataSubjectRequest, error) {
+	req, err := s.adminRequest(ctx, access, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.Retry(time.Now()); err != nil {
+		return nil, err
+	}
+	return req, s.repo.Update(ctx, req)
+}
+
+// Get devuelve la solicitud al titular o a un administrador del club
+func (s *DataSubjectRequestService) Get(ctx context.Context, access DocumentAccess, id uuid.UUID) (*domain.DataSubjectRequest, error) {
+	req, err := s.find(ctx, access.ClubID, id)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID != access.UserID && !isPrivacyAdmin(access.Role) {
+		return nil, domain.ErrDataSubjectRequestNotFound
+	}
+	return req, nil
+}
+
+// List devuelve las solicitudes del club a los administradores y las propias al resto
+func (s *DataSubjectRequestService) List(ctx context.Context, access DocumentAccess, filter domain.DataSubjectRequestFilter) ([]domain.DataSubjectRequest, error) {
+	if !isPrivacyAdmin(access.Role) {
+		filter.UserID = access.UserID
+	}
+	return s.repo.List(ctx, access.ClubID, filter)
+}
+
+// OpenArchive abre el ZIP de una exportación terminada. Solo lo puede descargar el titular.
+func (s *DataSubjectRequestService) OpenArchive(ctx context.Context, access DocumentAccess, id uuid.UUID) (io.ReadCloser, *domain.DataSubjectRequest, error) {
+	req, err := s.ownRequest(ctx, access, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !req.ArchiveAvailable(time.Now()) || s.archives == nil {
+		return nil, nil, domain.ErrDataArchiveNotAvailable
+	}
+
+	body, _, err := s.archives.Get(ctx, req.ArchiveKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, domain.ErrDataArchiveNotAvailable
+		}
+		return nil, nil, fmt.Errorf("error leyendo el archivo: %w", err)
+	}
+	if !req.ArchiveEncrypted {
+		return body, req, nil
+	}
+
+	defer body.Close()
+	if s.encryptor == nil {
+		return nil, nil, ErrDocumentEncrypted
+	}
+	sealed, err := io.ReadAll(body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error leyendo el archivo: %w", err)
+	}
+	plaintext, err := s.encryptor.Decrypt(ctx, req.ClubID, sealed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error descifrando el archivo: %w", err)
+	}
+	return io.NopCloser(bytes.NewReader(plaintext)), req, nil
+}
+
+// ProcessQueued procesa las solicitudes verificadas del club, de la que vence primero a la última.
+// Devuelve cuántas se completaron; las que fallan vuelven a la cola hasta agotar los reintentos.
+func (s *DataSubjectRequestService) ProcessQueued(ctx context.Context, clubID string) (int, error) {
+	requests, err := s.repo.List(ctx, clubID, domain.DataSubjectRequestFilter{
+		Statuses: []domain.DataSubjectRequestStatus{domain.DataSubjectStatusQueued, domain.DataSubjectStatusProcessing},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	completed := 0
+	for i := range requests {
+		req := &requests[i]
+		if req.Status == domain.DataSubjectStatusProcessing &&
+			req.StartedAt != nil && time.Since(*req.StartedAt) < dataRequestProcessingTimeout {
+			continue
+		}
+
+		from, attempts := req.Status, req.ProcessingAttempts
+		req.Start(time.Now())
+		claimed, err := s.repo.ClaimForProcessing(ctx, req, from, attempts)
+		if err != nil {
+			return completed, err
+		}
+		if !claimed {
+			// Otra ejecución del job la tomó entre la lectura y el update
+			continue
+		}
+
+		var processErr error
+		switch req.Type {
+		case domain.DataSubjectRequestExport:
+			processErr = s.processExport(ctx, req)
+		case domain.DataSubjectRequestErasure:
+			processErr = s.processErasure(ctx, req)
+		default:
+			processErr = domain.ErrInvalidDataSubjectRequest
+		}
+
+		if processErr != nil {
+			log.Printf("[DataSubjectRequestService] error procesando la solicitud %s: %v", req.ID, processErr)
+			req.Fail(processErr, time.Now())
+			if err := s.repo.Update(ctx, req); err != nil {
+				return completed, err
+			}
+			continue
+		}
+
+		req.Complete(time.Now())
+		if err := s.repo.Update(ctx, req); err != nil {
+			return completed, err
+		}
+		s.notifyCompleted(ctx, req)
+		completed++
+	}
+	return completed, nil
+}
+
+// WarnDeadlines avisa a los administradores las solicitudes verificadas que están por vencer o vencidas.
+// Cada solicitud se avisa una sola vez. Las que nunca se verificaron se rechazan al vencer el plazo.
+func (s *DataSubjectRequestService) WarnDeadlines(ctx context.Context, clubID string) (int, error) {
+	requests, err := s.repo.List(ctx, clubID, domain.DataSubjectRequestFilter{
+		Statuses: []domain.DataSubjectRequestStatus{
+			domain.DataSubjectStatusPendingVerification,
+			domain.DataSubjectStatusQueued,
+			domain.DataSubjectStatusProcessing,
+			domain.DataSubjectStatusFailed,
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	var admins []domain.User
+	warned := 0
+	for i := range requests {
+		req := &requests[i]
+		if req.VerificationAbandoned(now) {
+			if err := req.Reject("No se verificó la identidad del titular dentro del plazo", now); err == nil {
+				if err := s.repo.Update(ctx, req); err != nil {
+					return warned, err
+				}
+			}
+			continue
+		}
+		if !req.NeedsDeadlineWarning(now) {
+			continue
+		}
+
+		if admins == nil {
+			admins, err = s.userRepo.List(ctx, clubID, 100, 0, map[string]interface{}{"role": domain.RoleAdmin})
+			if err != nil {
+				return warned, err
+			}
+		}
+		body := fmt.Sprintf("La solicitud de %s %s del socio %s vence el %s (estado: %s).",
+			requestTypeLabel(req.Type), req.ID, req.UserID, req.DueAt.Format("02/01/2006"), req.Status)
+		if req.LastError != "" {
+			body += " Último error: " + req.LastError
+		}
+		for _, admin := range admins {
+			s.send(ctx, notificationSvc.Notification{
+				RecipientID: admin.ID,
+				Type:        notificationSvc.NotificationTypeEmail,
+				Title:       "⏰ Solicitud de datos personales por vencer",
+				Body:        body,
+			})
+		}
+
+		req.DeadlineWarnedAt = &now
+		if err := s.repo.Update(ctx, req); err != nil {
+			return warned, err
+		}
+		warned++
+	}
+	return warned, nil
+}
+
+// PurgeExpiredArchives borra los ZIP de exportación cuyo plazo de descarga terminó
+func (s *DataSubjectRequestService) PurgeExpiredArchives(ctx context.Context, clubID string) (int, error) {
+	if s.archives == nil {
+		return 0, nil
+	}
+	now := time.Now()
+	requests, err := s.repo.List(ctx, clubID, domain.DataSubjectRequestFilter{ArchiveExpiredBefore: &now})
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	for i := range requests {
+		if err := s.deleteArchive(ctx, &requests[i]); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// processExport arma el ZIP con todos los datos del socio y lo guarda para que lo descargue
+func (s *DataSubjectRequestService) processExport(ctx context.Context, req *domain.DataSubjectRequest) error {
+	if s.archives == nil {
+		return ErrDataArchiveStorageMissing
+	}
+
+	// El ZIP se arma en un archivo temporal: puede incluir muchos documentos escaneados
+	tmp, err := os.CreateTemp("", "data-export-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if err := s.writeExportArchive(ctx, req, tmp); err != nil {
+		return err
+	}
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	var body io.Reader = tmp
+	encrypted := false
+	if s.encryptor != nil {
+		data, err := io.ReadAll(tmp)
+		if err != nil {
+			return err
+		}
+		sealed, err := s.encryptor.Encrypt(ctx, req.ClubID, data)
+		if err != nil {
+			return fmt.Errorf("error cifrando el archivo: %w", err)
+		}
+		body, size, encrypted = bytes.NewReader(sealed), int64(len(sealed)), true
+	}
+
+	key := path.Join("privacy-exports", req.ClubID, req.ID.String()+".zip")
+	if err := s.archives.Put(ctx, key, body, size, "application/zip"); err != nil {
+		return fmt.Errorf("error guardando el archivo: %w", err)
+	}
+	expires := time.Now().Add(domain.DataArchiveTTL)
+	req.ArchiveKey = key
+	req.ArchiveEncrypted = encrypted
+	req.ArchiveExpiresAt = &expires
+	return nil
+}
+
+// exportedDocument es la ficha de un documento en documentos/documentos.json
+type exportedDocument struct {
+	Type           domain.DocumentType   `json:"type"`
+	Status         domain.DocumentStatus `json:"status"`
+	UploadedAt     time.Time             `json:"uploaded_at"`
+	ExpirationDate *time.Time            `json:"expiration_date,omitempty"`
+	File           string                `json:"file,omitempty"`
+	Note           string                `json:"note,omitempty"`
+}
+
+// writeExportArchive escribe el ZIP: perfil.json, una planilla JSON por cada módulo en datos/,
+// los documentos con sus archivos en documentos/ y LEEME.txt con el detalle del contenido
+func (s *DataSubjectRequestService) writeExportArchive(ctx context.Context, req *domain.DataSubjectRequest, w io.Writer) error {
+	profile, err := s.users.ExportUserData(ctx, req.ClubID, req.UserID)
+	if err != nil {
+		return fmt.Errorf("error exportando el perfil: %w", err)
+	}
+	sections, err := s.personalData.Collect(ctx, req.ClubID, req.UserID)
+	if err != nil {
+		return err
+	}
+	docs, err := s.docRepo.GetByUserID(ctx, req.ClubID, req.UserID)
+	if err != nil {
+		return fmt.Errorf("error obteniendo documentos: %w", err)
+	}
+
+	zw := zip.NewWriter(w)
+	contents := []string{"perfil.json: datos de la cuenta, menores a cargo y grupo familiar"}
+	if err := writeZipJSON(zw, "perfil.json", profile); err != nil {
+		return err
+	}
+	for _, section := range sections {
+		if err := writeZipJSON(zw, "datos/"+section.Name+".json", section.Records); err != nil {
+			return err
+		}
+		contents = append(contents, fmt.Sprintf("datos/%s.json: %d registros", section.Name, len(section.Records)))
+	}
+
+	// El titular accede a sus propios documentos; queda registrado como EXPORT en el log de datos de salud
+	access := DocumentAccess{ClubID: req.ClubID, UserID: req.UserID, Role: domain.RoleMember}
+	exported := make([]exportedDocument, 0, len(docs))
+	for i := range docs {
+		doc := &docs[i]
+		entry := exportedDocument{Type: doc.Type, Status: doc.Status, UploadedAt: doc.CreatedAt, ExpirationDate: doc.ExpirationDate}
+		name := fmt.Sprintf("documentos/%s_%s%s", doc.Type, doc.CreatedAt.Format("20060102"), documentExtension(doc))
+		if err := s.addExportedFile(ctx, zw, access, doc, name); err != nil {
+			entry.Note = "Archivo no disponible"
+		} else {
+			entry.File = name
+		}
+		exported = append(exported, entry)
+	}
+	if err := writeZipJSON(zw, "documentos/documentos.json", exported); err != nil {
+		return err
+	}
+	contents = append(contents, fmt.Sprintf("documentos/: %d documentos presentados y sus archivos", len(exported)))
+
+	readme, err := zw.Create("LEEME.txt")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(readme, "Exportación de datos personales\nSolicitud: %s\nGenerada: %s\n\nContenido:\n- %s\n",
+		req.ID, time.Now().Format("02/01/2006 15:04"), strings.Join(contents, "\n- "))
+	return zw.Close()
+}
+
+func (s *DataSubjectRequestService) addExportedFile(ctx context.Context, zw *zip.Writer, access DocumentAccess, doc *domain.UserDocument, name string) error {
+	if s.files == nil {
+		return ErrDocumentFileMissing
+	}
+	body, err := s.files.OpenForExport(ctx, access, doc)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	entry, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(entry, body)
+	return err
+}
+
+// processErasure borra los datos del socio (EraseUser) y deja constancia de lo que se conserva:
+// los registros financieros y las pruebas de consentimiento (domain.RetainedPersonalDataSections)
+// quedan asociados al socio anonimizado.
+func (s *DataSubjectRequestService) processErasure(ctx context.Context, req *domain.DataSubjectRequest) error {
+	if err := s.EraseUser(ctx, req.ClubID, req.UserID); err != nil {
+		return err
+	}
+
+	retained := make([]string, len(domain.RetainedPersonalDataSections))
+	for i, r := range domain.RetainedPersonalDataSections {
+		retained[i] = fmt.Sprintf("%s: %s, %d años", r.Section, r.LegalBasis, r.Years)
+	}
+	req.RetainedData = strings.Join(retained, "\n")
+	return nil
+}
+
+// EraseUser borra los documentos y la actividad del socio, elimina sus exportaciones anteriores y
+// anonimiza la cuenta, aunque ya esté dada de baja. También lo usa el job de retención.
+// Se puede repetir: en un reintento la cuenta vuelve a anonimizarse.
+func (s *DataSubjectRequestService) EraseUser(ctx context.Context, clubID, userID string) error {
+	if s.files != nil {
+		access := DocumentAccess{ClubID: clubID, UserID: userID, Role: domain.RoleMember}
+		if _, err := s.files.PurgeUserFiles(ctx, access, userID); err != nil {
+			return err
+		}
+	}
+	if _, err := s.personalData.EraseActivity(ctx, clubID, userID); err != nil {
+		return err
+	}
+
+	previous, err := s.repo.List(ctx, clubID, domain.DataSubjectRequestFilter{UserID: userID, Type: domain.DataSubjectRequestExport})
+	if err != nil {
+		return err
+	}
+	for i := range previous {
+		if previous[i].ArchiveKey != "" {
+			if err := s.deleteArchive(ctx, &previous[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := s.userRepo.AnonymizeForGDPR(ctx, clubID, userID); err != nil {
+		return fmt.Errorf("error anonimizando la cuenta: %w", err)
+	}
+	return nil
+}
+
+func (s *DataSubjectRequestService) deleteArchive(ctx context.Context, req *domain.DataSubjectRequest) error {
+	if s.archives == nil {
+		return ErrDataArchiveStorageMissing
+	}
+	if err := s.archives.Delete(ctx, req.ArchiveKey); err != nil {
+		return fmt.Errorf("error eliminando el archivo: %w", err)
+	}
+	req.ArchiveKey = ""
+	req.ArchiveEncrypted = false
+	return s.repo.Update(ctx, req)
+}
+
+func (s *DataSubjectRequestService) notifyCompleted(ctx context.Context, req *domain.DataSubjectRequest) {
+	n := notificationSvc.Notification{RecipientID: req.UserID, Type: notificationSvc.NotificationTypeEmail}
+	switch req.Type {
+	case domain.DataSubjectRequestExport:
+		n.Title = "📦 Tu exportación de datos está lista"
+		n.Body = fmt.Sprintf("Podés descargarla desde tu perfil hasta el %s.", req.ArchiveExpiresAt.Format("02/01/2006"))
+		n.ActionURL = fmt.Sprintf("/privacy-requests/%s/archive", req.ID)
+	case domain.DataSubjectRequestErasure:
+		n.Title = "Tus datos personales fueron eliminados"
+		n.Body = "Eliminamos tus datos personales y tu cuenta quedó dada de baja. Conservamos únicamente los " +
+			"registros que la ley nos obliga a guardar (pagos, compras y consentimientos)."
+	}
+	s.send(ctx, n)
+}
+
+func (s *DataSubjectRequestService) sendVerificationCode(ctx context.Context, req *domain.DataSubjectRequest, code string) {
+	s.send(ctx, notificationSvc.Notification{
+		RecipientID: req.UserID,
+		Type:        notificationSvc.NotificationTypeEmail,
+		Title:       "Código para confirmar tu solicitud de datos personales",
+		Body: fmt.Sprintf("Recibimos una solicitud de %s de tus datos personales. Para confirmarla ingresá el código %s "+
+			"(vence en 24 horas). Si no la pediste, ignorá este mensaje.", requestTypeLabel(req.Type), code),
+	})
+}
+
+func (s *DataSubjectRequestService) send(ctx context.Context, notification notificationSvc.Notification) {
+	if s.notifier == nil {
+		return
+	}
+	if err := s.notifier.Send(ctx, notification); err != nil {
+		log.Printf("[DataSubjectRequestService] error notificando a %s: %v", notification.RecipientID, err)
+	}
+}
+
+func (s *DataSubjectRequestService) find(ctx context.Context, clubID string, id uuid.UUID) (*domain.DataSubjectRequest, error) {
+	req, err := s.repo.GetByID(ctx, clubID, id)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, domain.ErrDataSubjectRequestNotFound
+	}
+	return req, nil
+}
+
+// ownRequest busca una solicitud del titular que accede; las de otros socios no existen para él
+func (s *DataSubjectRequestService) ownRequest(ctx context.Context, access DocumentAccess, id uuid.UUID) (*domain.DataSubjectRequest, error) {
+	req, err := s.find(ctx, access.ClubID, id)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID != access.UserID {
+		return nil, domain.ErrDataSubjectRequestNotFound
+	}
+	return req, nil
+}
+
+func (s *DataSubjectRequestService) adminRequest(ctx context.Context, access DocumentAccess, id uuid.UUID) (*domain.DataSubjectRequest, error) {
+	if !isPrivacyAdmin(access.Role) {
+		return nil, ErrDataSubjectRequestForbidden
+	}
+	return s.find(ctx, access.ClubID, id)
+}
+
+func requestTypeLabel(t domain.DataSubjectRequestType) string {
+	if t == domain.DataSubjectRequestErasure {
+		return "supresión"
+	}
+	return "exportación"
+}
+
+func writeZipJSON(zw *zip.Writer, name string, v interface{}) error {
+	entry, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(entry)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// newVerificationCode genera un código numérico de 6 dígitos
+func newVerificationCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
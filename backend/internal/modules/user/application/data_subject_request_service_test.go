@@ -0,0 +1,313 @@
+package application_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"regexp"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryDataSubjectRequests guarda las solicitudes en memoria, como copias
+type memoryDataSubjectRequests struct {
+	requests    map[uuid.UUID]domain.DataSubjectRequest
+	beforeClaim func() // Simula otra ejecución del job que actúa entre la lectura y la toma
+}
+
+func (m *memoryDataSubjectRequests) ClaimForProcessing(ctx context.Context, req *domain.DataSubjectRequest, from domain.DataSubjectRequestStatus, attempts int) (bool, error) {
+	if m.beforeClaim != nil {
+		m.beforeClaim()
+	}
+	stored, ok := m.requests[req.ID]
+	if !ok || stored.Status != from || stored.ProcessingAttempts != attempts {
+		return false, nil
+	}
+	m.requests[req.ID] = *req
+	return true, nil
+}
+
+func (m *memoryDataSubjectRequests) Create(ctx context.Context, req *domain.DataSubjectRequest) error {
+	m.requests[req.ID] = *req
+	return nil
+}
+
+func (m *memoryDataSubjectRequests) Update(ctx context.Context, req *domain.DataSubjectRequest) error {
+	m.requests[req.ID] = *req
+	return nil
+}
+
+func (m *memoryDataSubjectRequests) GetByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.DataSubjectRequest, error) {
+	req, ok := m.requests[id]
+	if !ok || req.ClubID != clubID {
+		return nil, nil
+	}
+	return &req, nil
+}
+
+func (m *memoryDataSubjectRequests) List(ctx context.Context, clubID string, filter domain.DataSubjectRequestFilter) ([]domain.DataSubjectRequest, error) {
+	var result []domain.DataSubjectRequest
+	for _, req := range m.requests {
+		if req.ClubID != clubID || (filter.UserID != "" && req.UserID != filter.UserID) || (filter.Type != "" && req.Type != filter.Type) {
+			continue
+		}
+		if len(filter.Statuses) > 0 {
+			found := false
+			for _, s := range filter.Statuses {
+				found = found || req.Status == s
+			}
+			if !found {
+				continue
+			}
+		}
+		if filter.ArchiveExpiredBefore != nil && (req.ArchiveKey == "" || !req.ArchiveExpiresAt.Before(*filter.ArchiveExpiredBefore)) {
+			continue
+		}
+		result = append(result, req)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].DueAt.Before(result[j].DueAt) })
+	return result, nil
+}
+
+type MockPersonalDataRepository struct {
+	mock.Mock
+}
+
+func (m *MockPersonalDataRepository) Collect(ctx context.Context, clubID, userID string) ([]domain.PersonalDataSection, error) {
+	args := m.Called(ctx, clubID, userID)
+	return args.Get(0).([]domain.PersonalDataSection), args.Error(1)
+}
+
+func (m *MockPersonalDataRepository) EraseActivity(ctx context.Context, clubID, userID string) (map[string]int64, error) {
+	args := m.Called(ctx, clubID, userID)
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+var verificationCodePattern = regexp.MustCompile(`\b\d{6}\b`)
+
+func TestDataSubjectRequestService(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	member := application.DocumentAccess{ClubID: clubID, UserID: "user-1", Role: domain.RoleMember}
+	admin := application.DocumentAccess{ClubID: clubID, UserID: "admin-1", Role: domain.RoleAdmin}
+
+	type fixture struct {
+		svc          *application.DataSubjectRequestService
+		repo         *memoryDataSubjectRequests
+		userRepo     *MockUserRepo
+		docRepo      *MockUserDocumentRepository
+		personalData *MockPersonalDataRepository
+		notifier     *MockNotificationSender
+		files        storage.FileStorage
+	}
+	setup := func(t *testing.T) fixture {
+		files, err := storage.NewLocalStorage(t.TempDir())
+		require.NoError(t, err)
+		f := fixture{
+			repo:         &memoryDataSubjectRequests{requests: map[uuid.UUID]domain.DataSubjectRequest{}},
+			userRepo:     new(MockUserRepo),
+			docRepo:      new(MockUserDocumentRepository),
+			personalData: new(MockPersonalDataRepository),
+			notifier:     new(MockNotificationSender),
+			files:        files,
+		}
+		accessLog := new(MockHealthDataAccessLogger)
+		accessLog.On("LogHealthDataAccess", mock.Anything).Return(nil)
+		f.svc = application.NewDataSubjectRequestService(f.repo, f.personalData, application.NewUserUseCases(f.userRepo, nil), f.userRepo, f.docRepo, f.notifier)
+		f.svc.SetArchiveStorage(application.NewDocumentStorageService(f.docRepo, files, nil, storage.NewURLSigner("secret", 0), accessLog), files)
+		f.userRepo.On("GetByID", ctx, clubID, "user-1").Return(&domain.User{ID: "user-1", Name: "Ana Pérez", ClubID: clubID}, nil)
+		return f
+	}
+	queued := func(t *testing.T, f fixture, requestType domain.DataSubjectRequestType) *domain.DataSubjectRequest {
+		req, err := domain.NewDataSubjectRequest(clubID, "user-1", "user-1", requestType, time.Now())
+		require.NoError(t, err)
+		require.NoError(t, req.VerifyByAdmin("admin-1", time.Now()))
+		require.NoError(t, f.repo.Create(ctx, req))
+		return req
+	}
+
+	t.Run("El titular confirma la solicitud con el código enviado por email", func(t *testing.T) {
+		f := setup(t)
+		var code string
+		f.notifier.On("Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool {
+			return n.RecipientID == "user-1"
+		})).Run(func(args mock.Arguments) {
+			code = verificationCodePattern.FindString(args.Get(1).(notificationSvc.Notification).Body)
+		}).Return(nil).Once()
+
+		req, err := f.svc.Create(ctx, member, "", domain.DataSubjectRequestExport)
+		require.NoError(t, err)
+		require.Len(t, code, 6)
+		assert.Equal(t, domain.DataSubjectStatusPendingVerification, req.Status)
+
+		_, err = f.svc.Create(ctx, member, "", domain.DataSubjectRequestExport)
+		assert.ErrorIs(t, err, domain.ErrDataSubjectRequestOpen)
+		_, err = f.svc.Create(ctx, member, "user-2", domain.DataSubjectRequestErasure)
+		assert.ErrorIs(t, err, application.ErrDataSubjectRequestForbidden)
+
+		wrong := "000000"
+		if code == wrong {
+			wrong = "111111"
+		}
+		_, err = f.svc.Verify(ctx, member, req.ID, wrong)
+		assert.ErrorIs(t, err, domain.ErrInvalidVerificationCode)
+		assert.Equal(t, 1, f.repo.requests[req.ID].VerificationAttempts, "los intentos fallidos se guardan")
+
+		_, err = f.svc.Verify(ctx, application.DocumentAccess{ClubID: clubID, UserID: "user-2", Role: domain.RoleMember}, req.ID, code)
+		assert.ErrorIs(t, err, domain.ErrDataSubjectRequestNotFound)
+
+		verified, err := f.svc.Verify(ctx, member, req.ID, code)
+		require.NoError(t, err)
+		assert.Equal(t, domain.DataSubjectStatusQueued, verified.Status)
+	})
+
+	t.Run("La exportación arma un ZIP con los datos de todos los módulos y sus documentos", func(t *testing.T) {
+		f := setup(t)
+		req := queued(t, f, domain.DataSubjectRequestExport)
+		require.NoError(t, f.files.Put(ctx, "club-1/user-1/dni.pdf", bytes.NewReader([]byte("dni ana")), 7, "application/pdf"))
+
+		f.userRepo.On("FindChildren", ctx, clubID, "user-1").Return([]domain.User{}, nil)
+		f.personalData.On("Collect", ctx, clubID, "user-1").Return([]domain.PersonalDataSection{
+			{Name: "pagos", Records: []map[string]interface{}{{"id": "pay-1", "amount": "1500.00"}}},
+			{Name: "asistencias", Records: []map[string]interface{}{}},
+		}, nil)
+		f.docRepo.On("GetByUserID", ctx, clubID, "user-1").Return([]domain.UserDocument{{
+			ID: uuid.New(), ClubID: clubID, UserID: "user-1", Type: domain.DocumentTypeDNIFront, Status: domain.DocumentStatusValid,
+			StorageKey: "club-1/user-1/dni.pdf", ContentType: "application/pdf", CreatedAt: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		}}, nil)
+		f.notifier.On("Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool {
+			return n.RecipientID == "user-1" && n.ActionURL != ""
+		})).Return(nil).Once()
+
+		completed, err := f.svc.ProcessQueued(ctx, clubID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, completed)
+		f.notifier.AssertExpectations(t)
+
+		_, _, err = f.svc.OpenArchive(ctx, admin, req.ID)
+		assert.ErrorIs(t, err, domain.ErrDataSubjectRequestNotFound, "solo el titular descarga sus datos")
+
+		body, done, err := f.svc.OpenArchive(ctx, member, req.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.DataSubjectStatusCompleted, done.Status)
+		data, _ := io.ReadAll(body)
+		body.Close()
+
+		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		entries := map[string]string{}
+		for _, file := range archive.File {
+			r, err := file.Open()
+			require.NoError(t, err)
+			content, _ := io.ReadAll(r)
+			r.Close()
+			entries[file.Name] = string(content)
+		}
+		assert.Contains(t, entries["perfil.json"], "Ana Pérez")
+		assert.Contains(t, entries["datos/pagos.json"], "pay-1")
+		assert.Contains(t, entries, "datos/asistencias.json")
+		assert.Equal(t, "dni ana", entries["documentos/DNI_FRONT_20260301.pdf"])
+		assert.Contains(t, entries["documentos/documentos.json"], "documentos/DNI_FRONT_20260301.pdf")
+		assert.Contains(t, entries, "LEEME.txt")
+	})
+
+	t.Run("La supresión borra documentos y actividad pero conserva los registros financieros", func(t *testing.T) {
+		f := setup(t)
+		req := queued(t, f, domain.DataSubjectRequestErasure)
+
+		// Exportación anterior todavía descargable: también se borra
+		expires := time.Now().Add(time.Hour)
+		previous := domain.DataSubjectRequest{
+			ID: uuid.New(), ClubID: clubID, UserID: "user-1", Type: domain.DataSubjectRequestExport,
+			Status: domain.DataSubjectStatusCompleted, ArchiveKey: "privacy-exports/club-1/old.zip", ArchiveExpiresAt: &expires,
+			DueAt: time.Now(),
+		}
+		f.repo.requests[previous.ID] = previous
+		require.NoError(t, f.files.Put(ctx, previous.ArchiveKey, bytes.NewReader([]byte("zip")), 3, "application/zip"))
+		require.NoError(t, f.files.Put(ctx, "club-1/user-1/apto.pdf", bytes.NewReader([]byte("apto")), 4, "application/pdf"))
+
+		docID := uuid.New()
+		f.docRepo.On("GetByUserID", ctx, clubID, "user-1").Return([]domain.UserDocument{{
+			ID: docID, ClubID: clubID, UserID: "user-1", Type: domain.DocumentTypeEMMACMedical, StorageKey: "club-1/user-1/apto.pdf",
+		}}, nil)
+		f.docRepo.On("Delete", ctx, clubID, docID).Return(nil).Once()
+		f.personalData.On("EraseActivity", ctx, clubID, "user-1").Return(map[string]int64{"asistencias": 12}, nil).Once()
+		f.userRepo.On("AnonymizeForGDPR", ctx, clubID, "user-1").Return(nil).Once()
+		f.notifier.On("Send", ctx, mock.Anything).Return(nil).Once()
+
+		completed, err := f.svc.ProcessQueued(ctx, clubID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, completed)
+
+		_, _, err = f.files.Get(ctx, "club-1/user-1/apto.pdf")
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		_, _, err = f.files.Get(ctx, previous.ArchiveKey)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		assert.Empty(t, f.repo.requests[previous.ID].ArchiveKey)
+
+		done := f.repo.requests[req.ID]
+		assert.Equal(t, domain.DataSubjectStatusCompleted, done.Status)
+		assert.Contains(t, done.RetainedData, "pagos")
+		f.personalData.AssertExpectations(t)
+		f.userRepo.AssertCalled(t, "AnonymizeForGDPR", ctx, clubID, "user-1")
+		f.docRepo.AssertExpectations(t)
+	})
+
+	t.Run("Una solicitud tomada por otra ejecución del job no se procesa dos veces", func(t *testing.T) {
+		f := setup(t)
+		req := queued(t, f, domain.DataSubjectRequestErasure)
+		f.repo.beforeClaim = func() {
+			other := f.repo.requests[req.ID]
+			other.Start(time.Now())
+			f.repo.requests[req.ID] = other
+		}
+
+		completed, err := f.svc.ProcessQueued(ctx, clubID)
+		require.NoError(t, err)
+		assert.Zero(t, completed)
+		assert.Equal(t, 1, f.repo.requests[req.ID].ProcessingAttempts)
+		f.personalData.AssertNotCalled(t, "EraseActivity", mock.Anything, mock.Anything, mock.Anything)
+		f.userRepo.AssertNotCalled(t, "AnonymizeForGDPR", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Un error vuelve a encolar la solicitud y avisa a los administradores antes del vencimiento", func(t *testing.T) {
+		f := setup(t)
+		req := queued(t, f, domain.DataSubjectRequestErasure)
+		stored := f.repo.requests[req.ID]
+		stored.DueAt = time.Now().Add(48 * time.Hour)
+		f.repo.requests[req.ID] = stored
+
+		f.docRepo.On("GetByUserID", ctx, clubID, "user-1").Return([]domain.UserDocument{}, nil)
+		f.personalData.On("EraseActivity", ctx, clubID, "user-1").Return(map[string]int64(nil), assert.AnError)
+
+		completed, err := f.svc.ProcessQueued(ctx, clubID)
+		require.NoError(t, err)
+		assert.Zero(t, completed)
+		assert.Equal(t, domain.DataSubjectStatusQueued, f.repo.requests[req.ID].Status)
+		assert.NotEmpty(t, f.repo.requests[req.ID].LastError)
+
+		f.userRepo.On("List", ctx, clubID, 100, 0, map[string]interface{}{"role": domain.RoleAdmin}).Return([]domain.User{{ID: "admin-1"}}, nil)
+		f.notifier.On("Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool {
+			return n.RecipientID == "admin-1"
+		})).Return(nil).Once()
+
+		warned, err := f.svc.WarnDeadlines(ctx, clubID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, warned)
+		warned, err = f.svc.WarnDeadlines(ctx, clubID)
+		require.NoError(t, err)
+		assert.Zero(t, warned, "cada solicitud se avisa una sola vez")
+		f.notifier.AssertExpectations(t)
+	})
+}
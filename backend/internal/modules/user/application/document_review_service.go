@@ -0,0 +1,298 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// DocumentReviewService administra la cola de revisión de documentos: toma por revisor,
+// historial de versiones, motivos de rechazo estandarizados, SLA con escalamiento y validación masiva
+type DocumentReviewService struct {
+	docRepo   domain.UserDocumentRepository
+	userRepo  domain.UserRepository
+	notifier  notificationSvc.NotificationSender
+	accessLog HealthDataAccessLogger
+	sla       time.Duration
+	now       func() time.Time
+}
+
+// NewDocumentReviewService crea una nueva instancia del servicio
+func NewDocumentReviewService(docRepo domain.UserDocumentRepository, userRepo domain.UserRepository, notifier notificationSvc.NotificationSender, accessLog HealthDataAccessLogger) *DocumentReviewService {
+	return &DocumentReviewService{
+		docRepo:   docRepo,
+		userRepo:  userRepo,
+		notifier:  notifier,
+		accessLog: accessLog,
+		sla:       domain.DefaultReviewSLA,
+		now:       time.Now,
+	}
+}
+
+// SetSLA cambia el plazo de revisión
+func (s *DocumentReviewService) SetSLA(sla time.Duration) {
+	if sla > 0 {
+		s.sla = sla
+	}
+}
+
+// ReviewQueueFilter filtra la cola de revisión
+type ReviewQueueFilter struct {
+	Type        domain.DocumentType
+	OnlyMine    bool // Solo los tomados por quien consulta
+	OnlyOverdue bool // Solo los que pasaron el SLA
+}
+
+// ReviewQueueItem es un documento pendiente con su SLA y estado de toma
+type ReviewQueueItem struct {
+	Document       domain.UserDocument `json:"document"`
+	DueAt          time.Time           `json:"due_at"`
+	Overdue        bool                `json:"overdue"`
+	ClaimedByMe    bool                `json:"claimed_by_me"`
+	ClaimedByOther bool                `json:"claimed_by_other"`
+}
+
+// ReviewDecision es el resultado de la revisión de un documento
+type ReviewDecision struct {
+	Approve        bool                   `json:"approve"`
+	Reason         domain.RejectionReason `json:"reason"`
+	Notes          string                 `json:"notes"`
+	ExpirationDate *time.Time             `json:"expiration_date"` // Corrige el vencimiento al aprobar
+}
+
+// BulkReviewResult informa qué documentos se validaron en una validación masiva
+type BulkReviewResult struct {
+	Approved []uuid.UUID          `json:"approved"`
+	Failed   map[uuid.UUID]string `json:"failed,omitempty"`
+}
+
+// Queue devuelve los documentos pendientes que quien consulta puede revisar, del más urgente al menos urgente
+func (s *DocumentReviewService) Queue(ctx context.Context, access DocumentAccess, filter ReviewQueueFilter) ([]ReviewQueueItem, error) {
+	docs, err := s.docRepo.GetPendingValidation(ctx, access.ClubID)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo documentos pendientes: %w", err)
+	}
+
+	now := s.now()
+	items := make([]ReviewQueueItem, 0, len(docs))
+	for _, doc := range docs {
+		if !doc.CanBeReviewedBy(access.Role) {
+			continue
+		}
+		if filter.Type != "" && doc.Type != filter.Type {
+			continue
+		}
+		item := ReviewQueueItem{
+			Document:       doc,
+			DueAt:          doc.ReviewDueAt(s.sla),
+			Overdue:        doc.IsReviewOverdue(s.sla, now),
+			ClaimedByMe:    doc.ReviewerID != nil && *doc.ReviewerID == access.UserID,
+			ClaimedByOther: doc.IsClaimedByOther(access.UserID, now),
+		}
+		if (filter.OnlyMine && !item.ClaimedByMe) || (filter.OnlyOverdue && !item.Overdue) {
+			continue
+		}
+		items = append(items, item)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DueAt.Before(items[j].DueAt)
+	})
+	return items, nil
+}
+
+// Claim toma el documento para revisarlo. La toma vence a las ReviewClaimTTL.
+func (s *DocumentReviewService) Claim(ctx context.Context, access DocumentAccess, docID uuid.UUID) (*domain.UserDocument, error) {
+	doc, err := s.reviewableDocument(ctx, access, docID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	if err := doc.Claim(access.UserID, now); err != nil {
+		return nil, err
+	}
+	claimed, err := s.docRepo.ClaimForReview(ctx, access.ClubID, docID, access.UserID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		// Otro revisor lo tomó entre la lectura y el update
+		return nil, domain.ErrDocumentClaimedByOther
+	}
+	return doc, nil
+}
+
+// Release devuelve a la cola un documento tomado por quien lo pide
+func (s *DocumentReviewService) Release(ctx context.Context, access DocumentAccess, docID uuid.UUID) error {
+	doc, err := s.reviewableDocument(ctx, access, docID)
+	if err != nil {
+		return err
+	}
+	if doc.IsClaimedByOther(access.UserID, s.now()) && access.Role != domain.RoleSuperAdmin {
+		return domain.ErrDocumentClaimedByOther
+	}
+	doc.Release()
+	return s.docRepo.Update(ctx, doc)
+}
+
+// History devuelve las versiones anteriores del mismo tipo de documento del socio, de la más nueva
+// a la más vieja, para comparar con la que se revisa
+func (s *DocumentReviewService) History(ctx context.Context, access DocumentAccess, docID uuid.UUID) ([]domain.UserDocument, error) {
+	doc, err := s.reviewableDocument(ctx, access, docID)
+	if err != nil {
+		return nil, err
+	}
+	docs, err := s.docRepo.GetByUserID(ctx, access.ClubID, doc.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo documentos: %w", err)
+	}
+
+	history := []domain.UserDocument{}
+	for _, d := range docs {
+		if d.Type == doc.Type && d.ID != doc.ID {
+			history = append(history, d)
+		}
+	}
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].CreatedAt.After(history[j].CreatedAt)
+	})
+	logHealthDataAccess(s.accessLog, access, doc, domain.HealthAccessView)
+	return history, nil
+}
+
+// Review aprueba o rechaza un documento. Al rechazar se notifica al socio con el motivo.
+func (s *DocumentReviewService) Review(ctx context.Context, access DocumentAccess, docID uuid.UUID, decision ReviewDecision) (*domain.UserDocument, error) {
+	doc, err := s.reviewableDocument(ctx, access, docID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	if decision.Approve {
+		err = doc.Approve(access.UserID, decision.ExpirationDate, now)
+	} else {
+		err = doc.Reject(access.UserID, decision.Reason, decision.Notes, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+	saved, err := s.docRepo.SaveReview(ctx, doc, now)
+	if err != nil {
+		return nil, err
+	}
+	if !saved {
+		// Otro revisor lo resolvió o lo tomó entre la lectura y el update
+		return nil, domain.ErrDocumentClaimedByOther
+	}
+
+	logHealthDataAccess(s.accessLog, access, doc, domain.HealthAccessValidate)
+	if !decision.Approve {
+		s.notifyRejection(ctx, doc)
+	}
+	return doc, nil
+}
+
+// BulkValidate aprueba varios documentos; los que no se pueden validar se informan sin cortar el resto
+func (s *DocumentReviewService) BulkValidate(ctx context.Context, access DocumentAccess, docIDs []uuid.UUID) *BulkReviewResult {
+	result := &BulkReviewResult{Approved: []uuid.UUID{}, Failed: map[uuid.UUID]string{}}
+	for _, id := range docIDs {
+		if _, err := s.Review(ctx, access, id, ReviewDecision{Approve: true}); err != nil {
+			result.Failed[id] = err.Error()
+			continue
+		}
+		result.Approved = append(result.Approved, id)
+	}
+	return result
+}
+
+// EscalateOverdue avisa a los administradores del club por cada documento que pasó el SLA
+// sin revisarse. Cada documento se escala una sola vez; devuelve cuántos se escalaron.
+func (s *DocumentReviewService) EscalateOverdue(ctx context.Context, clubID string) (int, error) {
+	docs, err := s.docRepo.GetPendingValidation(ctx, clubID)
+	if err != nil {
+		return 0, fmt.Errorf("error obteniendo documentos pendientes: %w", err)
+	}
+
+	now := s.now()
+	var admins []domain.User
+	escalated := 0
+	for i := range docs {
+		doc := &docs[i]
+		if doc.EscalatedAt != nil || !doc.IsReviewOverdue(s.sla, now) {
+			continue
+		}
+		if admins == nil {
+			if admins, err = s.userRepo.List(ctx, clubID, 100, 0, map[string]interface{}{"role": domain.RoleAdmin}); err != nil {
+				return escalated, fmt.Errorf("error obteniendo administradores: %w", err)
+			}
+		}
+
+		body := fmt.Sprintf("El documento %s subido el %s lleva más de %.0f horas sin revisar.",
+			doc.Type.Label(), doc.CreatedAt.Format("02/01 15:04"), s.sla.Hours())
+		if doc.ReviewerID != nil {
+			body += " Está tomado por un revisor pero no se resolvió."
+		}
+		for _, admin := range admins {
+			s.send(ctx, notificationSvc.Notification{
+				RecipientID: admin.ID,
+				Type:        notificationSvc.NotificationTypeEmail,
+				Title:       "⏰ Revisión de documento demorada",
+				Body:        body,
+			})
+		}
+
+		doc.EscalatedAt = &now
+		if err := s.docRepo.Update(ctx, doc); err != nil {
+			return escalated, err
+		}
+		escalated++
+	}
+	return escalated, nil
+}
+
+func (s *DocumentReviewService) notifyRejection(ctx context.Context, doc *domain.UserDocument) {
+	body := fmt.Sprintf("Tu %s fue rechazado: %s.", doc.Type.Label(), doc.RejectionReason.Description())
+	if doc.RejectionNotes != "" {
+		body += " Observación: " + doc.RejectionNotes + "."
+	}
+	body += " Por favor, subí un nuevo archivo."
+	s.send(ctx, notificationSvc.Notification{
+		RecipientID: doc.UserID,
+		Type:        notificationSvc.NotificationTypeEmail,
+		Title:       "❌ Documento rechazado",
+		Body:        body,
+	})
+}
+
+func (s *DocumentReviewService) send(ctx context.Context, notification notificationSvc.Notification) {
+	if s.notifier == nil {
+		return
+	}
+	if err := s.notifier.Send(ctx, notification); err != nil {
+		log.Printf("[DocumentReviewService] error notificando a %s: %v", notification.RecipientID, err)
+	}
+}
+
+// reviewableDocument busca el documento y verifica que quien accede pueda revisarlo
+func (s *DocumentReviewService) reviewableDocument(ctx context.Context, access DocumentAccess, docID uuid.UUID) (*domain.UserDocument, error) {
+	doc, err := s.docRepo.GetByID(ctx, access.ClubID, docID)
+	if err != nil || doc == nil {
+		return nil, ErrDocumentNotFound
+	}
+	if !doc.CanBeReviewedBy(access.Role) {
+		return nil, domain.ErrDocumentReviewForbidden
+	}
+	return doc, nil
+}
+
+// IsReviewConflict indica si el error se debe al estado del documento en la cola (toma o estado)
+func IsReviewConflict(err error) bool {
+	return errors.Is(err, domain.ErrDocumentClaimedByOther) || errors.Is(err, domain.ErrDocumentNotPending)
+}
@@ -0,0 +1,180 @@
+package application_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	notificationSvc "github.com/lukcba/club-pulse-system-api/backend/internal/modules/notification/service"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockNotificationSender struct {
+	mock.Mock
+}
+
+func (m *MockNotificationSender) Send(ctx context.Context, n notificationSvc.Notification) error {
+	return m.Called(ctx, n).Error(0)
+}
+
+func TestDocumentReviewService(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	future := time.Now().AddDate(1, 0, 0)
+	medicalStaff := application.DocumentAccess{ClubID: clubID, UserID: "medic-1", Role: domain.RoleMedicalStaff}
+	admin := application.DocumentAccess{ClubID: clubID, UserID: "admin-1", Role: domain.RoleAdmin}
+
+	setup := func() (*application.DocumentReviewService, *MockUserDocumentRepository, *MockUserRepo, *MockNotificationSender, *MockHealthDataAccessLogger) {
+		docRepo := new(MockUserDocumentRepository)
+		userRepo := new(MockUserRepo)
+		notifier := new(MockNotificationSender)
+		accessLog := new(MockHealthDataAccessLogger)
+		accessLog.On("LogHealthDataAccess", mock.Anything).Return(nil)
+		return application.NewDocumentReviewService(docRepo, userRepo, notifier, accessLog), docRepo, userRepo, notifier, accessLog
+	}
+	pending := func(docType domain.DocumentType, age time.Duration) *domain.UserDocument {
+		return &domain.UserDocument{
+			ID: uuid.New(), ClubID: clubID, UserID: "user-1", Type: docType,
+			Status: domain.DocumentStatusPending, ExpirationDate: &future, CreatedAt: time.Now().Add(-age),
+		}
+	}
+
+	t.Run("La cola ordena por SLA y oculta los aptos médicos a los administradores", func(t *testing.T) {
+		svc, docRepo, _, _, _ := setup()
+		recent := pending(domain.DocumentTypeDNIFront, time.Hour)
+		overdue := pending(domain.DocumentTypeDNIBack, 50*time.Hour)
+		medical := pending(domain.DocumentTypeEMMACMedical, 2*time.Hour)
+		docRepo.On("GetPendingValidation", ctx, clubID).Return([]domain.UserDocument{*recent, *overdue, *medical}, nil)
+
+		items, err := svc.Queue(ctx, admin, application.ReviewQueueFilter{})
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, overdue.ID, items[0].Document.ID)
+		assert.True(t, items[0].Overdue)
+
+		items, err = svc.Queue(ctx, medicalStaff, application.ReviewQueueFilter{OnlyOverdue: true})
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, overdue.ID, items[0].Document.ID)
+	})
+
+	t.Run("Tomar un documento ya tomado por otro revisor es un conflicto", func(t *testing.T) {
+		svc, docRepo, _, _, _ := setup()
+		doc := pending(domain.DocumentTypeEMMACMedical, time.Hour)
+		docRepo.On("GetByID", ctx, clubID, doc.ID).Return(doc, nil)
+		docRepo.On("ClaimForReview", ctx, clubID, doc.ID, "medic-1", mock.Anything).Return(false, nil).Once()
+
+		_, err := svc.Claim(ctx, medicalStaff, doc.ID)
+		assert.ErrorIs(t, err, domain.ErrDocumentClaimedByOther)
+		assert.True(t, application.IsReviewConflict(err))
+	})
+
+	t.Run("Un administrador no puede revisar un apto médico", func(t *testing.T) {
+		svc, docRepo, _, _, _ := setup()
+		doc := pending(domain.DocumentTypeEMMACMedical, time.Hour)
+		docRepo.On("GetByID", ctx, clubID, doc.ID).Return(doc, nil)
+
+		_, err := svc.Review(ctx, admin, doc.ID, application.ReviewDecision{Approve: true})
+		assert.ErrorIs(t, err, domain.ErrDocumentReviewForbidden)
+		docRepo.AssertNotCalled(t, "SaveReview", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Si otro revisor resolvió el documento antes no se pisa su decisión", func(t *testing.T) {
+		svc, docRepo, _, notifier, _ := setup()
+		doc := pending(domain.DocumentTypeDNIFront, time.Hour)
+		docRepo.On("GetByID", ctx, clubID, doc.ID).Return(doc, nil)
+		docRepo.On("SaveReview", ctx, doc, mock.Anything).Return(false, nil).Once()
+
+		_, err := svc.Review(ctx, admin, doc.ID, application.ReviewDecision{Reason: domain.RejectionIllegible})
+		assert.ErrorIs(t, err, domain.ErrDocumentClaimedByOther)
+		assert.True(t, application.IsReviewConflict(err))
+		notifier.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Rechazar exige motivo y notifica al socio", func(t *testing.T) {
+		svc, docRepo, _, notifier, accessLog := setup()
+		doc := pending(domain.DocumentTypeEMMACMedical, time.Hour)
+		docRepo.On("GetByID", ctx, clubID, doc.ID).Return(doc, nil)
+
+		_, err := svc.Review(ctx, medicalStaff, doc.ID, application.ReviewDecision{Approve: false})
+		assert.ErrorIs(t, err, domain.ErrInvalidRejectionReason)
+
+		docRepo.On("SaveReview", ctx, doc, mock.Anything).Return(true, nil).Once()
+		notifier.On("Send", ctx, mock.MatchedBy(func(n notificationSvc.Notification) bool {
+			return n.RecipientID == "user-1" &&
+				strings.Contains(n.Body, "Falta la firma") &&
+				strings.Contains(n.Body, "sin sello del club")
+		})).Return(nil).Once()
+
+		reviewed, err := svc.Review(ctx, medicalStaff, doc.ID, application.ReviewDecision{
+			Reason: domain.RejectionMissingSignature, Notes: "sin sello del club",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, domain.DocumentStatusRejected, reviewed.Status)
+		assert.Equal(t, "medic-1", *reviewed.ValidatedBy)
+		notifier.AssertExpectations(t)
+		accessLog.AssertCalled(t, "LogHealthDataAccess", mock.MatchedBy(func(l *domain.HealthDataAccessLog) bool {
+			return l.Action == domain.HealthAccessValidate
+		}))
+	})
+
+	t.Run("El historial muestra las versiones anteriores del mismo tipo", func(t *testing.T) {
+		svc, docRepo, _, _, _ := setup()
+		doc := pending(domain.DocumentTypeEMMACMedical, time.Hour)
+		older := domain.UserDocument{ID: uuid.New(), UserID: "user-1", Type: domain.DocumentTypeEMMACMedical, Status: domain.DocumentStatusRejected, CreatedAt: time.Now().AddDate(0, -1, 0)}
+		oldest := domain.UserDocument{ID: uuid.New(), UserID: "user-1", Type: domain.DocumentTypeEMMACMedical, Status: domain.DocumentStatusExpired, CreatedAt: time.Now().AddDate(-1, 0, 0)}
+		dni := domain.UserDocument{ID: uuid.New(), UserID: "user-1", Type: domain.DocumentTypeDNIFront}
+		docRepo.On("GetByID", ctx, clubID, doc.ID).Return(doc, nil)
+		docRepo.On("GetByUserID", ctx, clubID, "user-1").Return([]domain.UserDocument{oldest, *doc, dni, older}, nil)
+
+		history, err := svc.History(ctx, medicalStaff, doc.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, older.ID, history[0].ID)
+		assert.Equal(t, oldest.ID, history[1].ID)
+	})
+
+	t.Run("La validación masiva informa los que no se pudieron validar", func(t *testing.T) {
+		svc, docRepo, _, _, _ := setup()
+		dni := pending(domain.DocumentTypeDNIFront, time.Hour)
+		medical := pending(domain.DocumentTypeEMMACMedical, time.Hour)
+		missing := uuid.New()
+		docRepo.On("GetByID", ctx, clubID, dni.ID).Return(dni, nil)
+		docRepo.On("GetByID", ctx, clubID, medical.ID).Return(medical, nil)
+		docRepo.On("GetByID", ctx, clubID, missing).Return(nil, nil)
+		docRepo.On("SaveReview", ctx, dni, mock.Anything).Return(true, nil).Once()
+
+		result := svc.BulkValidate(ctx, admin, []uuid.UUID{dni.ID, medical.ID, missing})
+		assert.Equal(t, []uuid.UUID{dni.ID}, result.Approved)
+		assert.Len(t, result.Failed, 2)
+		assert.Equal(t, domain.DocumentStatusValid, dni.Status)
+	})
+
+	t.Run("Escala una sola vez los documentos que pasaron el SLA", func(t *testing.T) {
+		svc, docRepo, userRepo, notifier, _ := setup()
+		overdue := pending(domain.DocumentTypeEMMACMedical, 49*time.Hour)
+		escalatedAt := time.Now().Add(-time.Hour)
+		alreadyEscalated := pending(domain.DocumentTypeDNIFront, 72*time.Hour)
+		alreadyEscalated.EscalatedAt = &escalatedAt
+		onTime := pending(domain.DocumentTypeDNIBack, time.Hour)
+		docRepo.On("GetPendingValidation", ctx, clubID).Return([]domain.UserDocument{*overdue, *alreadyEscalated, *onTime}, nil)
+		userRepo.On("List", ctx, clubID, 100, 0, map[string]interface{}{"role": domain.RoleAdmin}).
+			Return([]domain.User{{ID: "admin-1"}, {ID: "admin-2"}}, nil)
+		notifier.On("Send", ctx, mock.Anything).Return(nil).Twice()
+		docRepo.On("Update", ctx, mock.MatchedBy(func(d *domain.UserDocument) bool {
+			return d.ID == overdue.ID && d.EscalatedAt != nil
+		})).Return(nil).Once()
+
+		escalated, err := svc.EscalateOverdue(ctx, clubID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, escalated)
+		notifier.AssertExpectations(t)
+		docRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,411 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/storage"
+)
+
+// DefaultMaxDocumentSize es el tamaño máximo de un archivo subido (10 MB)
+const DefaultMaxDocumentSize int64 = 10 << 20
+
+var (
+	ErrDocumentNotFound        = errors.New("documento no encontrado")
+	ErrDocumentAccessDenied    = errors.New("no tienes permiso para acceder a este documento")
+	ErrInvalidDocumentType     = errors.New("tipo de documento inválido")
+	ErrDocumentEmpty           = errors.New("el archivo está vacío")
+	ErrDocumentTooLarge        = errors.New("el archivo supera el tamaño máximo permitido")
+	ErrUnsupportedDocumentType = errors.New("formato de archivo no soportado (solo PDF, JPG o PNG)")
+	ErrDocumentInfected        = errors.New("el archivo fue rechazado por el antivirus")
+	ErrDocumentFileMissing     = errors.New("el documento no tiene un archivo almacenado")
+	ErrDocumentEncrypted       = errors.New("el documento está cifrado y el cifrado no está configurado")
+)
+
+// allowedDocumentContentTypes son los formatos aceptados, con la extensión usada en la clave del objeto
+var allowedDocumentContentTypes = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+// HealthDataAccessLogger registra los accesos a datos de salud (GDPR Art. 9).
+// Lo implementa postgres.HealthDataAccessLogRepository.
+type HealthDataAccessLogger interface {
+	LogHealthDataAccess(log *domain.HealthDataAccessLog) error
+}
+
+// DocumentEncryptor cifra los archivos con la clave de datos del club (ver encryption.Envelope)
+type DocumentEncryptor interface {
+	Encrypt(ctx context.Context, clubID string, plaintext []byte) ([]byte, error)
+	Decrypt(ctx context.Context, clubID string, data []byte) ([]byte, error)
+}
+
+// DocumentStorageService guarda los archivos de los documentos de usuario en el FileStorage,
+// valida formato y tamaño, pasa el antivirus y emite links de descarga de corta duración.
+type DocumentStorageService struct {
+	docRepo   domain.UserDocumentRepository
+	storage   storage.FileStorage
+	scanner   storage.Scanner
+	signer    *storage.URLSigner
+	accessLog HealthDataAccessLogger
+	encryptor DocumentEncryptor
+	maxSize   int64
+}
+
+// NewDocumentStorageService crea una nueva instancia del servicio. Sin scanner se aceptan todos los archivos.
+func NewDocumentStorageService(docRepo domain.UserDocumentRepository, fileStorage storage.FileStorage, scanner storage.Scanner, signer *storage.URLSigner, accessLog HealthDataAccessLogger) *DocumentStorageService {
+	if scanner == nil {
+		scanner = storage.NoopScanner{}
+	}
+	return &DocumentStorageService{
+		docRepo:   docRepo,
+		storage:   fileStorage,
+		scanner:   scanner,
+		signer:    signer,
+		accessLog: accessLog,
+		maxSize:   DefaultMaxDocumentSize,
+	}
+}
+
+// SetMaxSize cambia el tamaño máximo aceptado por archivo
+func (s *DocumentStorageService) SetMaxSize(maxSize int64) {
+	if maxSize > 0 {
+		s.maxSize = maxSize
+	}
+}
+
+// SetEncryptor habilita el cifrado en reposo de los documentos de salud (RequiresEncryption)
+func (s *DocumentStorageService) SetEncryptor(encryptor DocumentEncryptor) {
+	s.encryptor = encryptor
+}
+
+// DocumentAccess identifica a quién accede a un documento y desde dónde, para el control de acceso y la auditoría
+type DocumentAccess struct {
+	ClubID    string
+	UserID    string
+	Role      string
+	IPAddress string
+	UserAgent string
+}
+
+// UploadDocumentInput contiene el archivo subido y sus datos
+type UploadDocumentInput struct {
+	ClubID         string
+	UserID         string
+	Type           domain.DocumentType
+	ExpirationDate *time.Time
+	FileName       string
+	Size           int64 // Tamaño declarado por el cliente, se vuelve a verificar al leer
+	Body           io.Reader
+}
+
+// Upload valida el archivo, lo pasa por el antivirus, lo guarda en el storage y crea el documento.
+// Si falla el alta en la base se borra el objeto para no dejar archivos huérfanos.
+func (s *DocumentStorageService) Upload(ctx context.Context, input UploadDocumentInput) (*domain.UserDocument, error) {
+	if !domain.IsValidDocumentType(input.Type) {
+		return nil, ErrInvalidDocumentType
+	}
+	if input.Size > s.maxSize {
+		return nil, ErrDocumentTooLarge
+	}
+
+	data, err := io.ReadAll(io.LimitReader(input.Body, s.maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo archivo: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, ErrDocumentEmpty
+	}
+	if int64(len(data)) > s.maxSize {
+		return nil, ErrDocumentTooLarge
+	}
+
+	// El tipo se detecta por el contenido, no por la extensión ni el header del cliente
+	contentType := http.DetectContentType(data)
+	ext, ok := allowedDocumentContentTypes[contentType]
+	if !ok {
+		return nil, ErrUnsupportedDocumentType
+	}
+
+	if err := s.scanner.Scan(ctx, bytes.NewReader(data)); err != nil {
+		if errors.Is(err, storage.ErrInfected) {
+			return nil, ErrDocumentInfected
+		}
+		return nil, fmt.Errorf("error en el análisis antivirus: %w", err)
+	}
+
+	doc := &domain.UserDocument{
+		ID:             uuid.New(),
+		ClubID:         input.ClubID,
+		UserID:         input.UserID,
+		Type:           input.Type,
+		Status:         domain.DocumentStatusPending,
+		ExpirationDate: input.ExpirationDate,
+		ContentType:    contentType,
+		SizeBytes:      int64(len(data)),
+		FileName:       path.Base(strings.ReplaceAll(input.FileName, "\\", "/")),
+	}
+	doc.StorageKey = path.Join(input.ClubID, input.UserID, doc.ID.String()+ext)
+	doc.FileURL = fmt.Sprintf("/users/%s/documents/%s/file", input.UserID, doc.ID)
+
+	// Los datos de salud se cifran después del antivirus; SizeBytes sigue siendo el del archivo original
+	stored := data
+	if s.encryptor != nil && doc.RequiresEncryption() {
+		if stored, err = s.encryptor.Encrypt(ctx, input.ClubID, data); err != nil {
+			return nil, fmt.Errorf("error cifrando archivo: %w", err)
+		}
+		doc.Encrypted = true
+	}
+
+	if err := s.storage.Put(ctx, doc.StorageKey, bytes.NewReader(stored), int64(len(stored)), contentType); err != nil {
+		return nil, fmt.Errorf("error guardando archivo: %w", err)
+	}
+	if err := s.docRepo.Create(ctx, doc); err != nil {
+		_ = s.storage.Delete(ctx, doc.StorageKey)
+		return nil, fmt.Errorf("error guardando documento: %w", err)
+	}
+	return doc, nil
+}
+
+// GetDocument devuelve el documento si quien accede tiene permiso, registrando la consulta de datos de salud
+func (s *DocumentStorageService) GetDocument(ctx context.Context, access DocumentAccess, userID string, docID uuid.UUID) (*domain.UserDocument, error) {
+	doc, err := s.authorizedDocument(ctx, access, userID, docID)
+	if err != nil {
+		return nil, err
+	}
+	s.LogAccess(access, doc, domain.HealthAccessView)
+	return doc, nil
+}
+
+// SignDownload emite el token del link de descarga del documento para quien accede
+func (s *DocumentStorageService) SignDownload(access DocumentAccess, doc *domain.UserDocument) (string, time.Time) {
+	subject := strings.Join([]string{access.ClubID, doc.ID.String(), access.UserID, access.Role}, "|")
+	return s.signer.Sign(subject, time.Now())
+}
+
+// OpenFile abre el archivo de un documento para un usuario autenticado
+func (s *DocumentStorageService) OpenFile(ctx context.Context, access DocumentAccess, userID string, docID uuid.UUID) (io.ReadCloser, *domain.UserDocument, error) {
+	doc, err := s.authorizedDocument(ctx, access, userID, docID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.open(ctx, access, doc, domain.HealthAccessDownload)
+}
+
+// OpenForExport abre el archivo de un documento para una exportación (carpeta de liga, portabilidad).
+// Quien llama verifica el acceso (CanBeAccessedBy o el titular); el acceso a datos de salud queda
+// registrado como EXPORT.
+func (s *DocumentStorageService) OpenForExport(ctx context.Context, access DocumentAccess, doc *domain.UserDocument) (io.ReadCloser, error) {
+	body, _, err := s.open(ctx, access, doc, domain.HealthAccessExport)
+	return body, err
+}
+
+// OpenSignedFile abre el archivo a partir de un link firmado. El acceso se audita a nombre de quien pidió el link.
+func (s *DocumentStorageService) OpenSignedFile(ctx context.Context, token, ipAddress, userAgent string) (io.ReadCloser, *domain.UserDocument, error) {
+	subject, err := s.signer.Verify(token, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	parts := strings.Split(subject, "|")
+	if len(parts) != 4 {
+		return nil, nil, storage.ErrInvalidToken
+	}
+	docID, err := uuid.Parse(parts[1])
+	if err != nil {
+		return nil, nil, storage.ErrInvalidToken
+	}
+
+	access := DocumentAccess{ClubID: parts[0], UserID: parts[2], Role: parts[3], IPAddress: ipAddress, UserAgent: userAgent}
+	doc, err := s.authorizedDocument(ctx, access, "", docID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.open(ctx, access, doc, domain.HealthAccessDownload)
+}
+
+// DeleteDocument elimina el documento y su archivo. Solo el titular o un administrador pueden hacerlo.
+func (s *DocumentStorageService) DeleteDocument(ctx context.Context, access DocumentAccess, userID string, docID uuid.UUID) error {
+	doc, err := s.findDocument(ctx, access.ClubID, userID, docID)
+	if err != nil {
+		return err
+	}
+	if doc.UserID != access.UserID && access.Role != domain.RoleAdmin && access.Role != domain.RoleSuperAdmin {
+		return ErrDocumentAccessDenied
+	}
+
+	if err := s.docRepo.Delete(ctx, access.ClubID, docID); err != nil {
+		return fmt.Errorf("error eliminando documento: %w", err)
+	}
+	if doc.StorageKey != "" {
+		if err := s.storage.Delete(ctx, doc.StorageKey); err != nil {
+			return fmt.Errorf("error eliminando archivo: %w", err)
+		}
+	}
+	s.LogAccess(access, doc, domain.HealthAccessDelete)
+	return nil
+}
+
+// PurgeUserFiles borra todos los documentos del socio y sus archivos, para la supresión de datos
+// (GDPR Art. 17). Devuelve cuántos documentos se borraron.
+func (s *DocumentStorageService) PurgeUserFiles(ctx context.Context, access DocumentAccess, userID string) (int, error) {
+	docs, err := s.docRepo.GetByUserID(ctx, access.ClubID, userID)
+	if err != nil {
+		return 0, fmt.Errorf("error obteniendo documentos: %w", err)
+	}
+
+	purged := 0
+	for i := range docs {
+		doc := &docs[i]
+		// Primero el archivo: si falla, el documento queda y el reintento lo vuelve a intentar
+		if doc.StorageKey != "" {
+			if err := s.storage.Delete(ctx, doc.StorageKey); err != nil {
+				return purged, fmt.Errorf("error eliminando archivo: %w", err)
+			}
+		}
+		if err := s.docRepo.Delete(ctx, access.ClubID, doc.ID); err != nil {
+			return purged, fmt.Errorf("error eliminando documento: %w", err)
+		}
+		s.LogAccess(access, doc, domain.HealthAccessDelete)
+		purged++
+	}
+	return purged, nil
+}
+
+// EncryptStoredFiles cifra los archivos de documentos que lo requieren y se guardaron en claro (subidos
+// antes de configurar el cifrado). El archivo cifrado se escribe con otra clave y recién después de
+// actualizar el documento se borra el original, así un fallo nunca deja un archivo cifrado dos veces.
+// Devuelve cuántos documentos se cifraron.
+func (s *DocumentStorageService) EncryptStoredFiles(ctx context.Context, docs []domain.UserDocument) (int, error) {
+	if s.encryptor == nil {
+		return 0, ErrDocumentEncrypted
+	}
+
+	encrypted := 0
+	for i := range docs {
+		doc := &docs[i]
+		if doc.Encrypted || doc.StorageKey == "" || !doc.RequiresEncryption() {
+			continue
+		}
+		body, _, err := s.storage.Get(ctx, doc.StorageKey)
+		if err != nil {
+			return encrypted, fmt.Errorf("error leyendo archivo %s: %w", doc.StorageKey, err)
+		}
+		data, err := io.ReadAll(body)
+		body.Close()
+		if err != nil {
+			return encrypted, fmt.Errorf("error leyendo archivo %s: %w", doc.StorageKey, err)
+		}
+		sealed, err := s.encryptor.Encrypt(ctx, doc.ClubID, data)
+		if err != nil {
+			return encrypted, fmt.Errorf("error cifrando archivo %s: %w", doc.StorageKey, err)
+		}
+
+		plainKey := doc.StorageKey
+		ext := path.Ext(plainKey)
+		sealedKey := strings.TrimSuffix(plainKey, ext) + ".enc" + ext
+		if err := s.storage.Put(ctx, sealedKey, bytes.NewReader(sealed), int64(len(sealed)), doc.ContentType); err != nil {
+			return encrypted, fmt.Errorf("error guardando archivo %s: %w", sealedKey, err)
+		}
+		doc.StorageKey = sealedKey
+		doc.Encrypted = true
+		if err := s.docRepo.Update(ctx, doc); err != nil {
+			_ = s.storage.Delete(ctx, sealedKey)
+			return encrypted, fmt.Errorf("error actualizando documento: %w", err)
+		}
+		if err := s.storage.Delete(ctx, plainKey); err != nil {
+			return encrypted, fmt.Errorf("error eliminando archivo sin cifrar %s: %w", plainKey, err)
+		}
+		encrypted++
+	}
+	return encrypted, nil
+}
+
+// LogAccess registra el acceso en el log de datos de salud cuando el documento es un dato de salud
+func (s *DocumentStorageService) LogAccess(access DocumentAccess, doc *domain.UserDocument, action string) {
+	logHealthDataAccess(s.accessLog, access, doc, action)
+}
+
+func logHealthDataAccess(accessLog HealthDataAccessLogger, access DocumentAccess, doc *domain.UserDocument, action string) {
+	if accessLog == nil || !doc.IsHealthData() {
+		return
+	}
+	docID := doc.ID
+	// La auditoría no debe impedir el acceso: un error al registrar no se propaga
+	_ = accessLog.LogHealthDataAccess(&domain.HealthDataAccessLog{
+		ClubID:            doc.ClubID,
+		AccessedUserID:    doc.UserID,
+		AccessingUserID:   access.UserID,
+		AccessingUserRole: access.Role,
+		DocumentID:        &docID,
+		Action:            action,
+		IPAddress:         access.IPAddress,
+		UserAgent:         access.UserAgent,
+		AccessedAt:        time.Now(),
+	})
+}
+
+func (s *DocumentStorageService) open(ctx context.Context, access DocumentAccess, doc *domain.UserDocument, action string) (io.ReadCloser, *domain.UserDocument, error) {
+	if doc.StorageKey == "" {
+		return nil, nil, ErrDocumentFileMissing
+	}
+	body, _, err := s.storage.Get(ctx, doc.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, ErrDocumentFileMissing
+		}
+		return nil, nil, fmt.Errorf("error leyendo archivo: %w", err)
+	}
+
+	// El descifrado solo ocurre acá, después del control de acceso por rol
+	if doc.Encrypted {
+		defer body.Close()
+		if s.encryptor == nil {
+			return nil, nil, ErrDocumentEncrypted
+		}
+		sealed, err := io.ReadAll(body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error leyendo archivo: %w", err)
+		}
+		plaintext, err := s.encryptor.Decrypt(ctx, doc.ClubID, sealed)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error descifrando archivo: %w", err)
+		}
+		body = io.NopCloser(bytes.NewReader(plaintext))
+	}
+
+	s.LogAccess(access, doc, action)
+	return body, doc, nil
+}
+
+func (s *DocumentStorageService) authorizedDocument(ctx context.Context, access DocumentAccess, userID string, docID uuid.UUID) (*domain.UserDocument, error) {
+	doc, err := s.findDocument(ctx, access.ClubID, userID, docID)
+	if err != nil {
+		return nil, err
+	}
+	if !doc.CanBeAccessedBy(access.UserID, access.Role) {
+		return nil, ErrDocumentAccessDenied
+	}
+	return doc, nil
+}
+
+// findDocument busca el documento en el club; si se indica userID, debe pertenecer a ese usuario
+func (s *DocumentStorageService) findDocument(ctx context.Context, clubID, userID string, docID uuid.UUID) (*domain.UserDocument, error) {
+	doc, err := s.docRepo.GetByID(ctx, clubID, docID)
+	if err != nil || doc == nil {
+		return nil, ErrDocumentNotFound
+	}
+	if userID != "" && doc.UserID != userID {
+		return nil, ErrDocumentNotFound
+	}
+	return doc, nil
+}
@@ -0,0 +1,268 @@
+package application_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockHealthDataAccessLogger struct {
+	mock.Mock
+}
+
+func (m *MockHealthDataAccessLogger) LogHealthDataAccess(log *domain.HealthDataAccessLog) error {
+	return m.Called(log).Error(0)
+}
+
+func TestDocumentStorageService(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	pdf := []byte("%PDF-1.4\n1 0 obj << /Type /Catalog >> endobj\n")
+
+	setup := func(t *testing.T, scanner storage.Scanner) (*application.DocumentStorageService, *MockUserDocumentRepository, *MockHealthDataAccessLogger, *storage.LocalStorage) {
+		files, err := storage.NewLocalStorage(t.TempDir())
+		require.NoError(t, err)
+		repo := new(MockUserDocumentRepository)
+		accessLog := new(MockHealthDataAccessLogger)
+		svc := application.NewDocumentStorageService(repo, files, scanner, storage.NewURLSigner("secret", 0), accessLog)
+		return svc, repo, accessLog, files
+	}
+
+	upload := func(body []byte, docType domain.DocumentType) application.UploadDocumentInput {
+		return application.UploadDocumentInput{
+			ClubID: clubID, UserID: "user-1", Type: docType,
+			FileName: "apto.pdf", Size: int64(len(body)), Body: bytes.NewReader(body),
+		}
+	}
+
+	t.Run("Sube el archivo al storage y crea el documento", func(t *testing.T) {
+		svc, repo, _, files := setup(t, nil)
+		repo.On("Create", ctx, mock.AnythingOfType("*domain.UserDocument")).Return(nil).Once()
+
+		doc, err := svc.Upload(ctx, upload(pdf, domain.DocumentTypeEMMACMedical))
+		require.NoError(t, err)
+		assert.Equal(t, "application/pdf", doc.ContentType)
+		assert.Equal(t, int64(len(pdf)), doc.SizeBytes)
+		assert.Equal(t, "apto.pdf", doc.FileName)
+		assert.Equal(t, "club-1/user-1/"+doc.ID.String()+".pdf", doc.StorageKey)
+		assert.Equal(t, "/users/user-1/documents/"+doc.ID.String()+"/file", doc.FileURL)
+
+		body, _, err := files.Get(ctx, doc.StorageKey)
+		require.NoError(t, err)
+		stored, _ := io.ReadAll(body)
+		body.Close()
+		assert.Equal(t, pdf, stored)
+	})
+
+	t.Run("Rechaza formatos no soportados, archivos grandes y tipos inválidos", func(t *testing.T) {
+		svc, repo, _, _ := setup(t, nil)
+		svc.SetMaxSize(32)
+
+		_, err := svc.Upload(ctx, upload([]byte("MZ\x90\x00 ejecutable"), domain.DocumentTypeDNIFront))
+		assert.ErrorIs(t, err, application.ErrUnsupportedDocumentType)
+
+		_, err = svc.Upload(ctx, upload(pdf, domain.DocumentTypeDNIFront))
+		assert.ErrorIs(t, err, application.ErrDocumentTooLarge)
+
+		// El tamaño se verifica al leer aunque el cliente declare otro
+		input := upload(pdf, domain.DocumentTypeDNIFront)
+		input.Size = 10
+		_, err = svc.Upload(ctx, input)
+		assert.ErrorIs(t, err, application.ErrDocumentTooLarge)
+
+		_, err = svc.Upload(ctx, upload([]byte("%PDF-1.4"), "PASAPORTE"))
+		assert.ErrorIs(t, err, application.ErrInvalidDocumentType)
+
+		_, err = svc.Upload(ctx, upload(nil, domain.DocumentTypeDNIFront))
+		assert.ErrorIs(t, err, application.ErrDocumentEmpty)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("El antivirus rechaza el archivo antes de guardarlo", func(t *testing.T) {
+		scanner := storage.ScannerFunc(func(ctx context.Context, body io.Reader) error {
+			data, _ := io.ReadAll(body)
+			if bytes.Contains(data, []byte("EICAR")) {
+				return storage.ErrInfected
+			}
+			return nil
+		})
+		svc, repo, _, _ := setup(t, scanner)
+
+		_, err := svc.Upload(ctx, upload(append([]byte("%PDF-1.4 "), "EICAR"...), domain.DocumentTypeDNIFront))
+		assert.ErrorIs(t, err, application.ErrDocumentInfected)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Si falla la base se borra el archivo subido", func(t *testing.T) {
+		svc, repo, _, files := setup(t, nil)
+		var created *domain.UserDocument
+		repo.On("Create", ctx, mock.AnythingOfType("*domain.UserDocument")).Run(func(args mock.Arguments) {
+			created = args.Get(1).(*domain.UserDocument)
+		}).Return(assert.AnError).Once()
+
+		_, err := svc.Upload(ctx, upload(pdf, domain.DocumentTypeDNIFront))
+		assert.Error(t, err)
+		_, _, err = files.Get(ctx, created.StorageKey)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("Link firmado: descarga el archivo y registra el acceso al dato de salud", func(t *testing.T) {
+		svc, repo, accessLog, files := setup(t, nil)
+		doc := &domain.UserDocument{ID: uuid.New(), ClubID: clubID, UserID: "user-1", Type: domain.DocumentTypeEMMACMedical, StorageKey: "club-1/user-1/apto.pdf", ContentType: "application/pdf"}
+		require.NoError(t, files.Put(ctx, doc.StorageKey, bytes.NewReader(pdf), int64(len(pdf)), "application/pdf"))
+		repo.On("GetByID", ctx, clubID, doc.ID).Return(doc, nil)
+
+		medic := application.DocumentAccess{ClubID: clubID, UserID: "medic-1", Role: domain.RoleMedicalStaff, IPAddress: "10.0.0.1", UserAgent: "Mozilla/5.0"}
+		accessLog.On("LogHealthDataAccess", mock.MatchedBy(func(l *domain.HealthDataAccessLog) bool {
+			return l.Action == domain.HealthAccessView && l.AccessingUserID == "medic-1" && l.AccessedUserID == "user-1"
+		})).Return(nil).Once()
+		got, err := svc.GetDocument(ctx, medic, "user-1", doc.ID)
+		require.NoError(t, err)
+
+		token, _ := svc.SignDownload(medic, got)
+		accessLog.On("LogHealthDataAccess", mock.MatchedBy(func(l *domain.HealthDataAccessLog) bool {
+			return l.Action == domain.HealthAccessDownload && l.AccessingUserID == "medic-1" && *l.DocumentID == doc.ID && l.IPAddress == "10.0.0.2"
+		})).Return(nil).Once()
+		body, _, err := svc.OpenSignedFile(ctx, token, "10.0.0.2", "curl")
+		require.NoError(t, err)
+		data, _ := io.ReadAll(body)
+		body.Close()
+		assert.Equal(t, pdf, data)
+		accessLog.AssertExpectations(t)
+
+		forged, _ := storage.NewURLSigner("otro", 0).Sign(clubID+"|"+doc.ID.String()+"|user-9|"+domain.RoleSuperAdmin, time.Now())
+		_, _, err = svc.OpenSignedFile(ctx, forged, "10.0.0.2", "curl")
+		assert.ErrorIs(t, err, storage.ErrInvalidToken)
+	})
+
+	t.Run("Los aptos médicos se guardan cifrados y se descifran al descargar", func(t *testing.T) {
+		svc, repo, accessLog, files := setup(t, nil)
+		svc.SetEncryptor(xorEncryptor{})
+		var created *domain.UserDocument
+		repo.On("Create", ctx, mock.AnythingOfType("*domain.UserDocument")).Run(func(args mock.Arguments) {
+			created = args.Get(1).(*domain.UserDocument)
+		}).Return(nil).Once()
+
+		doc, err := svc.Upload(ctx, upload(pdf, domain.DocumentTypeEMMACMedical))
+		require.NoError(t, err)
+		assert.True(t, doc.Encrypted)
+		assert.Equal(t, int64(len(pdf)), doc.SizeBytes)
+
+		body, _, err := files.Get(ctx, doc.StorageKey)
+		require.NoError(t, err)
+		stored, _ := io.ReadAll(body)
+		body.Close()
+		assert.NotEqual(t, pdf, stored)
+
+		repo.On("GetByID", ctx, clubID, created.ID).Return(created, nil)
+		accessLog.On("LogHealthDataAccess", mock.Anything).Return(nil)
+		owner := application.DocumentAccess{ClubID: clubID, UserID: "user-1", Role: domain.RoleMember}
+		body, _, err = svc.OpenFile(ctx, owner, "user-1", created.ID)
+		require.NoError(t, err)
+		data, _ := io.ReadAll(body)
+		body.Close()
+		assert.Equal(t, pdf, data)
+
+		// El DNI no es dato de salud y se guarda sin cifrar
+		repo.On("Create", ctx, mock.AnythingOfType("*domain.UserDocument")).Return(nil).Once()
+		dni, err := svc.Upload(ctx, upload(pdf, domain.DocumentTypeDNIFront))
+		require.NoError(t, err)
+		assert.False(t, dni.Encrypted)
+	})
+
+	t.Run("Los certificados médicos de otros usuarios no los ven admins ni entrenadores", func(t *testing.T) {
+		svc, repo, accessLog, _ := setup(t, nil)
+		medical := &domain.UserDocument{ID: uuid.New(), ClubID: clubID, UserID: "user-1", Type: domain.DocumentTypeEMMACMedical, StorageKey: "k.pdf"}
+		dni := &domain.UserDocument{ID: uuid.New(), ClubID: clubID, UserID: "user-1", Type: domain.DocumentTypeDNIFront, StorageKey: "k.png"}
+		repo.On("GetByID", ctx, clubID, medical.ID).Return(medical, nil)
+		repo.On("GetByID", ctx, clubID, dni.ID).Return(dni, nil)
+
+		admin := application.DocumentAccess{ClubID: clubID, UserID: "admin-1", Role: domain.RoleAdmin}
+		_, err := svc.GetDocument(ctx, admin, "user-1", medical.ID)
+		assert.ErrorIs(t, err, application.ErrDocumentAccessDenied)
+
+		_, err = svc.GetDocument(ctx, admin, "user-1", dni.ID)
+		assert.NoError(t, err)
+
+		member := application.DocumentAccess{ClubID: clubID, UserID: "user-2", Role: domain.RoleMember}
+		_, err = svc.GetDocument(ctx, member, "user-1", dni.ID)
+		assert.ErrorIs(t, err, application.ErrDocumentAccessDenied)
+
+		// El documento debe pertenecer al usuario de la ruta
+		_, err = svc.GetDocument(ctx, admin, "user-2", dni.ID)
+		assert.ErrorIs(t, err, application.ErrDocumentNotFound)
+		accessLog.AssertNotCalled(t, "LogHealthDataAccess", mock.Anything)
+	})
+
+	t.Run("Eliminar borra el archivo del storage", func(t *testing.T) {
+		svc, repo, accessLog, files := setup(t, nil)
+		doc := &domain.UserDocument{ID: uuid.New(), ClubID: clubID, UserID: "user-1", Type: domain.DocumentTypeEMMACMedical, StorageKey: "club-1/user-1/apto.pdf"}
+		require.NoError(t, files.Put(ctx, doc.StorageKey, bytes.NewReader(pdf), int64(len(pdf)), "application/pdf"))
+		repo.On("GetByID", ctx, clubID, doc.ID).Return(doc, nil)
+		repo.On("Delete", ctx, clubID, doc.ID).Return(nil).Once()
+		accessLog.On("LogHealthDataAccess", mock.MatchedBy(func(l *domain.HealthDataAccessLog) bool {
+			return l.Action == domain.HealthAccessDelete
+		})).Return(nil).Once()
+
+		err := svc.DeleteDocument(ctx, application.DocumentAccess{ClubID: clubID, UserID: "user-2", Role: domain.RoleCoach}, "user-1", doc.ID)
+		assert.ErrorIs(t, err, application.ErrDocumentAccessDenied)
+
+		assert.NoError(t, svc.DeleteDocument(ctx, application.DocumentAccess{ClubID: clubID, UserID: "user-1", Role: domain.RoleMember}, "user-1", doc.ID))
+		_, _, err = files.Get(ctx, doc.StorageKey)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		accessLog.AssertExpectations(t)
+	})
+
+	t.Run("Cifra los aptos subidos antes de configurar el cifrado", func(t *testing.T) {
+		svc, repo, _, files := setup(t, nil)
+		svc.SetEncryptor(xorEncryptor{})
+		apto := domain.UserDocument{ID: uuid.New(), ClubID: clubID, UserID: "user-1", Type: domain.DocumentTypeEMMACMedical, StorageKey: "club-1/user-1/apto.pdf", ContentType: "application/pdf"}
+		dni := domain.UserDocument{ID: uuid.New(), ClubID: clubID, UserID: "user-1", Type: domain.DocumentTypeDNIFront, StorageKey: "club-1/user-1/dni.pdf"}
+		require.NoError(t, files.Put(ctx, apto.StorageKey, bytes.NewReader(pdf), int64(len(pdf)), "application/pdf"))
+		repo.On("Update", ctx, mock.MatchedBy(func(d *domain.UserDocument) bool {
+			return d.ID == apto.ID && d.Encrypted && d.StorageKey == "club-1/user-1/apto.enc.pdf"
+		})).Return(nil).Once()
+
+		count, err := svc.EncryptStoredFiles(ctx, []domain.UserDocument{apto, dni})
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		repo.AssertExpectations(t)
+
+		_, _, err = files.Get(ctx, apto.StorageKey)
+		assert.ErrorIs(t, err, storage.ErrNotFound, "el original sin cifrar se borra")
+		body, _, err := files.Get(ctx, "club-1/user-1/apto.enc.pdf")
+		require.NoError(t, err)
+		sealed, _ := io.ReadAll(body)
+		body.Close()
+		assert.Equal(t, pdf, xorBytes(sealed))
+	})
+}
+
+// xorEncryptor es un cifrador de prueba; el cifrado real está cubierto en platform/encryption
+type xorEncryptor struct{}
+
+func (xorEncryptor) Encrypt(ctx context.Context, clubID string, plaintext []byte) ([]byte, error) {
+	return xorBytes(plaintext), nil
+}
+
+func (xorEncryptor) Decrypt(ctx context.Context, clubID string, data []byte) ([]byte, error) {
+	return xorBytes(data), nil
+}
+
+func xorBytes(data []byte) []byte {
+	out := make([]byte, len(data))
+	for i, b := range data {
+		out[i] = b ^ 0x5a
+	}
+	return out
+}
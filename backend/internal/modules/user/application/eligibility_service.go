@@ -2,7 +2,9 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,7 +13,9 @@ import (
 
 // EligibilityService maneja la lógica de negocio para verificar la elegibilidad de un usuario
 type EligibilityService struct {
-	docRepo domain.UserDocumentRepository
+	docRepo  domain.UserDocumentRepository
+	ruleRepo domain.EligibilityRuleRepository
+	userRepo domain.UserRepository
 }
 
 // NewEligibilityService crea una nueva instancia del servicio
@@ -21,78 +25,231 @@ func NewEligibilityService(docRepo domain.UserDocumentRepository) *EligibilitySe
 	}
 }
 
+// SetRules habilita los requisitos configurables por club, disciplina y categoría.
+// userRepo se usa para saber si el jugador es menor (autorización parental).
+// Sin reglas se exige DNI + apto médico.
+func (s *EligibilityService) SetRules(ruleRepo domain.EligibilityRuleRepository, userRepo domain.UserRepository) {
+	s.ruleRepo = ruleRepo
+	s.userRepo = userRepo
+}
+
+var (
+	ErrEligibilityRuleNotFound = errors.New("regla de elegibilidad no encontrada")
+	ErrInvalidEligibilityRule  = errors.New("la regla debe tener al menos un requisito válido")
+)
+
 // EligibilityResult contiene el resultado de la verificación de elegibilidad
 type EligibilityResult struct {
-	IsEligible bool     `json:"is_eligible"`
-	Issues     []string `json:"issues,omitempty"`
-	HasDNI     bool     `json:"has_dni"`
-	HasEMMAC   bool     `json:"has_emmac"`
+	IsEligible   bool                            `json:"is_eligible"`
+	Issues       []string                        `json:"issues,omitempty"`
+	IssueDetails []domain.EligibilityIssue       `json:"issue_details,omitempty"`
+	Requirements []domain.EligibilityRequirement `json:"requirements"`
+	RuleID       *uuid.UUID                      `json:"rule_id,omitempty"` // Regla aplicada; vacío si se usaron los requisitos por defecto
+	HasDNI       bool                            `json:"has_dni"`
+	HasEMMAC     bool                            `json:"has_emmac"`
 }
 
-// CheckEligibility verifica si un usuario es elegible para participar
-// Un usuario es elegible si tiene:
-// 1. DNI (Frente y/o Dorso) válido
-// 2. Apto Médico (EMMAC) válido y no vencido
+// CheckEligibility verifica si un usuario es elegible para participar según la regla general del club
+// (por defecto DNI validado y apto médico válido y no vencido)
 func (s *EligibilityService) CheckEligibility(ctx context.Context, clubID, userID string) (*EligibilityResult, error) {
+	return s.CheckEligibilityFor(ctx, clubID, userID, domain.EligibilityScope{})
+}
+
+// CheckEligibilityFor verifica la elegibilidad con la regla de la disciplina y categoría indicadas
+func (s *EligibilityService) CheckEligibilityFor(ctx context.Context, clubID, userID string, scope domain.EligibilityScope) (*EligibilityResult, error) {
 	docs, err := s.docRepo.GetByUserID(ctx, clubID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("error obteniendo documentos: %w", err)
 	}
 
+	rule, err := s.ResolveRule(ctx, clubID, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	isMinor, err := s.isMinor(ctx, clubID, userID, rule.Requirements)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Evaluate(rule, docs, isMinor), nil
+}
+
+// ListIssues devuelve los requisitos incumplidos por el jugador (lo usa el semáforo de Team)
+func (s *EligibilityService) ListIssues(ctx context.Context, clubID, userID string, scope domain.EligibilityScope) ([]domain.EligibilityIssue, error) {
+	result, err := s.CheckEligibilityFor(ctx, clubID, userID, scope)
+	if err != nil {
+		return nil, err
+	}
+	return result.IssueDetails, nil
+}
+
+// Evaluate aplica la regla a documentos ya cargados (ej. la exportación para la liga)
+func (s *EligibilityService) Evaluate(rule *domain.EligibilityRule, docs []domain.UserDocument, isMinor bool) *EligibilityResult {
+	details := domain.EvaluateEligibility(rule.Requirements, docs, isMinor)
 	result := &EligibilityResult{
-		IsEligible: true,
-		Issues:     []string{},
-		HasDNI:     false,
-		HasEMMAC:   false,
+		Issues:       make([]string, 0, len(details)),
+		IssueDetails: details,
+		Requirements: rule.Requirements,
+		HasDNI:       domain.EvaluateRequirement(domain.RequirementDNI, docs) == nil,
+		HasEMMAC:     domain.EvaluateRequirement(domain.RequirementMedical, docs) == nil,
+	}
+	if rule.ID != uuid.Nil {
+		id := rule.ID
+		result.RuleID = &id
 	}
+	for _, issue := range details {
+		result.Issues = append(result.Issues, issue.Message)
+	}
+	result.IsEligible = len(details) == 0
+	return result
+}
 
-	// Verificar DNI
-	for _, doc := range docs {
-		if (doc.Type == domain.DocumentTypeDNIFront || doc.Type == domain.DocumentTypeDNIBack) &&
-			doc.Status == domain.DocumentStatusValid {
-			result.HasDNI = true
-			break
+// ResolveRule devuelve la regla más específica para el alcance, o una con los requisitos por defecto
+func (s *EligibilityService) ResolveRule(ctx context.Context, clubID string, scope domain.EligibilityScope) (*domain.EligibilityRule, error) {
+	if s.ruleRepo != nil {
+		rules, err := s.ruleRepo.ListByClub(ctx, clubID)
+		if err != nil {
+			return nil, fmt.Errorf("error obteniendo reglas de elegibilidad: %w", err)
+		}
+		if rule := domain.ResolveEligibilityRule(rules, scope); rule != nil {
+			return rule, nil
 		}
 	}
+	return &domain.EligibilityRule{ClubID: clubID, Requirements: domain.DefaultEligibilityRequirements}, nil
+}
 
-	// Verificar EMMAC (Apto Médico)
-	for _, doc := range docs {
-		if doc.Type == domain.DocumentTypeEMMACMedical {
-			if doc.Status == domain.DocumentStatusExpired || doc.IsExpired() {
-				result.Issues = append(result.Issues, "Apto físico vencido")
-			} else if doc.Status == domain.DocumentStatusValid {
-				result.HasEMMAC = true
-			} else if doc.Status == domain.DocumentStatusPending {
-				result.Issues = append(result.Issues, "Apto físico pendiente de validación")
-			} else if doc.Status == domain.DocumentStatusRejected {
-				result.Issues = append(result.Issues, "Apto físico rechazado")
-			}
-			break
+// isMinor solo busca al usuario si la regla pide autorización parental.
+// Si no se puede determinar, se exige la autorización.
+func (s *EligibilityService) isMinor(ctx context.Context, clubID, userID string, requirements []domain.EligibilityRequirement) (bool, error) {
+	needed := false
+	for _, r := range requirements {
+		if r == domain.RequirementParentalConsent {
+			needed = true
 		}
 	}
+	if !needed || s.userRepo == nil {
+		return needed, nil
+	}
+	user, err := s.userRepo.GetByID(ctx, clubID, userID)
+	if err != nil {
+		return false, fmt.Errorf("error obteniendo usuario: %w", err)
+	}
+	if user == nil {
+		return true, nil
+	}
+	return user.IsMinor(time.Now()), nil
+}
+
+// ListRules devuelve las reglas de elegibilidad configuradas por el club
+func (s *EligibilityService) ListRules(ctx context.Context, clubID string) ([]domain.EligibilityRule, error) {
+	if s.ruleRepo == nil {
+		return []domain.EligibilityRule{}, nil
+	}
+	return s.ruleRepo.ListByClub(ctx, clubID)
+}
+
+// EligibilityRuleInput son los datos editables de una regla
+type EligibilityRuleInput struct {
+	DisciplineID *uuid.UUID                      `json:"discipline_id"`
+	Category     string                          `json:"category"`
+	Name         string                          `json:"name"`
+	Requirements []domain.EligibilityRequirement `json:"requirements" binding:"required"`
+}
 
-	// Agregar issues si faltan documentos
-	if !result.HasDNI {
-		result.Issues = append(result.Issues, "DNI faltante o no validado")
+// CreateRule crea una regla de elegibilidad
+func (s *EligibilityService) CreateRule(ctx context.Context, clubID string, input EligibilityRuleInput) (*domain.EligibilityRule, error) {
+	if s.ruleRepo == nil {
+		return nil, errors.New("reglas de elegibilidad no configuradas")
 	}
-	if !result.HasEMMAC {
-		// Solo agregar si no hay otro issue relacionado con EMMAC
-		hasEMMACIssue := false
-		for _, issue := range result.Issues {
-			if issue == "Apto físico vencido" || issue == "Apto físico pendiente de validación" || issue == "Apto físico rechazado" {
-				hasEMMACIssue = true
-				break
-			}
-		}
-		if !hasEMMACIssue {
-			result.Issues = append(result.Issues, "Apto físico faltante")
-		}
+	rule := &domain.EligibilityRule{ID: uuid.New(), ClubID: clubID}
+	if err := applyRuleInput(rule, input); err != nil {
+		return nil, err
+	}
+	if err := s.checkRuleScopeFree(ctx, rule); err != nil {
+		return nil, err
+	}
+	if err := s.ruleRepo.Create(ctx, rule); err != nil {
+		return nil, err
 	}
+	return rule, nil
+}
 
-	// El usuario es elegible solo si tiene ambos documentos válidos
-	result.IsEligible = result.HasDNI && result.HasEMMAC && len(result.Issues) == 0
+// UpdateRule reemplaza el alcance y los requisitos de una regla
+func (s *EligibilityService) UpdateRule(ctx context.Context, clubID string, id uuid.UUID, input EligibilityRuleInput) (*domain.EligibilityRule, error) {
+	rule, err := s.getRule(ctx, clubID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyRuleInput(rule, input); err != nil {
+		return nil, err
+	}
+	if err := s.checkRuleScopeFree(ctx, rule); err != nil {
+		return nil, err
+	}
+	if err := s.ruleRepo.Update(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
 
-	return result, nil
+// DeleteRule elimina una regla; sus jugadores pasan a evaluarse con la siguiente regla que aplique
+func (s *EligibilityService) DeleteRule(ctx context.Context, clubID string, id uuid.UUID) error {
+	if _, err := s.getRule(ctx, clubID, id); err != nil {
+		return err
+	}
+	return s.ruleRepo.Delete(ctx, clubID, id)
+}
+
+func (s *EligibilityService) getRule(ctx context.Context, clubID string, id uuid.UUID) (*domain.EligibilityRule, error) {
+	if s.ruleRepo == nil {
+		return nil, ErrEligibilityRuleNotFound
+	}
+	rule, err := s.ruleRepo.GetByID(ctx, clubID, id)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, ErrEligibilityRuleNotFound
+	}
+	return rule, nil
+}
+
+// checkRuleScopeFree rechaza una segunda regla para la misma disciplina y categoría,
+// que el índice único de la tabla también impide
+func (s *EligibilityService) checkRuleScopeFree(ctx context.Context, rule *domain.EligibilityRule) error {
+	rules, err := s.ruleRepo.ListByClub(ctx, rule.ClubID)
+	if err != nil {
+		return fmt.Errorf("error obteniendo reglas de elegibilidad: %w", err)
+	}
+	for i := range rules {
+		if rules[i].ID != rule.ID && rules[i].SameScope(rule) {
+			return domain.ErrEligibilityRuleExists
+		}
+	}
+	return nil
+}
+
+func applyRuleInput(rule *domain.EligibilityRule, input EligibilityRuleInput) error {
+	requirements := make([]domain.EligibilityRequirement, 0, len(input.Requirements))
+	seen := map[domain.EligibilityRequirement]bool{}
+	for _, r := range input.Requirements {
+		if !domain.IsValidEligibilityRequirement(r) {
+			return fmt.Errorf("%w: %s", ErrInvalidEligibilityRule, r)
+		}
+		if !seen[r] {
+			seen[r] = true
+			requirements = append(requirements, r)
+		}
+	}
+	if len(requirements) == 0 {
+		return ErrInvalidEligibilityRule
+	}
+	rule.DisciplineID = input.DisciplineID
+	rule.Category = strings.TrimSpace(input.Category)
+	rule.Name = strings.TrimSpace(input.Name)
+	rule.Requirements = requirements
+	return nil
 }
 
 // GetDocumentSummary obtiene un resumen del estado de los documentos de un usuario
@@ -119,41 +276,3 @@ func (s *EligibilityService) GetDocumentSummary(ctx context.Context, clubID, use
 
 	return summary, nil
 }
-
-// ValidateDocument valida o rechaza un documento
-func (s *EligibilityService) ValidateDocument(ctx context.Context, clubID string, docID string, validatorID string, approve bool, notes string) error {
-	docUUID, err := parseUUID(docID)
-	if err != nil {
-		return fmt.Errorf("ID de documento inválido: %w", err)
-	}
-
-	doc, err := s.docRepo.GetByID(ctx, clubID, docUUID)
-	if err != nil {
-		return fmt.Errorf("documento no encontrado: %w", err)
-	}
-
-	// Verificar que el documento puede ser validado
-	if !doc.CanBeValidated() {
-		return fmt.Errorf("el documento no puede ser validado (estado: %s, vencido: %v)", doc.Status, doc.IsExpired())
-	}
-
-	// Actualizar el documento
-	now := time.Now()
-	doc.ValidatedAt = &now
-	doc.ValidatedBy = &validatorID
-
-	if approve {
-		doc.Status = domain.DocumentStatusValid
-		doc.RejectionNotes = ""
-	} else {
-		doc.Status = domain.DocumentStatusRejected
-		doc.RejectionNotes = notes
-	}
-
-	return s.docRepo.Update(ctx, doc)
-}
-
-// Helper function para parsear UUID
-func parseUUID(id string) (uuid.UUID, error) {
-	return uuid.Parse(id)
-}
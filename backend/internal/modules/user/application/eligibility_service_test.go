@@ -73,6 +73,16 @@ func (m *MockUserDocumentRepository) GetPendingValidation(ctx context.Context, c
 	return args.Get(0).([]domain.UserDocument), args.Error(1)
 }
 
+func (m *MockUserDocumentRepository) ClaimForReview(ctx context.Context, clubID string, id uuid.UUID, reviewerID string, now time.Time) (bool, error) {
+	args := m.Called(ctx, clubID, id, reviewerID, now)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserDocumentRepository) SaveReview(ctx context.Context, doc *domain.UserDocument, now time.Time) (bool, error) {
+	args := m.Called(ctx, doc, now)
+	return args.Bool(0), args.Error(1)
+}
+
 // Tests para EligibilityService
 
 func TestCheckEligibility_EligibleUser(t *testing.T) {
@@ -252,91 +262,148 @@ func TestCheckEligibility_NoDocuments(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
-func TestValidateDocument_Approve(t *testing.T) {
-	// Arrange
-	mockRepo := new(MockUserDocumentRepository)
-	service := application.NewEligibilityService(mockRepo)
-
-	clubID := "club-123"
-	docID := uuid.New()
-	validatorID := "admin-789"
+// MockEligibilityRuleRepository es un mock del repositorio de reglas de elegibilidad
+type MockEligibilityRuleRepository struct {
+	mock.Mock
+}
 
-	doc := &domain.UserDocument{
-		ID:     docID,
-		ClubID: clubID,
-		Status: domain.DocumentStatusPending,
-	}
+func (m *MockEligibilityRuleRepository) Create(ctx context.Context, rule *domain.EligibilityRule) error {
+	return m.Called(ctx, rule).Error(0)
+}
 
-	mockRepo.On("GetByID", mock.Anything, clubID, docID).Return(doc, nil)
-	mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.UserDocument")).Return(nil)
+func (m *MockEligibilityRuleRepository) Update(ctx context.Context, rule *domain.EligibilityRule) error {
+	return m.Called(ctx, rule).Error(0)
+}
 
-	// Act
-	err := service.ValidateDocument(context.Background(), clubID, docID.String(), validatorID, true, "")
+func (m *MockEligibilityRuleRepository) Delete(ctx context.Context, clubID string, id uuid.UUID) error {
+	return m.Called(ctx, clubID, id).Error(0)
+}
 
-	// Assert
-	assert.NoError(t, err)
-	assert.Equal(t, domain.DocumentStatusValid, doc.Status)
-	assert.NotNil(t, doc.ValidatedAt)
-	assert.Equal(t, &validatorID, doc.ValidatedBy)
+func (m *MockEligibilityRuleRepository) GetByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.EligibilityRule, error) {
+	args := m.Called(ctx, clubID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EligibilityRule), args.Error(1)
+}
 
-	mockRepo.AssertExpectations(t)
+func (m *MockEligibilityRuleRepository) ListByClub(ctx context.Context, clubID string) ([]domain.EligibilityRule, error) {
+	args := m.Called(ctx, clubID)
+	return args.Get(0).([]domain.EligibilityRule), args.Error(1)
 }
 
-func TestValidateDocument_Reject(t *testing.T) {
-	// Arrange
+func TestCheckEligibilityFor_DisciplineRule(t *testing.T) {
 	mockRepo := new(MockUserDocumentRepository)
+	ruleRepo := new(MockEligibilityRuleRepository)
+	userRepo := new(MockUserRepo)
 	service := application.NewEligibilityService(mockRepo)
+	service.SetRules(ruleRepo, userRepo)
 
 	clubID := "club-123"
-	docID := uuid.New()
-	validatorID := "admin-789"
-	rejectionNotes := "Imagen borrosa, por favor subir nuevamente"
-
-	doc := &domain.UserDocument{
-		ID:     docID,
-		ClubID: clubID,
-		Status: domain.DocumentStatusPending,
+	userID := "user-456"
+	football := uuid.New()
+	leagueRule := domain.EligibilityRule{
+		ID: uuid.New(), ClubID: clubID, DisciplineID: &football, Category: "2012",
+		Requirements: []domain.EligibilityRequirement{domain.RequirementDNI, domain.RequirementMedical, domain.RequirementLeagueForm, domain.RequirementParentalConsent},
 	}
+	ruleRepo.On("ListByClub", mock.Anything, clubID).Return([]domain.EligibilityRule{leagueRule}, nil)
 
-	mockRepo.On("GetByID", mock.Anything, clubID, docID).Return(doc, nil)
-	mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.UserDocument")).Return(nil)
+	futureDate := time.Now().AddDate(0, 6, 0)
+	mockRepo.On("GetByUserID", mock.Anything, clubID, userID).Return([]domain.UserDocument{
+		{Type: domain.DocumentTypeDNIFront, Status: domain.DocumentStatusValid},
+		{Type: domain.DocumentTypeEMMACMedical, Status: domain.DocumentStatusValid, ExpirationDate: &futureDate},
+	}, nil)
+	birth := time.Now().AddDate(-13, 0, 0)
+	userRepo.On("GetByID", mock.Anything, clubID, userID).Return(&domain.User{ID: userID, DateOfBirth: &birth}, nil)
+
+	// Fuera de la disciplina se aplican los requisitos por defecto
+	result, err := service.CheckEligibility(context.Background(), clubID, userID)
+	assert.NoError(t, err)
+	assert.True(t, result.IsEligible)
+	assert.Nil(t, result.RuleID)
+	userRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything, mock.Anything)
 
-	// Act
-	err := service.ValidateDocument(context.Background(), clubID, docID.String(), validatorID, false, rejectionNotes)
+	// La liga de fútbol 2012 pide ficha de liga y, para menores, autorización parental
+	result, err = service.CheckEligibilityFor(context.Background(), clubID, userID, domain.EligibilityScope{DisciplineID: &football, Category: "2012"})
+	assert.NoError(t, err)
+	assert.False(t, result.IsEligible)
+	assert.Equal(t, leagueRule.ID, *result.RuleID)
+	assert.True(t, result.HasDNI)
+	assert.True(t, result.HasEMMAC)
+	if assert.Len(t, result.IssueDetails, 2) {
+		assert.Equal(t, "LEAGUE_FORM_MISSING", result.IssueDetails[0].Code)
+		assert.Equal(t, "PARENTAL_CONSENT_MISSING", result.IssueDetails[1].Code)
+	}
+	assert.Contains(t, result.Issues, "Ficha de liga faltante")
+}
 
-	// Assert
+func TestCreateEligibilityRule_Validation(t *testing.T) {
+	ruleRepo := new(MockEligibilityRuleRepository)
+	service := application.NewEligibilityService(new(MockUserDocumentRepository))
+	service.SetRules(ruleRepo, nil)
+
+	_, err := service.CreateRule(context.Background(), "club-123", application.EligibilityRuleInput{})
+	assert.ErrorIs(t, err, application.ErrInvalidEligibilityRule)
+
+	_, err = service.CreateRule(context.Background(), "club-123", application.EligibilityRuleInput{
+		Requirements: []domain.EligibilityRequirement{"PASAPORTE"},
+	})
+	assert.ErrorIs(t, err, application.ErrInvalidEligibilityRule)
+
+	ruleRepo.On("ListByClub", mock.Anything, "club-123").Return([]domain.EligibilityRule{}, nil).Once()
+	ruleRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.EligibilityRule")).Return(nil).Once()
+	rule, err := service.CreateRule(context.Background(), "club-123", application.EligibilityRuleInput{
+		Category:     " 2012 ",
+		Requirements: []domain.EligibilityRequirement{domain.RequirementInsurance, domain.RequirementDNI, domain.RequirementInsurance},
+	})
 	assert.NoError(t, err)
-	assert.Equal(t, domain.DocumentStatusRejected, doc.Status)
-	assert.Equal(t, rejectionNotes, doc.RejectionNotes)
+	assert.Equal(t, "2012", rule.Category)
+	assert.Equal(t, []domain.EligibilityRequirement{domain.RequirementInsurance, domain.RequirementDNI}, rule.Requirements)
 
-	mockRepo.AssertExpectations(t)
+	ruleRepo.On("GetByID", mock.Anything, "club-123", mock.Anything).Return(nil, nil).Once()
+	err = service.DeleteRule(context.Background(), "club-123", uuid.New())
+	assert.ErrorIs(t, err, application.ErrEligibilityRuleNotFound)
 }
 
-func TestValidateDocument_CannotValidateExpired(t *testing.T) {
-	// Arrange
-	mockRepo := new(MockUserDocumentRepository)
-	service := application.NewEligibilityService(mockRepo)
+func TestCreateEligibilityRule_DuplicateScope(t *testing.T) {
+	ruleRepo := new(MockEligibilityRuleRepository)
+	service := application.NewEligibilityService(new(MockUserDocumentRepository))
+	service.SetRules(ruleRepo, nil)
 
 	clubID := "club-123"
-	docID := uuid.New()
-	validatorID := "admin-789"
-
-	pastDate := time.Now().AddDate(0, -1, 0) // Ya vencido
-	doc := &domain.UserDocument{
-		ID:             docID,
-		ClubID:         clubID,
-		Status:         domain.DocumentStatusPending,
-		ExpirationDate: &pastDate,
+	football := uuid.New()
+	existing := domain.EligibilityRule{
+		ID: uuid.New(), ClubID: clubID, DisciplineID: &football, Category: "2012",
+		Requirements: []domain.EligibilityRequirement{domain.RequirementDNI},
 	}
+	other := domain.EligibilityRule{
+		ID: uuid.New(), ClubID: clubID, DisciplineID: &football,
+		Requirements: []domain.EligibilityRequirement{domain.RequirementDNI},
+	}
+	ruleRepo.On("ListByClub", mock.Anything, clubID).Return([]domain.EligibilityRule{existing, other}, nil)
+
+	// Misma disciplina y categoría que una regla existente
+	_, err := service.CreateRule(context.Background(), clubID, application.EligibilityRuleInput{
+		DisciplineID: &football, Category: "2012",
+		Requirements: []domain.EligibilityRequirement{domain.RequirementInsurance},
+	})
+	assert.ErrorIs(t, err, domain.ErrEligibilityRuleExists)
+
+	// Editar una regla sin cambiar su alcance no choca consigo misma
+	ruleRepo.On("GetByID", mock.Anything, clubID, existing.ID).Return(&existing, nil)
+	ruleRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.EligibilityRule")).Return(nil).Once()
+	_, err = service.UpdateRule(context.Background(), clubID, existing.ID, application.EligibilityRuleInput{
+		DisciplineID: &football, Category: "2012",
+		Requirements: []domain.EligibilityRequirement{domain.RequirementInsurance},
+	})
+	assert.NoError(t, err)
 
-	mockRepo.On("GetByID", mock.Anything, clubID, docID).Return(doc, nil)
-
-	// Act
-	err := service.ValidateDocument(context.Background(), clubID, docID.String(), validatorID, true, "")
-
-	// Assert
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "no puede ser validado")
-
-	mockRepo.AssertExpectations(t)
+	// Pero no puede pasar al alcance de otra regla
+	_, err = service.UpdateRule(context.Background(), clubID, existing.ID, application.EligibilityRuleInput{
+		DisciplineID: &football,
+		Requirements: []domain.EligibilityRequirement{domain.RequirementInsurance},
+	})
+	assert.ErrorIs(t, err, domain.ErrEligibilityRuleExists)
+	ruleRepo.AssertNumberOfCalls(t, "Create", 0)
+	ruleRepo.AssertNumberOfCalls(t, "Update", 1)
 }
@@ -0,0 +1,326 @@
+package application
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+var ErrLeagueTeamNotFound = errors.New("equipo no encontrado")
+
+// LeagueTeam es un equipo listo para exportar: sus miembros ya evaluados con los requisitos de la liga
+type LeagueTeam struct {
+	ID      uuid.UUID
+	Name    string
+	Scope   domain.EligibilityScope
+	Members []TeamMember
+}
+
+// RosterField es un dato del jugador que puede ir como columna en el CSV de la federación
+type RosterField string
+
+const (
+	RosterFieldNumber        RosterField = "NUMBER"
+	RosterFieldName          RosterField = "NAME"
+	RosterFieldBirthDate     RosterField = "BIRTH_DATE"
+	RosterFieldCategory      RosterField = "CATEGORY" // Año de nacimiento
+	RosterFieldMedicalExpiry RosterField = "MEDICAL_EXPIRY"
+	RosterFieldStatus        RosterField = "STATUS"
+)
+
+// RosterColumn es una columna del CSV: el encabezado que pide la federación y el dato que lleva
+type RosterColumn struct {
+	Header string
+	Field  RosterField
+}
+
+// RosterCSVLayout define el formato del CSV de plantel que pide la federación
+type RosterCSVLayout struct {
+	Delimiter rune
+	Columns   []RosterColumn
+}
+
+// DefaultRosterCSVLayout es el formato de la planilla de inscripción habitual de las ligas.
+// Usa ';' porque es el separador que espera Excel en español. No lleva DNI: el número de documento
+// no se registra, solo el archivo escaneado.
+var DefaultRosterCSVLayout = RosterCSVLayout{
+	Delimiter: ';',
+	Columns: []RosterColumn{
+		{Header: "Nro", Field: RosterFieldNumber},
+		{Header: "Apellido y Nombre", Field: RosterFieldName},
+		{Header: "Fecha de Nacimiento", Field: RosterFieldBirthDate},
+		{Header: "Categoría", Field: RosterFieldCategory},
+		{Header: "Vto. Apto Médico", Field: RosterFieldMedicalExpiry},
+		{Header: "Estado Documentación", Field: RosterFieldStatus},
+	},
+}
+
+// LeagueMissingItem es un documento que no se pudo incluir en la carpeta
+type LeagueMissingItem struct {
+	Player   string `json:"player"`
+	Document string `json:"document"`
+	Reason   string `json:"reason"`
+}
+
+// LeaguePackageManifest resume el contenido de la carpeta generada
+type LeaguePackageManifest struct {
+	Files   int                 `json:"files"`
+	Missing []LeagueMissingItem `json:"missing"`
+}
+
+// SetPackageSources configura de dónde sale el plantel del equipo y los archivos para la carpeta ZIP
+func (s *LeagueExportService) SetPackageSources(roster domain.TeamRosterDirectory, userRepo domain.UserRepository, files *DocumentStorageService) {
+	s.roster = roster
+	s.userRepo = userRepo
+	s.files = files
+}
+
+// SetRosterLayout cambia el formato del CSV de plantel
+func (s *LeagueExportService) SetRosterLayout(layout RosterCSVLayout) {
+	s.layout = layout
+}
+
+// LoadTeam arma el equipo con su plantel vigente, ordenado por nombre, y evalúa a cada jugador.
+// Si scope es nil se usan la disciplina y categoría del equipo.
+func (s *LeagueExportService) LoadTeam(ctx context.Context, clubID string, teamID uuid.UUID, scope *domain.EligibilityScope) (*LeagueTeam, error) {
+	roster, err := s.roster.GetTeamRoster(ctx, clubID, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo el plantel: %w", err)
+	}
+	if roster == nil {
+		return nil, ErrLeagueTeamNotFound
+	}
+
+	users, err := s.userRepo.ListByIDs(ctx, clubID, roster.UserIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo jugadores: %w", err)
+	}
+	sort.SliceStable(users, func(i, j int) bool {
+		return strings.ToLower(users[i].Name) < strings.ToLower(users[j].Name)
+	})
+
+	team := &LeagueTeam{ID: roster.TeamID, Name: roster.Name, Scope: roster.Scope, Members: make([]TeamMember, len(users))}
+	if scope != nil {
+		team.Scope = *scope
+	}
+	for i, u := range users {
+		team.Members[i] = TeamMember{ID: u.ID, Name: u.Name, BirthDate: u.DateOfBirth}
+	}
+	if err := s.EvaluateMembers(ctx, clubID, team.Scope, team.Members); err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+// WriteLeaguePackage escribe la carpeta de liga como ZIP directamente en w, archivo por archivo, sin armarla
+// en memoria: la Lista de Buena Fe (PDF), el plantel en el CSV de la federación, los documentos exigidos
+// de cada jugador tal como se subieron y faltantes.csv con lo que no se pudo incluir.
+func (s *LeagueExportService) WriteLeaguePackage(ctx context.Context, access DocumentAccess, team *LeagueTeam, w io.Writer) (*LeaguePackageManifest, error) {
+	zw := zip.NewWriter(w)
+	manifest := &LeaguePackageManifest{Missing: []LeagueMissingItem{}}
+
+	entry, err := zw.Create("lista_buena_fe.pdf")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.GenerateLeagueFolder(team.Name, team.Members, entry); err != nil {
+		return nil, fmt.Errorf("error generando la lista de buena fe: %w", err)
+	}
+
+	entry, err = zw.Create("plantel.csv")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeRosterCSV(team, entry); err != nil {
+		return nil, fmt.Errorf("error generando el plantel: %w", err)
+	}
+	manifest.Files = 2
+
+	now := time.Now()
+	for i, member := range team.Members {
+		folder := fmt.Sprintf("jugadores/%02d_%s/", i+1, fileSlug(member.Name))
+		for _, r := range member.requirements() {
+			if !r.AppliesTo(member.isMinor(now)) {
+				continue
+			}
+			for _, docType := range r.DocumentTypes() {
+				written, reason := s.addMemberDocument(ctx, zw, access, folder, member, docType, now)
+				if written {
+					manifest.Files++
+				} else {
+					manifest.Missing = append(manifest.Missing, LeagueMissingItem{Player: member.Name, Document: docType.Label(), Reason: reason})
+				}
+			}
+		}
+	}
+
+	entry, err = zw.Create("faltantes.csv")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeMissingCSV(manifest.Missing, entry); err != nil {
+		return nil, err
+	}
+	return manifest, zw.Close()
+}
+
+// addMemberDocument copia al ZIP el documento válido más reciente del tipo; si no puede, devuelve el motivo
+func (s *LeagueExportService) addMemberDocument(ctx context.Context, zw *zip.Writer, access DocumentAccess, folder string, member TeamMember, docType domain.DocumentType, now time.Time) (bool, string) {
+	doc := latestDocument(member.Documents, docType)
+	switch {
+	case doc == nil:
+		return false, "No presentado"
+	case doc.Status == domain.DocumentStatusPending:
+		return false, "Pendiente de validación"
+	case doc.Status == domain.DocumentStatusRejected:
+		return false, "Rechazado"
+	case doc.Status == domain.DocumentStatusExpired || doc.IsExpired():
+		return false, "Vencido"
+	case !doc.CanBeAccessedBy(access.UserID, access.Role):
+		// Los aptos médicos solo los exporta personal médico o SUPER_ADMIN
+		return false, "Restringido (dato de salud)"
+	case s.files == nil:
+		return false, "Archivo no disponible"
+	}
+
+	body, err := s.files.OpenForExport(ctx, access, doc)
+	if err != nil {
+		return false, "Archivo no disponible"
+	}
+	defer body.Close()
+
+	entry, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     folder + string(docType) + documentExtension(doc),
+		Method:   zip.Deflate,
+		Modified: now,
+	})
+	if err != nil {
+		return false, "Archivo no disponible"
+	}
+	if _, err := io.Copy(entry, body); err != nil {
+		return false, "Archivo no disponible"
+	}
+	return true, ""
+}
+
+func (s *LeagueExportService) writeRosterCSV(team *LeagueTeam, w io.Writer) error {
+	layout := s.layout
+	if len(layout.Columns) == 0 {
+		layout = DefaultRosterCSVLayout
+	}
+	writer := csv.NewWriter(w)
+	if layout.Delimiter != 0 {
+		writer.Comma = layout.Delimiter
+	}
+
+	header := make([]string, len(layout.Columns))
+	for i, col := range layout.Columns {
+		header[i] = col.Header
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for i, member := range team.Members {
+		row := make([]string, len(layout.Columns))
+		for j, col := range layout.Columns {
+			row[j] = s.rosterValue(col.Field, i, member)
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func (s *LeagueExportService) rosterValue(field RosterField, index int, member TeamMember) string {
+	switch field {
+	case RosterFieldNumber:
+		return fmt.Sprintf("%d", index+1)
+	case RosterFieldName:
+		return member.Name
+	case RosterFieldBirthDate:
+		if member.BirthDate != nil {
+			return member.BirthDate.Format("02/01/2006")
+		}
+	case RosterFieldCategory:
+		if member.BirthDate != nil {
+			return member.BirthDate.Format("2006")
+		}
+	case RosterFieldMedicalExpiry:
+		if doc := latestDocument(member.Documents, domain.DocumentTypeEMMACMedical); doc != nil && doc.Status == domain.DocumentStatusValid && doc.ExpirationDate != nil {
+			return doc.ExpirationDate.Format("02/01/2006")
+		}
+	case RosterFieldStatus:
+		if issues, _ := s.memberIssues(member); len(issues) > 0 {
+			return "INCOMPLETA"
+		}
+		return "COMPLETA"
+	}
+	return ""
+}
+
+func writeMissingCSV(missing []LeagueMissingItem, w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = DefaultRosterCSVLayout.Delimiter
+	if err := writer.Write([]string{"Jugador", "Documento", "Motivo"}); err != nil {
+		return err
+	}
+	for _, item := range missing {
+		if err := writer.Write([]string{item.Player, item.Document, item.Reason}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// latestDocument devuelve el documento del tipo a incluir: el válido y vigente más reciente, o si no hay,
+// el más reciente (para informar el motivo). Los documentos vienen del más nuevo al más viejo.
+func latestDocument(docs []domain.UserDocument, docType domain.DocumentType) *domain.UserDocument {
+	var latest *domain.UserDocument
+	for i := range docs {
+		if docs[i].Type != docType {
+			continue
+		}
+		if docs[i].Status == domain.DocumentStatusValid && !docs[i].IsExpired() {
+			return &docs[i]
+		}
+		if latest == nil {
+			latest = &docs[i]
+		}
+	}
+	return latest
+}
+
+// documentExtension usa la extensión del formato detectado al subir el archivo
+func documentExtension(doc *domain.UserDocument) string {
+	if ext, ok := allowedDocumentContentTypes[doc.ContentType]; ok {
+		return ext
+	}
+	return strings.ToLower(filepath.Ext(doc.FileName))
+}
+
+var accentReplacer = strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u", "ñ", "n")
+
+// fileSlug convierte un nombre en un nombre de carpeta portable ("Pérez, Juan" -> "perez_juan")
+func fileSlug(name string) string {
+	slug := strings.FieldsFunc(accentReplacer.Replace(strings.ToLower(name)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(slug) == 0 {
+		return "jugador"
+	}
+	return strings.Join(slug, "_")
+}
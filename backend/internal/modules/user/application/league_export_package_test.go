@@ -0,0 +1,128 @@
+package application_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRosterDirectory struct {
+	roster *domain.TeamRoster
+}
+
+func (f fakeRosterDirectory) GetTeamRoster(ctx context.Context, clubID string, teamID uuid.UUID) (*domain.TeamRoster, error) {
+	return f.roster, nil
+}
+
+func TestLeagueExportService_WriteLeaguePackage(t *testing.T) {
+	ctx := context.TODO()
+	clubID := "club-1"
+	teamID := uuid.New()
+	future := time.Now().AddDate(1, 0, 0)
+	past := time.Now().AddDate(0, -1, 0)
+	birth := time.Date(2012, 5, 3, 0, 0, 0, 0, time.UTC)
+
+	files, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	put := func(key string, body string) {
+		require.NoError(t, files.Put(ctx, key, bytes.NewReader([]byte(body)), int64(len(body)), "application/pdf"))
+	}
+	put("club-1/ana/dni-front.pdf", "dni frente ana")
+	put("club-1/ana/dni-back.pdf", "dni dorso ana")
+	put("club-1/ana/apto.pdf", "apto ana")
+	put("club-1/bruno/dni-front.pdf", "dni frente bruno")
+
+	doc := func(userID string, docType domain.DocumentType, status domain.DocumentStatus, expiration *time.Time, key string) domain.UserDocument {
+		return domain.UserDocument{
+			ID: uuid.New(), ClubID: clubID, UserID: userID, Type: docType, Status: status,
+			ExpirationDate: expiration, StorageKey: key, ContentType: "application/pdf",
+		}
+	}
+
+	docRepo := new(MockUserDocumentRepository)
+	docRepo.On("GetByUserID", ctx, clubID, "ana").Return([]domain.UserDocument{
+		doc("ana", domain.DocumentTypeDNIFront, domain.DocumentStatusValid, nil, "club-1/ana/dni-front.pdf"),
+		doc("ana", domain.DocumentTypeDNIBack, domain.DocumentStatusValid, nil, "club-1/ana/dni-back.pdf"),
+		doc("ana", domain.DocumentTypeEMMACMedical, domain.DocumentStatusValid, &future, "club-1/ana/apto.pdf"),
+	}, nil)
+	docRepo.On("GetByUserID", ctx, clubID, "bruno").Return([]domain.UserDocument{
+		doc("bruno", domain.DocumentTypeDNIFront, domain.DocumentStatusValid, nil, "club-1/bruno/dni-front.pdf"),
+		doc("bruno", domain.DocumentTypeEMMACMedical, domain.DocumentStatusValid, &past, "club-1/bruno/apto.pdf"),
+	}, nil)
+
+	userRepo := new(MockUserRepo)
+	userRepo.On("ListByIDs", ctx, clubID, []string{"bruno", "ana"}).Return([]domain.User{
+		{ID: "bruno", Name: "Bruno Díaz", DateOfBirth: &birth},
+		{ID: "ana", Name: "Ana Pérez", DateOfBirth: &birth},
+	}, nil)
+
+	accessLog := new(MockHealthDataAccessLogger)
+	accessLog.On("LogHealthDataAccess", mock.MatchedBy(func(l *domain.HealthDataAccessLog) bool {
+		return l.Action == domain.HealthAccessExport && l.AccessedUserID == "ana"
+	})).Return(nil).Once()
+
+	svc := application.NewLeagueExportService(docRepo, application.NewEligibilityService(docRepo))
+	svc.SetPackageSources(
+		fakeRosterDirectory{roster: &domain.TeamRoster{TeamID: teamID, Name: "Sub 14", UserIDs: []string{"bruno", "ana"}}},
+		userRepo,
+		application.NewDocumentStorageService(docRepo, files, nil, storage.NewURLSigner("secret", 0), accessLog),
+	)
+
+	team, err := svc.LoadTeam(ctx, clubID, teamID, nil)
+	require.NoError(t, err)
+	require.Len(t, team.Members, 2)
+	assert.Equal(t, "Ana Pérez", team.Members[0].Name, "players are sorted by name")
+
+	var out bytes.Buffer
+	manifest, err := svc.WriteLeaguePackage(ctx, application.DocumentAccess{ClubID: clubID, UserID: "medic-1", Role: domain.RoleMedicalStaff}, team, &out)
+	require.NoError(t, err)
+	assert.Equal(t, 6, manifest.Files)
+	assert.ElementsMatch(t, []application.LeagueMissingItem{
+		{Player: "Bruno Díaz", Document: domain.DocumentTypeDNIBack.Label(), Reason: "No presentado"},
+		{Player: "Bruno Díaz", Document: domain.DocumentTypeEMMACMedical.Label(), Reason: "Vencido"},
+	}, manifest.Missing)
+
+	archive, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	require.NoError(t, err)
+	entries := map[string]string{}
+	for _, f := range archive.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		content, _ := io.ReadAll(r)
+		r.Close()
+		entries[f.Name] = string(content)
+	}
+
+	assert.Contains(t, entries, "lista_buena_fe.pdf")
+	assert.Equal(t, "apto ana", entries["jugadores/01_ana_perez/EMMAC_MEDICAL.pdf"])
+	assert.Equal(t, "dni dorso ana", entries["jugadores/01_ana_perez/DNI_BACK.pdf"])
+	assert.Equal(t, "dni frente bruno", entries["jugadores/02_bruno_diaz/DNI_FRONT.pdf"])
+	assert.NotContains(t, entries, "jugadores/02_bruno_diaz/EMMAC_MEDICAL.pdf")
+
+	assert.Equal(t, "Nro;Apellido y Nombre;Fecha de Nacimiento;Categoría;Vto. Apto Médico;Estado Documentación\n"+
+		"1;Ana Pérez;03/05/2012;2012;"+future.Format("02/01/2006")+";COMPLETA\n"+
+		"2;Bruno Díaz;03/05/2012;2012;"+past.Format("02/01/2006")+";INCOMPLETA\n", entries["plantel.csv"])
+	assert.Contains(t, entries["faltantes.csv"], "Bruno Díaz;Apto Médico (EMMAC);Vencido")
+	accessLog.AssertExpectations(t)
+
+	// Un administrador no ve datos de salud: el apto queda fuera y figura como restringido
+	out.Reset()
+	manifest, err = svc.WriteLeaguePackage(ctx, application.DocumentAccess{ClubID: clubID, UserID: "admin-1", Role: domain.RoleAdmin}, team, &out)
+	require.NoError(t, err)
+	assert.Equal(t, 5, manifest.Files)
+	assert.Contains(t, manifest.Missing, application.LeagueMissingItem{
+		Player: "Ana Pérez", Document: domain.DocumentTypeEMMACMedical.Label(), Reason: "Restringido (dato de salud)",
+	})
+	accessLog.AssertNumberOfCalls(t, "LogHealthDataAccess", 1)
+}
@@ -12,25 +12,80 @@ import (
 
 // TeamMember representa un miembro del equipo para la exportación
 type TeamMember struct {
-	ID        string
-	Name      string
-	DNI       string
-	BirthDate *time.Time
-	Documents []domain.UserDocument
+	ID          string
+	Name        string
+	BirthDate   *time.Time
+	Documents   []domain.UserDocument
+	Eligibility *EligibilityResult // Requisitos de la liga evaluados (ver EvaluateMembers)
 }
 
-// LeagueExportService maneja la generación de PDFs para la Liga
+// LeagueExportService maneja la generación de la carpeta para la Liga (PDF y paquete ZIP)
 type LeagueExportService struct {
-	docRepo domain.UserDocumentRepository
+	docRepo     domain.UserDocumentRepository
+	eligibility *EligibilityService
+	roster      domain.TeamRosterDirectory
+	userRepo    domain.UserRepository
+	files       *DocumentStorageService
+	layout      RosterCSVLayout
 }
 
 // NewLeagueExportService crea una nueva instancia del servicio
-func NewLeagueExportService(docRepo domain.UserDocumentRepository) *LeagueExportService {
+func NewLeagueExportService(docRepo domain.UserDocumentRepository, eligibility *EligibilityService) *LeagueExportService {
 	return &LeagueExportService{
-		docRepo: docRepo,
+		docRepo:     docRepo,
+		eligibility: eligibility,
 	}
 }
 
+// EvaluateMembers evalúa a cada miembro con la regla de elegibilidad de la disciplina y categoría,
+// la misma que usan el chequeo de elegibilidad y el semáforo del jugador
+func (s *LeagueExportService) EvaluateMembers(ctx context.Context, clubID string, scope domain.EligibilityScope, members []TeamMember) error {
+	rule, err := s.eligibility.ResolveRule(ctx, clubID, scope)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for i := range members {
+		if members[i].Documents == nil {
+			docs, err := s.GetMemberDocuments(ctx, clubID, members[i].ID)
+			if err != nil {
+				return err
+			}
+			members[i].Documents = docs
+		}
+		members[i].Eligibility = s.eligibility.Evaluate(rule, members[i].Documents, members[i].isMinor(now))
+	}
+	return nil
+}
+
+// memberIssues devuelve los requisitos incumplidos y cuántos requisitos se le exigen al miembro.
+// Sin evaluación previa se usan los requisitos por defecto.
+func (s *LeagueExportService) memberIssues(member TeamMember) ([]domain.EligibilityIssue, int) {
+	requirements := member.requirements()
+	isMinor := member.isMinor(time.Now())
+	required := 0
+	for _, r := range requirements {
+		if r.AppliesTo(isMinor) {
+			required++
+		}
+	}
+	if member.Eligibility != nil {
+		return member.Eligibility.IssueDetails, required
+	}
+	return domain.EvaluateEligibility(requirements, member.Documents, isMinor), required
+}
+
+func (m TeamMember) requirements() []domain.EligibilityRequirement {
+	if m.Eligibility != nil {
+		return m.Eligibility.Requirements
+	}
+	return domain.DefaultEligibilityRequirements
+}
+
+func (m TeamMember) isMinor(at time.Time) bool {
+	return m.BirthDate != nil && m.BirthDate.AddDate(domain.AdultAge, 0, 0).After(at)
+}
+
 // GenerateLeagueFolder genera un PDF con la "Carpeta de Liga"
 // Incluye:
 // - Página 1: Lista de Buena Fe (tabla resumen)
@@ -76,8 +131,7 @@ func (s *LeagueExportService) addSummaryPage(pdf *gofpdf.Fpdf, teamName string,
 
 	// Headers
 	pdf.CellFormat(10, 8, "N°", "1", 0, "C", true, 0, "")
-	pdf.CellFormat(60, 8, "Apellido y Nombre", "1", 0, "C", true, 0, "")
-	pdf.CellFormat(30, 8, "DNI", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(90, 8, "Apellido y Nombre", "1", 0, "C", true, 0, "")
 	pdf.CellFormat(30, 8, "Fecha Nac.", "1", 0, "C", true, 0, "")
 	pdf.CellFormat(40, 8, "Estado Doc.", "1", 1, "C", true, 0, "")
 
@@ -89,8 +143,7 @@ func (s *LeagueExportService) addSummaryPage(pdf *gofpdf.Fpdf, teamName string,
 		statusColor := s.getStatusColor(status)
 
 		pdf.CellFormat(10, 7, fmt.Sprintf("%d", i+1), "1", 0, "C", false, 0, "")
-		pdf.CellFormat(60, 7, member.Name, "1", 0, "L", false, 0, "")
-		pdf.CellFormat(30, 7, member.DNI, "1", 0, "C", false, 0, "")
+		pdf.CellFormat(90, 7, member.Name, "1", 0, "L", false, 0, "")
 
 		birthDate := "-"
 		if member.BirthDate != nil {
@@ -118,55 +171,34 @@ func (s *LeagueExportService) addPlayerDocumentsPage(pdf *gofpdf.Fpdf, member Te
 	pdf.CellFormat(0, 10, member.Name, "", 1, "C", false, 0, "")
 	pdf.Ln(5)
 
-	// DNI
-	pdf.SetFont("Arial", "", 10)
-	pdf.CellFormat(0, 6, fmt.Sprintf("DNI: %s", member.DNI), "", 1, "L", false, 0, "")
-	pdf.Ln(5)
-
-	// Buscar documentos
-	hasDNI := false
-	hasEMMAC := false
+	// Documentos válidos de los tipos exigidos
+	issues, _ := s.memberIssues(member)
+	required := map[domain.DocumentType]bool{}
+	for _, r := range member.requirements() {
+		for _, t := range r.DocumentTypes() {
+			required[t] = true
+		}
+	}
 
+	printed := map[domain.DocumentType]bool{}
 	for _, doc := range member.Documents {
-		if doc.Status != domain.DocumentStatusValid {
+		if doc.Status != domain.DocumentStatusValid || !required[doc.Type] || printed[doc.Type] {
 			continue
 		}
+		printed[doc.Type] = true
 
-		switch doc.Type {
-		case domain.DocumentTypeDNIFront, domain.DocumentTypeDNIBack:
-			if !hasDNI {
-				pdf.SetFont("Arial", "B", 11)
-				pdf.CellFormat(0, 7, "DNI:", "", 1, "L", false, 0, "")
-				pdf.SetFont("Arial", "", 9)
-				pdf.CellFormat(0, 6, fmt.Sprintf("Archivo: %s", doc.FileURL), "", 1, "L", false, 0, "")
-				pdf.Ln(3)
-				hasDNI = true
-
-				// TODO: Insertar imagen del DNI
-				// pdf.Image(doc.FileURL, 15, pdf.GetY(), 90, 0, false, "", 0, "")
-			}
-
-		case domain.DocumentTypeEMMACMedical:
-			if !hasEMMAC {
-				pdf.SetFont("Arial", "B", 11)
-				pdf.CellFormat(0, 7, "Apto Médico (EMMAC):", "", 1, "L", false, 0, "")
-				pdf.SetFont("Arial", "", 9)
-				pdf.CellFormat(0, 6, fmt.Sprintf("Archivo: %s", doc.FileURL), "", 1, "L", false, 0, "")
-
-				if doc.ExpirationDate != nil {
-					pdf.CellFormat(0, 6, fmt.Sprintf("Vencimiento: %s", doc.ExpirationDate.Format("02/01/2006")), "", 1, "L", false, 0, "")
-				}
-				pdf.Ln(3)
-				hasEMMAC = true
-
-				// TODO: Insertar imagen del apto médico
-				// pdf.Image(doc.FileURL, 15, pdf.GetY(), 90, 0, false, "", 0, "")
-			}
+		pdf.SetFont("Arial", "B", 11)
+		pdf.CellFormat(0, 7, doc.Type.Label()+":", "", 1, "L", false, 0, "")
+		pdf.SetFont("Arial", "", 9)
+		pdf.CellFormat(0, 6, fmt.Sprintf("Archivo: %s", doc.FileURL), "", 1, "L", false, 0, "")
+		if doc.ExpirationDate != nil {
+			pdf.CellFormat(0, 6, fmt.Sprintf("Vencimiento: %s", doc.ExpirationDate.Format("02/01/2006")), "", 1, "L", false, 0, "")
 		}
+		pdf.Ln(3)
 	}
 
-	// Advertencias si faltan documentos
-	if !hasDNI || !hasEMMAC {
+	// Advertencias por requisitos incumplidos
+	if len(issues) > 0 {
 		pdf.Ln(5)
 		pdf.SetFont("Arial", "B", 10)
 		pdf.SetTextColor(255, 0, 0)
@@ -174,37 +206,19 @@ func (s *LeagueExportService) addPlayerDocumentsPage(pdf *gofpdf.Fpdf, member Te
 		pdf.SetTextColor(0, 0, 0)
 		pdf.SetFont("Arial", "", 9)
 
-		if !hasDNI {
-			pdf.CellFormat(0, 6, "- Falta DNI", "", 1, "L", false, 0, "")
-		}
-		if !hasEMMAC {
-			pdf.CellFormat(0, 6, "- Falta Apto Médico", "", 1, "L", false, 0, "")
+		for _, issue := range issues {
+			pdf.CellFormat(0, 6, "- "+issue.Message, "", 1, "L", false, 0, "")
 		}
 	}
 }
 
-// getDocumentStatus determina el estado documental de un jugador
+// getDocumentStatus determina el estado documental de un jugador según los requisitos de la liga
 func (s *LeagueExportService) getDocumentStatus(member TeamMember) string {
-	hasDNI := false
-	hasEMMAC := false
-
-	for _, doc := range member.Documents {
-		if doc.Status != domain.DocumentStatusValid {
-			continue
-		}
-
-		if doc.Type == domain.DocumentTypeDNIFront || doc.Type == domain.DocumentTypeDNIBack {
-			hasDNI = true
-		}
-
-		if doc.Type == domain.DocumentTypeEMMACMedical && !doc.IsExpired() {
-			hasEMMAC = true
-		}
-	}
+	issues, required := s.memberIssues(member)
 
-	if hasDNI && hasEMMAC {
+	if len(issues) == 0 {
 		return "✓ Completo"
-	} else if hasDNI || hasEMMAC {
+	} else if len(issues) < required {
 		return "⚠ Incompleto"
 	}
 	return "✗ Sin Docs"
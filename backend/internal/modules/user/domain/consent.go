@@ -1,6 +1,11 @@
 package domain
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,6 +21,107 @@ const (
 	ConsentTypeHealthData ConsentType = "HEALTH_DATA"
 )
 
+// IsValid reports whether the consent type is one of the known types
+func (t ConsentType) IsValid() bool {
+	switch t {
+	case ConsentTypeTerms, ConsentTypePrivacy, ConsentTypeMarketing, ConsentTypeHealthData:
+		return true
+	}
+	return false
+}
+
+var (
+	ErrInvalidConsentPolicy    = errors.New("la política necesita tipo, versión y texto o URL")
+	ErrMarketingNotMandatory   = errors.New("el consentimiento de marketing no puede ser obligatorio")
+	ErrConsentPolicyExists     = errors.New("ya existe esa versión de la política")
+	ErrConsentPolicyNotFound   = errors.New("política no encontrada")
+	ErrConsentPolicyNotCurrent = errors.New("solo se puede aceptar la versión vigente de la política")
+	ErrConsentNotFound         = errors.New("no hay un consentimiento activo de ese tipo")
+	ErrMandatoryConsent        = errors.New("los consentimientos obligatorios no se revocan; para dejar de usar el servicio solicitá la supresión de tus datos")
+	ErrParentConsentRequired   = errors.New("el consentimiento de un menor lo tiene que dar su padre/madre")
+)
+
+// ConsentPolicy is a published version of a legal text the user consents to.
+// A policy becomes current at PublishedAt; publishing a new mandatory version forces
+// every user to accept it again before using the API (see ConsentService.PendingConsents).
+type ConsentPolicy struct {
+	ID          uuid.UUID   `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID      string      `json:"club_id" gorm:"not null;uniqueIndex:idx_consent_policy_version"`
+	ConsentType ConsentType `json:"consent_type" gorm:"not null;uniqueIndex:idx_consent_policy_version"`
+	Version     string      `json:"version" gorm:"not null;uniqueIndex:idx_consent_policy_version"`
+	Title       string      `json:"title"`
+	Content     string      `json:"content,omitempty" gorm:"type:text"`
+	URL         string      `json:"url,omitempty"`
+	ContentHash string      `json:"content_hash"` // SHA-256 of Content (or URL), proves which text was accepted
+	Mandatory   bool        `json:"mandatory" gorm:"not null;default:false"`
+	PublishedAt time.Time   `json:"published_at" gorm:"not null"`
+	CreatedBy   string      `json:"created_by,omitempty"`
+	CreatedAt   time.Time   `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (ConsentPolicy) TableName() string {
+	return "consent_policies"
+}
+
+// NewConsentPolicy validates and builds a policy version. Marketing consent must be
+// freely given (GDPR Art. 7.4), so it can never be a condition to use the service.
+func NewConsentPolicy(clubID string, consentType ConsentType, version, title, content, url string, mandatory bool, publishedAt time.Time, createdBy string) (*ConsentPolicy, error) {
+	version = strings.TrimSpace(version)
+	content = strings.TrimSpace(content)
+	url = strings.TrimSpace(url)
+	if !consentType.IsValid() || version == "" || (content == "" && url == "") {
+		return nil, ErrInvalidConsentPolicy
+	}
+	if mandatory && consentType == ConsentTypeMarketing {
+		return nil, ErrMarketingNotMandatory
+	}
+	hashed := content
+	if hashed == "" {
+		hashed = url
+	}
+	sum := sha256.Sum256([]byte(hashed))
+	return &ConsentPolicy{
+		ID:          uuid.New(),
+		ClubID:      clubID,
+		ConsentType: consentType,
+		Version:     version,
+		Title:       strings.TrimSpace(title),
+		Content:     content,
+		URL:         url,
+		ContentHash: hex.EncodeToString(sum[:]),
+		Mandatory:   mandatory,
+		PublishedAt: publishedAt,
+		CreatedBy:   createdBy,
+	}, nil
+}
+
+// IsPublished reports whether the policy is already in force at the given time
+func (p *ConsentPolicy) IsPublished(at time.Time) bool {
+	return !p.PublishedAt.After(at)
+}
+
+// CurrentPolicies returns, per consent type, the latest version published at the given time
+func CurrentPolicies(policies []ConsentPolicy, at time.Time) []ConsentPolicy {
+	latest := map[ConsentType]int{}
+	for i := range policies {
+		p := &policies[i]
+		if !p.IsPublished(at) {
+			continue
+		}
+		if j, ok := latest[p.ConsentType]; !ok || p.PublishedAt.After(policies[j].PublishedAt) {
+			latest[p.ConsentType] = i
+		}
+	}
+	current := make([]ConsentPolicy, 0, len(latest))
+	for _, t := range []ConsentType{ConsentTypeTerms, ConsentTypePrivacy, ConsentTypeHealthData, ConsentTypeMarketing} {
+		if i, ok := latest[t]; ok {
+			current = append(current, policies[i])
+		}
+	}
+	return current
+}
+
 // ConsentRecord represents a user's consent for data processing
 // Required by GDPR Article 7 - Conditions for consent
 type ConsentRecord struct {
@@ -29,6 +135,7 @@ type ConsentRecord struct {
 	IPAddress    string      `json:"ip_address,omitempty"`
 	UserAgent    string      `json:"user_agent,omitempty"`
 	ParentUserID *string     `json:"parent_user_id,omitempty"` // For minors
+	PolicyID     *uuid.UUID  `json:"policy_id,omitempty" gorm:"type:uuid"`
 	RevokedAt    *time.Time  `json:"revoked_at,omitempty"`
 	CreatedAt    time.Time   `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt    time.Time   `json:"updated_at" gorm:"autoUpdateTime"`
@@ -44,6 +151,26 @@ func (c *ConsentRecord) IsActive() bool {
 	return c.Accepted && c.RevokedAt == nil
 }
 
+// Covers reports whether this consent is an active acceptance of the given policy version
+func (c *ConsentRecord) Covers(policy *ConsentPolicy) bool {
+	if !c.IsActive() || c.ConsentType != policy.ConsentType {
+		return false
+	}
+	if c.PolicyID != nil {
+		return *c.PolicyID == policy.ID
+	}
+	return c.Version == policy.Version
+}
+
+// Revoke withdraws the consent (GDPR Art. 7.3); the record is kept as evidence
+func (c *ConsentRecord) Revoke(at time.Time) error {
+	if !c.IsActive() {
+		return ErrConsentNotFound
+	}
+	c.RevokedAt = &at
+	return nil
+}
+
 // HealthDataAccessLog tracks access to special category data (GDPR Article 9)
 type HealthDataAccessLog struct {
 	ID                uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
@@ -63,6 +190,15 @@ func (HealthDataAccessLog) TableName() string {
 	return "health_data_access_log"
 }
 
+// Health data access actions
+const (
+	HealthAccessView     = "VIEW"
+	HealthAccessDownload = "DOWNLOAD"
+	HealthAccessValidate = "VALIDATE"
+	HealthAccessDelete   = "DELETE"
+	HealthAccessExport   = "EXPORT" // Included in a league registration package
+)
+
 // GDPRErasureRequest tracks right to erasure requests (GDPR Article 17)
 type GDPRErasureRequest struct {
 	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
@@ -130,11 +266,14 @@ type FamilyGroupExport struct {
 
 // ConsentRepository defines the operations for consent management
 type ConsentRepository interface {
-	Create(record *ConsentRecord) error
-	GetByUserID(clubID, userID string) ([]ConsentRecord, error)
-	GetActiveByType(clubID, userID string, consentType ConsentType) (*ConsentRecord, error)
-	Revoke(clubID, userID string, consentType ConsentType) error
-	LogHealthDataAccess(log *HealthDataAccessLog) error
-	CreateErasureRequest(req *GDPRErasureRequest) error
-	UpdateErasureRequest(req *GDPRErasureRequest) error
+	CreatePolicy(ctx context.Context, policy *ConsentPolicy) error
+	// GetPolicy returns nil if the policy does not exist
+	GetPolicy(ctx context.Context, clubID string, id uuid.UUID) (*ConsentPolicy, error)
+	ListPolicies(ctx context.Context, clubID string) ([]ConsentPolicy, error)
+
+	Create(ctx context.Context, record *ConsentRecord) error
+	Update(ctx context.Context, record *ConsentRecord) error
+	GetByUserID(ctx context.Context, clubID, userID string) ([]ConsentRecord, error)
+	// ListConsentingEmails returns the emails of the club members with an active consent of the given type
+	ListConsentingEmails(ctx context.Context, clubID string, consentType ConsentType) ([]string, error)
 }
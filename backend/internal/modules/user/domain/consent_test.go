@@ -43,3 +43,49 @@ func TestConsentRecord_IsActive(t *testing.T) {
 		assert.False(t, consent.IsActive())
 	})
 }
+
+func TestConsentPolicy(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Validation", func(t *testing.T) {
+		_, err := domain.NewConsentPolicy("club-1", "NEWSLETTER", "2026-10", "", "Texto", "", false, now, "admin-1")
+		assert.ErrorIs(t, err, domain.ErrInvalidConsentPolicy)
+		_, err = domain.NewConsentPolicy("club-1", domain.ConsentTypePrivacy, "2026-10", "", " ", "", true, now, "admin-1")
+		assert.ErrorIs(t, err, domain.ErrInvalidConsentPolicy)
+		_, err = domain.NewConsentPolicy("club-1", domain.ConsentTypeMarketing, "2026-10", "", "Texto", "", true, now, "admin-1")
+		assert.ErrorIs(t, err, domain.ErrMarketingNotMandatory)
+
+		policy, err := domain.NewConsentPolicy("club-1", domain.ConsentTypePrivacy, "2026-10", "Privacidad", "Texto", "", true, now, "admin-1")
+		assert.NoError(t, err)
+		assert.Len(t, policy.ContentHash, 64)
+	})
+
+	t.Run("Current Policies", func(t *testing.T) {
+		old, _ := domain.NewConsentPolicy("club-1", domain.ConsentTypePrivacy, "2026-01", "", "v1", "", true, now.AddDate(0, -9, 0), "")
+		current, _ := domain.NewConsentPolicy("club-1", domain.ConsentTypePrivacy, "2026-10", "", "v2", "", true, now.AddDate(0, 0, -1), "")
+		scheduled, _ := domain.NewConsentPolicy("club-1", domain.ConsentTypePrivacy, "2027-01", "", "v3", "", true, now.AddDate(0, 2, 0), "")
+		terms, _ := domain.NewConsentPolicy("club-1", domain.ConsentTypeTerms, "2026-01", "", "t1", "", true, now.AddDate(0, -9, 0), "")
+
+		result := domain.CurrentPolicies([]domain.ConsentPolicy{*current, *scheduled, *old, *terms}, now)
+		assert.Len(t, result, 2)
+		assert.Equal(t, terms.ID, result[0].ID)
+		assert.Equal(t, current.ID, result[1].ID)
+	})
+
+	t.Run("Covers", func(t *testing.T) {
+		policy, _ := domain.NewConsentPolicy("club-1", domain.ConsentTypePrivacy, "2026-10", "", "v2", "", true, now, "")
+		record := &domain.ConsentRecord{ConsentType: domain.ConsentTypePrivacy, Version: "2026-10", Accepted: true}
+		assert.True(t, record.Covers(policy)) // Registros previos al registro de políticas: por versión
+
+		other := policy.ID
+		other[0] ^= 0xff
+		record.PolicyID = &other
+		assert.False(t, record.Covers(policy))
+
+		record.PolicyID = &policy.ID
+		assert.True(t, record.Covers(policy))
+		assert.NoError(t, record.Revoke(now))
+		assert.False(t, record.Covers(policy))
+		assert.ErrorIs(t, record.Revoke(now), domain.ErrConsentNotFound)
+	})
+}
@@ -0,0 +1,215 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RetentionCategory es un tipo de dato personal con plazo de conservación propio
+type RetentionCategory string
+
+const (
+	RetentionUserAccounts     RetentionCategory = "USER_ACCOUNTS"      // Cuentas dadas de baja (plazo desde la baja o data_retention_until vencida)
+	RetentionAccessLogs       RetentionCategory = "ACCESS_LOGS"        // Ingresos al club (molinetes, QR)
+	RetentionAuthLogs         RetentionCategory = "AUTH_LOGS"          // Inicios y cierres de sesión
+	RetentionHealthAccessLogs RetentionCategory = "HEALTH_ACCESS_LOGS" // Accesos a datos de salud (GDPR Art. 9)
+)
+
+// RetentionAction es lo que se hace con los datos vencidos
+type RetentionAction string
+
+const (
+	RetentionAnonymize RetentionAction = "ANONYMIZE" // Se borran los datos personales y se conservan los registros financieros
+	RetentionPurge     RetentionAction = "PURGE"     // Se borran los registros
+)
+
+// RetentionCategoryInfo describe una categoría: qué se hace al vencer, el plazo por defecto, el mínimo
+// que ningún club puede bajar y si se aplica sin que el club la haya configurado
+type RetentionCategoryInfo struct {
+	Category       RetentionCategory `json:"category"`
+	Action         RetentionAction   `json:"action"`
+	DefaultDays    int               `json:"default_days"`
+	MinimumDays    int               `json:"minimum_days"`
+	DefaultEnabled bool              `json:"default_enabled"`
+	Description    string            `json:"description"`
+}
+
+// RetentionCategories son las categorías que aplica el job de retención. El mínimo de los accesos a
+// datos de salud coincide con el de RetainedPersonalDataSections (responsabilidad proactiva, Art. 5.2).
+// La anonimización de cuentas es irreversible: no corre hasta que el club la habilita.
+var RetentionCategories = []RetentionCategoryInfo{
+	{RetentionUserAccounts, RetentionAnonymize, 90, 30, false, "Cuentas dadas de baja: se anonimizan al cumplirse el plazo desde la baja, o en la fecha data_retention_until del socio dado de baja"},
+	{RetentionAccessLogs, RetentionPurge, 365, 30, true, "Registros de ingreso al club"},
+	{RetentionAuthLogs, RetentionPurge, 180, 90, true, "Registros de inicio de sesión de los socios del club"},
+	{RetentionHealthAccessLogs, RetentionPurge, 5 * 365, 5 * 365, true, "Registro de accesos a documentos de salud"},
+}
+
+// RetentionCategoryByName devuelve la descripción de una categoría
+func RetentionCategoryByName(category RetentionCategory) (RetentionCategoryInfo, bool) {
+	for _, info := range RetentionCategories {
+		if info.Category == category {
+			return info, true
+		}
+	}
+	return RetentionCategoryInfo{}, false
+}
+
+var (
+	ErrInvalidRetentionCategory = errors.New("categoría de retención inválida")
+	ErrRetentionBelowMinimum    = errors.New("el plazo es menor al mínimo legal de la categoría")
+	ErrDataRetentionRunNotFound = errors.New("ejecución de retención no encontrada")
+)
+
+// DataRetentionPolicy es el plazo de conservación que un club configuró para una categoría.
+// Las categorías sin configurar usan el plazo por defecto.
+type DataRetentionPolicy struct {
+	ID            uuid.UUID         `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID        string            `json:"club_id" gorm:"not null;uniqueIndex:idx_data_retention_policy_category"`
+	Category      RetentionCategory `json:"category" gorm:"not null;uniqueIndex:idx_data_retention_policy_category"`
+	RetentionDays int               `json:"retention_days" gorm:"not null"`
+	Enabled       bool              `json:"enabled" gorm:"not null;default:true"`
+	UpdatedBy     string            `json:"updated_by,omitempty"`
+	CreatedAt     time.Time         `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time         `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (DataRetentionPolicy) TableName() string {
+	return "data_retention_policies"
+}
+
+// NewDataRetentionPolicy valida el plazo contra el mínimo de la categoría
+func NewDataRetentionPolicy(clubID string, category RetentionCategory, days int, enabled bool, updatedBy string) (*DataRetentionPolicy, error) {
+	info, ok := RetentionCategoryByName(category)
+	if !ok {
+		return nil, ErrInvalidRetentionCategory
+	}
+	if days < info.MinimumDays {
+		return nil, ErrRetentionBelowMinimum
+	}
+	return &DataRetentionPolicy{
+		ID:            uuid.New(),
+		ClubID:        clubID,
+		Category:      category,
+		RetentionDays: days,
+		Enabled:       enabled,
+		UpdatedBy:     updatedBy,
+	}, nil
+}
+
+// Cutoff es el instante antes del cual los datos de la categoría están vencidos
+func (p *DataRetentionPolicy) Cutoff(now time.Time) time.Time {
+	return now.AddDate(0, 0, -p.RetentionDays)
+}
+
+// EffectiveRetentionPolicies completa la configuración del club con los plazos por defecto (habilitadas
+// según DefaultEnabled), en el orden de RetentionCategories
+func EffectiveRetentionPolicies(clubID string, configured []DataRetentionPolicy) []DataRetentionPolicy {
+	byCategory := map[RetentionCategory]DataRetentionPolicy{}
+	for _, p := range configured {
+		byCategory[p.Category] = p
+	}
+	effective := make([]DataRetentionPolicy, 0, len(RetentionCategories))
+	for _, info := range RetentionCategories {
+		if p, ok := byCategory[info.Category]; ok {
+			effective = append(effective, p)
+			continue
+		}
+		effective = append(effective, DataRetentionPolicy{ClubID: clubID, Category: info.Category, RetentionDays: info.DefaultDays, Enabled: info.DefaultEnabled})
+	}
+	return effective
+}
+
+// DataRetentionRunStatus es el estado de una ejecución del job de retención
+type DataRetentionRunStatus string
+
+const (
+	DataRetentionRunning   DataRetentionRunStatus = "RUNNING"
+	DataRetentionCompleted DataRetentionRunStatus = "COMPLETED"
+	DataRetentionFailed    DataRetentionRunStatus = "FAILED" // Alguna categoría falló; el resto se aplicó
+)
+
+// DataRetentionItem es el resultado de una categoría en una ejecución
+type DataRetentionItem struct {
+	Category      RetentionCategory `json:"category"`
+	Action        RetentionAction   `json:"action"`
+	RetentionDays int               `json:"retention_days"`
+	Cutoff        time.Time         `json:"cutoff"`
+	Count         int64             `json:"count"`                 // Registros vencidos (dry run) o eliminados/anonimizados
+	SubjectIDs    []string          `json:"subject_ids,omitempty"` // Cuentas anonimizadas (o a anonimizar)
+	Error         string            `json:"error,omitempty"`
+}
+
+// DataRetentionRun es el registro de auditoría de una ejecución: qué se eliminó o anonimizó, con qué plazo
+// y quién la disparó. Un dry run guarda el mismo reporte sin modificar datos.
+type DataRetentionRun struct {
+	ID          uuid.UUID              `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID      string                 `json:"club_id" gorm:"index;not null"`
+	DryRun      bool                   `json:"dry_run" gorm:"not null"`
+	TriggeredBy string                 `json:"triggered_by"`
+	Status      DataRetentionRunStatus `json:"status" gorm:"not null"`
+	Items       []DataRetentionItem    `json:"items" gorm:"serializer:json"`
+	StartedAt   time.Time              `json:"started_at" gorm:"not null"`
+	FinishedAt  *time.Time             `json:"finished_at,omitempty"`
+	CreatedAt   time.Time              `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (DataRetentionRun) TableName() string {
+	return "data_retention_runs"
+}
+
+// NewDataRetentionRun inicia una ejecución
+func NewDataRetentionRun(clubID string, dryRun bool, triggeredBy string, now time.Time) *DataRetentionRun {
+	return &DataRetentionRun{
+		ID:          uuid.New(),
+		ClubID:      clubID,
+		DryRun:      dryRun,
+		TriggeredBy: triggeredBy,
+		Status:      DataRetentionRunning,
+		Items:       []DataRetentionItem{},
+		StartedAt:   now,
+	}
+}
+
+// Finish cierra la ejecución; queda FAILED si alguna categoría falló
+func (r *DataRetentionRun) Finish(now time.Time) {
+	r.Status = DataRetentionCompleted
+	for _, item := range r.Items {
+		if item.Error != "" {
+			r.Status = DataRetentionFailed
+		}
+	}
+	r.FinishedAt = &now
+}
+
+// TotalAffected es la cantidad de registros y cuentas alcanzados por la ejecución
+func (r *DataRetentionRun) TotalAffected() int64 {
+	var total int64
+	for _, item := range r.Items {
+		total += item.Count
+	}
+	return total
+}
+
+// DataRetentionRepository persiste los plazos configurados y las ejecuciones
+type DataRetentionRepository interface {
+	ListPolicies(ctx context.Context, clubID string) ([]DataRetentionPolicy, error)
+	// SavePolicy crea o reemplaza el plazo del club para la categoría
+	SavePolicy(ctx context.Context, policy *DataRetentionPolicy) error
+	CreateRun(ctx context.Context, run *DataRetentionRun) error
+	UpdateRun(ctx context.Context, run *DataRetentionRun) error
+	// GetRun devuelve nil si no existe
+	GetRun(ctx context.Context, clubID string, id uuid.UUID) (*DataRetentionRun, error)
+	ListRuns(ctx context.Context, clubID string, limit int) ([]DataRetentionRun, error)
+}
+
+// ExpiredDataRepository busca y borra los datos vencidos de cada categoría
+type ExpiredDataRepository interface {
+	// ExpiredUserIDs devuelve las cuentas sin anonimizar dadas de baja antes de deletedBefore,
+	// o dadas de baja y con data_retention_until anterior a now
+	ExpiredUserIDs(ctx context.Context, clubID string, deletedBefore, now time.Time) ([]string, error)
+	CountExpiredLogs(ctx context.Context, clubID string, category RetentionCategory, before time.Time) (int64, error)
+	PurgeExpiredLogs(ctx context.Context, clubID string, category RetentionCategory, before time.Time) (int64, error)
+}
@@ -0,0 +1,56 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataRetentionPolicy(t *testing.T) {
+	now := time.Date(2026, 10, 19, 3, 30, 0, 0, time.UTC)
+
+	t.Run("No se puede bajar del mínimo legal de la categoría", func(t *testing.T) {
+		_, err := domain.NewDataRetentionPolicy("club-1", domain.RetentionHealthAccessLogs, 365, true, "admin-1")
+		assert.ErrorIs(t, err, domain.ErrRetentionBelowMinimum)
+		_, err = domain.NewDataRetentionPolicy("club-1", "PHOTOS", 365, true, "admin-1")
+		assert.ErrorIs(t, err, domain.ErrInvalidRetentionCategory)
+
+		policy, err := domain.NewDataRetentionPolicy("club-1", domain.RetentionAccessLogs, 30, true, "admin-1")
+		require.NoError(t, err)
+		assert.Equal(t, now.AddDate(0, 0, -30), policy.Cutoff(now))
+	})
+
+	t.Run("Las categorías sin configurar usan el plazo por defecto", func(t *testing.T) {
+		configured, err := domain.NewDataRetentionPolicy("club-1", domain.RetentionAuthLogs, 400, false, "admin-1")
+		require.NoError(t, err)
+
+		effective := domain.EffectiveRetentionPolicies("club-1", []domain.DataRetentionPolicy{*configured})
+		require.Len(t, effective, len(domain.RetentionCategories))
+		for _, p := range effective {
+			info, ok := domain.RetentionCategoryByName(p.Category)
+			require.True(t, ok)
+			if p.Category == domain.RetentionAuthLogs {
+				assert.Equal(t, 400, p.RetentionDays)
+				assert.False(t, p.Enabled)
+				continue
+			}
+			assert.Equal(t, info.DefaultDays, p.RetentionDays)
+			assert.Equal(t, info.DefaultEnabled, p.Enabled)
+			assert.Equal(t, p.Category != domain.RetentionUserAccounts, p.Enabled, "la anonimización de cuentas se habilita a mano")
+		}
+	})
+
+	t.Run("La ejecución falla si alguna categoría falló", func(t *testing.T) {
+		run := domain.NewDataRetentionRun("club-1", false, domain.RoleSystem, now)
+		run.Items = append(run.Items,
+			domain.DataRetentionItem{Category: domain.RetentionAccessLogs, Count: 120},
+			domain.DataRetentionItem{Category: domain.RetentionUserAccounts, Count: 2, Error: "timeout"},
+		)
+		run.Finish(now)
+		assert.Equal(t, domain.DataRetentionFailed, run.Status)
+		assert.Equal(t, int64(122), run.TotalAffected())
+	})
+}
@@ -0,0 +1,345 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataSubjectResponseDeadline es el plazo para responder una solicitud de derechos (GDPR Art. 12.3: un mes)
+const DataSubjectResponseDeadline = 30 * 24 * time.Hour
+
+// DataSubjectDeadlineWarning es cuánto antes del vencimiento se avisa a los administradores
+const DataSubjectDeadlineWarning = 5 * 24 * time.Hour
+
+// VerificationCodeTTL es la validez del código enviado por email para verificar la identidad
+const VerificationCodeTTL = 24 * time.Hour
+
+// MaxVerificationAttempts es la cantidad de códigos incorrectos tolerados antes de invalidar el código
+const MaxVerificationAttempts = 5
+
+// MaxProcessingAttempts es la cantidad de veces que se reintenta procesar una solicitud antes de marcarla fallida
+const MaxProcessingAttempts = 3
+
+// DataArchiveTTL es cuánto tiempo queda disponible para descargar el archivo exportado
+const DataArchiveTTL = 7 * 24 * time.Hour
+
+var (
+	ErrDataSubjectRequestNotFound  = errors.New("solicitud no encontrada")
+	ErrDataSubjectRequestOpen      = errors.New("ya hay una solicitud del mismo tipo en curso")
+	ErrInvalidDataSubjectRequest   = errors.New("tipo de solicitud inválido")
+	ErrNotPendingVerification      = errors.New("la solicitud no está pendiente de verificación")
+	ErrInvalidVerificationCode     = errors.New("código de verificación incorrecto")
+	ErrVerificationCodeExpired     = errors.New("el código de verificación venció, pedí uno nuevo")
+	ErrDataSubjectRequestClosed    = errors.New("la solicitud ya fue cerrada")
+	ErrDataArchiveNotAvailable     = errors.New("el archivo de la exportación no está disponible")
+	ErrDataSubjectRequestNotFailed = errors.New("solo se pueden reintentar las solicitudes fallidas")
+)
+
+// DataSubjectRequestType es el derecho que ejerce el titular
+type DataSubjectRequestType string
+
+const (
+	DataSubjectRequestExport  DataSubjectRequestType = "EXPORT"  // Acceso y portabilidad (Art. 15 y 20)
+	DataSubjectRequestErasure DataSubjectRequestType = "ERASURE" // Supresión (Art. 17)
+)
+
+// IsValid verifica que el tipo sea uno de los soportados
+func (t DataSubjectRequestType) IsValid() bool {
+	return t == DataSubjectRequestExport || t == DataSubjectRequestErasure
+}
+
+// DataSubjectRequestStatus es el estado de la solicitud:
+// PENDING_VERIFICATION -> QUEUED -> PROCESSING -> COMPLETED, o REJECTED / FAILED
+type DataSubjectRequestStatus string
+
+const (
+	DataSubjectStatusPendingVerification DataSubjectRequestStatus = "PENDING_VERIFICATION"
+	DataSubjectStatusQueued              DataSubjectRequestStatus = "QUEUED"
+	DataSubjectStatusProcessing          DataSubjectRequestStatus = "PROCESSING"
+	DataSubjectStatusCompleted           DataSubjectRequestStatus = "COMPLETED"
+	DataSubjectStatusRejected            DataSubjectRequestStatus = "REJECTED"
+	DataSubjectStatusFailed              DataSubjectRequestStatus = "FAILED"
+)
+
+// Métodos de verificación de identidad
+const (
+	VerificationMethodEmailCode = "EMAIL_CODE" // El titular ingresó el código enviado a su email
+	VerificationMethodAdmin     = "ADMIN"      // Un administrador verificó la identidad (p. ej. DNI en secretaría)
+)
+
+// DataSubjectRequest es una solicitud de derechos del titular de los datos (GDPR Art. 15, 17 y 20).
+// Se procesa en segundo plano una vez verificada la identidad y debe resolverse antes de DueAt.
+type DataSubjectRequest struct {
+	ID          uuid.UUID                `json:"id" gorm:"type:uuid;primary_key"`
+	ClubID      string                   `json:"club_id" gorm:"index;not null"`
+	UserID      string                   `json:"user_id" gorm:"index;not null"`
+	Type        DataSubjectRequestType   `json:"type" gorm:"not null"`
+	Status      DataSubjectRequestStatus `json:"status" gorm:"index;not null"`
+	RequestedBy string                   `json:"requested_by" gorm:"not null"` // El titular o el administrador que la cargó
+
+	// Verificación de identidad
+	VerificationCodeHash  string     `json:"-"`
+	VerificationExpiresAt *time.Time `json:"-"`
+	VerificationAttempts  int        `json:"-" gorm:"default:0"`
+	VerificationMethod    string     `json:"verification_method,omitempty"`
+	VerifiedAt            *time.Time `json:"verified_at,omitempty"`
+	VerifiedBy            *string    `json:"verified_by,omitempty"`
+
+	// Plazo y procesamiento
+	DueAt              time.Time  `json:"due_at" gorm:"not null"`
+	DeadlineWarnedAt   *time.Time `json:"-"`
+	ProcessingAttempts int        `json:"-" gorm:"default:0"`
+	StartedAt          *time.Time `json:"started_at,omitempty"`
+	CompletedAt        *time.Time `json:"completed_at,omitempty"`
+	LastError          string     `json:"last_error,omitempty" gorm:"type:text"`
+
+	// Resultado
+	ArchiveKey       string     `json:"-"`
+	ArchiveEncrypted bool       `json:"-" gorm:"default:false"`
+	ArchiveExpiresAt *time.Time `json:"archive_expires_at,omitempty"`
+	RetainedData     string     `json:"retained_data,omitempty" gorm:"type:text"` // Datos conservados por obligación legal
+	RejectionReason  string     `json:"rejection_reason,omitempty" gorm:"type:text"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (DataSubjectRequest) TableName() string {
+	return "data_subject_requests"
+}
+
+// NewDataSubjectRequest crea la solicitud pendiente de verificación, con el plazo legal desde ahora
+func NewDataSubjectRequest(clubID, userID, requestedBy string, requestType DataSubjectRequestType, now time.Time) (*DataSubjectRequest, error) {
+	if !requestType.IsValid() {
+		return nil, ErrInvalidDataSubjectRequest
+	}
+	return &DataSubjectRequest{
+		ID:          uuid.New(),
+		ClubID:      clubID,
+		UserID:      userID,
+		Type:        requestType,
+		Status:      DataSubjectStatusPendingVerification,
+		RequestedBy: requestedBy,
+		DueAt:       now.Add(DataSubjectResponseDeadline),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// IsOpen indica si la solicitud todavía no fue resuelta. Una solicitud FAILED sigue abierta: el plazo
+// corre hasta que un administrador la reintente.
+func (r *DataSubjectRequest) IsOpen() bool {
+	return r.Status != DataSubjectStatusCompleted && r.Status != DataSubjectStatusRejected
+}
+
+// IsOverdue indica si la solicitud sigue abierta después del plazo legal
+func (r *DataSubjectRequest) IsOverdue(now time.Time) bool {
+	return r.IsOpen() && now.After(r.DueAt)
+}
+
+// DaysRemaining devuelve los días que faltan para el vencimiento (negativo si ya venció)
+func (r *DataSubjectRequest) DaysRemaining(now time.Time) int {
+	remaining := r.DueAt.Sub(now)
+	days := int(remaining / (24 * time.Hour))
+	if remaining < 0 && remaining%(24*time.Hour) != 0 {
+		days--
+	}
+	return days
+}
+
+// NeedsDeadlineWarning indica si hay que avisar que el plazo está por vencer (una sola vez). Las solicitudes
+// sin verificar no se avisan: dependen del titular y se rechazan solas al vencer (ver VerificationAbandoned).
+func (r *DataSubjectRequest) NeedsDeadlineWarning(now time.Time) bool {
+	return r.IsOpen() && r.Status != DataSubjectStatusPendingVerification &&
+		r.DeadlineWarnedAt == nil && now.After(r.DueAt.Add(-DataSubjectDeadlineWarning))
+}
+
+// VerificationAbandoned indica si el titular nunca verificó su identidad dentro del plazo
+func (r *DataSubjectRequest) VerificationAbandoned(now time.Time) bool {
+	return r.Status == DataSubjectStatusPendingVerification && now.After(r.DueAt)
+}
+
+// SetVerificationCode guarda el hash del código enviado al titular y reinicia los intentos
+func (r *DataSubjectRequest) SetVerificationCode(code string, now time.Time) error {
+	if r.Status != DataSubjectStatusPendingVerification {
+		return ErrNotPendingVerification
+	}
+	expires := now.Add(VerificationCodeTTL)
+	r.VerificationCodeHash = hashVerificationCode(code)
+	r.VerificationExpiresAt = &expires
+	r.VerificationAttempts = 0
+	r.UpdatedAt = now
+	return nil
+}
+
+// Verify controla el código ingresado por el titular; si es correcto la solicitud pasa a la cola.
+// Después de MaxVerificationAttempts intentos fallidos el código se invalida y hay que pedir otro.
+func (r *DataSubjectRequest) Verify(code string, now time.Time) error {
+	if r.Status != DataSubjectStatusPendingVerification {
+		return ErrNotPendingVerification
+	}
+	if r.VerificationCodeHash == "" || r.VerificationExpiresAt == nil || now.After(*r.VerificationExpiresAt) ||
+		r.VerificationAttempts >= MaxVerificationAttempts {
+		return ErrVerificationCodeExpired
+	}
+
+	r.UpdatedAt = now
+	if subtle.ConstantTimeCompare([]byte(hashVerificationCode(code)), []byte(r.VerificationCodeHash)) != 1 {
+		r.VerificationAttempts++
+		return ErrInvalidVerificationCode
+	}
+	r.markVerified(VerificationMethodEmailCode, r.UserID, now)
+	return nil
+}
+
+// VerifyByAdmin registra que un administrador verificó la identidad del titular por otro medio
+func (r *DataSubjectRequest) VerifyByAdmin(adminID string, now time.Time) error {
+	if r.Status != DataSubjectStatusPendingVerification {
+		return ErrNotPendingVerification
+	}
+	r.markVerified(VerificationMethodAdmin, adminID, now)
+	return nil
+}
+
+func (r *DataSubjectRequest) markVerified(method, verifiedBy string, now time.Time) {
+	r.Status = DataSubjectStatusQueued
+	r.VerificationMethod = method
+	r.VerifiedAt = &now
+	r.VerifiedBy = &verifiedBy
+	r.VerificationCodeHash = ""
+	r.VerificationExpiresAt = nil
+	r.UpdatedAt = now
+}
+
+// Reject cierra la solicitud sin procesarla, p. ej. si no se pudo verificar la identidad
+func (r *DataSubjectRequest) Reject(reason string, now time.Time) error {
+	if r.Status != DataSubjectStatusPendingVerification && r.Status != DataSubjectStatusQueued &&
+		r.Status != DataSubjectStatusFailed {
+		return ErrDataSubjectRequestClosed
+	}
+	r.Status = DataSubjectStatusRejected
+	r.RejectionReason = reason
+	r.CompletedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
+
+// Start marca el inicio del procesamiento
+func (r *DataSubjectRequest) Start(now time.Time) {
+	r.Status = DataSubjectStatusProcessing
+	r.ProcessingAttempts++
+	r.StartedAt = &now
+	r.UpdatedAt = now
+}
+
+// Complete cierra la solicitud procesada
+func (r *DataSubjectRequest) Complete(now time.Time) {
+	r.Status = DataSubjectStatusCompleted
+	r.CompletedAt = &now
+	r.LastError = ""
+	r.UpdatedAt = now
+}
+
+// Fail registra el error; la solicitud vuelve a la cola hasta agotar MaxProcessingAttempts
+func (r *DataSubjectRequest) Fail(err error, now time.Time) {
+	r.LastError = err.Error()
+	r.Status = DataSubjectStatusQueued
+	if r.ProcessingAttempts >= MaxProcessingAttempts {
+		r.Status = DataSubjectStatusFailed
+	}
+	r.UpdatedAt = now
+}
+
+// Retry vuelve a encolar una solicitud fallida
+func (r *DataSubjectRequest) Retry(now time.Time) error {
+	if r.Status != DataSubjectStatusFailed {
+		return ErrDataSubjectRequestNotFailed
+	}
+	r.Status = DataSubjectStatusQueued
+	r.ProcessingAttempts = 0
+	r.UpdatedAt = now
+	return nil
+}
+
+// ArchiveAvailable indica si el archivo exportado todavía se puede descargar
+func (r *DataSubjectRequest) ArchiveAvailable(now time.Time) bool {
+	return r.Status == DataSubjectStatusCompleted && r.ArchiveKey != "" &&
+		r.ArchiveExpiresAt != nil && now.Before(*r.ArchiveExpiresAt)
+}
+
+func hashVerificationCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// RetainedPersonalData es una categoría de datos que la supresión no borra porque una ley obliga a
+// conservarla (GDPR Art. 17.3.b). Quedan asociados al socio anonimizado hasta que venza el plazo.
+type RetainedPersonalData struct {
+	Section    string `json:"section"`
+	LegalBasis string `json:"legal_basis"`
+	Years      int    `json:"years"`
+}
+
+// RetainedPersonalDataSections son los registros que se conservan al suprimir los datos de un socio
+var RetainedPersonalDataSections = []RetainedPersonalData{
+	{Section: "pagos", LegalBasis: "Documentación contable (Código Civil y Comercial, art. 328)", Years: 10},
+	{Section: "compras", LegalBasis: "Documentación contable (Código Civil y Comercial, art. 328)", Years: 10},
+	{Section: "suscripciones", LegalBasis: "Documentación contable (Código Civil y Comercial, art. 328)", Years: 10},
+	{Section: "reservas", LegalBasis: "Respaldo de operaciones cobradas (Código Civil y Comercial, art. 328)", Years: 10},
+	{Section: "billetera", LegalBasis: "Documentación contable (Código Civil y Comercial, art. 328)", Years: 10},
+	{Section: "cobros_viajes", LegalBasis: "Documentación contable (Código Civil y Comercial, art. 328)", Years: 10},
+	{Section: "consentimientos", LegalBasis: "Prueba del consentimiento otorgado (GDPR Art. 7.1)", Years: 5},
+	{Section: "accesos_datos_salud", LegalBasis: "Responsabilidad proactiva sobre datos de salud (GDPR Art. 5.2)", Years: 5},
+}
+
+// IsRetainedSection indica si la sección se conserva por obligación legal al suprimir los datos
+func IsRetainedSection(section string) bool {
+	for _, r := range RetainedPersonalDataSections {
+		if r.Section == section {
+			return true
+		}
+	}
+	return false
+}
+
+// PersonalDataSection son los registros de una categoría de datos del socio (reservas, pagos, asistencias...)
+type PersonalDataSection struct {
+	Name    string                   `json:"name"`
+	Records []map[string]interface{} `json:"records"`
+}
+
+// PersonalDataRepository reúne los datos personales del socio que guardan los demás módulos
+type PersonalDataRepository interface {
+	// Collect devuelve todas las secciones, incluso las vacías
+	Collect(ctx context.Context, clubID, userID string) ([]PersonalDataSection, error)
+	// EraseActivity borra los registros de las secciones que no se conservan por obligación legal
+	// y devuelve cuántos registros se borraron por sección
+	EraseActivity(ctx context.Context, clubID, userID string) (map[string]int64, error)
+}
+
+// DataSubjectRequestFilter filtra las solicitudes de un club; los campos vacíos no filtran
+type DataSubjectRequestFilter struct {
+	UserID               string
+	Type                 DataSubjectRequestType
+	Statuses             []DataSubjectRequestStatus
+	ArchiveExpiredBefore *time.Time
+}
+
+// DataSubjectRequestRepository persiste las solicitudes de derechos
+type DataSubjectRequestRepository interface {
+	Create(ctx context.Context, req *DataSubjectRequest) error
+	Update(ctx context.Context, req *DataSubjectRequest) error
+	// ClaimForProcessing guarda el inicio del procesamiento (ver Start) solo si la solicitud sigue en el
+	// estado y con los intentos leídos; false si otro proceso la tomó antes
+	ClaimForProcessing(ctx context.Context, req *DataSubjectRequest, from DataSubjectRequestStatus, attempts int) (bool, error)
+	GetByID(ctx context.Context, clubID string, id uuid.UUID) (*DataSubjectRequest, error)
+	// List devuelve las solicitudes ordenadas por vencimiento
+	List(ctx context.Context, clubID string, filter DataSubjectRequestFilter) ([]DataSubjectRequest, error)
+}
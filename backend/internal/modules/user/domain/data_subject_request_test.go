@@ -0,0 +1,91 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSubjectRequest(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	newRequest := func() *domain.DataSubjectRequest {
+		req, err := domain.NewDataSubjectRequest("club-1", "user-1", "user-1", domain.DataSubjectRequestErasure, now)
+		require.NoError(t, err)
+		require.NoError(t, req.SetVerificationCode("123456", now))
+		return req
+	}
+
+	t.Run("El plazo legal es de 30 días desde la recepción", func(t *testing.T) {
+		req := newRequest()
+		assert.Equal(t, 30, req.DaysRemaining(now))
+		assert.Equal(t, 4, req.DaysRemaining(now.AddDate(0, 0, 26)))
+		assert.Equal(t, -1, req.DaysRemaining(now.AddDate(0, 0, 30).Add(time.Hour)))
+
+		_, err := domain.NewDataSubjectRequest("club-1", "user-1", "user-1", "RECTIFY", now)
+		assert.ErrorIs(t, err, domain.ErrInvalidDataSubjectRequest)
+	})
+
+	t.Run("El código correcto encola la solicitud", func(t *testing.T) {
+		req := newRequest()
+		assert.ErrorIs(t, req.Verify("000000", now), domain.ErrInvalidVerificationCode)
+		require.NoError(t, req.Verify("123456", now.Add(time.Hour)))
+		assert.Equal(t, domain.DataSubjectStatusQueued, req.Status)
+		assert.Equal(t, domain.VerificationMethodEmailCode, req.VerificationMethod)
+		assert.Empty(t, req.VerificationCodeHash)
+		assert.ErrorIs(t, req.Verify("123456", now), domain.ErrNotPendingVerification)
+	})
+
+	t.Run("El código vence y se invalida tras demasiados intentos", func(t *testing.T) {
+		req := newRequest()
+		assert.ErrorIs(t, req.Verify("123456", now.Add(domain.VerificationCodeTTL+time.Minute)), domain.ErrVerificationCodeExpired)
+
+		req = newRequest()
+		for i := 0; i < domain.MaxVerificationAttempts; i++ {
+			assert.ErrorIs(t, req.Verify("000000", now), domain.ErrInvalidVerificationCode)
+		}
+		assert.ErrorIs(t, req.Verify("123456", now), domain.ErrVerificationCodeExpired)
+
+		require.NoError(t, req.SetVerificationCode("654321", now))
+		require.NoError(t, req.Verify("654321", now))
+	})
+
+	t.Run("Los errores reintentan hasta agotar los intentos y la fallida sigue abierta", func(t *testing.T) {
+		req := newRequest()
+		require.NoError(t, req.VerifyByAdmin("admin-1", now))
+		for i := 1; i < domain.MaxProcessingAttempts; i++ {
+			req.Start(now)
+			req.Fail(errors.New("storage caído"), now)
+			assert.Equal(t, domain.DataSubjectStatusQueued, req.Status)
+		}
+		req.Start(now)
+		req.Fail(errors.New("storage caído"), now)
+		assert.Equal(t, domain.DataSubjectStatusFailed, req.Status)
+		assert.True(t, req.IsOpen())
+		assert.True(t, req.IsOverdue(now.AddDate(0, 0, 31)))
+
+		require.NoError(t, req.Retry(now))
+		assert.Equal(t, domain.DataSubjectStatusQueued, req.Status)
+		assert.Zero(t, req.ProcessingAttempts)
+	})
+
+	t.Run("Solo se avisa el vencimiento de las solicitudes verificadas", func(t *testing.T) {
+		unverified := newRequest()
+		assert.False(t, unverified.NeedsDeadlineWarning(now.AddDate(0, 0, 27)))
+		assert.True(t, unverified.VerificationAbandoned(now.AddDate(0, 0, 31)))
+
+		queued := newRequest()
+		require.NoError(t, queued.VerifyByAdmin("admin-1", now))
+		assert.False(t, queued.NeedsDeadlineWarning(now.AddDate(0, 0, 20)))
+		assert.True(t, queued.NeedsDeadlineWarning(now.AddDate(0, 0, 27)))
+	})
+
+	t.Run("Los registros financieros se conservan", func(t *testing.T) {
+		assert.True(t, domain.IsRetainedSection("pagos"))
+		assert.True(t, domain.IsRetainedSection("consentimientos"))
+		assert.False(t, domain.IsRetainedSection("asistencias"))
+	})
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// DefaultReviewSLA es el plazo para revisar un documento desde que se sube
+const DefaultReviewSLA = 48 * time.Hour
+
+// ReviewClaimTTL es cuánto dura la toma de un documento; vencida, otro revisor puede tomarlo
+const ReviewClaimTTL = 2 * time.Hour
+
+var (
+	ErrDocumentNotPending      = errors.New("el documento no está pendiente de revisión")
+	ErrDocumentClaimedByOther  = errors.New("el documento está siendo revisado por otra persona")
+	ErrInvalidRejectionReason  = errors.New("motivo de rechazo inválido")
+	ErrRejectionNotesRequired  = errors.New("el motivo OTHER requiere una observación")
+	ErrDocumentReviewForbidden = errors.New("no tienes permiso para revisar este documento")
+)
+
+// RejectionReason es un motivo de rechazo estandarizado, comunicado al socio
+type RejectionReason string
+
+const (
+	RejectionIllegible        RejectionReason = "ILLEGIBLE"
+	RejectionWrongDocument    RejectionReason = "WRONG_DOCUMENT"
+	RejectionExpired          RejectionReason = "EXPIRED"
+	RejectionIncomplete       RejectionReason = "INCOMPLETE"
+	RejectionNameMismatch     RejectionReason = "NAME_MISMATCH"
+	RejectionMissingSignature RejectionReason = "MISSING_SIGNATURE"
+	RejectionOther            RejectionReason = "OTHER"
+)
+
+var rejectionReasonDescriptions = map[RejectionReason]string{
+	RejectionIllegible:        "El archivo no se lee correctamente",
+	RejectionWrongDocument:    "El archivo no corresponde al tipo de documento",
+	RejectionExpired:          "El documento está vencido",
+	RejectionIncomplete:       "Falta una parte del documento (dorso, páginas, datos)",
+	RejectionNameMismatch:     "Los datos no coinciden con los del socio",
+	RejectionMissingSignature: "Falta la firma o el sello del profesional",
+	RejectionOther:            "Otro motivo",
+}
+
+// IsValidRejectionReason verifica que el motivo sea uno de los estandarizados
+func IsValidRejectionReason(r RejectionReason) bool {
+	_, ok := rejectionReasonDescriptions[r]
+	return ok
+}
+
+// Description devuelve el texto del motivo para el socio
+func (r RejectionReason) Description() string {
+	if d, ok := rejectionReasonDescriptions[r]; ok {
+		return d
+	}
+	return string(r)
+}
+
+// RejectionReasons devuelve los motivos disponibles, para armar el formulario de revisión
+func RejectionReasons() map[RejectionReason]string {
+	reasons := make(map[RejectionReason]string, len(rejectionReasonDescriptions))
+	for k, v := range rejectionReasonDescriptions {
+		reasons[k] = v
+	}
+	return reasons
+}
+
+// CanBeReviewedBy indica si el rol puede validar o rechazar el documento: los certificados médicos
+// solo el personal médico (son datos de salud), el resto también los administradores
+func (d *UserDocument) CanBeReviewedBy(role string) bool {
+	switch role {
+	case RoleSuperAdmin, RoleMedicalStaff:
+		return true
+	case RoleAdmin:
+		return !d.IsHealthData()
+	}
+	return false
+}
+
+// ReviewDueAt es el vencimiento del SLA de revisión
+func (d *UserDocument) ReviewDueAt(sla time.Duration) time.Time {
+	return d.CreatedAt.Add(sla)
+}
+
+// IsReviewOverdue indica si el documento sigue pendiente pasado el SLA
+func (d *UserDocument) IsReviewOverdue(sla time.Duration, now time.Time) bool {
+	return d.Status == DocumentStatusPending && now.After(d.ReviewDueAt(sla))
+}
+
+// IsClaimedByOther indica si otro revisor tiene tomado el documento y la toma sigue vigente
+func (d *UserDocument) IsClaimedByOther(reviewerID string, now time.Time) bool {
+	return d.ReviewerID != nil && *d.ReviewerID != reviewerID &&
+		d.ClaimedAt != nil && now.Before(d.ClaimedAt.Add(ReviewClaimTTL))
+}
+
+// Claim asigna el documento al revisor
+func (d *UserDocument) Claim(reviewerID string, now time.Time) error {
+	if d.Status != DocumentStatusPending {
+		return ErrDocumentNotPending
+	}
+	if d.IsClaimedByOther(reviewerID, now) {
+		return ErrDocumentClaimedByOther
+	}
+	d.ReviewerID = &reviewerID
+	d.ClaimedAt = &now
+	return nil
+}
+
+// Release libera la toma del documento
+func (d *UserDocument) Release() {
+	d.ReviewerID = nil
+	d.ClaimedAt = nil
+}
+
+// Approve valida el documento; expiration reemplaza el vencimiento si el revisor lo corrige
+func (d *UserDocument) Approve(reviewerID string, expiration *time.Time, now time.Time) error {
+	if !d.CanBeValidated() {
+		return ErrDocumentNotPending
+	}
+	if d.IsClaimedByOther(reviewerID, now) {
+		return ErrDocumentClaimedByOther
+	}
+	if expiration != nil {
+		d.ExpirationDate = expiration
+	}
+	d.Status = DocumentStatusValid
+	d.RejectionReason = ""
+	d.RejectionNotes = ""
+	d.markReviewed(reviewerID, now)
+	return nil
+}
+
+// Reject rechaza el documento con un motivo estandarizado
+func (d *UserDocument) Reject(reviewerID string, reason RejectionReason, notes string, now time.Time) error {
+	if !IsValidRejectionReason(reason) {
+		return ErrInvalidRejectionReason
+	}
+	if reason == RejectionOther && notes == "" {
+		return ErrRejectionNotesRequired
+	}
+	if d.Status != DocumentStatusPending {
+		return ErrDocumentNotPending
+	}
+	if d.IsClaimedByOther(reviewerID, now) {
+		return ErrDocumentClaimedByOther
+	}
+	d.Status = DocumentStatusRejected
+	d.RejectionReason = reason
+	d.RejectionNotes = notes
+	d.markReviewed(reviewerID, now)
+	return nil
+}
+
+func (d *UserDocument) markReviewed(reviewerID string, now time.Time) {
+	d.ValidatedAt = &now
+	d.ValidatedBy = &reviewerID
+	d.Release()
+}
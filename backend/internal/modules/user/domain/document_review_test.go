@@ -0,0 +1,69 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserDocument_Review(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	future := now.AddDate(1, 0, 0)
+	pending := func(docType domain.DocumentType) *domain.UserDocument {
+		return &domain.UserDocument{Type: docType, Status: domain.DocumentStatusPending, ExpirationDate: &future, CreatedAt: now.Add(-time.Hour)}
+	}
+
+	t.Run("Solo personal médico revisa certificados médicos", func(t *testing.T) {
+		medical := pending(domain.DocumentTypeEMMACMedical)
+		assert.True(t, medical.CanBeReviewedBy(domain.RoleMedicalStaff))
+		assert.True(t, medical.CanBeReviewedBy(domain.RoleSuperAdmin))
+		assert.False(t, medical.CanBeReviewedBy(domain.RoleAdmin))
+		assert.False(t, medical.CanBeReviewedBy(domain.RoleMember))
+
+		assert.True(t, pending(domain.DocumentTypeDNIFront).CanBeReviewedBy(domain.RoleAdmin))
+	})
+
+	t.Run("La toma de otro revisor bloquea hasta que vence", func(t *testing.T) {
+		doc := pending(domain.DocumentTypeDNIFront)
+		require.NoError(t, doc.Claim("rev-1", now))
+		assert.ErrorIs(t, doc.Claim("rev-2", now.Add(time.Hour)), domain.ErrDocumentClaimedByOther)
+		assert.ErrorIs(t, doc.Approve("rev-2", nil, now.Add(time.Hour)), domain.ErrDocumentClaimedByOther)
+
+		require.NoError(t, doc.Claim("rev-2", now.Add(domain.ReviewClaimTTL+time.Minute)))
+		assert.Equal(t, "rev-2", *doc.ReviewerID)
+	})
+
+	t.Run("Rechazar exige un motivo estandarizado", func(t *testing.T) {
+		doc := pending(domain.DocumentTypeDNIFront)
+		assert.ErrorIs(t, doc.Reject("rev-1", "FEO", "", now), domain.ErrInvalidRejectionReason)
+		assert.ErrorIs(t, doc.Reject("rev-1", domain.RejectionOther, "", now), domain.ErrRejectionNotesRequired)
+
+		require.NoError(t, doc.Reject("rev-1", domain.RejectionIllegible, "", now))
+		assert.Equal(t, domain.DocumentStatusRejected, doc.Status)
+		assert.Equal(t, domain.RejectionIllegible, doc.RejectionReason)
+		assert.Equal(t, "rev-1", *doc.ValidatedBy)
+		assert.Nil(t, doc.ReviewerID, "reviewing releases the claim")
+
+		assert.ErrorIs(t, doc.Approve("rev-1", nil, now), domain.ErrDocumentNotPending)
+	})
+
+	t.Run("Aprobar puede corregir el vencimiento", func(t *testing.T) {
+		doc := pending(domain.DocumentTypeEMMACMedical)
+		corrected := now.AddDate(0, 6, 0)
+		require.NoError(t, doc.Approve("rev-1", &corrected, now))
+		assert.Equal(t, domain.DocumentStatusValid, doc.Status)
+		assert.Equal(t, corrected, *doc.ExpirationDate)
+	})
+
+	t.Run("SLA de revisión", func(t *testing.T) {
+		doc := pending(domain.DocumentTypeDNIFront)
+		assert.False(t, doc.IsReviewOverdue(domain.DefaultReviewSLA, now))
+		assert.True(t, doc.IsReviewOverdue(domain.DefaultReviewSLA, now.Add(domain.DefaultReviewSLA)))
+
+		doc.Status = domain.DocumentStatusValid
+		assert.False(t, doc.IsReviewOverdue(domain.DefaultReviewSLA, now.Add(domain.DefaultReviewSLA)))
+	})
+}
@@ -0,0 +1,253 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EligibilityRequirement es un requisito documental para que un jugador pueda competir
+type EligibilityRequirement string
+
+const (
+	RequirementDNI             EligibilityRequirement = "DNI"
+	RequirementMedical         EligibilityRequirement = "MEDICAL"
+	RequirementInsurance       EligibilityRequirement = "INSURANCE"
+	RequirementLeagueForm      EligibilityRequirement = "LEAGUE_FORM"
+	RequirementParentalConsent EligibilityRequirement = "PARENTAL_CONSENT" // Solo se exige a menores
+)
+
+// ErrEligibilityRuleExists indica que el club ya tiene una regla para esa disciplina y categoría
+var ErrEligibilityRuleExists = errors.New("ya existe una regla para esa disciplina y categoría")
+
+// DefaultEligibilityRequirements se aplican cuando el club no configuró reglas: DNI + apto médico
+var DefaultEligibilityRequirements = []EligibilityRequirement{RequirementDNI, RequirementMedical}
+
+// requirementLabels es el nombre usado en los mensajes; feminine ajusta la concordancia ("vencida")
+var requirementLabels = map[EligibilityRequirement]struct {
+	label    string
+	feminine bool
+}{
+	RequirementDNI:             {"DNI", false},
+	RequirementMedical:         {"Apto físico", false},
+	RequirementInsurance:       {"Seguro", false},
+	RequirementLeagueForm:      {"Ficha de liga", true},
+	RequirementParentalConsent: {"Autorización parental", true},
+}
+
+// IsValidEligibilityRequirement verifica que el requisito sea uno de los soportados
+func IsValidEligibilityRequirement(r EligibilityRequirement) bool {
+	_, ok := requirementLabels[r]
+	return ok
+}
+
+// DocumentTypes devuelve los tipos de documento que cumplen el requisito
+func (r EligibilityRequirement) DocumentTypes() []DocumentType {
+	switch r {
+	case RequirementDNI:
+		return []DocumentType{DocumentTypeDNIFront, DocumentTypeDNIBack}
+	case RequirementMedical:
+		return []DocumentType{DocumentTypeEMMACMedical}
+	case RequirementInsurance:
+		return []DocumentType{DocumentTypeInsurance}
+	case RequirementLeagueForm:
+		return []DocumentType{DocumentTypeLeagueForm}
+	case RequirementParentalConsent:
+		return []DocumentType{DocumentTypeParentalConsent}
+	}
+	return nil
+}
+
+// AppliesTo indica si el requisito se exige al jugador (la autorización parental solo a menores)
+func (r EligibilityRequirement) AppliesTo(isMinor bool) bool {
+	return r != RequirementParentalConsent || isMinor
+}
+
+// EligibilityIssueStatus es el motivo por el que un requisito no se cumple
+type EligibilityIssueStatus string
+
+const (
+	IssueStatusMissing  EligibilityIssueStatus = "MISSING"
+	IssueStatusPending  EligibilityIssueStatus = "PENDING"
+	IssueStatusRejected EligibilityIssueStatus = "REJECTED"
+	IssueStatusExpired  EligibilityIssueStatus = "EXPIRED"
+)
+
+// EligibilityIssue es un requisito incumplido. Code combina requisito y motivo (ej. "MEDICAL_EXPIRED").
+type EligibilityIssue struct {
+	Code        string                 `json:"code"`
+	Requirement EligibilityRequirement `json:"requirement"`
+	Status      EligibilityIssueStatus `json:"status"`
+	Message     string                 `json:"message"`
+}
+
+func newEligibilityIssue(r EligibilityRequirement, status EligibilityIssueStatus) EligibilityIssue {
+	return EligibilityIssue{
+		Code:        string(r) + "_" + string(status),
+		Requirement: r,
+		Status:      status,
+		Message:     issueMessage(r, status),
+	}
+}
+
+func issueMessage(r EligibilityRequirement, status EligibilityIssueStatus) string {
+	if r == RequirementDNI {
+		// El DNI solo cuenta si está validado
+		return "DNI faltante o no validado"
+	}
+	meta, ok := requirementLabels[r]
+	if !ok {
+		meta.label = string(r)
+	}
+	suffix := "o"
+	if meta.feminine {
+		suffix = "a"
+	}
+	switch status {
+	case IssueStatusPending:
+		return meta.label + " pendiente de validación"
+	case IssueStatusRejected:
+		return meta.label + " rechazad" + suffix
+	case IssueStatusExpired:
+		return meta.label + " vencid" + suffix
+	}
+	return meta.label + " faltante"
+}
+
+// EvaluateRequirement verifica un requisito contra los documentos del jugador (ordenados del más nuevo al más viejo).
+// Alcanza con un documento válido y vigente; si no hay, el motivo lo da el documento más reciente.
+func EvaluateRequirement(r EligibilityRequirement, docs []UserDocument) *EligibilityIssue {
+	types := r.DocumentTypes()
+	var latest *UserDocument
+	for i := range docs {
+		doc := &docs[i]
+		if !containsDocumentType(types, doc.Type) {
+			continue
+		}
+		if doc.Status == DocumentStatusValid && !doc.IsExpired() {
+			return nil
+		}
+		if latest == nil {
+			latest = doc
+		}
+	}
+
+	status := IssueStatusMissing
+	if latest != nil && r != RequirementDNI {
+		switch {
+		case latest.Status == DocumentStatusExpired || latest.IsExpired():
+			status = IssueStatusExpired
+		case latest.Status == DocumentStatusPending:
+			status = IssueStatusPending
+		case latest.Status == DocumentStatusRejected:
+			status = IssueStatusRejected
+		}
+	}
+	issue := newEligibilityIssue(r, status)
+	return &issue
+}
+
+// EvaluateEligibility devuelve los requisitos incumplidos, en el orden de la regla
+func EvaluateEligibility(requirements []EligibilityRequirement, docs []UserDocument, isMinor bool) []EligibilityIssue {
+	issues := []EligibilityIssue{}
+	for _, r := range requirements {
+		if !r.AppliesTo(isMinor) {
+			continue
+		}
+		if issue := EvaluateRequirement(r, docs); issue != nil {
+			issues = append(issues, *issue)
+		}
+	}
+	return issues
+}
+
+func containsDocumentType(types []DocumentType, t DocumentType) bool {
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
+
+// EligibilityScope identifica la disciplina y categoría para la que se evalúa al jugador.
+// Vacío usa la regla general del club.
+type EligibilityScope struct {
+	DisciplineID *uuid.UUID `json:"discipline_id,omitempty"`
+	Category     string     `json:"category,omitempty"`
+}
+
+// EligibilityRule define los requisitos documentales de un club, opcionalmente para una disciplina
+// y/o categoría (ej. la liga de fútbol infantil pide seguro y ficha de liga)
+type EligibilityRule struct {
+	ID           uuid.UUID                `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ClubID       string                   `json:"club_id" gorm:"index;not null"`
+	DisciplineID *uuid.UUID               `json:"discipline_id,omitempty" gorm:"type:uuid"`
+	Category     string                   `json:"category,omitempty"`
+	Name         string                   `json:"name"`
+	Requirements []EligibilityRequirement `json:"requirements" gorm:"serializer:json;not null"`
+	CreatedAt    time.Time                `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time                `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName especifica el nombre de la tabla
+func (EligibilityRule) TableName() string {
+	return "eligibility_rules"
+}
+
+// Matches indica si la regla aplica al alcance: los campos vacíos de la regla aplican a todos
+func (r *EligibilityRule) Matches(scope EligibilityScope) bool {
+	if r.DisciplineID != nil && (scope.DisciplineID == nil || *scope.DisciplineID != *r.DisciplineID) {
+		return false
+	}
+	return r.Category == "" || r.Category == scope.Category
+}
+
+// SameScope indica si las dos reglas cubren la misma disciplina y categoría
+func (r *EligibilityRule) SameScope(other *EligibilityRule) bool {
+	if (r.DisciplineID == nil) != (other.DisciplineID == nil) {
+		return false
+	}
+	if r.DisciplineID != nil && *r.DisciplineID != *other.DisciplineID {
+		return false
+	}
+	return r.Category == other.Category
+}
+
+// specificity ordena las reglas: disciplina y categoría > disciplina > categoría > general
+func (r *EligibilityRule) specificity() int {
+	score := 0
+	if r.DisciplineID != nil {
+		score += 2
+	}
+	if r.Category != "" {
+		score++
+	}
+	return score
+}
+
+// ResolveEligibilityRule elige la regla más específica que aplica al alcance; nil si ninguna aplica
+func ResolveEligibilityRule(rules []EligibilityRule, scope EligibilityScope) *EligibilityRule {
+	var best *EligibilityRule
+	for i := range rules {
+		rule := &rules[i]
+		if !rule.Matches(scope) {
+			continue
+		}
+		if best == nil || rule.specificity() > best.specificity() {
+			best = rule
+		}
+	}
+	return best
+}
+
+// EligibilityRuleRepository define la persistencia de las reglas de elegibilidad
+type EligibilityRuleRepository interface {
+	Create(ctx context.Context, rule *EligibilityRule) error
+	Update(ctx context.Context, rule *EligibilityRule) error
+	Delete(ctx context.Context, clubID string, id uuid.UUID) error
+	GetByID(ctx context.Context, clubID string, id uuid.UUID) (*EligibilityRule, error)
+	ListByClub(ctx context.Context, clubID string) ([]EligibilityRule, error)
+}
@@ -0,0 +1,81 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveEligibilityRule(t *testing.T) {
+	football := uuid.New()
+	hockey := uuid.New()
+	rules := []domain.EligibilityRule{
+		{Name: "General", Requirements: []domain.EligibilityRequirement{domain.RequirementDNI}},
+		{Name: "Categoría 2012", Category: "2012"},
+		{Name: "Fútbol", DisciplineID: &football},
+		{Name: "Fútbol 2012", DisciplineID: &football, Category: "2012"},
+	}
+
+	tests := []struct {
+		name     string
+		scope    domain.EligibilityScope
+		expected string
+	}{
+		{"Sin alcance usa la regla general", domain.EligibilityScope{}, "General"},
+		{"Disciplina y categoría", domain.EligibilityScope{DisciplineID: &football, Category: "2012"}, "Fútbol 2012"},
+		{"Solo disciplina", domain.EligibilityScope{DisciplineID: &football, Category: "2015"}, "Fútbol"},
+		{"Otra disciplina con la categoría", domain.EligibilityScope{DisciplineID: &hockey, Category: "2012"}, "Categoría 2012"},
+		{"Otra disciplina", domain.EligibilityScope{DisciplineID: &hockey}, "General"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := domain.ResolveEligibilityRule(rules, tt.scope)
+			require.NotNil(t, rule)
+			assert.Equal(t, tt.expected, rule.Name)
+		})
+	}
+
+	assert.Nil(t, domain.ResolveEligibilityRule(rules[3:], domain.EligibilityScope{}))
+}
+
+func TestEvaluateEligibility(t *testing.T) {
+	past := time.Now().AddDate(0, -1, 0)
+	docs := []domain.UserDocument{
+		{Type: domain.DocumentTypeDNIBack, Status: domain.DocumentStatusValid},
+		{Type: domain.DocumentTypeInsurance, Status: domain.DocumentStatusValid, ExpirationDate: &past},
+		// El formulario nuevo está pendiente pero el anterior sigue vigente
+		{Type: domain.DocumentTypeLeagueForm, Status: domain.DocumentStatusPending},
+		{Type: domain.DocumentTypeLeagueForm, Status: domain.DocumentStatusValid},
+		{Type: domain.DocumentTypeEMMACMedical, Status: domain.DocumentStatusRejected},
+	}
+	requirements := []domain.EligibilityRequirement{
+		domain.RequirementDNI,
+		domain.RequirementMedical,
+		domain.RequirementInsurance,
+		domain.RequirementLeagueForm,
+		domain.RequirementParentalConsent,
+	}
+
+	issues := domain.EvaluateEligibility(requirements, docs, false)
+	require.Len(t, issues, 2)
+	assert.Equal(t, "MEDICAL_REJECTED", issues[0].Code)
+	assert.Equal(t, "Apto físico rechazado", issues[0].Message)
+	assert.Equal(t, "INSURANCE_EXPIRED", issues[1].Code)
+	assert.Equal(t, "Seguro vencido", issues[1].Message)
+
+	// A los menores además se les exige la autorización parental
+	issues = domain.EvaluateEligibility(requirements, docs, true)
+	require.Len(t, issues, 3)
+	assert.Equal(t, "PARENTAL_CONSENT_MISSING", issues[2].Code)
+	assert.Equal(t, "Autorización parental faltante", issues[2].Message)
+
+	// El DNI solo cuenta validado
+	issue := domain.EvaluateRequirement(domain.RequirementDNI, []domain.UserDocument{{Type: domain.DocumentTypeDNIFront, Status: domain.DocumentStatusPending}})
+	require.NotNil(t, issue)
+	assert.Equal(t, "DNI_MISSING", issue.Code)
+	assert.Equal(t, "DNI faltante o no validado", issue.Message)
+}
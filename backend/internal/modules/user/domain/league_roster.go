@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// TeamRoster es el plantel vigente de un equipo (grupo de entrenamiento) para la carpeta de liga
+type TeamRoster struct {
+	TeamID  uuid.UUID
+	Name    string
+	Scope   EligibilityScope // Disciplina y categoría del grupo, para resolver los requisitos
+	UserIDs []string
+}
+
+// TeamRosterDirectory devuelve el plantel de un equipo; nil si no existe.
+// Lo implementa un adaptador del módulo Disciplines.
+type TeamRosterDirectory interface {
+	GetTeamRoster(ctx context.Context, clubID string, teamID uuid.UUID) (*TeamRoster, error)
+}
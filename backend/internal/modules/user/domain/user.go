@@ -67,6 +67,7 @@ const (
 	RoleMember       = "MEMBER"
 	RoleCoach        = "COACH"
 	RoleMedicalStaff = "MEDICAL_STAFF" // GDPR Article 9 - Special category data access
+	RoleSystem       = "SYSTEM"        // Background jobs and maintenance commands, never assigned to users
 )
 
 type MedicalCertStatus string
@@ -85,6 +86,32 @@ func (u *User) CalculateCategory() string {
 	return u.DateOfBirth.Format("2006")
 }
 
+// AdultAge is the age from which a member no longer needs parental authorization
+const AdultAge = 18
+
+// IsMinor reports whether the user is under AdultAge at the given time. Without a birth date,
+// a member linked to a parent account is considered a minor.
+func (u *User) IsMinor(at time.Time) bool {
+	if u.DateOfBirth == nil {
+		return u.ParentID != nil
+	}
+	return u.DateOfBirth.AddDate(AdultAge, 0, 0).After(at)
+}
+
+// SensitiveDataVisibleTo reports whether the caller may read the encrypted fields
+// (emergency contacts, insurance number): the member, their parent, staff that needs them
+// during activities, and internal processes.
+func (u *User) SensitiveDataVisibleTo(accessorID, role string) bool {
+	if accessorID != "" && (accessorID == u.ID || (u.ParentID != nil && *u.ParentID == accessorID)) {
+		return true
+	}
+	switch role {
+	case RoleSuperAdmin, RoleAdmin, RoleCoach, RoleMedicalStaff, RoleSystem:
+		return true
+	}
+	return false
+}
+
 type UserRepository interface {
 	GetByID(ctx context.Context, clubID, id string) (*User, error)
 	// Update updates the non-auth fields of the user
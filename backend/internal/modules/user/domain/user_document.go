@@ -16,6 +16,8 @@ const (
 	DocumentTypeEMMACMedical DocumentType = "EMMAC_MEDICAL"
 	DocumentTypeLeagueForm   DocumentType = "LEAGUE_FORM"
 	DocumentTypeInsurance    DocumentType = "INSURANCE"
+	// Autorización firmada por el padre/tutor para que un menor compita
+	DocumentTypeParentalConsent DocumentType = "PARENTAL_CONSENT"
 )
 
 // DocumentStatus representa el estado de validación de un documento
@@ -30,19 +32,28 @@ const (
 
 // UserDocument representa un documento subido por un usuario (DNI, apto médico, etc.)
 type UserDocument struct {
-	ID             uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	ClubID         string         `json:"club_id" gorm:"index;not null"`
-	UserID         string         `json:"user_id" gorm:"index;not null"`
-	Type           DocumentType   `json:"type" gorm:"not null"`
-	FileURL        string         `json:"file_url" gorm:"not null"`
-	Status         DocumentStatus `json:"status" gorm:"default:'PENDING'"`
-	ExpirationDate *time.Time     `json:"expiration_date,omitempty"`
-	RejectionNotes string         `json:"rejection_notes,omitempty"`
-	UploadedAt     time.Time      `json:"uploaded_at" gorm:"autoCreateTime"`
-	ValidatedAt    *time.Time     `json:"validated_at,omitempty"`
-	ValidatedBy    *string        `json:"validated_by,omitempty"` // Admin UserID
-	CreatedAt      time.Time      `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt      time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	ID              uuid.UUID       `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ClubID          string          `json:"club_id" gorm:"index;not null"`
+	UserID          string          `json:"user_id" gorm:"index;not null"`
+	Type            DocumentType    `json:"type" gorm:"not null"`
+	FileURL         string          `json:"file_url" gorm:"not null"` // Endpoint autenticado que sirve el archivo
+	StorageKey      string          `json:"-"`                        // Clave del objeto en el FileStorage
+	ContentType     string          `json:"content_type,omitempty"`
+	SizeBytes       int64           `json:"size_bytes,omitempty"`
+	FileName        string          `json:"file_name,omitempty"` // Nombre original del archivo subido
+	Encrypted       bool            `json:"encrypted"`           // Archivo cifrado con la clave de datos del club
+	Status          DocumentStatus  `json:"status" gorm:"default:'PENDING'"`
+	ExpirationDate  *time.Time      `json:"expiration_date,omitempty"`
+	RejectionNotes  string          `json:"rejection_notes,omitempty"`
+	RejectionReason RejectionReason `json:"rejection_reason,omitempty"`
+	ReviewerID      *string         `json:"reviewer_id,omitempty"` // Revisor que tomó el documento de la cola
+	ClaimedAt       *time.Time      `json:"claimed_at,omitempty"`
+	EscalatedAt     *time.Time      `json:"escalated_at,omitempty"` // Aviso de SLA vencido enviado
+	UploadedAt      time.Time       `json:"uploaded_at" gorm:"autoCreateTime"`
+	ValidatedAt     *time.Time      `json:"validated_at,omitempty"`
+	ValidatedBy     *string         `json:"validated_by,omitempty"` // Admin UserID
+	CreatedAt       time.Time       `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time       `json:"updated_at" gorm:"autoUpdateTime"`
 }
 
 // TableName especifica el nombre de la tabla en la base de datos
@@ -83,6 +94,65 @@ func (d *UserDocument) CanBeValidated() bool {
 	return d.Status == DocumentStatusPending
 }
 
+// IsHealthData indica si el documento es un dato de salud (GDPR Art. 9) y su acceso debe auditarse
+func (d *UserDocument) IsHealthData() bool {
+	return d.Type == DocumentTypeEMMACMedical
+}
+
+// EncryptedDocumentTypes son los tipos cuyo archivo se guarda cifrado: aptos médicos y datos del seguro
+var EncryptedDocumentTypes = []DocumentType{DocumentTypeEMMACMedical, DocumentTypeInsurance}
+
+// RequiresEncryption indica si el archivo se guarda cifrado (ver EncryptedDocumentTypes)
+func (d *UserDocument) RequiresEncryption() bool {
+	for _, t := range EncryptedDocumentTypes {
+		if d.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+// CanBeAccessedBy verifica si un usuario puede ver el archivo del documento.
+// El titular siempre puede; los certificados médicos solo los ve personal médico o SUPER_ADMIN,
+// el resto de la documentación también administradores y entrenadores.
+func (d *UserDocument) CanBeAccessedBy(userID, role string) bool {
+	if d.UserID == userID || role == RoleSuperAdmin || role == RoleMedicalStaff {
+		return true
+	}
+	if d.IsHealthData() {
+		return false
+	}
+	return role == RoleAdmin || role == RoleCoach
+}
+
+// Label retorna el nombre legible del tipo de documento
+func (t DocumentType) Label() string {
+	switch t {
+	case DocumentTypeDNIFront:
+		return "DNI (Frente)"
+	case DocumentTypeDNIBack:
+		return "DNI (Dorso)"
+	case DocumentTypeEMMACMedical:
+		return "Apto Médico (EMMAC)"
+	case DocumentTypeLeagueForm:
+		return "Formulario de Liga"
+	case DocumentTypeInsurance:
+		return "Seguro"
+	case DocumentTypeParentalConsent:
+		return "Autorización Parental"
+	}
+	return string(t)
+}
+
+// IsValidDocumentType verifica que el tipo de documento sea uno de los soportados
+func IsValidDocumentType(t DocumentType) bool {
+	switch t {
+	case DocumentTypeDNIFront, DocumentTypeDNIBack, DocumentTypeEMMACMedical, DocumentTypeLeagueForm, DocumentTypeInsurance, DocumentTypeParentalConsent:
+		return true
+	}
+	return false
+}
+
 // UserDocumentRepository define las operaciones de persistencia para documentos de usuario
 type UserDocumentRepository interface {
 	Create(ctx context.Context, doc *UserDocument) error
@@ -99,4 +169,9 @@ type UserDocumentRepository interface {
 	// Operaciones masivas
 	GetAllByType(ctx context.Context, clubID string, docType DocumentType) ([]UserDocument, error)
 	GetPendingValidation(ctx context.Context, clubID string) ([]UserDocument, error)
+
+	// Cola de revisión: toma el documento si está pendiente y libre (o con la toma vencida)
+	ClaimForReview(ctx context.Context, clubID string, id uuid.UUID, reviewerID string, now time.Time) (bool, error)
+	// Guarda la decisión solo si el documento sigue pendiente y nadie más lo tiene tomado
+	SaveReview(ctx context.Context, doc *UserDocument, now time.Time) (bool, error)
 }
@@ -34,3 +34,15 @@ func TestCalculateCategory_NoDateOfBirth(t *testing.T) {
 	// Validation
 	assert.Equal(t, "Files", category, "Default category should be 'Files'")
 }
+
+func TestIsMinor(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	seventeen := time.Date(2008, 3, 11, 0, 0, 0, 0, time.UTC)
+	eighteen := time.Date(2008, 3, 10, 0, 0, 0, 0, time.UTC)
+	parentID := "parent-1"
+
+	assert.True(t, (&domain.User{DateOfBirth: &seventeen}).IsMinor(now))
+	assert.False(t, (&domain.User{DateOfBirth: &eighteen}).IsMinor(now))
+	assert.True(t, (&domain.User{ParentID: &parentID}).IsMinor(now), "Linked to a parent without birth date")
+	assert.False(t, (&domain.User{}).IsMinor(now))
+}
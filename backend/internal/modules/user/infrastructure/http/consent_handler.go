@@ -0,0 +1,175 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// ConsentHandler maneja las políticas versionadas y los consentimientos de los socios
+type ConsentHandler struct {
+	service *application.ConsentService
+}
+
+// NewConsentHandler crea una nueva instancia del handler
+func NewConsentHandler(service *application.ConsentService) *ConsentHandler {
+	return &ConsentHandler{service: service}
+}
+
+func consentErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrConsentPolicyNotFound),
+		errors.Is(err, domain.ErrConsentNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, application.ErrConsentForbidden),
+		errors.Is(err, domain.ErrParentConsentRequired):
+		return http.StatusForbidden
+	case errors.Is(err, domain.ErrConsentPolicyExists),
+		errors.Is(err, domain.ErrConsentPolicyNotCurrent),
+		errors.Is(err, domain.ErrMandatoryConsent):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrInvalidConsentPolicy),
+		errors.Is(err, domain.ErrMarketingNotMandatory):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// PublishPolicy registra una nueva versión de política (administradores)
+// POST /consent-policies
+func (h *ConsentHandler) PublishPolicy(c *gin.Context) {
+	var input application.PublishConsentPolicyInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	policy, err := h.service.PublishPolicy(c.Request.Context(), documentAccess(c), input)
+	if err != nil {
+		c.JSON(consentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, policy)
+}
+
+// ListPolicies devuelve las versiones vigentes de cada política
+// GET /consent-policies?history=true
+func (h *ConsentHandler) ListPolicies(c *gin.Context) {
+	policies, err := h.service.ListPolicies(c.Request.Context(), documentAccess(c), c.Query("history") == "true")
+	if err != nil {
+		c.JSON(consentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, policies)
+}
+
+// History devuelve los consentimientos del socio, de un hijo/a, o de cualquier socio para los administradores
+// GET /consents?user_id=
+func (h *ConsentHandler) History(c *gin.Context) {
+	records, err := h.service.History(c.Request.Context(), documentAccess(c), c.Query("user_id"))
+	if err != nil {
+		c.JSON(consentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, records)
+}
+
+// Pending devuelve las versiones obligatorias que faltan aceptar
+// GET /consents/pending?user_id=
+func (h *ConsentHandler) Pending(c *gin.Context) {
+	pending, err := h.service.Pending(c.Request.Context(), documentAccess(c), c.Query("user_id"))
+	if err != nil {
+		c.JSON(consentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, pending)
+}
+
+// AcceptConsentRequest identifica la versión aceptada. user_id lo usa el padre/madre para aceptar por su hijo/a.
+type AcceptConsentRequest struct {
+	PolicyID uuid.UUID `json:"policy_id" binding:"required"`
+	UserID   string    `json:"user_id"`
+}
+
+// Accept registra la aceptación de una política
+// POST /consents
+func (h *ConsentHandler) Accept(c *gin.Context) {
+	var req AcceptConsentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	record, err := h.service.Accept(c.Request.Context(), documentAccess(c), req.UserID, req.PolicyID)
+	if err != nil {
+		c.JSON(consentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, record)
+}
+
+// Revoke retira el consentimiento de un tipo
+// DELETE /consents/:type?user_id=
+func (h *ConsentHandler) Revoke(c *gin.Context) {
+	consentType := domain.ConsentType(c.Param("type"))
+	if !consentType.IsValid() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Tipo de consentimiento inválido"})
+		return
+	}
+	revoked, err := h.service.Revoke(c.Request.Context(), documentAccess(c), c.Query("user_id"), consentType)
+	if err != nil {
+		c.JSON(consentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, revoked)
+}
+
+// ConsentGate se agrega al AuthMiddleware: mientras el socio tenga pendiente una versión obligatoria
+// vigente, responde 403 CONSENT_REQUIRED con las políticas a aceptar. Las rutas de /consents,
+// /consent-policies y auth se registran sin el gate para poder resolverlo.
+// Si no se puede calcular lo pendiente se deja pasar: una caída de la base no debe bloquear a todos.
+func ConsentGate(service *application.ConsentService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, clubID := c.GetString("userID"), c.GetString("userClubID")
+		if userID == "" || clubID == "" || c.GetString("userRole") == domain.RoleSuperAdmin {
+			return
+		}
+		pending, err := service.PendingConsents(c.Request.Context(), clubID, userID)
+		if err != nil {
+			log.Printf("[ConsentGate] error calculando consentimientos pendientes de %s: %v", userID, err)
+			return
+		}
+		if len(pending.Policies) == 0 {
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+			"error":   "Debe aceptar las nuevas versiones de las políticas para continuar",
+			"type":    "CONSENT_REQUIRED",
+			"pending": pending,
+		})
+	}
+}
+
+// RegisterConsentRoutes registra las rutas de políticas y consentimientos.
+// authMiddleware no debe incluir el ConsentGate.
+func RegisterConsentRoutes(router *gin.RouterGroup, handler *ConsentHandler, authMiddleware, tenantMiddleware gin.HandlerFunc) {
+	policies := router.Group("/consent-policies")
+	policies.Use(authMiddleware, tenantMiddleware)
+	{
+		policies.GET("", handler.ListPolicies)
+		policies.POST("", handler.PublishPolicy)
+	}
+
+	consents := router.Group("/consents")
+	consents.Use(authMiddleware, tenantMiddleware)
+	{
+		consents.GET("", handler.History)
+		consents.GET("/pending", handler.Pending)
+		consents.POST("", handler.Accept)
+		consents.DELETE("/:type", handler.Revoke)
+	}
+}
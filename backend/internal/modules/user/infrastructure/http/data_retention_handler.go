@@ -0,0 +1,133 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// DataRetentionHandler maneja los plazos de conservación de datos y el historial del job de retención
+type DataRetentionHandler struct {
+	service *application.DataRetentionService
+}
+
+// NewDataRetentionHandler crea una nueva instancia del handler
+func NewDataRetentionHandler(service *application.DataRetentionService) *DataRetentionHandler {
+	return &DataRetentionHandler{service: service}
+}
+
+func dataRetentionErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrDataRetentionRunNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, application.ErrDataRetentionForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, domain.ErrInvalidRetentionCategory),
+		errors.Is(err, domain.ErrRetentionBelowMinimum):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ListPolicies devuelve los plazos vigentes de cada categoría
+// GET /data-retention/policies
+func (h *DataRetentionHandler) ListPolicies(c *gin.Context) {
+	policies, err := h.service.Policies(c.Request.Context(), documentAccess(c).ClubID)
+	if err != nil {
+		c.JSON(dataRetentionErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, policies)
+}
+
+// UpdateDataRetentionPolicyRequest contiene el plazo en días y si la categoría se aplica
+type UpdateDataRetentionPolicyRequest struct {
+	RetentionDays int   `json:"retention_days" binding:"required,min=1"`
+	Enabled       *bool `json:"enabled"`
+}
+
+// UpdatePolicy configura el plazo de una categoría
+// PUT /data-retention/policies/:category
+func (h *DataRetentionHandler) UpdatePolicy(c *gin.Context) {
+	var req UpdateDataRetentionPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	enabled := req.Enabled == nil || *req.Enabled
+	policy, err := h.service.UpdatePolicy(c.Request.Context(), documentAccess(c), domain.RetentionCategory(c.Param("category")), req.RetentionDays, enabled)
+	if err != nil {
+		c.JSON(dataRetentionErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, policy)
+}
+
+// DryRun informa qué se anonimizaría o borraría hoy, sin modificar datos
+// POST /data-retention/dry-run
+func (h *DataRetentionHandler) DryRun(c *gin.Context) {
+	h.trigger(c, true)
+}
+
+// Run aplica los plazos en el momento, sin esperar al job
+// POST /data-retention/runs
+func (h *DataRetentionHandler) Run(c *gin.Context) {
+	h.trigger(c, false)
+}
+
+func (h *DataRetentionHandler) trigger(c *gin.Context, dryRun bool) {
+	run, err := h.service.Trigger(c.Request.Context(), documentAccess(c), dryRun)
+	if err != nil {
+		c.JSON(dataRetentionErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, run)
+}
+
+// ListRuns devuelve el historial de ejecuciones (registro de auditoría de lo eliminado)
+// GET /data-retention/runs
+func (h *DataRetentionHandler) ListRuns(c *gin.Context) {
+	runs, err := h.service.Runs(c.Request.Context(), documentAccess(c))
+	if err != nil {
+		c.JSON(dataRetentionErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, runs)
+}
+
+// GetRun devuelve el reporte de una ejecución
+// GET /data-retention/runs/:id
+func (h *DataRetentionHandler) GetRun(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de ejecución inválido"})
+		return
+	}
+	run, err := h.service.GetRun(c.Request.Context(), documentAccess(c), id)
+	if err != nil {
+		c.JSON(dataRetentionErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, run)
+}
+
+// RegisterDataRetentionRoutes registra las rutas de retención de datos
+func RegisterDataRetentionRoutes(router *gin.RouterGroup, handler *DataRetentionHandler, authMiddleware, tenantMiddleware gin.HandlerFunc) {
+	retention := router.Group("/data-retention")
+	retention.Use(authMiddleware, tenantMiddleware)
+	{
+		retention.GET("/policies", handler.ListPolicies)
+
+		// Administradores
+		retention.PUT("/policies/:category", handler.UpdatePolicy)
+		retention.POST("/dry-run", handler.DryRun)
+		retention.POST("/runs", handler.Run)
+		retention.GET("/runs", handler.ListRuns)
+		retention.GET("/runs/:id", handler.GetRun)
+	}
+}
@@ -0,0 +1,250 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// DataSubjectRequestHandler maneja las solicitudes de derechos GDPR (exportación y supresión de datos)
+type DataSubjectRequestHandler struct {
+	service *application.DataSubjectRequestService
+}
+
+// NewDataSubjectRequestHandler crea una nueva instancia del handler
+func NewDataSubjectRequestHandler(service *application.DataSubjectRequestService) *DataSubjectRequestHandler {
+	return &DataSubjectRequestHandler{service: service}
+}
+
+// DataSubjectRequestResponse agrega a la solicitud el seguimiento del plazo legal
+type DataSubjectRequestResponse struct {
+	*domain.DataSubjectRequest
+	DaysRemaining    int  `json:"days_remaining"`
+	Overdue          bool `json:"overdue"`
+	ArchiveAvailable bool `json:"archive_available"`
+}
+
+func newDataSubjectRequestResponse(req *domain.DataSubjectRequest) DataSubjectRequestResponse {
+	now := time.Now()
+	return DataSubjectRequestResponse{
+		DataSubjectRequest: req,
+		DaysRemaining:      req.DaysRemaining(now),
+		Overdue:            req.IsOverdue(now),
+		ArchiveAvailable:   req.ArchiveAvailable(now),
+	}
+}
+
+func dataSubjectRequestErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrDataSubjectRequestNotFound),
+		errors.Is(err, domain.ErrDataArchiveNotAvailable):
+		return http.StatusNotFound
+	case errors.Is(err, application.ErrDataSubjectRequestForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, domain.ErrDataSubjectRequestOpen),
+		errors.Is(err, domain.ErrNotPendingVerification),
+		errors.Is(err, domain.ErrDataSubjectRequestClosed),
+		errors.Is(err, domain.ErrDataSubjectRequestNotFailed):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrInvalidDataSubjectRequest),
+		errors.Is(err, domain.ErrInvalidVerificationCode),
+		errors.Is(err, domain.ErrVerificationCodeExpired):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// dataSubjectRequestID lee el :id de la ruta; responde 400 si no es válido
+func dataSubjectRequestID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de solicitud inválido"})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// respond devuelve la solicitud o el error de la operación
+func (h *DataSubjectRequestHandler) respond(c *gin.Context, status int, req *domain.DataSubjectRequest, err error) {
+	if err != nil {
+		c.JSON(dataSubjectRequestErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(status, newDataSubjectRequestResponse(req))
+}
+
+// CreateDataSubjectRequest representa una solicitud de exportación o supresión.
+// user_id solo lo usan los administradores para cargar la solicitud de un socio.
+type CreateDataSubjectRequest struct {
+	Type   domain.DataSubjectRequestType `json:"type" binding:"required"`
+	UserID string                        `json:"user_id"`
+}
+
+// Create registra la solicitud y envía el código de verificación al email del titular
+// POST /privacy-requests
+func (h *DataSubjectRequestHandler) Create(c *gin.Context) {
+	var req CreateDataSubjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	created, err := h.service.Create(c.Request.Context(), documentAccess(c), req.UserID, req.Type)
+	h.respond(c, http.StatusCreated, created, err)
+}
+
+// List devuelve las solicitudes propias, o las del club para los administradores
+// GET /privacy-requests?status=&type=&user_id=
+func (h *DataSubjectRequestHandler) List(c *gin.Context) {
+	filter := domain.DataSubjectRequestFilter{
+		UserID: c.Query("user_id"),
+		Type:   domain.DataSubjectRequestType(c.Query("type")),
+	}
+	if status := c.Query("status"); status != "" {
+		filter.Statuses = []domain.DataSubjectRequestStatus{domain.DataSubjectRequestStatus(status)}
+	}
+
+	requests, err := h.service.List(c.Request.Context(), documentAccess(c), filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	response := make([]DataSubjectRequestResponse, len(requests))
+	for i := range requests {
+		response[i] = newDataSubjectRequestResponse(&requests[i])
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// Get devuelve el estado de una solicitud
+// GET /privacy-requests/:id
+func (h *DataSubjectRequestHandler) Get(c *gin.Context) {
+	id, ok := dataSubjectRequestID(c)
+	if !ok {
+		return
+	}
+	req, err := h.service.Get(c.Request.Context(), documentAccess(c), id)
+	h.respond(c, http.StatusOK, req, err)
+}
+
+// VerifyDataSubjectRequest contiene el código recibido por email
+type VerifyDataSubjectRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// Verify confirma la identidad del titular con el código y encola la solicitud
+// POST /privacy-requests/:id/verify
+func (h *DataSubjectRequestHandler) Verify(c *gin.Context) {
+	id, ok := dataSubjectRequestID(c)
+	if !ok {
+		return
+	}
+	var body VerifyDataSubjectRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req, err := h.service.Verify(c.Request.Context(), documentAccess(c), id, body.Code)
+	h.respond(c, http.StatusOK, req, err)
+}
+
+// ResendCode envía un código de verificación nuevo
+// POST /privacy-requests/:id/resend-code
+func (h *DataSubjectRequestHandler) ResendCode(c *gin.Context) {
+	id, ok := dataSubjectRequestID(c)
+	if !ok {
+		return
+	}
+	req, err := h.service.ResendCode(c.Request.Context(), documentAccess(c), id)
+	h.respond(c, http.StatusOK, req, err)
+}
+
+// VerifyIdentity registra que un administrador verificó la identidad del titular (p. ej. con el DNI en secretaría)
+// POST /privacy-requests/:id/verify-identity
+func (h *DataSubjectRequestHandler) VerifyIdentity(c *gin.Context) {
+	id, ok := dataSubjectRequestID(c)
+	if !ok {
+		return
+	}
+	req, err := h.service.VerifyIdentity(c.Request.Context(), documentAccess(c), id)
+	h.respond(c, http.StatusOK, req, err)
+}
+
+// RejectDataSubjectRequest contiene el motivo del rechazo, que se comunica al titular
+type RejectDataSubjectRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// Reject cierra la solicitud sin procesarla
+// POST /privacy-requests/:id/reject
+func (h *DataSubjectRequestHandler) Reject(c *gin.Context) {
+	id, ok := dataSubjectRequestID(c)
+	if !ok {
+		return
+	}
+	var body RejectDataSubjectRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req, err := h.service.Reject(c.Request.Context(), documentAccess(c), id, body.Reason)
+	h.respond(c, http.StatusOK, req, err)
+}
+
+// Retry vuelve a encolar una solicitud fallida
+// POST /privacy-requests/:id/retry
+func (h *DataSubjectRequestHandler) Retry(c *gin.Context) {
+	id, ok := dataSubjectRequestID(c)
+	if !ok {
+		return
+	}
+	req, err := h.service.Retry(c.Request.Context(), documentAccess(c), id)
+	h.respond(c, http.StatusOK, req, err)
+}
+
+// DownloadArchive descarga el ZIP de una exportación terminada (solo el titular)
+// GET /privacy-requests/:id/archive
+func (h *DataSubjectRequestHandler) DownloadArchive(c *gin.Context) {
+	id, ok := dataSubjectRequestID(c)
+	if !ok {
+		return
+	}
+	body, req, err := h.service.OpenArchive(c.Request.Context(), documentAccess(c), id)
+	if err != nil {
+		c.JSON(dataSubjectRequestErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	defer body.Close()
+
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("mis_datos_%s.zip", req.CreatedAt.Format("20060102"))))
+	c.Header("Cache-Control", "private, no-store")
+	c.Status(http.StatusOK)
+	_, _ = io.Copy(c.Writer, body)
+}
+
+// RegisterDataSubjectRequestRoutes registra las rutas de solicitudes de derechos
+func RegisterDataSubjectRequestRoutes(router *gin.RouterGroup, handler *DataSubjectRequestHandler, authMiddleware, tenantMiddleware gin.HandlerFunc) {
+	requests := router.Group("/privacy-requests")
+	requests.Use(authMiddleware, tenantMiddleware)
+	{
+		requests.POST("", handler.Create)
+		requests.GET("", handler.List)
+		requests.GET("/:id", handler.Get)
+		requests.POST("/:id/verify", handler.Verify)
+		requests.POST("/:id/resend-code", handler.ResendCode)
+		requests.GET("/:id/archive", handler.DownloadArchive)
+
+		// Administradores
+		requests.POST("/:id/verify-identity", handler.VerifyIdentity)
+		requests.POST("/:id/reject", handler.Reject)
+		requests.POST("/:id/retry", handler.Retry)
+	}
+}
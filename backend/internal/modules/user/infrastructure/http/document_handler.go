@@ -1,6 +1,9 @@
 package http
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -8,37 +11,96 @@ import (
 	"github.com/google/uuid"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/storage"
 )
 
 // DocumentHandler maneja las peticiones HTTP relacionadas con documentos de usuario
 type DocumentHandler struct {
 	docRepo            domain.UserDocumentRepository
 	eligibilityService *application.EligibilityService
+	storageService     *application.DocumentStorageService
+	reviewService      *application.DocumentReviewService
+	downloadPath       string // Ruta pública de los links firmados, se completa al registrar las rutas
 }
 
 // NewDocumentHandler crea una nueva instancia del handler
-func NewDocumentHandler(docRepo domain.UserDocumentRepository, eligibilityService *application.EligibilityService) *DocumentHandler {
+func NewDocumentHandler(docRepo domain.UserDocumentRepository, eligibilityService *application.EligibilityService, storageService *application.DocumentStorageService, reviewService *application.DocumentReviewService) *DocumentHandler {
 	return &DocumentHandler{
 		docRepo:            docRepo,
 		eligibilityService: eligibilityService,
+		storageService:     storageService,
+		reviewService:      reviewService,
+		downloadPath:       "/documents/download",
 	}
 }
 
+// documentAccess arma los datos de quien accede para el control de acceso y la auditoría
+func documentAccess(c *gin.Context) application.DocumentAccess {
+	return application.DocumentAccess{
+		ClubID:    c.GetString("clubID"),
+		UserID:    c.GetString("userID"),
+		Role:      c.GetString("userRole"),
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+}
+
+func documentErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, application.ErrDocumentNotFound),
+		errors.Is(err, application.ErrDocumentFileMissing):
+		return http.StatusNotFound
+	case errors.Is(err, application.ErrDocumentAccessDenied):
+		return http.StatusForbidden
+	case errors.Is(err, application.ErrDocumentTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, application.ErrUnsupportedDocumentType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, application.ErrInvalidDocumentType),
+		errors.Is(err, application.ErrDocumentEmpty),
+		errors.Is(err, application.ErrDocumentInfected):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, storage.ErrInvalidToken),
+		errors.Is(err, storage.ErrTokenExpired):
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// streamDocument envía el archivo del documento con su tipo y nombre original
+func streamDocument(c *gin.Context, body io.ReadCloser, doc *domain.UserDocument) {
+	defer body.Close()
+	fileName := doc.FileName
+	if fileName == "" {
+		fileName = string(doc.Type)
+	}
+	contentType := doc.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=%q", fileName))
+	c.Header("Cache-Control", "private, no-store")
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.DataFromReader(http.StatusOK, doc.SizeBytes, contentType, body, nil)
+}
+
 // UploadDocumentRequest representa la solicitud de upload de documento
 type UploadDocumentRequest struct {
 	Type           string  `form:"type" binding:"required"`
 	ExpirationDate *string `form:"expiration_date"` // Formato: YYYY-MM-DD
 }
 
-// UploadDocument maneja el upload de un documento
-// POST /users/:userId/documents
+// UploadDocument maneja el upload de un documento. El archivo se valida (PDF, JPG o PNG y tamaño máximo),
+// pasa por el antivirus y se guarda en el storage configurado.
+// POST /users/:id/documents
 func (h *DocumentHandler) UploadDocument(c *gin.Context) {
-	clubID := c.GetString("club_id")
-	userID := c.Param("userId")
-	currentUserID := c.GetString("user_id")
+	clubID := c.GetString("clubID")
+	userID := c.Param("id")
+	currentUserID := c.GetString("userID")
 
 	// Verificar que el usuario solo puede subir sus propios documentos (a menos que sea admin)
-	role := c.GetString("role")
+	role := c.GetString("userRole")
 	if userID != currentUserID && role != "ADMIN" && role != "SUPER_ADMIN" {
 		c.JSON(http.StatusForbidden, gin.H{"error": "No tienes permiso para subir documentos de otro usuario"})
 		return
@@ -57,10 +119,6 @@ func (h *DocumentHandler) UploadDocument(c *gin.Context) {
 		return
 	}
 
-	// TODO: Subir archivo a MinIO/S3
-	// Por ahora, guardamos la ruta local como placeholder
-	fileURL := "/uploads/documents/" + uuid.New().String() + "_" + file.Filename
-
 	// Parsear fecha de vencimiento si existe
 	var expirationDate *time.Time
 	if req.ExpirationDate != nil && *req.ExpirationDate != "" {
@@ -72,18 +130,24 @@ func (h *DocumentHandler) UploadDocument(c *gin.Context) {
 		expirationDate = &parsed
 	}
 
-	// Crear documento
-	doc := &domain.UserDocument{
+	body, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No se pudo leer el archivo"})
+		return
+	}
+	defer body.Close()
+
+	doc, err := h.storageService.Upload(c.Request.Context(), application.UploadDocumentInput{
 		ClubID:         clubID,
 		UserID:         userID,
 		Type:           domain.DocumentType(req.Type),
-		FileURL:        fileURL,
-		Status:         domain.DocumentStatusPending,
 		ExpirationDate: expirationDate,
-	}
-
-	if err := h.docRepo.Create(c.Request.Context(), doc); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error al guardar documento"})
+		FileName:       file.Filename,
+		Size:           file.Size,
+		Body:           body,
+	})
+	if err != nil {
+		c.JSON(documentErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -91,10 +155,10 @@ func (h *DocumentHandler) UploadDocument(c *gin.Context) {
 }
 
 // ListDocuments lista todos los documentos de un usuario
-// GET /users/:userId/documents
+// GET /users/:id/documents
 func (h *DocumentHandler) ListDocuments(c *gin.Context) {
-	clubID := c.GetString("club_id")
-	userID := c.Param("userId")
+	clubID := c.GetString("clubID")
+	userID := c.Param("id")
 
 	docs, err := h.docRepo.GetByUserID(c.Request.Context(), clubID, userID)
 	if err != nil {
@@ -105,70 +169,84 @@ func (h *DocumentHandler) ListDocuments(c *gin.Context) {
 	c.JSON(http.StatusOK, docs)
 }
 
-// GetDocument obtiene un documento específico
-// GET /users/:userId/documents/:docId
+// GetDocument obtiene un documento específico junto con un link de descarga de corta duración
+// GET /users/:id/documents/:docId
 // GDPR Article 9: Special category data (health) requires stricter access control
 func (h *DocumentHandler) GetDocument(c *gin.Context) {
-	clubID := c.GetString("club_id")
-	docID := c.Param("docId")
-	userID := c.Param("userId")
-	currentUserID := c.GetString("user_id")
-	role := c.GetString("role")
-
-	docUUID, err := uuid.Parse(docID)
+	docUUID, err := uuid.Parse(c.Param("docId"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de documento inválido"})
 		return
 	}
 
-	doc, err := h.docRepo.GetByID(c.Request.Context(), clubID, docUUID)
+	// Los certificados médicos de otros usuarios solo los ve personal médico o SUPER_ADMIN;
+	// cada consulta queda registrada en el log de accesos a datos de salud
+	access := documentAccess(c)
+	doc, err := h.storageService.GetDocument(c.Request.Context(), access, c.Param("id"), docUUID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Documento no encontrado"})
+		c.JSON(documentErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
-	// GDPR Article 9: Strict access control for health data
-	if doc.Type == domain.DocumentTypeEMMACMedical {
-		// Only allow access if:
-		// 1. User is viewing their own document
-		// 2. User has MEDICAL_STAFF role
-		// 3. User has SUPER_ADMIN role
-		isOwner := userID == currentUserID || doc.UserID == currentUserID
-		isMedicalStaff := role == domain.RoleMedicalStaff
-		isSuperAdmin := role == domain.RoleSuperAdmin
-
-		if !isOwner && !isMedicalStaff && !isSuperAdmin {
-			c.JSON(http.StatusForbidden, gin.H{
-				"error":   "Acceso restringido a datos médicos",
-				"details": "Solo personal médico autorizado puede acceder a certificados médicos de otros usuarios",
-			})
-			return
-		}
+	response := gin.H{"document": doc}
+	if doc.StorageKey != "" {
+		token, expiresAt := h.storageService.SignDownload(access, doc)
+		response["download_url"] = h.downloadPath + "?token=" + token
+		response["download_expires_at"] = expiresAt
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// DownloadDocumentFile envía el archivo del documento
+// GET /users/:id/documents/:docId/file
+func (h *DocumentHandler) DownloadDocumentFile(c *gin.Context) {
+	docUUID, err := uuid.Parse(c.Param("docId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de documento inválido"})
+		return
+	}
+
+	body, doc, err := h.storageService.OpenFile(c.Request.Context(), documentAccess(c), c.Param("id"), docUUID)
+	if err != nil {
+		c.JSON(documentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	streamDocument(c, body, doc)
+}
 
-		// TODO: Log health data access for GDPR compliance audit
-		// h.auditQueue.LogHealthDataAccess(c.Request.Context(), currentUserID, doc.UserID, docUUID, "VIEW")
+// DownloadSignedDocument envía el archivo a partir de un link firmado, sin sesión (para visores y <img>)
+// GET /documents/download?token=...
+func (h *DocumentHandler) DownloadSignedDocument(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Token requerido"})
+		return
 	}
 
-	c.JSON(http.StatusOK, doc)
+	body, doc, err := h.storageService.OpenSignedFile(c.Request.Context(), token, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		c.JSON(documentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	streamDocument(c, body, doc)
 }
 
 // ValidateDocumentRequest representa la solicitud de validación
 type ValidateDocumentRequest struct {
-	Approve bool   `json:"approve" binding:"required"`
+	Approve *bool  `json:"approve" binding:"required"`
 	Notes   string `json:"notes"`
 }
 
-// ValidateDocument valida o rechaza un documento
-// PUT /users/:userId/documents/:docId/validate
+// legacyRejectionNotes es la observación de un rechazo sin notas por la ruta anterior a la cola de revisión
+const legacyRejectionNotes = "Rechazado por el revisor"
+
+// ValidateDocument valida o rechaza un documento. Mantiene el contrato anterior a la cola de revisión
+// (approve + notes, errores 400): un rechazo se registra con el motivo OTHER y las notas como observación.
+// Los certificados médicos solo los revisa personal médico.
+// PUT /users/:id/documents/:docId/validate
 func (h *DocumentHandler) ValidateDocument(c *gin.Context) {
-	clubID := c.GetString("club_id")
-	docID := c.Param("docId")
-	validatorID := c.GetString("user_id")
-
-	// Solo admins pueden validar documentos
-	role := c.GetString("role")
-	if role != "ADMIN" && role != "SUPER_ADMIN" {
-		c.JSON(http.StatusForbidden, gin.H{"error": "Solo administradores pueden validar documentos"})
+	docID, ok := reviewDocumentID(c)
+	if !ok {
 		return
 	}
 
@@ -178,58 +256,60 @@ func (h *DocumentHandler) ValidateDocument(c *gin.Context) {
 		return
 	}
 
-	err := h.eligibilityService.ValidateDocument(c.Request.Context(), clubID, docID, validatorID, req.Approve, req.Notes)
+	decision := application.ReviewDecision{Approve: *req.Approve, Notes: req.Notes}
+	if !decision.Approve {
+		decision.Reason = domain.RejectionOther
+		if decision.Notes == "" {
+			decision.Notes = legacyRejectionNotes
+		}
+	}
+
+	doc, err := h.reviewService.Review(c.Request.Context(), documentAccess(c), docID, decision)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		status := http.StatusBadRequest
+		if errors.Is(err, domain.ErrDocumentReviewForbidden) {
+			status = http.StatusForbidden
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
 		return
 	}
 
-	// Obtener el documento actualizado
-	docUUID, _ := uuid.Parse(docID)
-	doc, _ := h.docRepo.GetByID(c.Request.Context(), clubID, docUUID)
-
 	c.JSON(http.StatusOK, gin.H{
 		"message":  "Documento validado exitosamente",
 		"document": doc,
 	})
 }
 
-// DeleteDocument elimina un documento
-// DELETE /users/:userId/documents/:docId
+// DeleteDocument elimina un documento y su archivo
+// DELETE /users/:id/documents/:docId
 func (h *DocumentHandler) DeleteDocument(c *gin.Context) {
-	clubID := c.GetString("club_id")
-	userID := c.Param("userId")
-	docID := c.Param("docId")
-	currentUserID := c.GetString("user_id")
-
-	// Verificar permisos
-	role := c.GetString("role")
-	if userID != currentUserID && role != "ADMIN" && role != "SUPER_ADMIN" {
-		c.JSON(http.StatusForbidden, gin.H{"error": "No tienes permiso para eliminar documentos de otro usuario"})
-		return
-	}
-
-	docUUID, err := uuid.Parse(docID)
+	docUUID, err := uuid.Parse(c.Param("docId"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de documento inválido"})
 		return
 	}
 
-	if err := h.docRepo.Delete(c.Request.Context(), clubID, docUUID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error al eliminar documento"})
+	if err := h.storageService.DeleteDocument(c.Request.Context(), documentAccess(c), c.Param("id"), docUUID); err != nil {
+		c.JSON(documentErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{"message": "Documento eliminado exitosamente"})
 }
 
-// CheckEligibility verifica la elegibilidad de un usuario
-// GET /users/:userId/eligibility
+// CheckEligibility verifica la elegibilidad de un usuario con la regla de la disciplina y categoría
+// GET /users/:id/eligibility?discipline_id=&category=
 func (h *DocumentHandler) CheckEligibility(c *gin.Context) {
-	clubID := c.GetString("club_id")
-	userID := c.Param("userId")
+	clubID := c.GetString("clubID")
+	userID := c.Param("id")
+
+	scope, err := eligibilityScope(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 
-	result, err := h.eligibilityService.CheckEligibility(c.Request.Context(), clubID, userID)
+	result, err := h.eligibilityService.CheckEligibilityFor(c.Request.Context(), clubID, userID, scope)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error al verificar elegibilidad"})
 		return
@@ -239,10 +319,10 @@ func (h *DocumentHandler) CheckEligibility(c *gin.Context) {
 }
 
 // GetDocumentSummary obtiene un resumen del estado de los documentos
-// GET /users/:userId/documents/summary
+// GET /users/:id/documents/summary
 func (h *DocumentHandler) GetDocumentSummary(c *gin.Context) {
-	clubID := c.GetString("club_id")
-	userID := c.Param("userId")
+	clubID := c.GetString("clubID")
+	userID := c.Param("id")
 
 	summary, err := h.eligibilityService.GetDocumentSummary(c.Request.Context(), clubID, userID)
 	if err != nil {
@@ -253,20 +333,49 @@ func (h *DocumentHandler) GetDocumentSummary(c *gin.Context) {
 	c.JSON(http.StatusOK, summary)
 }
 
-// RegisterDocumentRoutes registra las rutas de documentos
-func RegisterDocumentRoutes(router *gin.RouterGroup, handler *DocumentHandler, authMiddleware gin.HandlerFunc) {
+// RegisterDocumentRoutes registra las rutas de documentos.
+// El parámetro del usuario es :id para convivir con las rutas /users/:id del módulo.
+func RegisterDocumentRoutes(router *gin.RouterGroup, handler *DocumentHandler, authMiddleware, tenantMiddleware gin.HandlerFunc) {
 	users := router.Group("/users")
-	users.Use(authMiddleware)
+	users.Use(authMiddleware, tenantMiddleware)
 	{
 		// Rutas de documentos
-		users.POST("/:userId/documents", handler.UploadDocument)
-		users.GET("/:userId/documents", handler.ListDocuments)
-		users.GET("/:userId/documents/summary", handler.GetDocumentSummary)
-		users.GET("/:userId/documents/:docId", handler.GetDocument)
-		users.PUT("/:userId/documents/:docId/validate", handler.ValidateDocument)
-		users.DELETE("/:userId/documents/:docId", handler.DeleteDocument)
+		users.POST("/:id/documents", handler.UploadDocument)
+		users.GET("/:id/documents", handler.ListDocuments)
+		users.GET("/:id/documents/summary", handler.GetDocumentSummary)
+		users.GET("/:id/documents/:docId", handler.GetDocument)
+		users.GET("/:id/documents/:docId/file", handler.DownloadDocumentFile)
+		users.PUT("/:id/documents/:docId/validate", handler.ValidateDocument)
+		users.DELETE("/:id/documents/:docId", handler.DeleteDocument)
 
 		// Elegibilidad
-		users.GET("/:userId/eligibility", handler.CheckEligibility)
+		users.GET("/:id/eligibility", handler.CheckEligibility)
 	}
+
+	// Cola de revisión de documentos (personal médico y administradores)
+	reviews := router.Group("/document-reviews")
+	reviews.Use(authMiddleware, tenantMiddleware)
+	{
+		reviews.GET("", handler.ListReviewQueue)
+		reviews.GET("/reasons", handler.ListRejectionReasons)
+		reviews.POST("/bulk-validate", handler.BulkValidateDocuments)
+		reviews.POST("/:docId/claim", handler.ClaimDocument)
+		reviews.DELETE("/:docId/claim", handler.ReleaseDocument)
+		reviews.GET("/:docId/history", handler.GetDocumentHistory)
+		reviews.POST("/:docId/review", handler.ReviewDocument)
+	}
+
+	// Requisitos de elegibilidad por disciplina y categoría
+	rules := router.Group("/eligibility-rules")
+	rules.Use(authMiddleware, tenantMiddleware)
+	{
+		rules.GET("", handler.ListEligibilityRules)
+		rules.POST("", handler.CreateEligibilityRule)
+		rules.PUT("/:id", handler.UpdateEligibilityRule)
+		rules.DELETE("/:id", handler.DeleteEligibilityRule)
+	}
+
+	// Links firmados de corta duración: el token reemplaza a la sesión
+	handler.downloadPath = router.BasePath() + "/documents/download"
+	router.GET("/documents/download", handler.DownloadSignedDocument)
 }
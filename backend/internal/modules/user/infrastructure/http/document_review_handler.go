@@ -0,0 +1,178 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+func documentReviewErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrDocumentReviewForbidden):
+		return http.StatusForbidden
+	case application.IsReviewConflict(err):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrInvalidRejectionReason),
+		errors.Is(err, domain.ErrRejectionNotesRequired):
+		return http.StatusBadRequest
+	}
+	return documentErrorStatus(err)
+}
+
+// reviewDocumentID lee el :docId de la ruta; responde 400 si no es válido
+func reviewDocumentID(c *gin.Context) (uuid.UUID, bool) {
+	docID, err := uuid.Parse(c.Param("docId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de documento inválido"})
+		return uuid.Nil, false
+	}
+	return docID, true
+}
+
+// ListReviewQueue lista los documentos pendientes que quien consulta puede revisar, ordenados por vencimiento del SLA
+// GET /document-reviews?type=&mine=true&overdue=true
+func (h *DocumentHandler) ListReviewQueue(c *gin.Context) {
+	access := documentAccess(c)
+	if access.Role != domain.RoleAdmin && access.Role != domain.RoleSuperAdmin && access.Role != domain.RoleMedicalStaff {
+		c.JSON(http.StatusForbidden, gin.H{"error": domain.ErrDocumentReviewForbidden.Error()})
+		return
+	}
+
+	items, err := h.reviewService.Queue(c.Request.Context(), access, application.ReviewQueueFilter{
+		Type:        domain.DocumentType(c.Query("type")),
+		OnlyMine:    c.Query("mine") == "true",
+		OnlyOverdue: c.Query("overdue") == "true",
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, items)
+}
+
+// ListRejectionReasons devuelve los motivos de rechazo estandarizados
+// GET /document-reviews/reasons
+func (h *DocumentHandler) ListRejectionReasons(c *gin.Context) {
+	c.JSON(http.StatusOK, domain.RejectionReasons())
+}
+
+// ClaimDocument toma un documento para revisarlo, así dos revisores no trabajan sobre el mismo
+// POST /document-reviews/:docId/claim
+func (h *DocumentHandler) ClaimDocument(c *gin.Context) {
+	docID, ok := reviewDocumentID(c)
+	if !ok {
+		return
+	}
+
+	doc, err := h.reviewService.Claim(c.Request.Context(), documentAccess(c), docID)
+	if err != nil {
+		c.JSON(documentReviewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"document":         doc,
+		"claim_expires_at": doc.ClaimedAt.Add(domain.ReviewClaimTTL),
+	})
+}
+
+// ReleaseDocument devuelve el documento a la cola
+// DELETE /document-reviews/:docId/claim
+func (h *DocumentHandler) ReleaseDocument(c *gin.Context) {
+	docID, ok := reviewDocumentID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.reviewService.Release(c.Request.Context(), documentAccess(c), docID); err != nil {
+		c.JSON(documentReviewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Documento liberado"})
+}
+
+// GetDocumentHistory devuelve las versiones anteriores del mismo tipo de documento del socio
+// GET /document-reviews/:docId/history
+func (h *DocumentHandler) GetDocumentHistory(c *gin.Context) {
+	docID, ok := reviewDocumentID(c)
+	if !ok {
+		return
+	}
+
+	history, err := h.reviewService.History(c.Request.Context(), documentAccess(c), docID)
+	if err != nil {
+		c.JSON(documentReviewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, history)
+}
+
+// ReviewDocumentRequest representa la decisión del revisor. Al rechazar, reason es obligatorio.
+type ReviewDocumentRequest struct {
+	Approve        *bool                  `json:"approve" binding:"required"`
+	Reason         domain.RejectionReason `json:"reason"`
+	Notes          string                 `json:"notes"`
+	ExpirationDate *string                `json:"expiration_date"` // Formato: YYYY-MM-DD
+}
+
+// ReviewDocument aprueba o rechaza un documento de la cola
+// POST /document-reviews/:docId/review
+func (h *DocumentHandler) ReviewDocument(c *gin.Context) {
+	docID, ok := reviewDocumentID(c)
+	if !ok {
+		return
+	}
+
+	var req ReviewDocumentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	decision := application.ReviewDecision{Approve: *req.Approve, Reason: req.Reason, Notes: req.Notes}
+	if req.ExpirationDate != nil && *req.ExpirationDate != "" {
+		parsed, err := time.Parse("2006-01-02", *req.ExpirationDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de fecha inválido. Use YYYY-MM-DD"})
+			return
+		}
+		decision.ExpirationDate = &parsed
+	}
+
+	doc, err := h.reviewService.Review(c.Request.Context(), documentAccess(c), docID, decision)
+	if err != nil {
+		c.JSON(documentReviewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	message := "Documento validado exitosamente"
+	if !decision.Approve {
+		message = "Documento rechazado"
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":  message,
+		"document": doc,
+	})
+}
+
+// BulkValidateRequest representa la validación masiva de documentos
+type BulkValidateRequest struct {
+	DocumentIDs []uuid.UUID `json:"document_ids" binding:"required,min=1,max=100"`
+}
+
+// BulkValidateDocuments aprueba varios documentos a la vez; informa los que no se pudieron validar
+// POST /document-reviews/bulk-validate
+func (h *DocumentHandler) BulkValidateDocuments(c *gin.Context) {
+	var req BulkValidateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result := h.reviewService.BulkValidate(c.Request.Context(), documentAccess(c), req.DocumentIDs)
+	c.JSON(http.StatusOK, result)
+}
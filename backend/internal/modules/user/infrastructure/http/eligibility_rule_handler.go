@@ -0,0 +1,122 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// eligibilityScope lee la disciplina y categoría opcionales de la query (?discipline_id=&category=)
+func eligibilityScope(c *gin.Context) (domain.EligibilityScope, error) {
+	scope := domain.EligibilityScope{Category: c.Query("category")}
+	if raw := c.Query("discipline_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return scope, errors.New("discipline_id inválido")
+		}
+		scope.DisciplineID = &id
+	}
+	return scope, nil
+}
+
+func eligibilityRuleErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, application.ErrEligibilityRuleNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, application.ErrInvalidEligibilityRule):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrEligibilityRuleExists):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
+func canManageEligibilityRules(c *gin.Context) bool {
+	role := c.GetString("userRole")
+	return role == domain.RoleAdmin || role == domain.RoleSuperAdmin
+}
+
+// ListEligibilityRules lista los requisitos configurados por el club
+// GET /eligibility-rules
+func (h *DocumentHandler) ListEligibilityRules(c *gin.Context) {
+	rules, err := h.eligibilityService.ListRules(c.Request.Context(), c.GetString("clubID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"rules":    rules,
+		"defaults": domain.DefaultEligibilityRequirements,
+	})
+}
+
+// CreateEligibilityRule crea una regla para el club, una disciplina y/o una categoría
+// POST /eligibility-rules
+func (h *DocumentHandler) CreateEligibilityRule(c *gin.Context) {
+	if !canManageEligibilityRules(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Solo administradores pueden configurar requisitos"})
+		return
+	}
+	var input application.EligibilityRuleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rule, err := h.eligibilityService.CreateRule(c.Request.Context(), c.GetString("clubID"), input)
+	if err != nil {
+		c.JSON(eligibilityRuleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, rule)
+}
+
+// UpdateEligibilityRule reemplaza el alcance y los requisitos de una regla
+// PUT /eligibility-rules/:id
+func (h *DocumentHandler) UpdateEligibilityRule(c *gin.Context) {
+	if !canManageEligibilityRules(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Solo administradores pueden configurar requisitos"})
+		return
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de regla inválido"})
+		return
+	}
+	var input application.EligibilityRuleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rule, err := h.eligibilityService.UpdateRule(c.Request.Context(), c.GetString("clubID"), id, input)
+	if err != nil {
+		c.JSON(eligibilityRuleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, rule)
+}
+
+// DeleteEligibilityRule elimina una regla
+// DELETE /eligibility-rules/:id
+func (h *DocumentHandler) DeleteEligibilityRule(c *gin.Context) {
+	if !canManageEligibilityRules(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Solo administradores pueden configurar requisitos"})
+		return
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de regla inválido"})
+		return
+	}
+
+	if err := h.eligibilityService.DeleteRule(c.Request.Context(), c.GetString("clubID"), id); err != nil {
+		c.JSON(eligibilityRuleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Regla eliminada"})
+}
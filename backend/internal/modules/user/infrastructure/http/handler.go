@@ -12,7 +12,8 @@ import (
 )
 
 type UserHandler struct {
-	useCases *application.UserUseCases
+	useCases     *application.UserUseCases
+	dataRequests *application.DataSubjectRequestService
 }
 
 func NewUserHandler(useCases *application.UserUseCases) *UserHandler {
@@ -21,6 +22,27 @@ func NewUserHandler(useCases *application.UserUseCases) *UserHandler {
 	}
 }
 
+// SetDataSubjectRequests makes the GDPR endpoints queue a data subject request (identity verification,
+// 30-day deadline, background processing) instead of exporting or erasing synchronously
+func (h *UserHandler) SetDataSubjectRequests(service *application.DataSubjectRequestService) {
+	h.dataRequests = service
+}
+
+// queueDataSubjectRequest creates the request for the authenticated user and answers 202
+func (h *UserHandler) queueDataSubjectRequest(c *gin.Context, clubID string, requestType domain.DataSubjectRequestType) {
+	access := documentAccess(c)
+	access.ClubID = clubID
+	req, err := h.dataRequests.Create(c.Request.Context(), access, "", requestType)
+	if err != nil {
+		c.JSON(dataSubjectRequestErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": "Request received. Confirm it with the code sent to your email; it will be processed within 30 days.",
+		"request": newDataSubjectRequestResponse(req),
+	})
+}
+
 type UserResponse struct {
 	*domain.User
 	Category string `json:"category"`
@@ -496,6 +518,8 @@ func RegisterPublicRoutes(r *gin.RouterGroup, handler *UserHandler) {
 
 // ExportMyData implements GDPR Article 20 - Right to data portability
 // GET /users/me/data-export
+// With data subject requests enabled it queues an EXPORT request; the full archive is downloaded from
+// /privacy-requests/:id/archive once processed.
 func (h *UserHandler) ExportMyData(c *gin.Context) {
 	userID, exists := c.Get("userID")
 	if !exists {
@@ -509,6 +533,11 @@ func (h *UserHandler) ExportMyData(c *gin.Context) {
 		clubID = "system"
 	}
 
+	if h.dataRequests != nil {
+		h.queueDataSubjectRequest(c, clubID, domain.DataSubjectRequestExport)
+		return
+	}
+
 	exportData, err := h.useCases.ExportUserData(c.Request.Context(), clubID, userID.(string))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -524,6 +553,7 @@ func (h *UserHandler) ExportMyData(c *gin.Context) {
 // RequestErasure implements GDPR Article 17 - Right to erasure (Right to be forgotten)
 // DELETE /users/me/gdpr-erasure
 // Note: This anonymizes the user's own data. For admin deletion, use DELETE /users/:id
+// With data subject requests enabled it queues an ERASURE request that is processed after identity verification.
 func (h *UserHandler) RequestErasure(c *gin.Context) {
 	userID, exists := c.Get("userID")
 	if !exists {
@@ -537,6 +567,11 @@ func (h *UserHandler) RequestErasure(c *gin.Context) {
 		clubID = "system"
 	}
 
+	if h.dataRequests != nil {
+		h.queueDataSubjectRequest(c, clubID, domain.DataSubjectRequestErasure)
+		return
+	}
+
 	// Users can request erasure of their own data
 	// This will anonymize their data rather than delete it, preserving referential integrity
 	if err := h.useCases.DeleteUserGDPR(c.Request.Context(), clubID, userID.(string), "SELF_REQUEST"); err != nil {
@@ -1,12 +1,16 @@
 package http
 
 import (
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
 )
 
 // LeagueExportHandler maneja las peticiones de exportación para la Liga
@@ -21,61 +25,101 @@ func NewLeagueExportHandler(exportService *application.LeagueExportService) *Lea
 	}
 }
 
-// ExportLeagueFolder genera y descarga el PDF de la Carpeta de Liga
-// GET /teams/:teamId/league-export
-func (h *LeagueExportHandler) ExportLeagueFolder(c *gin.Context) {
-	clubID := c.GetString("club_id")
-	teamID := c.Param("teamId")
-
+// loadTeam verifica permisos y arma el equipo evaluado; responde el error y devuelve nil si falla
+func (h *LeagueExportHandler) loadTeam(c *gin.Context) *application.LeagueTeam {
 	// Verificar permisos (solo admins y coaches)
-	role := c.GetString("role")
-	if role != "ADMIN" && role != "SUPER_ADMIN" && role != "COACH" {
+	role := c.GetString("userRole")
+	if role != domain.RoleAdmin && role != domain.RoleSuperAdmin && role != domain.RoleCoach {
 		c.JSON(http.StatusForbidden, gin.H{"error": "No tienes permiso para exportar carpetas de liga"})
-		return
+		return nil
 	}
 
-	// TODO: Obtener miembros del equipo desde el repositorio de Team
-	// Por ahora, usamos datos de ejemplo
-	members := []application.TeamMember{
-		// Esto debería venir de la base de datos
+	teamID, err := uuid.Parse(c.Param("teamId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de equipo inválido"})
+		return nil
 	}
 
-	// Obtener documentos de cada miembro
-	for i := range members {
-		docs, err := h.exportService.GetMemberDocuments(c.Request.Context(), clubID, members[i].ID)
+	// Por defecto se evalúa con los requisitos de la disciplina y categoría del equipo
+	var override *domain.EligibilityScope
+	if c.Query("discipline_id") != "" || c.Query("category") != "" {
+		scope, err := eligibilityScope(c)
 		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return nil
+		}
+		override = &scope
+	}
+
+	team, err := h.exportService.LoadTeam(c.Request.Context(), c.GetString("clubID"), teamID, override)
+	if err != nil {
+		if errors.Is(err, application.ErrLeagueTeamNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		} else {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Error obteniendo documentos"})
-			return
 		}
-		members[i].Documents = docs
+		return nil
 	}
 
 	// Filtrar solo miembros elegibles (opcional, basado en query param)
-	onlyEligible := c.Query("only_eligible") == "true"
-	if onlyEligible {
-		members = h.exportService.FilterEligibleMembers(members)
+	if c.Query("only_eligible") == "true" {
+		team.Members = h.exportService.FilterEligibleMembers(team.Members)
 	}
+	return team
+}
 
-	// Generar PDF
-	teamName := fmt.Sprintf("Equipo %s", teamID) // TODO: Obtener nombre real del equipo
+// ExportLeagueFolder genera y descarga el PDF de la Carpeta de Liga
+// GET /teams/:teamId/league-export?discipline_id=&category=&only_eligible=
+func (h *LeagueExportHandler) ExportLeagueFolder(c *gin.Context) {
+	team := h.loadTeam(c)
+	if team == nil {
+		return
+	}
 
 	// Configurar headers para descarga
-	filename := fmt.Sprintf("carpeta_liga_%s_%s.pdf", teamID, uuid.New().String()[:8])
+	filename := fmt.Sprintf("carpeta_liga_%s_%s.pdf", team.ID, uuid.New().String()[:8])
 	c.Header("Content-Type", "application/pdf")
 	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
 
 	// Generar y escribir PDF directamente a la respuesta
-	if err := h.exportService.GenerateLeagueFolder(teamName, members, c.Writer); err != nil {
+	if err := h.exportService.GenerateLeagueFolder(team.Name, team.Members, c.Writer); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error generando PDF"})
 		return
 	}
 }
 
+// ExportLeaguePackage descarga la carpeta de liga completa en un ZIP: Lista de Buena Fe, plantel en CSV,
+// DNI y aptos médicos de cada jugador y faltantes.csv. Se escribe a medida que se genera.
+// GET /teams/:teamId/league-export/zip?discipline_id=&category=&only_eligible=
+func (h *LeagueExportHandler) ExportLeaguePackage(c *gin.Context) {
+	team := h.loadTeam(c)
+	if team == nil {
+		return
+	}
+
+	filename := fmt.Sprintf("carpeta_liga_%s_%s.zip", team.ID, time.Now().Format("20060102"))
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Header("Cache-Control", "private, no-store")
+
+	// Con la respuesta ya iniciada no se puede informar el error con otro status: se corta la descarga
+	manifest, err := h.exportService.WriteLeaguePackage(c.Request.Context(), documentAccess(c), team, c.Writer)
+	if err != nil {
+		log.Printf("[LeagueExportHandler] error generando carpeta del equipo %s: %v", team.ID, err)
+		c.Abort()
+		return
+	}
+	if len(manifest.Missing) > 0 {
+		log.Printf("[LeagueExportHandler] carpeta del equipo %s con %d documentos faltantes", team.ID, len(manifest.Missing))
+	}
+}
+
 // RegisterLeagueExportRoutes registra las rutas de exportación
-func RegisterLeagueExportRoutes(router *gin.RouterGroup, handler *LeagueExportHandler, authMiddleware gin.HandlerFunc) {
+func RegisterLeagueExportRoutes(router *gin.RouterGroup, handler *LeagueExportHandler, authMiddleware, tenantMiddleware gin.HandlerFunc) {
 	teams := router.Group("/teams")
-	teams.Use(authMiddleware)
+	teams.Use(authMiddleware, tenantMiddleware)
 	{
 		teams.GET("/:teamId/league-export", handler.ExportLeagueFolder)
+		teams.GET("/:teamId/league-export/zip", handler.ExportLeaguePackage)
 	}
 }
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"gorm.io/gorm"
+)
+
+// ConsentRepository persiste las versiones de políticas y los consentimientos usando PostgreSQL
+type ConsentRepository struct {
+	db *gorm.DB
+}
+
+// NewConsentRepository crea una nueva instancia del repositorio
+func NewConsentRepository(db *gorm.DB) *ConsentRepository {
+	return &ConsentRepository{db: db}
+}
+
+// CreatePolicy registra una nueva versión de política
+func (r *ConsentRepository) CreatePolicy(ctx context.Context, policy *domain.ConsentPolicy) error {
+	return r.db.WithContext(ctx).Create(policy).Error
+}
+
+// GetPolicy obtiene una versión de política del club; devuelve nil si no existe
+func (r *ConsentRepository) GetPolicy(ctx context.Context, clubID string, id uuid.UUID) (*domain.ConsentPolicy, error) {
+	var policy domain.ConsentPolicy
+	err := r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).First(&policy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// ListPolicies obtiene todas las versiones del club, de la más nueva a la más vieja
+func (r *ConsentRepository) ListPolicies(ctx context.Context, clubID string) ([]domain.ConsentPolicy, error) {
+	var policies []domain.ConsentPolicy
+	err := r.db.WithContext(ctx).Where("club_id = ?", clubID).
+		Order("published_at DESC").
+		Find(&policies).Error
+	return policies, err
+}
+
+// Create registra un consentimiento
+func (r *ConsentRepository) Create(ctx context.Context, record *domain.ConsentRecord) error {
+	return r.db.WithContext(ctx).Create(record).Error
+}
+
+// Update actualiza un consentimiento (revocación)
+func (r *ConsentRepository) Update(ctx context.Context, record *domain.ConsentRecord) error {
+	return r.db.WithContext(ctx).Save(record).Error
+}
+
+// GetByUserID obtiene el historial de consentimientos del usuario, del más nuevo al más viejo
+func (r *ConsentRepository) GetByUserID(ctx context.Context, clubID, userID string) ([]domain.ConsentRecord, error) {
+	var records []domain.ConsentRecord
+	err := r.db.WithContext(ctx).Where("club_id = ? AND user_id = ?", clubID, userID).
+		Order("accepted_at DESC").
+		Find(&records).Error
+	return records, err
+}
+
+// ListConsentingEmails obtiene los emails de los socios con un consentimiento vigente del tipo indicado
+func (r *ConsentRepository) ListConsentingEmails(ctx context.Context, clubID string, consentType domain.ConsentType) ([]string, error) {
+	var emails []string
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT DISTINCT u.email FROM consent_records c
+		JOIN users u ON u.id = c.user_id AND u.club_id = c.club_id
+		WHERE c.club_id = ? AND c.consent_type = ? AND c.accepted AND c.revoked_at IS NULL
+			AND u.deleted_at IS NULL AND u.email <> ''`, clubID, consentType).
+		Scan(&emails).Error
+	return emails, err
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DataRetentionRepository persiste los plazos de retención y las ejecuciones del job usando PostgreSQL
+type DataRetentionRepository struct {
+	db *gorm.DB
+}
+
+// NewDataRetentionRepository crea una nueva instancia del repositorio
+func NewDataRetentionRepository(db *gorm.DB) *DataRetentionRepository {
+	return &DataRetentionRepository{db: db}
+}
+
+// ListPolicies obtiene los plazos configurados por el club
+func (r *DataRetentionRepository) ListPolicies(ctx context.Context, clubID string) ([]domain.DataRetentionPolicy, error) {
+	var policies []domain.DataRetentionPolicy
+	err := r.db.WithContext(ctx).Where("club_id = ?", clubID).Find(&policies).Error
+	return policies, err
+}
+
+// SavePolicy crea o reemplaza el plazo del club para la categoría
+func (r *DataRetentionRepository) SavePolicy(ctx context.Context, policy *domain.DataRetentionPolicy) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "club_id"}, {Name: "category"}},
+		DoUpdates: clause.AssignmentColumns([]string{"retention_days", "enabled", "updated_by", "updated_at"}),
+	}).Create(policy).Error
+}
+
+// CreateRun registra el inicio de una ejecución
+func (r *DataRetentionRepository) CreateRun(ctx context.Context, run *domain.DataRetentionRun) error {
+	return r.db.WithContext(ctx).Create(run).Error
+}
+
+// UpdateRun guarda el resultado de una ejecución
+func (r *DataRetentionRepository) UpdateRun(ctx context.Context, run *domain.DataRetentionRun) error {
+	return r.db.WithContext(ctx).Save(run).Error
+}
+
+// GetRun obtiene una ejecución del club; devuelve nil si no existe
+func (r *DataRetentionRepository) GetRun(ctx context.Context, clubID string, id uuid.UUID) (*domain.DataRetentionRun, error) {
+	var run domain.DataRetentionRun
+	err := r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).First(&run).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// ListRuns obtiene las últimas ejecuciones del club, de la más nueva a la más vieja
+func (r *DataRetentionRepository) ListRuns(ctx context.Context, clubID string, limit int) ([]domain.DataRetentionRun, error) {
+	var runs []domain.DataRetentionRun
+	err := r.db.WithContext(ctx).Where("club_id = ?", clubID).
+		Order("started_at DESC").
+		Limit(limit).
+		Find(&runs).Error
+	return runs, err
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"gorm.io/gorm"
+)
+
+// DataSubjectRequestRepository persiste las solicitudes de derechos GDPR usando PostgreSQL
+type DataSubjectRequestRepository struct {
+	db *gorm.DB
+}
+
+// NewDataSubjectRequestRepository crea una nueva instancia del repositorio
+func NewDataSubjectRequestRepository(db *gorm.DB) *DataSubjectRequestRepository {
+	return &DataSubjectRequestRepository{db: db}
+}
+
+// Create crea una nueva solicitud
+func (r *DataSubjectRequestRepository) Create(ctx context.Context, req *domain.DataSubjectRequest) error {
+	return r.db.WithContext(ctx).Create(req).Error
+}
+
+// Update actualiza una solicitud existente
+func (r *DataSubjectRequestRepository) Update(ctx context.Context, req *domain.DataSubjectRequest) error {
+	return r.db.WithContext(ctx).Save(req).Error
+}
+
+// ClaimForProcessing marca la solicitud como en proceso con un update condicional, para que dos
+// ejecuciones del job no procesen la misma solicitud
+func (r *DataSubjectRequestRepository) ClaimForProcessing(ctx context.Context, req *domain.DataSubjectRequest, from domain.DataSubjectRequestStatus, attempts int) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&domain.DataSubjectRequest{}).
+		Where("club_id = ? AND id = ? AND status = ? AND processing_attempts = ?", req.ClubID, req.ID, from, attempts).
+		Updates(map[string]interface{}{
+			"status":              req.Status,
+			"processing_attempts": req.ProcessingAttempts,
+			"started_at":          req.StartedAt,
+			"updated_at":          req.UpdatedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// GetByID obtiene una solicitud del club; devuelve nil si no existe
+func (r *DataSubjectRequestRepository) GetByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.DataSubjectRequest, error) {
+	var req domain.DataSubjectRequest
+	err := r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).First(&req).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// List devuelve las solicitudes del club que cumplen el filtro, de la que vence primero a la última
+func (r *DataSubjectRequestRepository) List(ctx context.Context, clubID string, filter domain.DataSubjectRequestFilter) ([]domain.DataSubjectRequest, error) {
+	query := r.db.WithContext(ctx).Where("club_id = ?", clubID)
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+	if filter.ArchiveExpiredBefore != nil {
+		query = query.Where("archive_key <> '' AND archive_expires_at < ?", *filter.ArchiveExpiredBefore)
+	}
+
+	var requests []domain.DataSubjectRequest
+	err := query.Order("due_at ASC").Find(&requests).Error
+	return requests, err
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"gorm.io/gorm"
+)
+
+// EligibilityRuleRepository implementa el repositorio de reglas de elegibilidad usando PostgreSQL
+type EligibilityRuleRepository struct {
+	db *gorm.DB
+}
+
+// NewEligibilityRuleRepository crea una nueva instancia del repositorio
+func NewEligibilityRuleRepository(db *gorm.DB) *EligibilityRuleRepository {
+	return &EligibilityRuleRepository{db: db}
+}
+
+// Create crea una nueva regla
+func (r *EligibilityRuleRepository) Create(ctx context.Context, rule *domain.EligibilityRule) error {
+	return r.db.WithContext(ctx).Create(rule).Error
+}
+
+// Update actualiza una regla existente
+func (r *EligibilityRuleRepository) Update(ctx context.Context, rule *domain.EligibilityRule) error {
+	return r.db.WithContext(ctx).Where("club_id = ?", rule.ClubID).Save(rule).Error
+}
+
+// Delete elimina una regla del club
+func (r *EligibilityRuleRepository) Delete(ctx context.Context, clubID string, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).Delete(&domain.EligibilityRule{}).Error
+}
+
+// GetByID obtiene una regla por su ID; nil si no existe
+func (r *EligibilityRuleRepository) GetByID(ctx context.Context, clubID string, id uuid.UUID) (*domain.EligibilityRule, error) {
+	var rule domain.EligibilityRule
+	err := r.db.WithContext(ctx).Where("club_id = ? AND id = ?", clubID, id).First(&rule).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// ListByClub obtiene todas las reglas del club
+func (r *EligibilityRuleRepository) ListByClub(ctx context.Context, clubID string) ([]domain.EligibilityRule, error) {
+	var rules []domain.EligibilityRule
+	err := r.db.WithContext(ctx).Where("club_id = ?", clubID).
+		Order("created_at ASC").
+		Find(&rules).Error
+	return rules, err
+}
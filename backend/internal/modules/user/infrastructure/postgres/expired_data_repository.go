@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"gorm.io/gorm"
+)
+
+// purgeBatchSize acota cuántos registros borra cada DELETE, para no bloquear las tablas de logs
+const purgeBatchSize = 5000
+
+// expiredLogSource es una tabla de logs con plazo de retención. where recibe (club_id, before).
+// auth_logs no tiene club: se toman los logs de los socios del club.
+type expiredLogSource struct {
+	table string
+	where string
+}
+
+var expiredLogSources = map[domain.RetentionCategory]expiredLogSource{
+	domain.RetentionAccessLogs: {
+		table: "access_logs",
+		where: `club_id = ? AND timestamp < ?`,
+	},
+	domain.RetentionAuthLogs: {
+		table: "auth_logs",
+		where: `user_id IN (SELECT id FROM users WHERE club_id = ?) AND created_at < ?`,
+	},
+	domain.RetentionHealthAccessLogs: {
+		table: "health_data_access_log",
+		where: `club_id = ? AND accessed_at < ?`,
+	},
+}
+
+// ExpiredDataRepository busca y borra los datos vencidos en las tablas de los demás módulos
+type ExpiredDataRepository struct {
+	db *gorm.DB
+}
+
+// NewExpiredDataRepository crea una nueva instancia del repositorio
+func NewExpiredDataRepository(db *gorm.DB) *ExpiredDataRepository {
+	return &ExpiredDataRepository{db: db}
+}
+
+// ExpiredUserIDs devuelve las cuentas dadas de baja y vencidas que todavía no se anonimizaron. Una cuenta
+// activa nunca se anonimiza, aunque tenga data_retention_until vencida.
+func (r *ExpiredDataRepository) ExpiredUserIDs(ctx context.Context, clubID string, deletedBefore, now time.Time) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT id FROM users
+		WHERE club_id = ? AND email NOT LIKE '%@gdpr.erased' AND deleted_at IS NOT NULL
+			AND (deleted_at < ? OR (data_retention_until IS NOT NULL AND data_retention_until < ?))
+		ORDER BY id`, clubID, deletedBefore, now).
+		Scan(&ids).Error
+	return ids, err
+}
+
+// CountExpiredLogs cuenta los registros de la categoría anteriores a before
+func (r *ExpiredDataRepository) CountExpiredLogs(ctx context.Context, clubID string, category domain.RetentionCategory, before time.Time) (int64, error) {
+	source, ok := expiredLogSources[category]
+	if !ok {
+		return 0, domain.ErrInvalidRetentionCategory
+	}
+	var count int64
+	err := r.db.WithContext(ctx).Raw(fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s`, source.table, source.where), clubID, before).
+		Scan(&count).Error
+	return count, err
+}
+
+// PurgeExpiredLogs borra en lotes los registros de la categoría anteriores a before
+func (r *ExpiredDataRepository) PurgeExpiredLogs(ctx context.Context, clubID string, category domain.RetentionCategory, before time.Time) (int64, error) {
+	source, ok := expiredLogSources[category]
+	if !ok {
+		return 0, domain.ErrInvalidRetentionCategory
+	}
+	query := fmt.Sprintf(`DELETE FROM %[1]s WHERE id IN (SELECT id FROM %[1]s WHERE %[2]s LIMIT ?)`, source.table, source.where)
+
+	var purged int64
+	for {
+		result := r.db.WithContext(ctx).Exec(query, clubID, before, purgeBatchSize)
+		if result.Error != nil {
+			return purged, fmt.Errorf("error borrando %s: %w", source.table, result.Error)
+		}
+		purged += result.RowsAffected
+		if result.RowsAffected < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
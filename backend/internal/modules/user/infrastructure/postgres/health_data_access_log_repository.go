@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"gorm.io/gorm"
+)
+
+// HealthDataAccessLogRepository persiste el log de accesos a datos de salud (GDPR Art. 9)
+type HealthDataAccessLogRepository struct {
+	db *gorm.DB
+}
+
+// NewHealthDataAccessLogRepository crea una nueva instancia del repositorio
+func NewHealthDataAccessLogRepository(db *gorm.DB) *HealthDataAccessLogRepository {
+	return &HealthDataAccessLogRepository{db: db}
+}
+
+// LogHealthDataAccess registra un acceso. El log es de solo inserción.
+func (r *HealthDataAccessLogRepository) LogHealthDataAccess(log *domain.HealthDataAccessLog) error {
+	return r.db.Create(log).Error
+}
@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"gorm.io/gorm"
+)
+
+// personalDataSource es una sección de datos del socio que guarda otro módulo. Las consultas reciben
+// (club_id, user_id), o solo user_id si la tabla no tiene club (userOnly). Las secciones que se conservan
+// por obligación legal (domain.RetainedPersonalDataSections) no tienen erase.
+type personalDataSource struct {
+	section  string
+	query    string
+	erase    string
+	userOnly bool
+}
+
+var personalDataSources = []personalDataSource{
+	{
+		section: "reservas",
+		query:   `SELECT * FROM bookings WHERE club_id = ? AND user_id = ? ORDER BY start_time`,
+	},
+	{
+		section: "pagos",
+		query:   `SELECT * FROM payments WHERE club_id = ? AND payer_id = ? ORDER BY created_at`,
+	},
+	{
+		section: "compras",
+		query:   `SELECT * FROM orders WHERE club_id = ? AND user_id = ? ORDER BY created_at`,
+	},
+	{
+		section: "suscripciones",
+		query:   `SELECT * FROM subscriptions WHERE club_id = ? AND user_id = ? ORDER BY start_date`,
+	},
+	{
+		section:  "billetera",
+		query:    `SELECT * FROM wallets WHERE user_id = ?`,
+		userOnly: true,
+	},
+	{
+		section: "cobros_viajes",
+		query:   `SELECT * FROM travel_event_charges WHERE club_id = ? AND user_id = ? ORDER BY created_at`,
+	},
+	{
+		section: "asistencias",
+		query: `SELECT r.*, l.date, l.training_group_id FROM attendance_records r
+			JOIN attendance_lists l ON l.id = r.attendance_list_id
+			WHERE l.club_id = ? AND r.user_id = ? ORDER BY l.date`,
+		erase: `DELETE FROM attendance_records r USING attendance_lists l
+			WHERE l.id = r.attendance_list_id AND l.club_id = ? AND r.user_id = ?`,
+	},
+	{
+		section: "accesos",
+		query:   `SELECT * FROM access_logs WHERE club_id = ? AND user_id = ? ORDER BY timestamp`,
+		erase:   `DELETE FROM access_logs WHERE club_id = ? AND user_id = ?`,
+	},
+	{
+		section: "insignias",
+		query: `SELECT ub.*, b.code, b.name FROM user_badges ub
+			JOIN badges b ON b.id = ub.badge_id
+			WHERE b.club_id = ? AND ub.user_id = ? ORDER BY ub.awarded_at`,
+		erase: `DELETE FROM user_badges ub USING badges b
+			WHERE b.id = ub.badge_id AND b.club_id = ? AND ub.user_id = ?`,
+	},
+	{
+		section: "misiones",
+		query: `SELECT um.*, m.code, m.name FROM user_missions um
+			JOIN missions m ON m.id = um.mission_id
+			WHERE m.club_id = ? AND um.user_id = ? ORDER BY um.assigned_at`,
+		erase: `DELETE FROM user_missions um USING missions m
+			WHERE m.id = um.mission_id AND m.club_id = ? AND um.user_id = ?`,
+	},
+	{
+		section:  "estadisticas",
+		query:    `SELECT * FROM user_stats WHERE user_id = ?`,
+		erase:    `DELETE FROM user_stats WHERE user_id = ?`,
+		userOnly: true,
+	},
+	{
+		section: "eventos",
+		query: `SELECT er.* FROM event_rsvps er
+			JOIN travel_events te ON te.id = er.event_id
+			WHERE te.club_id = ? AND er.user_id = ? ORDER BY er.created_at`,
+		erase: `DELETE FROM event_rsvps er USING travel_events te
+			WHERE te.id = er.event_id AND te.club_id = ? AND er.user_id = ?`,
+	},
+	{
+		section:  "sesiones",
+		query:    `SELECT id, type, ip_address, user_agent, success, created_at FROM auth_logs WHERE user_id = ? ORDER BY created_at`,
+		erase:    `DELETE FROM auth_logs WHERE user_id = ?`,
+		userOnly: true,
+	},
+	{
+		section: "consentimientos",
+		query:   `SELECT * FROM consent_records WHERE club_id = ? AND user_id = ? ORDER BY accepted_at`,
+	},
+	{
+		section: "accesos_datos_salud",
+		query:   `SELECT * FROM health_data_access_log WHERE club_id = ? AND accessed_user_id = ? ORDER BY accessed_at`,
+	},
+}
+
+func (s personalDataSource) args(clubID, userID string) []interface{} {
+	if s.userOnly {
+		return []interface{}{userID}
+	}
+	return []interface{}{clubID, userID}
+}
+
+// PersonalDataRepository lee y borra los datos personales del socio en las tablas de los demás módulos
+type PersonalDataRepository struct {
+	db *gorm.DB
+}
+
+// NewPersonalDataRepository crea una nueva instancia del repositorio
+func NewPersonalDataRepository(db *gorm.DB) *PersonalDataRepository {
+	return &PersonalDataRepository{db: db}
+}
+
+// Collect devuelve los registros del socio de todas las secciones
+func (r *PersonalDataRepository) Collect(ctx context.Context, clubID, userID string) ([]domain.PersonalDataSection, error) {
+	sections := make([]domain.PersonalDataSection, 0, len(personalDataSources))
+	for _, source := range personalDataSources {
+		var rows []map[string]interface{}
+		if err := r.db.WithContext(ctx).Raw(source.query, source.args(clubID, userID)...).Scan(&rows).Error; err != nil {
+			return nil, fmt.Errorf("error leyendo %s: %w", source.section, err)
+		}
+		for _, row := range rows {
+			for k, v := range row {
+				// Los NUMERIC y JSONB llegan como bytes; como string se exportan legibles
+				if b, ok := v.([]byte); ok {
+					row[k] = string(b)
+				}
+			}
+		}
+		if rows == nil {
+			rows = []map[string]interface{}{}
+		}
+		sections = append(sections, domain.PersonalDataSection{Name: source.section, Records: rows})
+	}
+	return sections, nil
+}
+
+// EraseActivity borra en una sola transacción los registros de las secciones que no se conservan
+func (r *PersonalDataRepository) EraseActivity(ctx context.Context, clubID, userID string) (map[string]int64, error) {
+	erased := map[string]int64{}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, source := range personalDataSources {
+			if source.erase == "" || domain.IsRetainedSection(source.section) {
+				continue
+			}
+			result := tx.Exec(source.erase, source.args(clubID, userID)...)
+			if result.Error != nil {
+				return fmt.Errorf("error borrando %s: %w", source.section, result.Error)
+			}
+			erased[source.section] = result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return erased, nil
+}
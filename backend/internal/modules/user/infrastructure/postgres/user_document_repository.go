@@ -43,6 +43,17 @@ func (r *UserDocumentRepository) GetByUserID(ctx context.Context, clubID, userID
 	return docs, err
 }
 
+// ListUnencryptedFiles obtiene, de todos los clubes, los documentos de esos tipos con archivo guardado sin cifrar.
+// Lo usa rotate-keys para cifrar los subidos antes de configurar ENCRYPTION_MASTER_KEY.
+func (r *UserDocumentRepository) ListUnencryptedFiles(ctx context.Context, types []domain.DocumentType) ([]domain.UserDocument, error) {
+	var docs []domain.UserDocument
+	err := r.db.WithContext(ctx).
+		Where("encrypted = ? AND storage_key IS NOT NULL AND storage_key <> '' AND type IN ?", false, types).
+		Order("created_at").
+		Find(&docs).Error
+	return docs, err
+}
+
 // GetByUserAndType obtiene un documento específico de un usuario por tipo
 func (r *UserDocumentRepository) GetByUserAndType(ctx context.Context, clubID, userID string, docType domain.DocumentType) (*domain.UserDocument, error) {
 	var doc domain.UserDocument
@@ -117,3 +128,42 @@ func (r *UserDocumentRepository) GetPendingValidation(ctx context.Context, clubI
 		Find(&docs).Error
 	return docs, err
 }
+
+// ClaimForReview asigna el documento al revisor con un update condicional, para que dos revisores
+// no tomen el mismo documento a la vez
+func (r *UserDocumentRepository) ClaimForReview(ctx context.Context, clubID string, id uuid.UUID, reviewerID string, now time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&domain.UserDocument{}).
+		Where("club_id = ? AND id = ? AND status = ?", clubID, id, domain.DocumentStatusPending).
+		Where("reviewer_id IS NULL OR reviewer_id = ? OR claimed_at < ?", reviewerID, now.Add(-domain.ReviewClaimTTL)).
+		Updates(map[string]interface{}{"reviewer_id": reviewerID, "claimed_at": now})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// SaveReview guarda la aprobación o el rechazo con un update condicional: si entre la lectura y el
+// update otro revisor resolvió o tomó el documento, no se pisa su decisión
+func (r *UserDocumentRepository) SaveReview(ctx context.Context, doc *domain.UserDocument, now time.Time) (bool, error) {
+	reviewerID := ""
+	if doc.ValidatedBy != nil {
+		reviewerID = *doc.ValidatedBy
+	}
+	result := r.db.WithContext(ctx).Model(&domain.UserDocument{}).
+		Where("club_id = ? AND id = ? AND status = ?", doc.ClubID, doc.ID, domain.DocumentStatusPending).
+		Where("reviewer_id IS NULL OR reviewer_id = ? OR claimed_at < ?", reviewerID, now.Add(-domain.ReviewClaimTTL)).
+		Updates(map[string]interface{}{
+			"status":           doc.Status,
+			"expiration_date":  doc.ExpirationDate,
+			"rejection_reason": doc.RejectionReason,
+			"rejection_notes":  doc.RejectionNotes,
+			"validated_at":     doc.ValidatedAt,
+			"validated_by":     doc.ValidatedBy,
+			"reviewer_id":      nil,
+			"claimed_at":       nil,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
@@ -7,17 +7,67 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/middleware"
 	"gorm.io/gorm"
 )
 
 type PostgresUserRepository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	cipher FieldCipher
 }
 
 func NewPostgresUserRepository(db *gorm.DB) *PostgresUserRepository {
 	return &PostgresUserRepository{db: db}
 }
 
+// FieldCipher encrypts the sensitive columns at rest (see encryption.Envelope)
+type FieldCipher interface {
+	EncryptString(ctx context.Context, clubID, value string) (string, error)
+	DecryptString(ctx context.Context, clubID, value string) (string, error)
+}
+
+// SetFieldCipher enables encryption of emergency contacts and insurance number.
+// Once enabled, those fields are only decrypted for callers allowed by User.SensitiveDataVisibleTo
+// (taken from the request context); for anyone else they are returned empty.
+func (r *PostgresUserRepository) SetFieldCipher(cipher FieldCipher) {
+	r.cipher = cipher
+}
+
+// sealSensitive encrypts the sensitive values of the user, in place of the plaintext ones
+func (r *PostgresUserRepository) sealSensitive(ctx context.Context, clubID string, values ...*string) error {
+	if r.cipher == nil {
+		return nil
+	}
+	for _, v := range values {
+		sealed, err := r.cipher.EncryptString(ctx, clubID, *v)
+		if err != nil {
+			return err
+		}
+		*v = sealed
+	}
+	return nil
+}
+
+// revealSensitive copies the sensitive columns into the domain user, decrypting them when the caller may see them
+func (r *PostgresUserRepository) revealSensitive(ctx context.Context, model *UserModel, user *domain.User) {
+	user.InsuranceProvider = model.InsuranceProvider
+	if r.cipher == nil {
+		user.EmergencyContactName = model.EmergencyContactName
+		user.EmergencyContactPhone = model.EmergencyContactPhone
+		user.InsuranceNumber = model.InsuranceNumber
+		return
+	}
+
+	principal, _ := middleware.PrincipalFromContext(ctx)
+	if !user.SensitiveDataVisibleTo(principal.UserID, principal.Role) {
+		return
+	}
+	// A value that cannot be decrypted (e.g. unknown key) is left empty rather than leaking ciphertext
+	user.EmergencyContactName, _ = r.cipher.DecryptString(ctx, model.ClubID, model.EmergencyContactName)
+	user.EmergencyContactPhone, _ = r.cipher.DecryptString(ctx, model.ClubID, model.EmergencyContactPhone)
+	user.InsuranceNumber, _ = r.cipher.DecryptString(ctx, model.ClubID, model.InsuranceNumber)
+}
+
 // UserModel mirrors the database schema defined in Auth module.
 // In a larger system, we might use a shared kernel, or duplicate/map.
 // We duplicate here to keep modules decoupled in code, even if coupling in DB.
@@ -71,26 +121,26 @@ func (r *PostgresUserRepository) GetByID(ctx context.Context, clubID, id string)
 	}
 
 	status := domain.MedicalCertStatus(model.MedicalCertStatus)
-	return &domain.User{
-		ID:                    model.ID,
-		Name:                  model.Name,
-		Email:                 model.Email,
-		Role:                  model.Role,
-		DateOfBirth:           model.DateOfBirth,
-		SportsPreferences:     model.SportsPreferences,
-		ParentID:              model.ParentID,
-		CreatedAt:             model.CreatedAt,
-		UpdatedAt:             model.UpdatedAt,
-		Stats:                 model.Stats,
-		Wallet:                model.Wallet,
-		ClubID:                model.ClubID,
-		EmergencyContactName:  model.EmergencyContactName,
-		EmergencyContactPhone: model.EmergencyContactPhone,
-		InsuranceProvider:     model.InsuranceProvider,
-		InsuranceNumber:       model.InsuranceNumber,
-		MedicalCertStatus:     &status,
-		MedicalCertExpiry:     model.MedicalCertExpiry,
-	}, nil
+	user := &domain.User{
+		ID:                   model.ID,
+		Name:                 model.Name,
+		Email:                model.Email,
+		Role:                 model.Role,
+		DateOfBirth:          model.DateOfBirth,
+		SportsPreferences:    model.SportsPreferences,
+		ParentID:             model.ParentID,
+		CreatedAt:            model.CreatedAt,
+		UpdatedAt:            model.UpdatedAt,
+		Stats:                model.Stats,
+		Wallet:               model.Wallet,
+		ClubID:               model.ClubID,
+		MedicalCertStatus:    &status,
+		MedicalCertExpiry:    model.MedicalCertExpiry,
+		TermsAcceptedAt:      model.TermsAcceptedAt,
+		PrivacyPolicyVersion: model.PrivacyPolicyVersion,
+	}
+	r.revealSensitive(ctx, &model, user)
+	return user, nil
 }
 
 func (r *PostgresUserRepository) Update(ctx context.Context, user *domain.User) error {
@@ -108,17 +158,21 @@ func (r *PostgresUserRepository) Update(ctx context.Context, user *domain.User)
 		updates["sports_preferences"] = user.SportsPreferences
 	}
 	// Operational Updates
-	if user.EmergencyContactName != "" {
-		updates["emergency_contact_name"] = user.EmergencyContactName
+	contactName, contactPhone, insuranceNumber := user.EmergencyContactName, user.EmergencyContactPhone, user.InsuranceNumber
+	if err := r.sealSensitive(ctx, user.ClubID, &contactName, &contactPhone, &insuranceNumber); err != nil {
+		return err
+	}
+	if contactName != "" {
+		updates["emergency_contact_name"] = contactName
 	}
-	if user.EmergencyContactPhone != "" {
-		updates["emergency_contact_phone"] = user.EmergencyContactPhone
+	if contactPhone != "" {
+		updates["emergency_contact_phone"] = contactPhone
 	}
 	if user.InsuranceProvider != "" {
 		updates["insurance_provider"] = user.InsuranceProvider
 	}
-	if user.InsuranceNumber != "" {
-		updates["insurance_number"] = user.InsuranceNumber
+	if insuranceNumber != "" {
+		updates["insurance_number"] = insuranceNumber
 	}
 	if user.MedicalCertStatus != nil {
 		updates["medical_cert_status"] = *user.MedicalCertStatus
@@ -165,6 +219,10 @@ func (r *PostgresUserRepository) List(ctx context.Context, clubID string, limit,
 		query = query.Where("name ILIKE ? OR email ILIKE ?", "%"+search+"%", "%"+search+"%")
 	}
 
+	if role, ok := filters["role"].(string); ok && role != "" {
+		query = query.Where("role = ?", role)
+	}
+
 	if category, ok := filters["category"].(string); ok && category != "" {
 		// Filter by Year of DateOfBirth
 		// SQLite/Postgres syntax might differ slightly, but standard SQL is EXTRACT(YEAR FROM ...)
@@ -181,21 +239,24 @@ func (r *PostgresUserRepository) List(ctx context.Context, clubID string, limit,
 	for i, model := range models {
 		status := domain.MedicalCertStatus(model.MedicalCertStatus)
 		users[i] = domain.User{
-			ID:                model.ID,
-			Name:              model.Name,
-			Email:             model.Email,
-			Role:              model.Role,
-			DateOfBirth:       model.DateOfBirth,
-			SportsPreferences: model.SportsPreferences,
-			ParentID:          model.ParentID,
-			CreatedAt:         model.CreatedAt,
-			UpdatedAt:         model.UpdatedAt,
-			ClubID:            model.ClubID,
-			MedicalCertStatus: &status,
-			MedicalCertExpiry: model.MedicalCertExpiry,
-			Stats:             model.Stats,
-			Wallet:            model.Wallet,
+			ID:                   model.ID,
+			Name:                 model.Name,
+			Email:                model.Email,
+			Role:                 model.Role,
+			DateOfBirth:          model.DateOfBirth,
+			SportsPreferences:    model.SportsPreferences,
+			ParentID:             model.ParentID,
+			CreatedAt:            model.CreatedAt,
+			UpdatedAt:            model.UpdatedAt,
+			ClubID:               model.ClubID,
+			MedicalCertStatus:    &status,
+			MedicalCertExpiry:    model.MedicalCertExpiry,
+			TermsAcceptedAt:      model.TermsAcceptedAt,
+			PrivacyPolicyVersion: model.PrivacyPolicyVersion,
+			Stats:                model.Stats,
+			Wallet:               model.Wallet,
 		}
+		r.revealSensitive(ctx, &models[i], &users[i])
 	}
 	return users, nil
 }
@@ -212,22 +273,25 @@ func (r *PostgresUserRepository) ListByIDs(ctx context.Context, clubID string, i
 	for i, m := range models {
 		status := domain.MedicalCertStatus(m.MedicalCertStatus)
 		users[i] = domain.User{
-			ID:                m.ID,
-			Name:              m.Name,
-			Email:             m.Email,
-			Role:              m.Role,
-			DateOfBirth:       m.DateOfBirth,
-			SportsPreferences: m.SportsPreferences,
-			ParentID:          m.ParentID,
-			CreatedAt:         m.CreatedAt,
-			UpdatedAt:         m.UpdatedAt,
-			ClubID:            m.ClubID,
-			MedicalCertStatus: &status,
-			MedicalCertExpiry: m.MedicalCertExpiry,
-			Stats:             m.Stats,
-			Wallet:            m.Wallet,
+			ID:                   m.ID,
+			Name:                 m.Name,
+			Email:                m.Email,
+			Role:                 m.Role,
+			DateOfBirth:          m.DateOfBirth,
+			SportsPreferences:    m.SportsPreferences,
+			ParentID:             m.ParentID,
+			CreatedAt:            m.CreatedAt,
+			UpdatedAt:            m.UpdatedAt,
+			ClubID:               m.ClubID,
+			MedicalCertStatus:    &status,
+			MedicalCertExpiry:    m.MedicalCertExpiry,
+			TermsAcceptedAt:      m.TermsAcceptedAt,
+			PrivacyPolicyVersion: m.PrivacyPolicyVersion,
+			Stats:                m.Stats,
+			Wallet:               m.Wallet,
 			// Simplified mapping, add other fields if needed for Attendance (Name is key)
 		}
+		r.revealSensitive(ctx, &models[i], &users[i])
 	}
 	return users, nil
 }
@@ -243,21 +307,24 @@ func (r *PostgresUserRepository) FindChildren(ctx context.Context, clubID, paren
 	for i, m := range models {
 		status := domain.MedicalCertStatus(m.MedicalCertStatus)
 		users[i] = domain.User{
-			ID:                m.ID,
-			Name:              m.Name,
-			Email:             m.Email,
-			Role:              m.Role,
-			DateOfBirth:       m.DateOfBirth,
-			ParentID:          m.ParentID,
-			SportsPreferences: m.SportsPreferences,
-			CreatedAt:         m.CreatedAt,
-			UpdatedAt:         m.UpdatedAt,
-			ClubID:            m.ClubID,
-			MedicalCertStatus: &status,
-			MedicalCertExpiry: m.MedicalCertExpiry,
-			Stats:             m.Stats,
-			Wallet:            m.Wallet,
+			ID:                   m.ID,
+			Name:                 m.Name,
+			Email:                m.Email,
+			Role:                 m.Role,
+			DateOfBirth:          m.DateOfBirth,
+			ParentID:             m.ParentID,
+			SportsPreferences:    m.SportsPreferences,
+			CreatedAt:            m.CreatedAt,
+			UpdatedAt:            m.UpdatedAt,
+			ClubID:               m.ClubID,
+			MedicalCertStatus:    &status,
+			MedicalCertExpiry:    m.MedicalCertExpiry,
+			TermsAcceptedAt:      m.TermsAcceptedAt,
+			PrivacyPolicyVersion: m.PrivacyPolicyVersion,
+			Stats:                m.Stats,
+			Wallet:               m.Wallet,
 		}
+		r.revealSensitive(ctx, &models[i], &users[i])
 	}
 	return users, nil
 }
@@ -280,6 +347,16 @@ func (r *PostgresUserRepository) Create(ctx context.Context, user *domain.User)
 		model.MedicalCertStatus = string(*user.MedicalCertStatus)
 	}
 	model.MedicalCertExpiry = user.MedicalCertExpiry
+	model.TermsAcceptedAt = user.TermsAcceptedAt
+	model.PrivacyPolicyVersion = user.PrivacyPolicyVersion
+
+	model.EmergencyContactName = user.EmergencyContactName
+	model.EmergencyContactPhone = user.EmergencyContactPhone
+	model.InsuranceProvider = user.InsuranceProvider
+	model.InsuranceNumber = user.InsuranceNumber
+	if err := r.sealSensitive(ctx, user.ClubID, &model.EmergencyContactName, &model.EmergencyContactPhone, &model.InsuranceNumber); err != nil {
+		return err
+	}
 
 	// Note: We are relying on the DB/GORM to ignore or default fields not present here (like password)
 	// But since this is creating a "User", the Auth module model requires Password.
@@ -329,18 +406,20 @@ func (r *PostgresUserRepository) GetByEmail(ctx context.Context, clubID, email s
 	status := domain.MedicalCertStatus(model.MedicalCertStatus)
 
 	return &domain.User{
-		ID:                model.ID,
-		Name:              model.Name,
-		Email:             model.Email,
-		Role:              model.Role,
-		DateOfBirth:       model.DateOfBirth,
-		SportsPreferences: model.SportsPreferences,
-		ParentID:          model.ParentID,
-		CreatedAt:         model.CreatedAt,
-		UpdatedAt:         model.UpdatedAt,
-		ClubID:            model.ClubID,
-		MedicalCertStatus: &status,
-		MedicalCertExpiry: model.MedicalCertExpiry,
+		ID:                   model.ID,
+		Name:                 model.Name,
+		Email:                model.Email,
+		Role:                 model.Role,
+		DateOfBirth:          model.DateOfBirth,
+		SportsPreferences:    model.SportsPreferences,
+		ParentID:             model.ParentID,
+		CreatedAt:            model.CreatedAt,
+		UpdatedAt:            model.UpdatedAt,
+		ClubID:               model.ClubID,
+		MedicalCertStatus:    &status,
+		MedicalCertExpiry:    model.MedicalCertExpiry,
+		TermsAcceptedAt:      model.TermsAcceptedAt,
+		PrivacyPolicyVersion: model.PrivacyPolicyVersion,
 	}, nil
 }
 
@@ -348,9 +427,9 @@ func (r *PostgresUserRepository) GetByEmail(ctx context.Context, clubID, email s
 // Instead of soft-delete, it anonymizes personal data and removes sensitive documents
 func (r *PostgresUserRepository) AnonymizeForGDPR(ctx context.Context, clubID, id string) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 1. Check user exists
+		// 1. Check user exists (Unscoped: accounts already soft-deleted are anonymized too)
 		var user UserModel
-		if err := tx.Where("id = ? AND club_id = ?", id, clubID).First(&user).Error; err != nil {
+		if err := tx.Unscoped().Where("id = ? AND club_id = ?", id, clubID).First(&user).Error; err != nil {
 			return err
 		}
 
@@ -369,7 +448,7 @@ func (r *PostgresUserRepository) AnonymizeForGDPR(ctx context.Context, clubID, i
 			"updated_at":              gorm.Expr("NOW()"),
 		}
 
-		if err := tx.Model(&UserModel{}).
+		if err := tx.Unscoped().Model(&UserModel{}).
 			Where("id = ? AND club_id = ?", id, clubID).
 			Updates(anonymizedData).Error; err != nil {
 			return err
@@ -379,48 +458,49 @@ func (r *PostgresUserRepository) AnonymizeForGDPR(ctx context.Context, clubID, i
 		if err := tx.Unscoped().
 			Where("user_id = ? AND club_id = ?", id, clubID).
 			Delete(&domain.UserDocument{}).Error; err != nil {
-			// Log but don't fail if documents table doesn't exist
-			_ = err // Satisfy SA9003
+			return err
+		}
+
+		// Steps 4-7 touch tables owned by other modules that may not exist in every deployment.
+		// Each one runs in a savepoint: in Postgres a failed statement aborts the whole transaction.
+		optional := func(query string, args ...interface{}) {
+			_ = tx.Transaction(func(sp *gorm.DB) error {
+				return sp.Exec(query, args...).Error
+			})
 		}
 
 		// 4. Dissociate user from audit logs (replace UserID with anonymous placeholder)
 		// This preserves the audit trail while removing PII
-		if err := tx.Exec(`
+		optional(`
 			UPDATE audit_logs 
 			SET user_id = 'GDPR_ERASED', 
 			    details = '{"gdpr_erased": true}'
 			WHERE user_id = ?
-		`, id).Error; err != nil {
-			_ = err // Satisfy SA9003
-		}
+		`, id)
 
 		// 5. Dissociate from authentication logs
-		if err := tx.Exec(`
-			UPDATE authentication_logs 
+		optional(`
+			UPDATE auth_logs 
 			SET user_id = 'GDPR_ERASED',
 			    ip_address = '0.0.0.0',
 			    user_agent = 'GDPR_ERASED'
 			WHERE user_id = ?
-		`, id).Error; err != nil {
-			_ = err // Satisfy SA9003
-		}
+		`, id)
 
 		// 6. Revoke all refresh tokens for this user
-		// 6. Revoke all refresh tokens for this user
-		_ = tx.Exec(`
+		optional(`
 			UPDATE refresh_tokens 
 			SET is_revoked = true, 
 			    revoked_at = NOW()
 			WHERE user_id = ?
-		`, id).Error
+		`, id)
 
 		// 7. Log the GDPR erasure request
-		// 7. Log the GDPR erasure request
-		_ = tx.Exec(`
+		optional(`
 			INSERT INTO gdpr_erasure_requests (club_id, user_id, status, executed_at, notes)
 			VALUES (?, ?, 'COMPLETED', NOW(), 'Automated GDPR erasure')
 			ON CONFLICT DO NOTHING
-		`, clubID, id).Error
+		`, clubID, id)
 
 		// 8. Finally, soft-delete the user record
 		if err := tx.Delete(&UserModel{}, "id = ? AND club_id = ?", id, clubID).Error; err != nil {
@@ -430,3 +510,44 @@ func (r *PostgresUserRepository) AnonymizeForGDPR(ctx context.Context, clubID, i
 		return nil
 	})
 }
+
+// ReencryptSensitiveData encrypts the sensitive columns of every user with the current data key of
+// their club. Plaintext values written before encryption was enabled are encrypted too.
+// Used by the key rotation command; it returns the number of users rewritten.
+func (r *PostgresUserRepository) ReencryptSensitiveData(ctx context.Context) (int, error) {
+	if r.cipher == nil {
+		return 0, errors.New("field encryption is not configured")
+	}
+
+	updated := 0
+	var models []UserModel
+	err := r.db.WithContext(ctx).Model(&UserModel{}).
+		Select("id", "club_id", "emergency_contact_name", "emergency_contact_phone", "insurance_number").
+		Where("emergency_contact_name <> '' OR emergency_contact_phone <> '' OR insurance_number <> ''").
+		FindInBatches(&models, 200, func(tx *gorm.DB, batch int) error {
+			for _, m := range models {
+				values := []string{m.EmergencyContactName, m.EmergencyContactPhone, m.InsuranceNumber}
+				for i, v := range values {
+					plain, err := r.cipher.DecryptString(ctx, m.ClubID, v)
+					if err != nil {
+						return err
+					}
+					// Decrypted values are sealed again, with the active data key
+					if values[i], err = r.cipher.EncryptString(ctx, m.ClubID, plain); err != nil {
+						return err
+					}
+				}
+				if err := r.db.WithContext(ctx).Model(&UserModel{}).Where("id = ? AND club_id = ?", m.ID, m.ClubID).
+					UpdateColumns(map[string]interface{}{
+						"emergency_contact_name":  values[0],
+						"emergency_contact_phone": values[1],
+						"insurance_number":        values[2],
+					}).Error; err != nil {
+					return err
+				}
+				updated++
+			}
+			return nil
+		}).Error
+	return updated, err
+}
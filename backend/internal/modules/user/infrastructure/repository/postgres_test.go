@@ -8,6 +8,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/infrastructure/repository"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/encryption"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/platform/middleware"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -112,6 +114,40 @@ func (s *UserRepositorySuite) TestCreateAndGet() {
 	}
 }
 
+func (s *UserRepositorySuite) TestSignupConsentRoundTrip() {
+	clubID := "club-alpha"
+	acceptedAt := time.Now().UTC().Truncate(time.Second)
+	user := &domain.User{
+		ID:                   uuid.New().String(),
+		Name:                 "Consent User",
+		Email:                "consent@example.com",
+		ClubID:               clubID,
+		TermsAcceptedAt:      &acceptedAt,
+		PrivacyPolicyVersion: "2026-01",
+	}
+	s.Require().NoError(s.repo.Create(context.Background(), user))
+
+	// Stored by Create
+	var raw repository.UserModel
+	s.Require().NoError(s.db.First(&raw, "id = ?", user.ID).Error)
+	s.Require().NotNil(raw.TermsAcceptedAt)
+	s.Equal("2026-01", raw.PrivacyPolicyVersion)
+
+	// Read back through GetByID, which is what ConsentService uses
+	fetched, err := s.repo.GetByID(context.Background(), clubID, user.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(fetched)
+	s.Require().NotNil(fetched.TermsAcceptedAt)
+	s.True(acceptedAt.Equal(*fetched.TermsAcceptedAt))
+	s.Equal("2026-01", fetched.PrivacyPolicyVersion)
+
+	listed, err := s.repo.ListByIDs(context.Background(), clubID, []string{user.ID})
+	s.Require().NoError(err)
+	s.Require().Len(listed, 1)
+	s.Equal("2026-01", listed[0].PrivacyPolicyVersion)
+	s.NotNil(listed[0].TermsAcceptedAt)
+}
+
 func (s *UserRepositorySuite) TestGetByEmail() {
 	clubID := "club-alpha"
 	user := &domain.User{
@@ -157,6 +193,68 @@ func (s *UserRepositorySuite) TestUpdate() {
 	}
 }
 
+func (s *UserRepositorySuite) TestSensitiveFieldsEncryptedAtRest() {
+	s.Require().NoError(s.db.AutoMigrate(&encryption.DataKey{}))
+	defer s.db.Exec("DELETE FROM club_data_keys")
+
+	key, err := encryption.GenerateKey()
+	s.Require().NoError(err)
+	master, err := encryption.ParseMasterKeys("k1", key, "")
+	s.Require().NoError(err)
+	repo := repository.NewPostgresUserRepository(s.db)
+	repo.SetFieldCipher(encryption.NewEnvelope(master, encryption.NewGormDataKeyStore(s.db)))
+
+	clubID := "club-alpha"
+	user := &domain.User{
+		ID:                    uuid.New().String(),
+		Name:                  "Sensitive User",
+		Email:                 "sensitive@example.com",
+		ClubID:                clubID,
+		EmergencyContactName:  "Ana Pérez",
+		EmergencyContactPhone: "11-5555-0000",
+		InsuranceProvider:     "OSDE",
+		InsuranceNumber:       "210-55555",
+	}
+	s.Require().NoError(repo.Create(context.Background(), user))
+
+	// Stored as ciphertext
+	var raw repository.UserModel
+	s.Require().NoError(s.db.First(&raw, "id = ?", user.ID).Error)
+	s.True(encryption.IsEncryptedString(raw.InsuranceNumber))
+	s.True(encryption.IsEncryptedString(raw.EmergencyContactPhone))
+	s.Equal("OSDE", raw.InsuranceProvider)
+
+	// Decrypted for the owner and medical staff
+	owner := middleware.WithPrincipal(context.Background(), middleware.Principal{UserID: user.ID, Role: domain.RoleMember})
+	fetched, err := repo.GetByID(owner, clubID, user.ID)
+	s.Require().NoError(err)
+	s.Equal("210-55555", fetched.InsuranceNumber)
+	s.Equal("Ana Pérez", fetched.EmergencyContactName)
+
+	medic := middleware.WithPrincipal(context.Background(), middleware.Principal{UserID: "medic-1", Role: domain.RoleMedicalStaff})
+	fetched, err = repo.GetByID(medic, clubID, user.ID)
+	s.Require().NoError(err)
+	s.Equal("11-5555-0000", fetched.EmergencyContactPhone)
+
+	// Other members and anonymous callers get the fields empty
+	other := middleware.WithPrincipal(context.Background(), middleware.Principal{UserID: "user-2", Role: domain.RoleMember})
+	for _, ctx := range []context.Context{other, context.Background()} {
+		fetched, err = repo.GetByID(ctx, clubID, user.ID)
+		s.Require().NoError(err)
+		s.Empty(fetched.InsuranceNumber)
+		s.Empty(fetched.EmergencyContactName)
+		s.Equal("OSDE", fetched.InsuranceProvider)
+	}
+
+	// Re-encryption keeps the values readable
+	count, err := repo.ReencryptSensitiveData(middleware.SystemContext(context.Background()))
+	s.Require().NoError(err)
+	s.Equal(1, count)
+	fetched, err = repo.GetByID(owner, clubID, user.ID)
+	s.Require().NoError(err)
+	s.Equal("210-55555", fetched.InsuranceNumber)
+}
+
 func TestUserRepositorySuite(t *testing.T) {
 	suite.Run(t, new(UserRepositorySuite))
 }
@@ -0,0 +1,26 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+// DataRetentionJob aplica los plazos de conservación del club: anonimiza las cuentas vencidas y borra
+// los logs de accesos, de sesión y de accesos a datos de salud más viejos que su plazo.
+// Con dryRun solo registra el reporte de lo que se borraría.
+type DataRetentionJob struct {
+	retention *application.DataRetentionService
+	dryRun    bool
+}
+
+// NewDataRetentionJob crea una nueva instancia del job
+func NewDataRetentionJob(retention *application.DataRetentionService, dryRun bool) *DataRetentionJob {
+	return &DataRetentionJob{retention: retention, dryRun: dryRun}
+}
+
+// Run ejecuta la retención del club y devuelve el registro de la ejecución
+func (j *DataRetentionJob) Run(ctx context.Context, clubID string) (*domain.DataRetentionRun, error) {
+	return j.retention.Run(ctx, clubID, j.dryRun, domain.RoleSystem)
+}
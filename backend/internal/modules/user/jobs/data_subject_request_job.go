@@ -0,0 +1,37 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+)
+
+// DataSubjectRequestJob procesa las solicitudes de derechos GDPR verificadas (exportación y supresión),
+// avisa a los administradores las que están por vencer el plazo legal y borra las exportaciones vencidas.
+type DataSubjectRequestJob struct {
+	requests *application.DataSubjectRequestService
+}
+
+// NewDataSubjectRequestJob crea una nueva instancia del job
+func NewDataSubjectRequestJob(requests *application.DataSubjectRequestService) *DataSubjectRequestJob {
+	return &DataSubjectRequestJob{requests: requests}
+}
+
+// Run procesa la cola del club y devuelve cuántas solicitudes se completaron
+func (j *DataSubjectRequestJob) Run(ctx context.Context, clubID string) (int, error) {
+	completed, err := j.requests.ProcessQueued(ctx, clubID)
+	if err != nil {
+		return completed, err
+	}
+
+	if warned, err := j.requests.WarnDeadlines(ctx, clubID); err != nil {
+		log.Printf("[DataSubjectRequestJob] error revisando plazos del club %s: %v", clubID, err)
+	} else if warned > 0 {
+		log.Printf("[DataSubjectRequestJob] %d solicitudes por vencer en el club %s", warned, clubID)
+	}
+	if _, err := j.requests.PurgeExpiredArchives(ctx, clubID); err != nil {
+		log.Printf("[DataSubjectRequestJob] error borrando exportaciones vencidas del club %s: %v", clubID, err)
+	}
+	return completed, nil
+}
@@ -52,7 +52,7 @@ func (j *DocumentExpirationJob) notifyExpiringDocuments(days int, title string)
 
 	for _, doc := range docs {
 		message := fmt.Sprintf("Tu %s vence el %s. Por favor, renuévalo pronto.",
-			getDocumentTypeName(doc.Type),
+			doc.Type.Label(),
 			doc.ExpirationDate.Format("02/01/2006"))
 
 		notification := service.Notification{
@@ -90,7 +90,7 @@ func (j *DocumentExpirationJob) markExpiredDocuments() {
 
 		// Notificar al usuario
 		message := fmt.Sprintf("Tu %s ha vencido. Por favor, sube un nuevo documento.",
-			getDocumentTypeName(doc.Type))
+			doc.Type.Label())
 
 		notification := service.Notification{
 			RecipientID: doc.UserID,
@@ -107,24 +107,6 @@ func (j *DocumentExpirationJob) markExpiredDocuments() {
 	}
 }
 
-// getDocumentTypeName retorna el nombre legible del tipo de documento
-func getDocumentTypeName(docType domain.DocumentType) string {
-	switch docType {
-	case domain.DocumentTypeDNIFront:
-		return "DNI (Frente)"
-	case domain.DocumentTypeDNIBack:
-		return "DNI (Dorso)"
-	case domain.DocumentTypeEMMACMedical:
-		return "Apto Médico (EMMAC)"
-	case domain.DocumentTypeLeagueForm:
-		return "Formulario de Liga"
-	case domain.DocumentTypeInsurance:
-		return "Seguro"
-	default:
-		return string(docType)
-	}
-}
-
 // RunPeriodically ejecuta el job periódicamente
 func (j *DocumentExpirationJob) RunPeriodically(interval time.Duration, stop <-chan bool) {
 	ticker := time.NewTicker(interval)
@@ -0,0 +1,23 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/application"
+)
+
+// DocumentReviewEscalationJob avisa a los administradores cuando un documento pendiente supera el SLA
+// de revisión. Cada documento se escala una sola vez.
+type DocumentReviewEscalationJob struct {
+	reviews *application.DocumentReviewService
+}
+
+// NewDocumentReviewEscalationJob crea una nueva instancia del job
+func NewDocumentReviewEscalationJob(reviews *application.DocumentReviewService) *DocumentReviewEscalationJob {
+	return &DocumentReviewEscalationJob{reviews: reviews}
+}
+
+// Run escala los documentos demorados del club y devuelve cuántos se escalaron
+func (j *DocumentReviewEscalationJob) Run(ctx context.Context, clubID string) (int, error) {
+	return j.reviews.EscalateOverdue(ctx, clubID)
+}
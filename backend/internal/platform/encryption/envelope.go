@@ -0,0 +1,331 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDecrypt         = errors.New("unable to decrypt data")
+	ErrDataKeyNotFound = errors.New("data key not found")
+)
+
+// Ciphertext layouts. Binary blobs (files) start with a magic header; string columns
+// carry a text prefix so legacy plaintext values can be told apart and read as is.
+var blobMagic = []byte("CPE1")
+
+const stringPrefix = "enc:v1:"
+
+// activeKeyTTL bounds how long a process keeps encrypting with a data key after
+// another process (e.g. the rotation command) replaced it.
+const activeKeyTTL = 5 * time.Minute
+
+// DataKey is a per-club data-encryption key, stored wrapped by a master key.
+// Rotating creates a new version; retired versions are kept to decrypt older data.
+type DataKey struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key"`
+	ClubID      string    `gorm:"not null;uniqueIndex:idx_club_data_keys_version"`
+	Version     int       `gorm:"not null;uniqueIndex:idx_club_data_keys_version"`
+	WrappedKey  []byte    `gorm:"not null"`
+	MasterKeyID string    `gorm:"not null"`
+	Active      bool      `gorm:"not null;default:true"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	RetiredAt   *time.Time
+}
+
+func (DataKey) TableName() string {
+	return "club_data_keys"
+}
+
+// DataKeyStore persists the wrapped data keys
+type DataKeyStore interface {
+	// GetActive returns nil when the club has no key yet
+	GetActive(ctx context.Context, clubID string) (*DataKey, error)
+	// Get returns nil when the version does not exist
+	Get(ctx context.Context, clubID string, version int) (*DataKey, error)
+	Create(ctx context.Context, key *DataKey) error
+	// Rewrap stores the key material wrapped with another master key
+	Rewrap(ctx context.Context, key *DataKey) error
+	// Rotate retires current and creates next atomically
+	Rotate(ctx context.Context, current, next *DataKey) error
+	List(ctx context.Context) ([]DataKey, error)
+}
+
+type activeKey struct {
+	version   int
+	key       []byte
+	fetchedAt time.Time
+}
+
+// Envelope implements envelope encryption: data is encrypted with the club data key
+// (AES-256-GCM, bound to the club as associated data) and data keys are wrapped by the master key.
+type Envelope struct {
+	master *MasterKeys
+	store  DataKeyStore
+	now    func() time.Time
+
+	mu       sync.Mutex
+	active   map[string]activeKey
+	versions map[string][]byte // "<club>#<version>" -> unwrapped key
+}
+
+func NewEnvelope(master *MasterKeys, store DataKeyStore) *Envelope {
+	return &Envelope{
+		master:   master,
+		store:    store,
+		now:      time.Now,
+		active:   map[string]activeKey{},
+		versions: map[string][]byte{},
+	}
+}
+
+// Encrypt seals a blob with the active data key of the club, creating the first key on demand
+func (e *Envelope) Encrypt(ctx context.Context, clubID string, plaintext []byte) ([]byte, error) {
+	version, key, err := e.activeKey(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := seal(key, plaintext, []byte(clubID))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, 0, len(blobMagic)+4+len(sealed))
+	out = append(out, blobMagic...)
+	out = binary.BigEndian.AppendUint32(out, uint32(version))
+	return append(out, sealed...), nil
+}
+
+// Decrypt opens a blob produced by Encrypt for the same club
+func (e *Envelope) Decrypt(ctx context.Context, clubID string, data []byte) ([]byte, error) {
+	if !IsEncrypted(data) || len(data) < len(blobMagic)+4 {
+		return nil, ErrDecrypt
+	}
+	version := int(binary.BigEndian.Uint32(data[len(blobMagic):]))
+	key, err := e.versionKey(ctx, clubID, version)
+	if err != nil {
+		return nil, err
+	}
+	return open(key, data[len(blobMagic)+4:], []byte(clubID))
+}
+
+// IsEncrypted reports whether a blob was produced by Encrypt
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, blobMagic)
+}
+
+// EncryptString encrypts a column value. Empty values are kept empty.
+func (e *Envelope) EncryptString(ctx context.Context, clubID, value string) (string, error) {
+	if value == "" || IsEncryptedString(value) {
+		return value, nil
+	}
+	sealed, err := e.Encrypt(ctx, clubID, []byte(value))
+	if err != nil {
+		return "", err
+	}
+	return stringPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptString decrypts a column value. Values written before encryption was enabled are returned as is.
+func (e *Envelope) DecryptString(ctx context.Context, clubID, value string) (string, error) {
+	if !IsEncryptedString(value) {
+		return value, nil
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(value, stringPrefix))
+	if err != nil {
+		return "", ErrDecrypt
+	}
+	plaintext, err := e.Decrypt(ctx, clubID, sealed)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// IsEncryptedString reports whether a column value was produced by EncryptString
+func IsEncryptedString(value string) bool {
+	return strings.HasPrefix(value, stringPrefix)
+}
+
+// RotateDataKey creates a new data key version for the club. New data is encrypted with it,
+// older versions remain available to decrypt existing data.
+func (e *Envelope) RotateDataKey(ctx context.Context, clubID string) (*DataKey, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	current, err := e.store.GetActive(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	version := 1
+	if current != nil {
+		version = current.Version + 1
+	}
+	next, key, err := e.newDataKey(clubID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if current == nil {
+		err = e.store.Create(ctx, next)
+	} else {
+		now := e.now()
+		current.Active = false
+		current.RetiredAt = &now
+		err = e.store.Rotate(ctx, current, next)
+	}
+	if err != nil {
+		return nil, err
+	}
+	e.versions[versionCacheKey(clubID, version)] = key
+	e.active[clubID] = activeKey{version: version, key: key, fetchedAt: e.now()}
+	return next, nil
+}
+
+// RotateMasterKey rewraps every data key that is not wrapped with the primary master key.
+// After it completes the previous master keys can be removed from the configuration.
+func (e *Envelope) RotateMasterKey(ctx context.Context) (int, error) {
+	keys, err := e.store.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rewrapped := 0
+	for i := range keys {
+		dk := &keys[i]
+		if dk.MasterKeyID == e.master.PrimaryID() {
+			continue
+		}
+		aad := wrapAAD(dk.ClubID, dk.Version)
+		plain, err := e.master.unwrap(dk.MasterKeyID, dk.WrappedKey, aad)
+		if err != nil {
+			return rewrapped, fmt.Errorf("club %s key v%d: %w", dk.ClubID, dk.Version, err)
+		}
+		wrapped, err := e.master.wrap(plain, aad)
+		if err != nil {
+			return rewrapped, err
+		}
+		dk.WrappedKey = wrapped
+		dk.MasterKeyID = e.master.PrimaryID()
+		if err := e.store.Rewrap(ctx, dk); err != nil {
+			return rewrapped, err
+		}
+		rewrapped++
+	}
+	return rewrapped, nil
+}
+
+// RotateAllDataKeys rotates the data key of every club that already has one
+func (e *Envelope) RotateAllDataKeys(ctx context.Context) (int, error) {
+	keys, err := e.store.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rotated := 0
+	for _, dk := range keys {
+		if !dk.Active {
+			continue
+		}
+		if _, err := e.RotateDataKey(ctx, dk.ClubID); err != nil {
+			return rotated, fmt.Errorf("club %s: %w", dk.ClubID, err)
+		}
+		rotated++
+	}
+	return rotated, nil
+}
+
+func (e *Envelope) activeKey(ctx context.Context, clubID string) (int, []byte, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if cached, ok := e.active[clubID]; ok && e.now().Sub(cached.fetchedAt) < activeKeyTTL {
+		return cached.version, cached.key, nil
+	}
+
+	dk, err := e.store.GetActive(ctx, clubID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if dk == nil {
+		// First encryption for the club
+		created, _, err := e.newDataKey(clubID, 1)
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := e.store.Create(ctx, created); err != nil {
+			// Another instance may have created it concurrently
+			if dk, _ = e.store.GetActive(ctx, clubID); dk == nil {
+				return 0, nil, err
+			}
+		} else {
+			dk = created
+		}
+	}
+	key, err := e.master.unwrap(dk.MasterKeyID, dk.WrappedKey, wrapAAD(clubID, dk.Version))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	e.versions[versionCacheKey(clubID, dk.Version)] = key
+	e.active[clubID] = activeKey{version: dk.Version, key: key, fetchedAt: e.now()}
+	return dk.Version, key, nil
+}
+
+func (e *Envelope) versionKey(ctx context.Context, clubID string, version int) ([]byte, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if key, ok := e.versions[versionCacheKey(clubID, version)]; ok {
+		return key, nil
+	}
+	dk, err := e.store.Get(ctx, clubID, version)
+	if err != nil {
+		return nil, err
+	}
+	if dk == nil {
+		return nil, ErrDataKeyNotFound
+	}
+	key, err := e.master.unwrap(dk.MasterKeyID, dk.WrappedKey, wrapAAD(clubID, version))
+	if err != nil {
+		return nil, err
+	}
+	e.versions[versionCacheKey(clubID, version)] = key
+	return key, nil
+}
+
+func (e *Envelope) newDataKey(clubID string, version int) (*DataKey, []byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, err
+	}
+	wrapped, err := e.master.wrap(key, wrapAAD(clubID, version))
+	if err != nil {
+		return nil, nil, err
+	}
+	return &DataKey{
+		ID:          uuid.New(),
+		ClubID:      clubID,
+		Version:     version,
+		WrappedKey:  wrapped,
+		MasterKeyID: e.master.PrimaryID(),
+		Active:      true,
+	}, key, nil
+}
+
+// wrapAAD binds a wrapped key to its club and version so it cannot be swapped between rows
+func wrapAAD(clubID string, version int) string {
+	return clubID + "#" + strconv.Itoa(version)
+}
+
+func versionCacheKey(clubID string, version int) string {
+	return wrapAAD(clubID, version)
+}
@@ -0,0 +1,194 @@
+package encryption
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryKeyStore struct {
+	mu   sync.Mutex
+	keys []DataKey
+}
+
+func (s *memoryKeyStore) GetActive(ctx context.Context, clubID string) (*DataKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.keys {
+		if s.keys[i].ClubID == clubID && s.keys[i].Active {
+			k := s.keys[i]
+			return &k, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *memoryKeyStore) Get(ctx context.Context, clubID string, version int) (*DataKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.keys {
+		if s.keys[i].ClubID == clubID && s.keys[i].Version == version {
+			k := s.keys[i]
+			return &k, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *memoryKeyStore) Create(ctx context.Context, key *DataKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = append(s.keys, *key)
+	return nil
+}
+
+func (s *memoryKeyStore) Rewrap(ctx context.Context, key *DataKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.keys {
+		if s.keys[i].ID == key.ID {
+			s.keys[i].WrappedKey = key.WrappedKey
+			s.keys[i].MasterKeyID = key.MasterKeyID
+		}
+	}
+	return nil
+}
+
+func (s *memoryKeyStore) Rotate(ctx context.Context, current, next *DataKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.keys {
+		if s.keys[i].ID == current.ID {
+			s.keys[i].Active = false
+			s.keys[i].RetiredAt = current.RetiredAt
+		}
+	}
+	s.keys = append(s.keys, *next)
+	return nil
+}
+
+func (s *memoryKeyStore) List(ctx context.Context) ([]DataKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]DataKey(nil), s.keys...), nil
+}
+
+func newTestMasterKeys(t *testing.T, primaryID string, previous string) (*MasterKeys, string) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	master, err := ParseMasterKeys(primaryID, key, previous)
+	require.NoError(t, err)
+	return master, key
+}
+
+func TestParseMasterKeys(t *testing.T) {
+	_, err := ParseMasterKeys("k1", "", "")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+	_, err = ParseMasterKeys("k1", "c2hvcnQ=", "")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+
+	key, _ := GenerateKey()
+	_, err = ParseMasterKeys("k2", key, "k2:"+key)
+	assert.Error(t, err)
+
+	master, err := ParseMasterKeys("", key, " k0:"+key+" ,")
+	require.NoError(t, err)
+	assert.Equal(t, "k1", master.PrimaryID())
+}
+
+func TestEnvelope_EncryptDecrypt(t *testing.T) {
+	ctx := context.TODO()
+	master, _ := newTestMasterKeys(t, "k1", "")
+	store := &memoryKeyStore{}
+	env := NewEnvelope(master, store)
+
+	blob, err := env.Encrypt(ctx, "club-1", []byte("%PDF apto medico"))
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(blob))
+	assert.NotContains(t, string(blob), "apto medico")
+	assert.Len(t, store.keys, 1, "the first data key is created on demand")
+
+	plain, err := env.Decrypt(ctx, "club-1", blob)
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF apto medico", string(plain))
+
+	// Ciphertext is bound to the club
+	_, err = env.Decrypt(ctx, "club-2", blob)
+	assert.Error(t, err)
+
+	// A fresh process (empty cache) decrypts with the stored wrapped key
+	plain, err = NewEnvelope(master, store).Decrypt(ctx, "club-1", blob)
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF apto medico", string(plain))
+
+	value, err := env.EncryptString(ctx, "club-1", "OSDE 210-55555")
+	require.NoError(t, err)
+	assert.True(t, IsEncryptedString(value))
+	again, _ := env.EncryptString(ctx, "club-1", value)
+	assert.Equal(t, value, again, "already encrypted values are not encrypted twice")
+
+	got, err := env.DecryptString(ctx, "club-1", value)
+	require.NoError(t, err)
+	assert.Equal(t, "OSDE 210-55555", got)
+
+	legacy, err := env.DecryptString(ctx, "club-1", "11-5555-0000")
+	require.NoError(t, err)
+	assert.Equal(t, "11-5555-0000", legacy)
+
+	empty, _ := env.EncryptString(ctx, "club-1", "")
+	assert.Empty(t, empty)
+}
+
+func TestEnvelope_Rotation(t *testing.T) {
+	ctx := context.TODO()
+	oldMaster, oldKey := newTestMasterKeys(t, "k1", "")
+	store := &memoryKeyStore{}
+	env := NewEnvelope(oldMaster, store)
+
+	before, err := env.EncryptString(ctx, "club-1", "Ana Pérez")
+	require.NoError(t, err)
+
+	// Data key rotation: new data uses v2, v1 data is still readable
+	rotated, err := env.RotateDataKey(ctx, "club-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, rotated.Version)
+	after, err := env.EncryptString(ctx, "club-1", "Ana Pérez")
+	require.NoError(t, err)
+	blob, _ := env.Encrypt(ctx, "club-1", []byte("x"))
+	assert.Equal(t, []byte{0, 0, 0, 2}, blob[4:8])
+
+	_, err = env.Encrypt(ctx, "club-2", []byte("x"))
+	require.NoError(t, err)
+	rotatedClubs, err := env.RotateAllDataKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rotatedClubs)
+	active, _ := store.GetActive(ctx, "club-1")
+	assert.Equal(t, 3, active.Version)
+
+	// Master key rotation: data keys are rewrapped with the new primary key
+	newMaster, newKey := newTestMasterKeys(t, "k2", "k1:"+oldKey)
+	rewrapped, err := NewEnvelope(newMaster, store).RotateMasterKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 5, rewrapped)
+	for _, k := range store.keys {
+		assert.Equal(t, "k2", k.MasterKeyID)
+	}
+
+	// Once rewrapped, the previous master key is no longer needed
+	onlyNew, err := ParseMasterKeys("k2", newKey, "")
+	require.NoError(t, err)
+	standalone := NewEnvelope(onlyNew, store)
+	for _, value := range []string{before, after} {
+		got, err := standalone.DecryptString(ctx, "club-1", value)
+		require.NoError(t, err)
+		assert.Equal(t, "Ana Pérez", got)
+	}
+
+	// Without the right master key nothing can be unwrapped
+	otherMaster, _ := newTestMasterKeys(t, "k9", "")
+	_, err = NewEnvelope(otherMaster, store).DecryptString(ctx, "club-1", before)
+	assert.ErrorIs(t, err, ErrUnknownMasterKey)
+}
@@ -0,0 +1,66 @@
+package encryption
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// GormDataKeyStore keeps the wrapped data keys in the club_data_keys table
+type GormDataKeyStore struct {
+	db *gorm.DB
+}
+
+func NewGormDataKeyStore(db *gorm.DB) *GormDataKeyStore {
+	return &GormDataKeyStore{db: db}
+}
+
+func (s *GormDataKeyStore) GetActive(ctx context.Context, clubID string) (*DataKey, error) {
+	var key DataKey
+	err := s.db.WithContext(ctx).Where("club_id = ? AND active = ?", clubID, true).Order("version DESC").First(&key).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (s *GormDataKeyStore) Get(ctx context.Context, clubID string, version int) (*DataKey, error) {
+	var key DataKey
+	err := s.db.WithContext(ctx).Where("club_id = ? AND version = ?", clubID, version).First(&key).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (s *GormDataKeyStore) Create(ctx context.Context, key *DataKey) error {
+	return s.db.WithContext(ctx).Create(key).Error
+}
+
+func (s *GormDataKeyStore) Rewrap(ctx context.Context, key *DataKey) error {
+	return s.db.WithContext(ctx).Model(&DataKey{}).Where("id = ?", key.ID).
+		Updates(map[string]interface{}{"wrapped_key": key.WrappedKey, "master_key_id": key.MasterKeyID}).Error
+}
+
+func (s *GormDataKeyStore) Rotate(ctx context.Context, current, next *DataKey) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&DataKey{}).Where("id = ?", current.ID).
+			Updates(map[string]interface{}{"active": false, "retired_at": current.RetiredAt}).Error; err != nil {
+			return err
+		}
+		return tx.Create(next).Error
+	})
+}
+
+func (s *GormDataKeyStore) List(ctx context.Context) ([]DataKey, error) {
+	var keys []DataKey
+	err := s.db.WithContext(ctx).Order("club_id, version").Find(&keys).Error
+	return keys, err
+}
@@ -0,0 +1,141 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeySize is the size of master and data keys (AES-256)
+const KeySize = 32
+
+var (
+	ErrUnknownMasterKey = errors.New("data key is wrapped with an unknown master key")
+	ErrInvalidKey       = errors.New("encryption keys must be 32 bytes, base64 encoded")
+)
+
+// MasterKeys holds the key-encryption keys loaded from configuration.
+// Only the primary key wraps new data keys; previous keys are kept to unwrap
+// data keys until they are rewrapped by a rotation.
+type MasterKeys struct {
+	primaryID string
+	keys      map[string][]byte
+}
+
+// ParseMasterKeys builds the master keys from configuration values.
+// previous is a comma separated list of "id:base64key" entries.
+func ParseMasterKeys(primaryID, primary, previous string) (*MasterKeys, error) {
+	if primaryID == "" {
+		primaryID = "k1"
+	}
+	key, err := decodeKey(primary)
+	if err != nil {
+		return nil, err
+	}
+	m := &MasterKeys{primaryID: primaryID, keys: map[string][]byte{primaryID: key}}
+
+	for _, entry := range strings.Split(previous, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, encoded, ok := strings.Cut(entry, ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid previous master key entry %q", id)
+		}
+		if id == primaryID {
+			return nil, fmt.Errorf("previous master key %q has the same id as the primary key", id)
+		}
+		key, err := decodeKey(encoded)
+		if err != nil {
+			return nil, err
+		}
+		m.keys[id] = key
+	}
+	return m, nil
+}
+
+// MasterKeysFromEnv loads ENCRYPTION_MASTER_KEY, ENCRYPTION_MASTER_KEY_ID and
+// ENCRYPTION_PREVIOUS_MASTER_KEYS. It returns nil when encryption is not configured.
+func MasterKeysFromEnv() (*MasterKeys, error) {
+	primary := os.Getenv("ENCRYPTION_MASTER_KEY")
+	if primary == "" {
+		return nil, nil
+	}
+	return ParseMasterKeys(os.Getenv("ENCRYPTION_MASTER_KEY_ID"), primary, os.Getenv("ENCRYPTION_PREVIOUS_MASTER_KEYS"))
+}
+
+// PrimaryID returns the id of the key used to wrap new data keys
+func (m *MasterKeys) PrimaryID() string {
+	return m.primaryID
+}
+
+// GenerateKey returns a random key, base64 encoded, suitable for the configuration
+func GenerateKey() (string, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+func (m *MasterKeys) wrap(dataKey []byte, aad string) ([]byte, error) {
+	return seal(m.keys[m.primaryID], dataKey, []byte(aad))
+}
+
+func (m *MasterKeys) unwrap(masterKeyID string, wrapped []byte, aad string) ([]byte, error) {
+	key, ok := m.keys[masterKeyID]
+	if !ok {
+		return nil, ErrUnknownMasterKey
+	}
+	return open(key, wrapped, []byte(aad))
+}
+
+func decodeKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil || len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	return key, nil
+}
+
+// seal encrypts with AES-256-GCM and returns nonce || ciphertext
+func seal(key, plaintext, aad []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(key, sealed, aad []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, ErrDecrypt
+	}
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], aad)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
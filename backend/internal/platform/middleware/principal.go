@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"context"
+
+	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
+)
+
+type principalKey struct{}
+
+// Principal is the authenticated caller, carried in the request context so that
+// data-layer policies (e.g. decrypting sensitive fields) can be applied outside handlers.
+type Principal struct {
+	UserID string
+	Role   string
+}
+
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the caller set by the auth middleware or SystemContext
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// SystemContext marks background work (jobs, maintenance commands) that acts on behalf of the platform
+func SystemContext(ctx context.Context) context.Context {
+	return WithPrincipal(ctx, Principal{UserID: "system", Role: userDomain.RoleSystem})
+}
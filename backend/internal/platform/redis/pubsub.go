@@ -21,6 +21,7 @@ const (
 const (
 	ChannelBookings    = "clubpulse:bookings"
 	ChannelMaintenance = "clubpulse:maintenance"
+	ChannelTopics      = "clubpulse:topics"
 )
 
 // BookingEvent represents a booking-related event
@@ -34,6 +35,12 @@ type BookingEvent struct {
 	Timestamp  time.Time `json:"timestamp"`
 }
 
+// TopicEvent wraps a WebSocket message addressed to a Hub topic so every API instance can deliver it
+type TopicEvent struct {
+	Topic   string          `json:"topic"`
+	Payload json.RawMessage `json:"payload"`
+}
+
 // EventPublisher publishes events to Redis Pub/Sub
 type EventPublisher struct {
 	redis *RedisClient
@@ -85,6 +92,16 @@ func (p *EventPublisher) PublishSlotAvailable(ctx context.Context, facilityID st
 	return p.redis.Publish(ctx, ChannelBookings, string(data))
 }
 
+// PublishTopicEvent fans out a WebSocket message for a topic (e.g. "match:<id>") to all instances
+func (p *EventPublisher) PublishTopicEvent(ctx context.Context, topic string, payload []byte) error {
+	data, err := json.Marshal(TopicEvent{Topic: topic, Payload: payload})
+	if err != nil {
+		return err
+	}
+
+	return p.redis.Publish(ctx, ChannelTopics, string(data))
+}
+
 // EventSubscriber subscribes to Redis Pub/Sub channels
 type EventSubscriber struct {
 	redis *RedisClient
@@ -121,6 +138,34 @@ func (s *EventSubscriber) SubscribeToBookings(ctx context.Context, handler func(
 	return nil
 }
 
+// SubscribeToTopics subscribes to topic events and calls the handler for each event
+func (s *EventSubscriber) SubscribeToTopics(ctx context.Context, handler func(event TopicEvent)) error {
+	pubsub := s.redis.Subscribe(ctx, ChannelTopics)
+
+	go func() {
+		ch := pubsub.Channel()
+		for {
+			select {
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				var event TopicEvent
+				if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+					log.Printf("Failed to unmarshal topic event: %v", err)
+					continue
+				}
+				handler(event)
+			case <-ctx.Done():
+				pubsub.Close()
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
 func (s *EventSubscriber) processMessage(msg *goredis.Message, handler func(event BookingEvent)) {
 	var event BookingEvent
 	if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var ErrInfected = errors.New("file rejected by virus scan")
+
+// Scanner is the hook called on every upload before it reaches the storage backend.
+// Implementations return ErrInfected (optionally wrapped with the signature name) to reject a file.
+type Scanner interface {
+	Scan(ctx context.Context, body io.Reader) error
+}
+
+// NoopScanner accepts every file. Used when no antivirus is configured.
+type NoopScanner struct{}
+
+func (NoopScanner) Scan(ctx context.Context, body io.Reader) error {
+	return nil
+}
+
+// ScannerFunc adapts a function to the Scanner interface.
+type ScannerFunc func(ctx context.Context, body io.Reader) error
+
+func (f ScannerFunc) Scan(ctx context.Context, body io.Reader) error {
+	return f(ctx, body)
+}
+
+// HTTPScanner sends each file to an antivirus service over HTTP (e.g. a ClamAV REST wrapper).
+// The service answers 200/204 for clean files and 406/422 for infected ones, with the
+// signature name in the body; any other answer fails the upload.
+type HTTPScanner struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPScanner(url string, client *http.Client) (*HTTPScanner, error) {
+	if url == "" {
+		return nil, errors.New("http scanner: url is required")
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+	return &HTTPScanner{url: url, client: client}, nil
+}
+
+func (s *HTTPScanner) Scan(ctx context.Context, body io.Reader) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("http scanner: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return nil
+	case http.StatusNotAcceptable, http.StatusUnprocessableEntity:
+		signature, _ := io.ReadAll(io.LimitReader(resp.Body, 256))
+		if name := strings.TrimSpace(string(signature)); name != "" {
+			return fmt.Errorf("%w: %s", ErrInfected, name)
+		}
+		return ErrInfected
+	}
+	return fmt.Errorf("http scanner: unexpected status %d", resp.StatusCode)
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid download token")
+	ErrTokenExpired = errors.New("download token expired")
+)
+
+// URLSigner issues the short-lived tokens used in download links.
+// A token is "<base64url(subject)>.<expiresUnix>.<hmac>"; the subject is opaque to the signer.
+type URLSigner struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewURLSigner(secret string, ttl time.Duration) *URLSigner {
+	if ttl <= 0 {
+		ttl = 5 * time.Minute
+	}
+	return &URLSigner{secret: []byte(secret), ttl: ttl}
+}
+
+func (s *URLSigner) Sign(subject string, now time.Time) (string, time.Time) {
+	expiresAt := now.Add(s.ttl).Truncate(time.Second)
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(subject))
+	exp := strconv.FormatInt(expiresAt.Unix(), 10)
+	return encoded + "." + exp + "." + s.mac(encoded, exp), expiresAt
+}
+
+// Verify returns the subject of a valid, unexpired token.
+func (s *URLSigner) Verify(token string, now time.Time) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", ErrInvalidToken
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(s.mac(parts[0], parts[1]))) {
+		return "", ErrInvalidToken
+	}
+	exp, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	if now.After(time.Unix(exp, 0)) {
+		return "", ErrTokenExpired
+	}
+	subject, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	return string(subject), nil
+}
+
+func (s *URLSigner) mac(subject, exp string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(subject + "|" + exp))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+)
+
+var (
+	ErrNotFound   = errors.New("object not found")
+	ErrInvalidKey = errors.New("invalid object key")
+)
+
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+}
+
+// FileStorage is the pluggable backend where uploaded files are kept.
+// Keys are slash separated relative paths such as "club-1/user-1/<uuid>.pdf".
+type FileStorage interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get returns ErrNotFound when the key does not exist. The caller closes the reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error)
+	// Delete is idempotent: deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// cleanKey rejects absolute keys and keys that escape the storage root.
+func cleanKey(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return "", ErrInvalidKey
+	}
+	cleaned := path.Clean(key)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", ErrInvalidKey
+	}
+	return cleaned, nil
+}
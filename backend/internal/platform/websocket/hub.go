@@ -318,6 +318,39 @@ func (h *Hub) subscribeToRedis(ctx context.Context) {
 	if err != nil {
 		log.Printf("Redis error: %v", err)
 	}
+
+	// Topic messages published by any instance (live scoring, etc.)
+	err = subscriber.SubscribeToTopics(ctx, func(event platformRedis.TopicEvent) {
+		h.broadcast <- broadcastMessage{topic: event.Topic, payload: event.Payload}
+	})
+	if err != nil {
+		log.Printf("Redis error: %v", err)
+	}
+}
+
+// Publish sends a typed message to every client subscribed to topic.
+// The message goes through Redis so clients connected to other instances receive it too;
+// if Redis is unavailable it is delivered to the local subscribers only.
+func (h *Hub) Publish(ctx context.Context, topic, msgType string, payload interface{}) error {
+	data, err := json.Marshal(WebSocketMessage{
+		Type:      msgType,
+		Payload:   payload,
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := platformRedis.NewEventPublisher().PublishTopicEvent(ctx, topic, data); err != nil {
+		log.Printf("Redis fan-out unavailable for %s, delivering locally: %v", topic, err)
+		h.BroadcastToTopic(topic, data)
+	}
+	return nil
+}
+
+// BroadcastToTopic delivers a raw message to the clients subscribed to topic on this instance.
+func (h *Hub) BroadcastToTopic(topic string, message []byte) {
+	h.broadcast <- broadcastMessage{topic: topic, payload: message}
 }
 
 // --- Handlers ---
@@ -0,0 +1,22 @@
+-- Player-level match events (goals, assists, cards, MVP, substitutions)
+CREATE TABLE IF NOT EXISTS match_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    tournament_id UUID NOT NULL REFERENCES championships(id) ON DELETE CASCADE,
+    match_id UUID NOT NULL REFERENCES tournament_matches(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL, -- 'GOAL', 'ASSIST', 'YELLOW_CARD', 'RED_CARD', 'MVP', 'SUBSTITUTION'
+    minute INT NOT NULL DEFAULT 0,
+    related_user_id VARCHAR(100), -- Jugador que ingresa en un SUBSTITUTION
+    notes TEXT,
+
+    recorded_by VARCHAR(100),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_match_events_club_id ON match_events(club_id);
+CREATE INDEX IF NOT EXISTS idx_match_events_match_id ON match_events(match_id);
+CREATE INDEX IF NOT EXISTS idx_match_events_tournament_type ON match_events(tournament_id, type);
+CREATE INDEX IF NOT EXISTS idx_match_events_user_id ON match_events(user_id);
+
+COMMENT ON TABLE match_events IS 'Incidencias por jugador en partidos de torneo (goleadores, tarjetas, figura)';
@@ -0,0 +1,22 @@
+-- Disciplinary suspensions per tournament (automatic from cards or manual)
+CREATE TABLE IF NOT EXISTS player_suspensions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    tournament_id UUID NOT NULL REFERENCES championships(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason VARCHAR(30) NOT NULL, -- 'YELLOW_ACCUMULATION', 'DOUBLE_YELLOW', 'RED_CARD', 'MANUAL'
+    source_match_id UUID REFERENCES tournament_matches(id) ON DELETE SET NULL,
+    matches_suspended INT NOT NULL,
+    served_match_ids JSONB NOT NULL DEFAULT '[]', -- Partidos en los que ya se cumplió la fecha
+    notes TEXT,
+
+    created_by VARCHAR(100),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_player_suspensions_club_id ON player_suspensions(club_id);
+CREATE INDEX IF NOT EXISTS idx_player_suspensions_tournament_user ON player_suspensions(tournament_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_player_suspensions_source_match ON player_suspensions(source_match_id);
+
+COMMENT ON TABLE player_suspensions IS 'Sanciones disciplinarias por torneo (acumulación de amarillas, rojas, tribunal)';
@@ -0,0 +1,3 @@
+ALTER TABLE tournament_matches DROP COLUMN IF EXISTS location;
+ALTER TABLE tournament_matches DROP COLUMN IF EXISTS round;
+-- teams.captain_id predates this migration in some environments (disciplines teams), so it is kept.
@@ -0,0 +1,7 @@
+-- Fields needed to host disciplines tournaments (LEAGUE/BRACKET) in the championship model
+ALTER TABLE tournament_matches ADD COLUMN IF NOT EXISTS round VARCHAR(100);
+ALTER TABLE tournament_matches ADD COLUMN IF NOT EXISTS location VARCHAR(255);
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS captain_id VARCHAR(100);
+
+COMMENT ON COLUMN tournament_matches.round IS 'Fecha o ronda ("1", "Semifinal"), migrado desde matches.round';
+COMMENT ON COLUMN tournament_matches.location IS 'Lugar en texto libre cuando el partido no tiene reserva';
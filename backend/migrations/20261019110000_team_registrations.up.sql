@@ -0,0 +1,32 @@
+-- Registration of teams from other clubs (inter-club tournaments)
+CREATE TABLE IF NOT EXISTS team_registrations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL, -- Organizing club
+    tournament_id UUID NOT NULL REFERENCES championships(id) ON DELETE CASCADE,
+    team_name VARCHAR(255) NOT NULL,
+    origin_club VARCHAR(255),
+    contact_name VARCHAR(255) NOT NULL,
+    contact_email VARCHAR(255) NOT NULL,
+    contact_phone VARCHAR(50),
+    roster JSONB NOT NULL DEFAULT '[]', -- Lista de buena fe: jugadores por DNI y sus documentos
+    status VARCHAR(30) NOT NULL DEFAULT 'PENDING_PAYMENT', -- 'PENDING_PAYMENT', 'PENDING_APPROVAL', 'APPROVED', 'REJECTED'
+
+    entry_fee DECIMAL(10,2) NOT NULL DEFAULT 0,
+    payment_id UUID,
+    paid_at TIMESTAMPTZ,
+
+    access_token VARCHAR(100) NOT NULL,
+
+    team_id UUID REFERENCES teams(id) ON DELETE SET NULL,
+    group_id UUID REFERENCES groups(id) ON DELETE SET NULL,
+    reviewed_by VARCHAR(100),
+    reviewed_at TIMESTAMPTZ,
+    rejection_reason TEXT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_team_registrations_club_id ON team_registrations(club_id);
+CREATE INDEX IF NOT EXISTS idx_team_registrations_tournament_status ON team_registrations(tournament_id, status);
+
+COMMENT ON TABLE team_registrations IS 'Inscripciones de equipos de otros clubes a torneos interclubes';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS official_assignments;
+DROP TABLE IF EXISTS official_availability;
+DROP TABLE IF EXISTS officials;
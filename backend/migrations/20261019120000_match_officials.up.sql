@@ -0,0 +1,50 @@
+-- Registry of referees and match officials, availability calendar and per-match assignments with fees
+CREATE TABLE IF NOT EXISTS officials (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    user_id VARCHAR(100) REFERENCES users(id) ON DELETE SET NULL,
+    first_name VARCHAR(100) NOT NULL,
+    last_name VARCHAR(100) NOT NULL,
+    email VARCHAR(255),
+    phone VARCHAR(50),
+    qualifications JSONB NOT NULL DEFAULT '[]', -- [{"sport": "FUTBOL", "role": "REFEREE", "level": "Regional"}]
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_officials_club_id ON officials(club_id);
+CREATE INDEX IF NOT EXISTS idx_officials_user_id ON officials(user_id);
+
+CREATE TABLE IF NOT EXISTS official_availability (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    official_id UUID NOT NULL REFERENCES officials(id) ON DELETE CASCADE,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    notes TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (end_time > start_time)
+);
+CREATE INDEX IF NOT EXISTS idx_official_availability_range ON official_availability(club_id, start_time, end_time);
+CREATE INDEX IF NOT EXISTS idx_official_availability_official ON official_availability(official_id);
+
+CREATE TABLE IF NOT EXISTS official_assignments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    tournament_id UUID NOT NULL REFERENCES championships(id) ON DELETE CASCADE,
+    match_id UUID NOT NULL REFERENCES tournament_matches(id) ON DELETE CASCADE,
+    official_id UUID NOT NULL REFERENCES officials(id) ON DELETE CASCADE,
+    role VARCHAR(30) NOT NULL, -- 'REFEREE', 'LINE_JUDGE', 'TABLE_OFFICIAL'
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    fee DECIMAL(10,2) NOT NULL DEFAULT 0, -- Honorario fijado al designar
+    paid_at TIMESTAMPTZ,
+    assigned_by VARCHAR(100),
+    auto_assigned BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (match_id, official_id)
+);
+CREATE INDEX IF NOT EXISTS idx_official_assignments_club_range ON official_assignments(club_id, start_time, end_time);
+CREATE INDEX IF NOT EXISTS idx_official_assignments_official ON official_assignments(official_id, start_time);
+
+COMMENT ON TABLE official_assignments IS 'Designaciones de árbitros y oficiales de mesa; fee es el monto a pagar por partido';
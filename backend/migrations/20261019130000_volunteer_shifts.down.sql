@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_volunteer_assignments_hours_status;
+DROP INDEX IF EXISTS idx_volunteer_assignments_shift_id;
+ALTER TABLE volunteer_assignments
+    DROP COLUMN IF EXISTS reminder_sent_at,
+    DROP COLUMN IF EXISTS credit_payment_id,
+    DROP COLUMN IF EXISTS hours_reviewed_at,
+    DROP COLUMN IF EXISTS hours_reviewed_by,
+    DROP COLUMN IF EXISTS hours_status,
+    DROP COLUMN IF EXISTS hours,
+    DROP COLUMN IF EXISTS check_out_at,
+    DROP COLUMN IF EXISTS check_in_at,
+    DROP COLUMN IF EXISTS shift_id;
+
+DROP TABLE IF EXISTS volunteer_shifts;
@@ -0,0 +1,30 @@
+-- Open volunteer shifts per match (self sign-up)
+CREATE TABLE IF NOT EXISTS volunteer_shifts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    match_id UUID NOT NULL,
+    role VARCHAR(100) NOT NULL, -- 'BUFFET', 'SECURITY', 'TRANSPORT', etc.
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    capacity INT NOT NULL CHECK (capacity > 0),
+    description TEXT,
+    created_by VARCHAR(100),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_volunteer_shifts_club_start ON volunteer_shifts(club_id, start_time);
+CREATE INDEX IF NOT EXISTS idx_volunteer_shifts_match_id ON volunteer_shifts(match_id);
+
+-- Attendance and hours tracking on assignments
+ALTER TABLE volunteer_assignments
+    ADD COLUMN IF NOT EXISTS shift_id UUID REFERENCES volunteer_shifts(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS check_in_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS check_out_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS hours DECIMAL(6,2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS hours_status VARCHAR(30) NOT NULL DEFAULT '', -- '', 'PENDING_APPROVAL', 'APPROVED', 'REJECTED', 'CREDITED'
+    ADD COLUMN IF NOT EXISTS hours_reviewed_by VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS hours_reviewed_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS credit_payment_id UUID, -- LABOR_EXCHANGE payment that credited the hours
+    ADD COLUMN IF NOT EXISTS reminder_sent_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS idx_volunteer_assignments_shift_id ON volunteer_assignments(shift_id);
+CREATE INDEX IF NOT EXISTS idx_volunteer_assignments_hours_status ON volunteer_assignments(club_id, hours_status);
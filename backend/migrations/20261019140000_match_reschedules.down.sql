@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_match_reschedule_requests_pending;
+DROP INDEX IF EXISTS idx_match_reschedule_requests_match;
+DROP TABLE IF EXISTS match_reschedule_requests;
@@ -0,0 +1,24 @@
+-- Reschedule proposals between team captains (matches can also be POSTPONED)
+CREATE TABLE IF NOT EXISTS match_reschedule_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    match_id UUID NOT NULL REFERENCES tournament_matches(id) ON DELETE CASCADE,
+    requesting_team_id UUID NOT NULL,
+    responding_team_id UUID NOT NULL,
+    requested_by VARCHAR(100) NOT NULL,
+    reason TEXT,
+    proposed_slots JSONB NOT NULL DEFAULT '[]', -- [{date, court_id, location}]
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING', -- 'PENDING', 'ACCEPTED', 'REJECTED', 'WITHDRAWN'
+    accepted_slot INT,
+    responded_by VARCHAR(100),
+    responded_at TIMESTAMPTZ,
+    response_note TEXT,
+    previous_date TIMESTAMPTZ NOT NULL,
+    previous_booking_id UUID,
+    new_booking_id UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_match_reschedule_requests_match ON match_reschedule_requests(club_id, match_id);
+-- Only one open proposal per match
+CREATE UNIQUE INDEX IF NOT EXISTS idx_match_reschedule_requests_pending ON match_reschedule_requests(match_id) WHERE status = 'PENDING';
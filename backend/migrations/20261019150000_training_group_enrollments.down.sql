@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_training_group_staff_user;
+DROP TABLE IF EXISTS training_group_staff;
+DROP INDEX IF EXISTS idx_training_group_enrollments_open;
+DROP INDEX IF EXISTS idx_training_group_enrollments_user;
+DROP INDEX IF EXISTS idx_training_group_enrollments_group;
+DROP TABLE IF EXISTS training_group_enrollments;
+ALTER TABLE training_groups DROP COLUMN IF EXISTS monthly_fee;
+ALTER TABLE training_groups DROP COLUMN IF EXISTS capacity;
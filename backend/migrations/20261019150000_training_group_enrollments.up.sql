@@ -0,0 +1,32 @@
+-- Explicit training group rosters: capacity, monthly fee, enrollments (with waitlist) and coaching staff
+ALTER TABLE training_groups ADD COLUMN IF NOT EXISTS capacity INT NOT NULL DEFAULT 0; -- 0 = unlimited
+ALTER TABLE training_groups ADD COLUMN IF NOT EXISTS monthly_fee DECIMAL(10,2) NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS training_group_enrollments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    group_id UUID NOT NULL REFERENCES training_groups(id) ON DELETE CASCADE,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE', -- 'ACTIVE', 'WAITLISTED', 'WITHDRAWN'
+    start_date DATE NOT NULL,
+    end_date DATE,
+    notes TEXT,
+    created_by VARCHAR(100),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_training_group_enrollments_group ON training_group_enrollments(club_id, group_id, status);
+CREATE INDEX IF NOT EXISTS idx_training_group_enrollments_user ON training_group_enrollments(club_id, user_id);
+-- A member holds at most one open (active or waitlisted) enrollment per group
+CREATE UNIQUE INDEX IF NOT EXISTS idx_training_group_enrollments_open ON training_group_enrollments(group_id, user_id) WHERE status IN ('ACTIVE', 'WAITLISTED');
+
+CREATE TABLE IF NOT EXISTS training_group_staff (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    group_id UUID NOT NULL REFERENCES training_groups(id) ON DELETE CASCADE,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL DEFAULT 'COACH', -- 'HEAD_COACH', 'COACH', 'ASSISTANT'
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(group_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_training_group_staff_user ON training_group_staff(club_id, user_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_training_group_sessions_group;
+DROP TABLE IF EXISTS training_group_sessions;
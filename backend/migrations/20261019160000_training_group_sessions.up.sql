@@ -0,0 +1,16 @@
+-- Structured weekly sessions of training groups; training_groups.schedule keeps a generated summary
+CREATE TABLE IF NOT EXISTS training_group_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    group_id UUID NOT NULL REFERENCES training_groups(id) ON DELETE CASCADE,
+    day_of_week INT NOT NULL CHECK (day_of_week BETWEEN 0 AND 6), -- 0 = Sunday
+    start_time VARCHAR(5) NOT NULL, -- 'HH:MM'
+    end_time VARCHAR(5) NOT NULL,
+    facility_id UUID,
+    valid_from DATE NOT NULL,
+    valid_until DATE NOT NULL,
+    recurring_rule_id UUID, -- CLASS rule holding the facility (booking module)
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_training_group_sessions_group ON training_group_sessions(club_id, group_id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS attendance_sync_operations;
+ALTER TABLE attendance_records DROP COLUMN IF EXISTS source;
+ALTER TABLE attendance_records DROP COLUMN IF EXISTS marked_by;
+ALTER TABLE attendance_records DROP COLUMN IF EXISTS marked_at;
+-- scanned_at is kept: it predates this migration in the model
@@ -0,0 +1,16 @@
+-- QR check-in and offline coach sync: which event decided each record, and processed idempotency keys
+ALTER TABLE attendance_records ADD COLUMN IF NOT EXISTS scanned_at TIMESTAMPTZ;
+ALTER TABLE attendance_records ADD COLUMN IF NOT EXISTS marked_at TIMESTAMPTZ; -- Client time of the last manual mark
+ALTER TABLE attendance_records ADD COLUMN IF NOT EXISTS marked_by VARCHAR(100);
+ALTER TABLE attendance_records ADD COLUMN IF NOT EXISTS source VARCHAR(10); -- 'MANUAL', 'SCAN'
+
+CREATE TABLE IF NOT EXISTS attendance_sync_operations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    idempotency_key VARCHAR(100) NOT NULL,
+    attendance_list_id UUID,
+    user_id VARCHAR(100),
+    outcome VARCHAR(20) NOT NULL, -- 'APPLIED', 'SUPERSEDED', 'REJECTED'
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(club_id, idempotency_key)
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_attendance_lists_group_date;
+DROP INDEX IF EXISTS idx_attendance_alerts_open;
+DROP TABLE IF EXISTS attendance_alerts;
@@ -0,0 +1,13 @@
+-- At-risk attendance alerts: one open alert per player, group and reason until the player recovers
+CREATE TABLE IF NOT EXISTS attendance_alerts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    training_group_id UUID NOT NULL REFERENCES training_groups(id) ON DELETE CASCADE,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason VARCHAR(30) NOT NULL, -- 'CONSECUTIVE_ABSENCES', 'LOW_ATTENDANCE'
+    value DOUBLE PRECISION NOT NULL DEFAULT 0, -- Streak length or attendance rate
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_attendance_alerts_open ON attendance_alerts(club_id, training_group_id) WHERE resolved_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_attendance_lists_group_date ON attendance_lists(club_id, training_group_id, date);
@@ -0,0 +1,3 @@
+ALTER TABLE attendance_records DROP COLUMN IF EXISTS absence_notice_id;
+DROP INDEX IF EXISTS idx_attendance_absence_notices_user;
+DROP TABLE IF EXISTS attendance_absence_notices;
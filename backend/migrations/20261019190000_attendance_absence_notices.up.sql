@@ -0,0 +1,17 @@
+-- Absences reported ahead of time by parents; covered records are marked EXCUSED on list generation
+CREATE TABLE IF NOT EXISTS attendance_absence_notices (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reported_by VARCHAR(100) NOT NULL,
+    training_group_id UUID REFERENCES training_groups(id) ON DELETE CASCADE, -- NULL covers every session
+    from_date DATE NOT NULL,
+    until_date DATE NOT NULL,
+    reason TEXT NOT NULL,
+    document_url TEXT,
+    cancelled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_attendance_absence_notices_user ON attendance_absence_notices(club_id, user_id, from_date);
+
+ALTER TABLE attendance_records ADD COLUMN IF NOT EXISTS absence_notice_id UUID REFERENCES attendance_absence_notices(id) ON DELETE SET NULL;
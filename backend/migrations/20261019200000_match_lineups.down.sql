@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS match_availability_reminders;
+DROP TABLE IF EXISTS match_lineup_players;
+DROP INDEX IF EXISTS idx_match_lineups_club_id;
+DROP TABLE IF EXISTS match_lineups;
@@ -0,0 +1,30 @@
+-- Lineup builder: squad and lineup per training group match, plus availability reminders sent
+CREATE TABLE IF NOT EXISTS match_lineups (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    match_event_id UUID NOT NULL UNIQUE,
+    formation VARCHAR(20),
+    status VARCHAR(20) NOT NULL DEFAULT 'DRAFT', -- 'DRAFT', 'LOCKED'
+    locked_at TIMESTAMPTZ,
+    locked_by VARCHAR(100),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_match_lineups_club_id ON match_lineups(club_id);
+
+CREATE TABLE IF NOT EXISTS match_lineup_players (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    lineup_id UUID NOT NULL REFERENCES match_lineups(id) ON DELETE CASCADE,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL, -- 'STARTER', 'SUBSTITUTE'
+    position VARCHAR(20),
+    shirt_number INT,
+    UNIQUE(lineup_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS match_availability_reminders (
+    match_event_id UUID NOT NULL,
+    user_id VARCHAR(100) NOT NULL,
+    sent_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (match_event_id, user_id)
+);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_travel_event_passengers_vehicle_id;
+DROP INDEX IF EXISTS idx_travel_passenger_event_user;
+DROP TABLE IF EXISTS travel_event_passengers;
+DROP INDEX IF EXISTS idx_travel_event_vehicles_club_id;
+DROP TABLE IF EXISTS travel_event_vehicles;
+DROP INDEX IF EXISTS idx_travel_event_charges_club_id;
+DROP TABLE IF EXISTS travel_event_charges;
@@ -0,0 +1,40 @@
+-- Travel logistics: per-participant charges collected through Payment and carpool planning
+CREATE TABLE IF NOT EXISTS travel_event_charges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    event_id UUID NOT NULL REFERENCES travel_events(id) ON DELETE CASCADE,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    amount DECIMAL(10,2) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING', -- 'PENDING', 'PAID', 'CANCELLED'
+    payment_id UUID,
+    paid_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(event_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_travel_event_charges_club_id ON travel_event_charges(club_id);
+
+CREATE TABLE IF NOT EXISTS travel_event_vehicles (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    event_id UUID NOT NULL REFERENCES travel_events(id) ON DELETE CASCADE,
+    driver_id VARCHAR(100) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    seats INT NOT NULL CHECK (seats > 0),
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(event_id, driver_id)
+);
+CREATE INDEX IF NOT EXISTS idx_travel_event_vehicles_club_id ON travel_event_vehicles(club_id);
+
+CREATE TABLE IF NOT EXISTS travel_event_passengers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES travel_events(id) ON DELETE CASCADE,
+    vehicle_id UUID NOT NULL REFERENCES travel_event_vehicles(id) ON DELETE CASCADE,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    requires_authorization BOOLEAN NOT NULL DEFAULT FALSE,
+    authorized_by VARCHAR(100),
+    authorized_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_travel_passenger_event_user ON travel_event_passengers(event_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_travel_event_passengers_vehicle_id ON travel_event_passengers(vehicle_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_travel_event_authorizations_parent_id;
+DROP INDEX IF EXISTS idx_travel_event_authorizations_club_id;
+DROP INDEX IF EXISTS idx_travel_authorization_event_minor;
+DROP TABLE IF EXISTS travel_event_authorizations;
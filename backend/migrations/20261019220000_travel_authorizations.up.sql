@@ -0,0 +1,22 @@
+-- Parental travel authorization: per-event e-signed consent for minors
+CREATE TABLE IF NOT EXISTS travel_event_authorizations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    event_id UUID NOT NULL REFERENCES travel_events(id) ON DELETE CASCADE,
+    minor_id VARCHAR(100) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    parent_id VARCHAR(100) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING', -- 'PENDING', 'SIGNED', 'REVOKED'
+    requested_by VARCHAR(100),
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    signed_name VARCHAR(255),
+    terms_hash VARCHAR(64), -- SHA-256 of the signed text
+    signed_at TIMESTAMPTZ,
+    ip_address VARCHAR(45),
+    user_agent TEXT,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_travel_authorization_event_minor ON travel_event_authorizations(event_id, minor_id);
+CREATE INDEX IF NOT EXISTS idx_travel_event_authorizations_club_id ON travel_event_authorizations(club_id);
+CREATE INDEX IF NOT EXISTS idx_travel_event_authorizations_parent_id ON travel_event_authorizations(parent_id);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_health_access_log_document;
+
+ALTER TABLE user_documents DROP COLUMN IF EXISTS file_name;
+ALTER TABLE user_documents DROP COLUMN IF EXISTS size_bytes;
+ALTER TABLE user_documents DROP COLUMN IF EXISTS content_type;
+ALTER TABLE user_documents DROP COLUMN IF EXISTS storage_key;
@@ -0,0 +1,10 @@
+-- User documents are stored in the configured FileStorage (local filesystem or S3/MinIO)
+ALTER TABLE user_documents ADD COLUMN IF NOT EXISTS storage_key TEXT;
+ALTER TABLE user_documents ADD COLUMN IF NOT EXISTS content_type VARCHAR(100);
+ALTER TABLE user_documents ADD COLUMN IF NOT EXISTS size_bytes BIGINT;
+ALTER TABLE user_documents ADD COLUMN IF NOT EXISTS file_name VARCHAR(255);
+
+COMMENT ON COLUMN user_documents.storage_key IS 'Object key in the file storage backend';
+COMMENT ON COLUMN user_documents.file_url IS 'Authenticated API path that streams the file';
+
+CREATE INDEX IF NOT EXISTS idx_health_access_log_document ON health_data_access_log(document_id) WHERE document_id IS NOT NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE user_documents DROP COLUMN IF EXISTS encrypted;
+
+DROP INDEX IF EXISTS idx_club_data_keys_active;
+DROP INDEX IF EXISTS idx_club_data_keys_version;
+DROP TABLE IF EXISTS club_data_keys;
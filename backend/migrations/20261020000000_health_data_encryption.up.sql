@@ -0,0 +1,28 @@
+-- Per-club data keys for envelope encryption, wrapped by the master key from configuration.
+-- Retired versions are kept so data encrypted with them can still be read.
+CREATE TABLE IF NOT EXISTS club_data_keys (
+    id UUID PRIMARY KEY,
+    club_id VARCHAR(100) NOT NULL,
+    version INT NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    master_key_id VARCHAR(50) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    retired_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_club_data_keys_version ON club_data_keys(club_id, version);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_club_data_keys_active ON club_data_keys(club_id) WHERE active;
+
+COMMENT ON TABLE club_data_keys IS 'Wrapped per-club data encryption keys (AES-256-GCM)';
+
+ALTER TABLE user_documents ADD COLUMN IF NOT EXISTS encrypted BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Sensitive user fields hold ciphertext ("enc:v1:..."), longer than the plaintext values
+ALTER TABLE users ADD COLUMN IF NOT EXISTS emergency_contact_name TEXT;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS emergency_contact_phone TEXT;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS insurance_provider TEXT;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS insurance_number TEXT;
+ALTER TABLE users ALTER COLUMN emergency_contact_name TYPE TEXT;
+ALTER TABLE users ALTER COLUMN emergency_contact_phone TYPE TEXT;
+ALTER TABLE users ALTER COLUMN insurance_number TYPE TEXT;
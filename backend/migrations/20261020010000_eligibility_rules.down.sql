@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_eligibility_rules_scope;
+DROP INDEX IF EXISTS idx_eligibility_rules_club;
+DROP TABLE IF EXISTS eligibility_rules;
@@ -0,0 +1,18 @@
+-- Document requirements per club, optionally narrowed to a discipline and/or category.
+-- The most specific matching rule applies; without rules DNI + medical certificate are required.
+CREATE TABLE IF NOT EXISTS eligibility_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    discipline_id UUID REFERENCES disciplines(id) ON DELETE CASCADE,
+    category VARCHAR(20) NOT NULL DEFAULT '',
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    requirements JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_eligibility_rules_club ON eligibility_rules(club_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_eligibility_rules_scope
+    ON eligibility_rules(club_id, COALESCE(discipline_id, '00000000-0000-0000-0000-000000000000'::uuid), category);
+
+COMMENT ON TABLE eligibility_rules IS 'Required documents (DNI, MEDICAL, INSURANCE, LEAGUE_FORM, PARENTAL_CONSENT) to be eligible to compete';
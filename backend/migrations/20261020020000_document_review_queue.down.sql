@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_user_documents_review_queue;
+
+ALTER TABLE user_documents
+    DROP COLUMN IF EXISTS escalated_at,
+    DROP COLUMN IF EXISTS claimed_at,
+    DROP COLUMN IF EXISTS reviewer_id,
+    DROP COLUMN IF EXISTS rejection_reason;
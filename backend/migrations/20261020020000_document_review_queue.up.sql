@@ -0,0 +1,14 @@
+-- Review queue for user documents: reviewer claims, standardized rejection reasons and SLA escalation.
+ALTER TABLE user_documents
+    ADD COLUMN IF NOT EXISTS rejection_reason VARCHAR(30) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS reviewer_id VARCHAR(100) REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS claimed_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS escalated_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_user_documents_review_queue
+    ON user_documents(club_id, created_at)
+    WHERE status = 'PENDING';
+
+COMMENT ON COLUMN user_documents.rejection_reason IS 'ILLEGIBLE, WRONG_DOCUMENT, EXPIRED, INCOMPLETE, NAME_MISMATCH, MISSING_SIGNATURE or OTHER';
+COMMENT ON COLUMN user_documents.reviewer_id IS 'Reviewer who claimed the document; the claim expires after two hours';
+COMMENT ON COLUMN user_documents.escalated_at IS 'When admins were notified that the review SLA was exceeded';
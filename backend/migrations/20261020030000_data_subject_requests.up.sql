@@ -0,0 +1,40 @@
+-- GDPR data subject requests (access/portability and erasure) processed asynchronously:
+-- identity verification, 30-day response deadline, export archive and retention-aware erasure.
+CREATE TABLE IF NOT EXISTS data_subject_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(100) NOT NULL,
+    user_id VARCHAR(100) NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    status VARCHAR(30) NOT NULL DEFAULT 'PENDING_VERIFICATION',
+    requested_by VARCHAR(100) NOT NULL,
+    verification_code_hash VARCHAR(64) NOT NULL DEFAULT '',
+    verification_expires_at TIMESTAMPTZ,
+    verification_attempts INT NOT NULL DEFAULT 0,
+    verification_method VARCHAR(20) NOT NULL DEFAULT '',
+    verified_at TIMESTAMPTZ,
+    verified_by VARCHAR(100),
+    due_at TIMESTAMPTZ NOT NULL,
+    deadline_warned_at TIMESTAMPTZ,
+    processing_attempts INT NOT NULL DEFAULT 0,
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    last_error TEXT NOT NULL DEFAULT '',
+    archive_key VARCHAR(255) NOT NULL DEFAULT '',
+    archive_encrypted BOOLEAN NOT NULL DEFAULT FALSE,
+    archive_expires_at TIMESTAMPTZ,
+    retained_data TEXT NOT NULL DEFAULT '',
+    rejection_reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_data_subject_requests_user ON data_subject_requests(club_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_data_subject_requests_open
+    ON data_subject_requests(club_id, due_at)
+    WHERE status NOT IN ('COMPLETED', 'REJECTED');
+
+COMMENT ON TABLE data_subject_requests IS 'GDPR Articles 15, 17 and 20 - data subject requests, due 30 days after receipt';
+COMMENT ON COLUMN data_subject_requests.type IS 'EXPORT or ERASURE';
+COMMENT ON COLUMN data_subject_requests.status IS 'PENDING_VERIFICATION, QUEUED, PROCESSING, COMPLETED, REJECTED or FAILED';
+COMMENT ON COLUMN data_subject_requests.archive_key IS 'Object storage key of the export ZIP; cleared when the download window ends';
+COMMENT ON COLUMN data_subject_requests.retained_data IS 'Records kept after erasure because the law requires it (financial records, consent proof)';
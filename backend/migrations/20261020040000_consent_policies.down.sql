@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_consent_records_active;
+ALTER TABLE consent_records DROP COLUMN IF EXISTS policy_id;
+DROP TABLE IF EXISTS consent_policies;
@@ -0,0 +1,23 @@
+-- Versioned consent policies (terms, privacy, health data, marketing). Publishing a new
+-- mandatory version forces users to accept it again before using the API.
+CREATE TABLE IF NOT EXISTS consent_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(255) NOT NULL,
+    consent_type VARCHAR(50) NOT NULL,
+    version VARCHAR(20) NOT NULL,
+    title VARCHAR(255) NOT NULL DEFAULT '',
+    content TEXT NOT NULL DEFAULT '',
+    url VARCHAR(500) NOT NULL DEFAULT '',
+    content_hash VARCHAR(64) NOT NULL,
+    mandatory BOOLEAN NOT NULL DEFAULT FALSE,
+    published_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_consent_policies_marketing_optional CHECK (NOT (mandatory AND consent_type = 'MARKETING'))
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_consent_policy_version ON consent_policies(club_id, consent_type, version);
+CREATE INDEX IF NOT EXISTS idx_consent_policies_published ON consent_policies(club_id, published_at);
+
+-- Each consent points to the policy version that was accepted
+ALTER TABLE consent_records ADD COLUMN IF NOT EXISTS policy_id UUID REFERENCES consent_policies(id);
+CREATE INDEX IF NOT EXISTS idx_consent_records_active ON consent_records(club_id, consent_type) WHERE accepted AND revoked_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_users_data_retention_until;
+DROP TABLE IF EXISTS data_retention_runs;
+DROP TABLE IF EXISTS data_retention_policies;
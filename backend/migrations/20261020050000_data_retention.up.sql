@@ -0,0 +1,29 @@
+-- Data retention: per-club retention periods by data category, and the audit trail of every
+-- retention run (what was anonymized or purged, with which period and cutoff).
+CREATE TABLE IF NOT EXISTS data_retention_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(255) NOT NULL,
+    category VARCHAR(50) NOT NULL,
+    retention_days INT NOT NULL CHECK (retention_days > 0),
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_by VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_data_retention_policy_category ON data_retention_policies(club_id, category);
+
+CREATE TABLE IF NOT EXISTS data_retention_runs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    club_id VARCHAR(255) NOT NULL,
+    dry_run BOOLEAN NOT NULL,
+    triggered_by VARCHAR(255) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL,
+    items JSONB NOT NULL DEFAULT '[]', -- Report per category
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_data_retention_runs_club ON data_retention_runs(club_id, started_at DESC);
+
+-- Expired accounts lookup
+CREATE INDEX IF NOT EXISTS idx_users_data_retention_until ON users(club_id, data_retention_until) WHERE data_retention_until IS NOT NULL;
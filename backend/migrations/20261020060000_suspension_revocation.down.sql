@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_player_suspensions_source_event;
+ALTER TABLE player_suspensions DROP COLUMN IF EXISTS revoked_at;
+ALTER TABLE player_suspensions DROP COLUMN IF EXISTS source_event_id;
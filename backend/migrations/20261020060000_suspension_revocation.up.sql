@@ -0,0 +1,6 @@
+-- Automatic suspensions keep the card that triggered them and are revoked when that card is deleted
+ALTER TABLE player_suspensions ADD COLUMN IF NOT EXISTS source_event_id UUID;
+ALTER TABLE player_suspensions ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS idx_player_suspensions_source_event ON player_suspensions(source_event_id);
+
+COMMENT ON COLUMN player_suspensions.source_event_id IS 'Tarjeta (match_events) que generó la sanción automática; sin FK para conservarla al borrar el evento';
@@ -0,0 +1,3 @@
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS chk_payments_payer;
+ALTER TABLE payments DROP COLUMN IF EXISTS payer_email;
+ALTER TABLE payments ALTER COLUMN payer_id SET NOT NULL;
@@ -0,0 +1,6 @@
+-- Payments from external payers (e.g. entry fees of teams from other clubs) have no user account
+ALTER TABLE payments ALTER COLUMN payer_id DROP NOT NULL;
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS payer_email VARCHAR(255);
+ALTER TABLE payments ADD CONSTRAINT chk_payments_payer CHECK (payer_id IS NOT NULL OR payer_email IS NOT NULL);
+
+COMMENT ON COLUMN payments.payer_email IS 'Email del pagador externo (sin cuenta en el club); payer_id queda en NULL';
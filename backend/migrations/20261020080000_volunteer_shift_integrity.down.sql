@@ -0,0 +1,4 @@
+ALTER TABLE volunteer_assignments DROP CONSTRAINT IF EXISTS chk_volunteer_assignments_credit;
+ALTER TABLE volunteer_assignments DROP CONSTRAINT IF EXISTS volunteer_assignments_shift_id_fkey;
+ALTER TABLE volunteer_assignments
+    ADD CONSTRAINT volunteer_assignments_shift_id_fkey FOREIGN KEY (shift_id) REFERENCES volunteer_shifts(id) ON DELETE CASCADE;
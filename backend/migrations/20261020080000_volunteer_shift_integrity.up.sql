@@ -0,0 +1,8 @@
+-- Deleting a shift no longer cascades to the sign-ups: worked hours must survive (the service refuses it)
+ALTER TABLE volunteer_assignments DROP CONSTRAINT IF EXISTS volunteer_assignments_shift_id_fkey;
+ALTER TABLE volunteer_assignments
+    ADD CONSTRAINT volunteer_assignments_shift_id_fkey FOREIGN KEY (shift_id) REFERENCES volunteer_shifts(id) ON DELETE RESTRICT;
+
+-- Credited hours always point to the LABOR_EXCHANGE payment that credited them (one credit per assignment)
+ALTER TABLE volunteer_assignments ADD CONSTRAINT chk_volunteer_assignments_credit
+    CHECK (hours_status <> 'CREDITED' OR credit_payment_id IS NOT NULL);
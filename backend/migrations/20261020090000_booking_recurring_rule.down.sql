@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_bookings_recurring_rule;
+ALTER TABLE bookings DROP COLUMN IF EXISTS recurring_rule_id;
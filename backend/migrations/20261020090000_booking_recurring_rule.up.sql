@@ -0,0 +1,15 @@
+-- Link generated bookings to their recurring rule so they can be cancelled with it
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS recurring_rule_id UUID;
+CREATE INDEX IF NOT EXISTS idx_bookings_recurring_rule ON bookings(recurring_rule_id) WHERE recurring_rule_id IS NOT NULL;
+
+-- Backfill: bookings generated before this column existed belong to the system user and match a rule's facility, weekday and start time
+UPDATE bookings b
+SET recurring_rule_id = r.id
+FROM recurring_rules r
+WHERE b.recurring_rule_id IS NULL
+  AND b.user_id = '00000000-0000-0000-0000-000000000000'
+  AND b.club_id = r.club_id
+  AND b.facility_id = r.facility_id
+  AND EXTRACT(DOW FROM b.start_time) = r.day_of_week
+  AND b.start_time::time = r.start_time::time
+  AND b.start_time::date BETWEEN r.start_date::date AND r.end_date::date;
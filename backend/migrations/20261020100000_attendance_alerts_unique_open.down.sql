@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_attendance_alerts_unique_open;
@@ -0,0 +1,16 @@
+-- One open alert per player, group and reason, even if two job runs overlap
+-- Close duplicates left by earlier overlapping runs, keeping the oldest open alert
+UPDATE attendance_alerts a
+SET resolved_at = NOW()
+WHERE a.resolved_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM attendance_alerts b
+    WHERE b.resolved_at IS NULL
+      AND b.club_id = a.club_id
+      AND b.training_group_id = a.training_group_id
+      AND b.user_id = a.user_id
+      AND b.reason = a.reason
+      AND (b.created_at < a.created_at OR (b.created_at = a.created_at AND b.id < a.id))
+  );
+CREATE UNIQUE INDEX IF NOT EXISTS idx_attendance_alerts_unique_open
+    ON attendance_alerts(club_id, training_group_id, user_id, reason) WHERE resolved_at IS NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE attendance_absence_notices DROP COLUMN IF EXISTS document_encrypted;
+ALTER TABLE attendance_absence_notices DROP COLUMN IF EXISTS document_size_bytes;
+ALTER TABLE attendance_absence_notices DROP COLUMN IF EXISTS document_content_type;
+ALTER TABLE attendance_absence_notices DROP COLUMN IF EXISTS document_key;
@@ -0,0 +1,11 @@
+-- Medical certificates of absence notices are stored in the configured FileStorage, encrypted at rest
+ALTER TABLE attendance_absence_notices ADD COLUMN IF NOT EXISTS document_key TEXT;
+ALTER TABLE attendance_absence_notices ADD COLUMN IF NOT EXISTS document_content_type VARCHAR(100);
+ALTER TABLE attendance_absence_notices ADD COLUMN IF NOT EXISTS document_size_bytes BIGINT;
+ALTER TABLE attendance_absence_notices ADD COLUMN IF NOT EXISTS document_encrypted BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Earlier uploads only stored a placeholder path, the file was never kept
+UPDATE attendance_absence_notices SET document_url = NULL WHERE document_key IS NULL;
+
+COMMENT ON COLUMN attendance_absence_notices.document_key IS 'Object key of the medical certificate in the file storage backend';
+COMMENT ON COLUMN attendance_absence_notices.document_url IS 'Authenticated API path that streams the medical certificate';
@@ -14,6 +14,7 @@ import (
 	"github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/domain"
 	attendanceHttp "github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/infrastructure/http"
 	attendanceRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/attendance/infrastructure/repository"
+	disciplineRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/disciplines/infrastructure/repository"
 	membershipDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/membership/domain"
 	membershipRepo "github.com/lukcba/club-pulse-system-api/backend/internal/modules/membership/infrastructure/repository"
 	userDomain "github.com/lukcba/club-pulse-system-api/backend/internal/modules/user/domain"
@@ -40,7 +41,7 @@ func TestAttendanceFlow(t *testing.T) {
 	uRepo := userRepo.NewPostgresUserRepository(db)
 	aRepo := attendanceRepo.NewPostgresAttendanceRepository(db)
 	mRepo := membershipRepo.NewPostgresMembershipRepository(db)
-	aUseCase := attendanceApp.NewAttendanceUseCases(aRepo, uRepo, mRepo)
+	aUseCase := attendanceApp.NewAttendanceUseCases(aRepo, uRepo, mRepo, disciplineRepo.NewPostgresEnrollmentRepository(db))
 
 	aHandler := attendanceHttp.NewAttendanceHandler(aUseCase)
 
@@ -59,7 +59,7 @@ func TestChampionshipIsolation(t *testing.T) {
 	clubUC := clubApp.NewClubUseCases(cRepo, cRepo, cRepo, notifier)
 
 	bookingAdapter := championshipSvc.NewChampionshipBookingAdapter(nil)
-	champUC := championshipApp.NewChampionshipUseCases(champRepo, bookingAdapter, nil)
+	champUC := championshipApp.NewChampionshipUseCases(champRepo, bookingAdapter, nil, nil)
 
 	// Handler
 	handler := championshipHttp.NewChampionshipHandler(champUC, nil, clubUC)
@@ -41,7 +41,7 @@ func TestChampionshipsFlow(t *testing.T) {
 	userR := userRepo.NewPostgresUserRepository(db)
 	dRepo := disciplineRepo.NewPostgresDisciplineRepository(db)
 	tRepo := disciplineRepo.NewPostgresTournamentRepository(db)
-	dUC := disciplineApp.NewDisciplineUseCases(dRepo, tRepo, userR)
+	dUC := disciplineApp.NewDisciplineUseCases(dRepo, tRepo, userR, disciplineRepo.NewPostgresEnrollmentRepository(db))
 	dHandler := disciplineHttp.NewDisciplineHandler(dUC)
 
 	// Auth Setup for Helper
@@ -77,7 +77,7 @@ func TestKnockoutBracketGeneration(t *testing.T) {
 	bookingUC := bookingApp.NewBookingUseCases(bookingR, recurringR, facR, clubRepository, userR, nil, nil)
 	bookingAdapter := champSvc.NewChampionshipBookingAdapter(bookingUC)
 
-	champUC := champApp.NewChampionshipUseCases(champRepository, bookingAdapter, userUC)
+	champUC := champApp.NewChampionshipUseCases(champRepository, bookingAdapter, userUC, nil)
 	volunteerService := champApp.NewVolunteerService(volunteerRepository)
 	clubUC := clubApp.NewClubUseCases(clubRepository, clubRepository, clubRepository, nil)
 
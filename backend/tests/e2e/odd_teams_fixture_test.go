@@ -26,7 +26,7 @@ func TestOddTeamsFixtureGeneration(t *testing.T) {
 	_ = db.AutoMigrate(&domain.Tournament{}, &domain.TournamentStage{}, &domain.Group{}, &domain.Standing{}, &domain.TournamentMatch{}, &domain.Team{})
 
 	repo := championshipRepo.NewPostgresChampionshipRepository(db)
-	uc := championshipApp.NewChampionshipUseCases(repo, nil, nil)
+	uc := championshipApp.NewChampionshipUseCases(repo, nil, nil, nil)
 	h := championshipHttp.NewChampionshipHandler(uc, nil, nil)
 
 	r := gin.New()
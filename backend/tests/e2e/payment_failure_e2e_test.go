@@ -101,6 +101,7 @@ func TestPaymentFailureFlow(t *testing.T) {
 	// Data Setup
 	paymentID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
 	bookingID := uuid.New()
+	payerID := uuid.New()
 
 	// Create Booking
 	db.Create(&bookingDomain.Booking{
@@ -117,7 +118,7 @@ func TestPaymentFailureFlow(t *testing.T) {
 		ReferenceType: "BOOKING",
 		Status:        paymentDomain.PaymentStatusPending,
 		Amount:        decimal.NewFromFloat(100.0),
-		PayerID:       uuid.New(),
+		PayerID:       &payerID,
 		Method:        paymentDomain.PaymentMethodMercadoPago,
 		ExternalID:    "12345",
 	})
@@ -46,13 +46,14 @@ func TestPaymentWebhookFlow(t *testing.T) {
 	amount := decimal.NewFromFloat(5000.00)
 
 	paymentID := uuid.New()
+	payerID := uuid.New()
 	payment := &domain.Payment{
 		ID:            paymentID,
 		Amount:        amount,
 		Currency:      "ARS",
 		Status:        domain.PaymentStatusPending,
 		Method:        domain.PaymentMethodMercadoPago,
-		PayerID:       uuid.New(),
+		PayerID:       &payerID,
 		ReferenceID:   uuid.New(),
 		ReferenceType: "MEMBERSHIP",
 	}